@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"emperror.dev/errors"
+	"github.com/golang-jwt/jwt"
+	"time"
+)
+
+// BearerScheme is the scheme of the authorization header which carries the access token of the user
+const BearerScheme = "Bearer"
+
+type JwtConfig struct {
+	SigningKey string `mapstructure:"signingKey" env:"SigningKey"`
+	Issuer     string `mapstructure:"issuer" env:"Issuer"`
+}
+
+// UserClaims are the claims of the access token, the subject is the id of the user
+type UserClaims struct {
+	Email string `json:"email"`
+	jwt.StandardClaims
+}
+
+// ParseAccessToken verifies the signature, the expiry and the issuer of the HS256 access token and returns its claims, a token without an
+// expiry is rejected
+func ParseAccessToken(config *JwtConfig, accessToken string) (*UserClaims, error) {
+	if config.SigningKey == "" {
+		return nil, errors.New("the signing key of the access tokens is not configured")
+	}
+
+	claims := &UserClaims{}
+	_, err := jwt.ParseWithClaims(accessToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, errors.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return []byte(config.SigningKey), nil
+	})
+	if err != nil {
+		return nil, errors.WrapIf(err, "jwt.ParseWithClaims")
+	}
+
+	// the standard claims accept a token without an expiry, it would never expire
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.New("the access token has no expiry")
+	}
+	if config.Issuer != "" && !claims.VerifyIssuer(config.Issuer, true) {
+		return nil, errors.Errorf("unexpected issuer %s", claims.Issuer)
+	}
+	if claims.Email == "" {
+		return nil, errors.New("the access token has no email claim")
+	}
+
+	return claims, nil
+}
+
+// NewAccessToken signs an HS256 access token for the user which expires after expiresIn, a zero expiresIn keeps the expiry of the claims.
+// the services only verify tokens and it is used by the tests
+func NewAccessToken(config *JwtConfig, claims *UserClaims, expiresIn time.Duration) (string, error) {
+	if claims.Issuer == "" {
+		claims.Issuer = config.Issuer
+	}
+	if expiresIn > 0 {
+		claims.ExpiresAt = time.Now().Add(expiresIn).Unix()
+	}
+
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(config.SigningKey))
+	if err != nil {
+		return "", errors.WrapIf(err, "jwt.SignedString")
+	}
+
+	return accessToken, nil
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"emperror.dev/errors"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency code
+type Currency string
+
+const (
+	USD Currency = "USD"
+	EUR Currency = "EUR"
+	GBP Currency = "GBP"
+	CHF Currency = "CHF"
+	CAD Currency = "CAD"
+	AUD Currency = "AUD"
+	CNY Currency = "CNY"
+	INR Currency = "INR"
+	JPY Currency = "JPY"
+	KRW Currency = "KRW"
+	KWD Currency = "KWD"
+	BHD Currency = "BHD"
+)
+
+// currencyExponents are the number of the digits of the minor units of the supported currencies
+var currencyExponents = map[Currency]int32{
+	USD: 2,
+	EUR: 2,
+	GBP: 2,
+	CHF: 2,
+	CAD: 2,
+	AUD: 2,
+	CNY: 2,
+	INR: 2,
+	JPY: 0,
+	KRW: 0,
+	KWD: 3,
+	BHD: 3,
+}
+
+func ParseCurrency(code string) (Currency, error) {
+	currency := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if !currency.IsValid() {
+		return "", errors.Errorf("currency %q is not supported", code)
+	}
+
+	return currency, nil
+}
+
+func (c Currency) IsValid() bool {
+	_, ok := currencyExponents[c]
+	return ok
+}
+
+// Exponent is the number of the fraction digits of the currency amounts, 2 for cents
+func (c Currency) Exponent() int32 {
+	return currencyExponents[c]
+}
+
+func (c Currency) String() string {
+	return string(c)
+}
+
+// GormDataType is the column type of the currency fields in the gorm migrations
+func (c Currency) GormDataType() string {
+	return "char(3)"
+}
+
+// Value writes the currency code, an empty currency is stored as an empty text
+func (c Currency) Value() (driver.Value, error) {
+	return string(c), nil
+}
+
+// Scan trims the padding of the char(3) columns, so the empty currency of a zero money is read back as empty
+func (c *Currency) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		*c = Currency(strings.TrimSpace(v))
+	case []byte:
+		*c = Currency(strings.TrimSpace(string(v)))
+	case nil:
+		*c = ""
+	default:
+		return errors.Errorf("[Currency_Scan] can't scan %T to currency", src)
+	}
+
+	return nil
+}
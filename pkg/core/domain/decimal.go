@@ -0,0 +1,392 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"emperror.dev/errors"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// maxDecimalScale is the max number of the fraction digits, the longer fractions are rounded half to even
+const maxDecimalScale = 18
+
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds the half values away from zero, 2.345 -> 2.35 and -2.345 -> -2.35
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds the half values to the even neighbour, 2.345 -> 2.34 and 2.355 -> 2.36
+	RoundHalfEven
+	// RoundDown truncates the extra digits, 2.349 -> 2.34
+	RoundDown
+)
+
+// ErrDecimalOutOfRange is returned when the unscaled value of a decimal doesn't fit in an int64
+var ErrDecimalOutOfRange = errors.New("decimal is out of range")
+
+// Decimal is an exact decimal number, value * 10^-scale. The arithmetic panics when the unscaled value doesn't fit in an int64, so it is for the bounded
+// values like rates, the amounts of the client input should be calculated by Money which returns ErrDecimalOutOfRange instead
+type Decimal struct {
+	value int64
+	scale int32
+}
+
+func NewDecimal(value int64, scale int32) Decimal {
+	if scale < 0 {
+		return fromBig(new(big.Int).Mul(big.NewInt(value), pow10(-scale)), 0)
+	}
+	if scale > maxDecimalScale {
+		return roundBig(big.NewInt(value), scale, maxDecimalScale, RoundHalfEven)
+	}
+
+	return Decimal{value: value, scale: scale}
+}
+
+// ParseDecimal parses a decimal number like `12.50`, `-0.5` or `1.2e3`
+func ParseDecimal(s string) (Decimal, error) {
+	text := strings.TrimSpace(s)
+	mantissa, exponent := text, int64(0)
+	if i := strings.IndexAny(text, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(text[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, errors.Errorf("invalid decimal %q", s)
+		}
+		mantissa, exponent = text[:i], e
+	}
+
+	sign := ""
+	if strings.HasPrefix(mantissa, "-") || strings.HasPrefix(mantissa, "+") {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	integer, fraction, _ := strings.Cut(mantissa, ".")
+	if integer == "" && fraction == "" || !isDigits(integer) || !isDigits(fraction) {
+		return Decimal{}, errors.Errorf("invalid decimal %q", s)
+	}
+
+	value, ok := new(big.Int).SetString(sign+integer+fraction, 10)
+	if !ok {
+		return Decimal{}, errors.Errorf("invalid decimal %q", s)
+	}
+	scale := int64(len(fraction)) - exponent
+	if scale < 0 {
+		value.Mul(value, pow10(int32(-scale)))
+		scale = 0
+	}
+	if scale > maxDecimalScale {
+		value = roundBigValue(value, int32(scale), maxDecimalScale, RoundHalfEven)
+		scale = maxDecimalScale
+	}
+	if !value.IsInt64() {
+		return Decimal{}, errors.WithMessagef(ErrDecimalOutOfRange, "decimal %q", s)
+	}
+
+	return Decimal{value: value.Int64(), scale: int32(scale)}, nil
+}
+
+// NewDecimalFromFloat converts the shortest representation of the float, so 0.1 is converted to 0.1 and not to its binary approximation
+func NewDecimalFromFloat(f float64) (Decimal, error) {
+	return ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+func (d Decimal) Sign() int {
+	switch {
+	case d.value > 0:
+		return 1
+	case d.value < 0:
+		return -1
+	}
+	return 0
+}
+
+func (d Decimal) IsZero() bool {
+	return d.value == 0
+}
+
+func (d Decimal) Neg() Decimal {
+	return fromBig(new(big.Int).Neg(big.NewInt(d.value)), d.scale)
+}
+
+func (d Decimal) Add(other Decimal) Decimal {
+	return mustDecimal(d.add(other))
+}
+
+func (d Decimal) Sub(other Decimal) Decimal {
+	return mustDecimal(d.sub(other))
+}
+
+func (d Decimal) Mul(other Decimal) Decimal {
+	return mustDecimal(d.mul(other))
+}
+
+func (d Decimal) MulInt(n int64) Decimal {
+	return fromBig(new(big.Int).Mul(big.NewInt(d.value), big.NewInt(n)), d.scale)
+}
+
+// MulDiv multiplies the decimal by numerator / denominator and rounds the result to the scale with the rounding mode, the denominator shouldn't be zero
+func (d Decimal) MulDiv(numerator Decimal, denominator Decimal, scale int32, mode RoundingMode) Decimal {
+	if denominator.IsZero() {
+		panic("decimal division by zero")
+	}
+
+	return mustDecimal(d.mulDiv(numerator, denominator, scale, mode))
+}
+
+// Round rounds the decimal to the scale with the rounding mode, a larger scale only adds trailing zeros
+func (d Decimal) Round(scale int32, mode RoundingMode) Decimal {
+	return mustDecimal(d.round(scale, mode))
+}
+
+// Cmp returns -1, 0 or 1 when the decimal is less than, equal to or greater than the other decimal
+func (d Decimal) Cmp(other Decimal) int {
+	scale := maxScale(d.scale, other.scale)
+	return d.rescaled(scale).Cmp(other.rescaled(scale))
+}
+
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+func (d Decimal) String() string {
+	digits := strconv.FormatInt(d.value, 10)
+	sign := ""
+	if d.value < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if d.scale == 0 {
+		return sign + digits
+	}
+	if len(digits) <= int(d.scale) {
+		digits = strings.Repeat("0", int(d.scale)-len(digits)+1) + digits
+	}
+	point := len(digits) - int(d.scale)
+
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// MarshalJSON writes the decimal as a json string, so the json clients don't lose the precision in their floats
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON reads a json string or a json number
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	text := string(data)
+	if text == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(text); err == nil {
+		text = unquoted
+	}
+
+	parsed, err := ParseDecimal(text)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+
+	return nil
+}
+
+// MarshalBSONValue writes the decimal as a Decimal128, so mongo compares and sorts it as a number
+func (d Decimal) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	decimal128, err := primitive.ParseDecimal128(d.String())
+	if err != nil {
+		return 0, nil, errors.WrapIf(err, "[Decimal_MarshalBSONValue] error in converting the decimal to Decimal128")
+	}
+
+	return bsontype.Decimal128, bsoncore.AppendDecimal128(nil, decimal128), nil
+}
+
+// UnmarshalBSONValue reads a Decimal128, a double, an integer or a string
+func (d *Decimal) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	value := bsoncore.Value{Type: t, Data: data}
+
+	var parsed Decimal
+	var err error
+	switch t {
+	case bsontype.Decimal128:
+		parsed, err = ParseDecimal(value.Decimal128().String())
+	case bsontype.Double:
+		parsed, err = NewDecimalFromFloat(value.Double())
+	case bsontype.Int32:
+		parsed = NewDecimal(int64(value.Int32()), 0)
+	case bsontype.Int64:
+		parsed = NewDecimal(value.Int64(), 0)
+	case bsontype.String:
+		parsed, err = ParseDecimal(value.StringValue())
+	case bsontype.Null:
+		return nil
+	default:
+		return errors.Errorf("[Decimal_UnmarshalBSONValue] can't decode bson type %s to decimal", t)
+	}
+	if err != nil {
+		return err
+	}
+	*d = parsed
+
+	return nil
+}
+
+// Value writes the decimal as a string, the sql numeric columns keep it exact
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+func (d *Decimal) Scan(src interface{}) error {
+	var parsed Decimal
+	var err error
+	switch v := src.(type) {
+	case string:
+		parsed, err = ParseDecimal(v)
+	case []byte:
+		parsed, err = ParseDecimal(string(v))
+	case float64:
+		parsed, err = NewDecimalFromFloat(v)
+	case int64:
+		parsed = NewDecimal(v, 0)
+	default:
+		return errors.Errorf("[Decimal_Scan] can't scan %T to decimal", src)
+	}
+	if err != nil {
+		return err
+	}
+	*d = parsed
+
+	return nil
+}
+
+// GormDataType is the column type of the decimal fields in the gorm migrations
+func (d Decimal) GormDataType() string {
+	return "numeric"
+}
+
+func (d Decimal) add(other Decimal) (Decimal, error) {
+	scale := maxScale(d.scale, other.scale)
+	return checkedFromBig(new(big.Int).Add(d.rescaled(scale), other.rescaled(scale)), scale)
+}
+
+func (d Decimal) sub(other Decimal) (Decimal, error) {
+	scale := maxScale(d.scale, other.scale)
+	return checkedFromBig(new(big.Int).Sub(d.rescaled(scale), other.rescaled(scale)), scale)
+}
+
+func (d Decimal) mul(other Decimal) (Decimal, error) {
+	value := new(big.Int).Mul(big.NewInt(d.value), big.NewInt(other.value))
+	scale := d.scale + other.scale
+	if scale > maxDecimalScale {
+		return checkedFromBig(roundBigValue(value, scale, maxDecimalScale, RoundHalfEven), maxDecimalScale)
+	}
+
+	return checkedFromBig(value, scale)
+}
+
+func (d Decimal) mulUint(n uint64) (Decimal, error) {
+	return checkedFromBig(new(big.Int).Mul(big.NewInt(d.value), new(big.Int).SetUint64(n)), d.scale)
+}
+
+func (d Decimal) mulDiv(numerator Decimal, denominator Decimal, scale int32, mode RoundingMode) (Decimal, error) {
+	if denominator.IsZero() {
+		return Decimal{}, errors.New("decimal division by zero")
+	}
+
+	// d * numerator / denominator at the scale is d.value * numerator * 10^scale / (denominator * 10^d.scale)
+	s := maxScale(numerator.scale, denominator.scale)
+	value := new(big.Int).Mul(big.NewInt(d.value), numerator.rescaled(s))
+	value.Mul(value, pow10(scale))
+	divisor := new(big.Int).Mul(denominator.rescaled(s), pow10(d.scale))
+
+	return checkedFromBig(roundQuo(value, divisor, mode), scale)
+}
+
+func (d Decimal) round(scale int32, mode RoundingMode) (Decimal, error) {
+	if scale >= d.scale {
+		return checkedFromBig(d.rescaled(scale), scale)
+	}
+
+	return checkedFromBig(roundBigValue(big.NewInt(d.value), d.scale, scale, mode), scale)
+}
+
+func (d Decimal) rescaled(scale int32) *big.Int {
+	return new(big.Int).Mul(big.NewInt(d.value), pow10(scale-d.scale))
+}
+
+func fromBig(value *big.Int, scale int32) Decimal {
+	return mustDecimal(checkedFromBig(value, scale))
+}
+
+func checkedFromBig(value *big.Int, scale int32) (Decimal, error) {
+	if !value.IsInt64() {
+		return Decimal{}, errors.WithMessagef(ErrDecimalOutOfRange, "decimal %s with scale %d", value, scale)
+	}
+
+	return Decimal{value: value.Int64(), scale: scale}, nil
+}
+
+func mustDecimal(d Decimal, err error) Decimal {
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+func roundBig(value *big.Int, fromScale int32, toScale int32, mode RoundingMode) Decimal {
+	return fromBig(roundBigValue(value, fromScale, toScale, mode), toScale)
+}
+
+// roundBigValue drops the fraction digits between the two scales with the rounding mode
+func roundBigValue(value *big.Int, fromScale int32, toScale int32, mode RoundingMode) *big.Int {
+	return roundQuo(value, pow10(fromScale-toScale), mode)
+}
+
+// roundQuo divides the value by the divisor and rounds the quotient with the rounding mode
+func roundQuo(value *big.Int, divisor *big.Int, mode RoundingMode) *big.Int {
+	if divisor.Sign() < 0 {
+		value, divisor = new(big.Int).Neg(value), new(big.Int).Neg(divisor)
+	}
+	quotient, remainder := new(big.Int).QuoRem(value, divisor, new(big.Int))
+	if remainder.Sign() == 0 || mode == RoundDown {
+		return quotient
+	}
+
+	// compares twice the remainder with the divisor to find out whether the dropped digits are more than a half
+	half := new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(divisor)
+	if half > 0 || half == 0 && (mode == RoundHalfUp || quotient.Bit(0) == 1) {
+		quotient.Add(quotient, big.NewInt(int64(value.Sign())))
+	}
+
+	return quotient
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func maxScale(a int32, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
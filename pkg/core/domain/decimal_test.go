@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"testing"
+)
+
+func Test_Parse_Decimal(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{"12.50", "12.50"},
+		{"-0.5", "-0.5"},
+		{".25", "0.25"},
+		{"7", "7"},
+		{"+3.10", "3.10"},
+		{"1.2e3", "1200"},
+		{"125e-2", "1.25"},
+	}
+	for _, test := range tests {
+		d, err := ParseDecimal(test.text)
+		require.NoError(t, err, test.text)
+		assert.Equal(t, test.expected, d.String(), test.text)
+	}
+
+	for _, text := range []string{"", "abc", "1.2.3", "--1", "1e", "."} {
+		_, err := ParseDecimal(text)
+		assert.Error(t, err, text)
+	}
+}
+
+func Test_Decimal_Arithmetic(t *testing.T) {
+	a, _ := ParseDecimal("0.1")
+	b, _ := ParseDecimal("0.2")
+	assert.Equal(t, "0.3", a.Add(b).String())
+	assert.Equal(t, "-0.1", a.Sub(b).String())
+	assert.Equal(t, "0.02", a.Mul(b).String())
+	assert.Equal(t, "0.7", a.MulInt(7).String())
+	assert.Equal(t, -1, a.Cmp(b))
+
+	c, _ := ParseDecimal("0.30")
+	assert.True(t, a.Add(b).Equal(c))
+}
+
+func Test_Decimal_Round(t *testing.T) {
+	tests := []struct {
+		text     string
+		mode     RoundingMode
+		expected string
+	}{
+		{"2.345", RoundHalfUp, "2.35"},
+		{"-2.345", RoundHalfUp, "-2.35"},
+		{"2.344", RoundHalfUp, "2.34"},
+		{"2.345", RoundHalfEven, "2.34"},
+		{"2.355", RoundHalfEven, "2.36"},
+		{"-2.355", RoundHalfEven, "-2.36"},
+		{"2.3451", RoundHalfEven, "2.35"},
+		{"2.349", RoundDown, "2.34"},
+		{"-2.349", RoundDown, "-2.34"},
+		{"2.3", RoundHalfUp, "2.30"},
+	}
+	for _, test := range tests {
+		d, err := ParseDecimal(test.text)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, d.Round(2, test.mode).String(), test.text)
+	}
+}
+
+func Test_Decimal_Mul_Div(t *testing.T) {
+	a, _ := ParseDecimal("10")
+	b, _ := ParseDecimal("2.5")
+	c, _ := ParseDecimal("7.5")
+	assert.Equal(t, "3.33", a.MulDiv(b, c, 2, RoundHalfUp).String())
+	assert.Equal(t, "3.333", a.MulDiv(b, c, 3, RoundDown).String())
+	assert.Equal(t, "-3.33", a.Neg().MulDiv(b, c, 2, RoundHalfUp).String())
+	assert.Equal(t, "-3.33", a.MulDiv(b, c.Neg(), 2, RoundHalfUp).String())
+	assert.Equal(t, "0.12", b.MulDiv(b, a.MulInt(5), 2, RoundHalfEven).String())
+}
+
+func Test_Decimal_From_Float(t *testing.T) {
+	d, err := NewDecimalFromFloat(0.1 + 0.2)
+	require.NoError(t, err)
+	assert.Equal(t, "0.30", d.Round(2, RoundHalfUp).String())
+
+	d, err = NewDecimalFromFloat(1.005)
+	require.NoError(t, err)
+	assert.Equal(t, "1.01", d.Round(2, RoundHalfUp).String())
+}
+
+func Test_Decimal_Json(t *testing.T) {
+	d, _ := ParseDecimal("12.50")
+	data, err := json.Marshal(d)
+	require.NoError(t, err)
+	assert.Equal(t, `"12.50"`, string(data))
+
+	var fromString, fromNumber Decimal
+	require.NoError(t, json.Unmarshal([]byte(`"12.50"`), &fromString))
+	require.NoError(t, json.Unmarshal([]byte(`12.5`), &fromNumber))
+	assert.True(t, fromString.Equal(fromNumber))
+}
+
+func Test_Decimal_Bson(t *testing.T) {
+	type document struct {
+		Price Decimal `bson:"price"`
+	}
+
+	d, _ := ParseDecimal("12.50")
+	data, err := bson.Marshal(document{Price: d})
+	require.NoError(t, err)
+
+	var decoded document
+	require.NoError(t, bson.Unmarshal(data, &decoded))
+	assert.Equal(t, "12.50", decoded.Price.String())
+
+	// the documents of the float prices are still readable
+	data, err = bson.Marshal(bson.M{"price": 12.5})
+	require.NoError(t, err)
+	require.NoError(t, bson.Unmarshal(data, &decoded))
+	assert.Equal(t, "12.5", decoded.Price.String())
+}
+
+func Test_Decimal_Sql(t *testing.T) {
+	d, _ := ParseDecimal("12.50")
+	value, err := d.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "12.50", value)
+
+	var scanned Decimal
+	require.NoError(t, scanned.Scan([]byte("99.99")))
+	assert.Equal(t, "99.99", scanned.String())
+}
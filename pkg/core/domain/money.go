@@ -0,0 +1,200 @@
+package domain
+
+import (
+	"emperror.dev/errors"
+	"encoding/json"
+	"fmt"
+)
+
+// ErrCurrencyMismatch is returned by the arithmetic of the amounts of two different currencies
+var ErrCurrencyMismatch = errors.New("money currencies don't match")
+
+// Money is an amount in a currency, the constructors and the arithmetic round the amount half up to the minor unit of the currency.
+// The amounts which don't fit in the decimal range return ErrDecimalOutOfRange, so the client amounts and quantities can't overflow them.
+// The fields are exported for the gorm embedded columns, the values should be created by the constructors
+type Money struct {
+	Amount   Decimal  `json:"amount" bson:"amount"`
+	Currency Currency `json:"currency" bson:"currency"`
+}
+
+func NewMoney(amount Decimal, currency Currency) (Money, error) {
+	if !currency.IsValid() {
+		return Money{}, errors.Errorf("currency %q is not supported", currency)
+	}
+
+	rounded, err := amount.round(currency.Exponent(), RoundHalfUp)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: rounded, Currency: currency}, nil
+}
+
+// ParseMoney creates the money of a decimal amount text like `12.50` and a currency code
+func ParseMoney(amount string, currency string) (Money, error) {
+	decimal, err := ParseDecimal(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	c, err := ParseCurrency(currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return NewMoney(decimal, c)
+}
+
+// MustParseMoney is like ParseMoney but panics if the amount or the currency is invalid, it is for the known amounts like the test prices
+func MustParseMoney(amount string, currency string) Money {
+	money, err := ParseMoney(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+
+	return money
+}
+
+func NewMoneyFromFloat(amount float64, currency Currency) (Money, error) {
+	decimal, err := NewDecimalFromFloat(amount)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return NewMoney(decimal, currency)
+}
+
+func ZeroMoney(currency Currency) Money {
+	return Money{Amount: NewDecimal(0, currency.Exponent()), Currency: currency}
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, errors.WithDetails(ErrCurrencyMismatch, "currency", m.Currency, "otherCurrency", other.Currency)
+	}
+
+	sum, err := m.Amount.add(other.Amount)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return NewMoney(sum, m.Currency)
+}
+
+func (m Money) Subtract(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, errors.WithDetails(ErrCurrencyMismatch, "currency", m.Currency, "otherCurrency", other.Currency)
+	}
+
+	difference, err := m.Amount.sub(other.Amount)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return NewMoney(difference, m.Currency)
+}
+
+// Multiply multiplies the amount by a quantity like the quantity of an order item
+func (m Money) Multiply(quantity uint64) (Money, error) {
+	amount, err := m.Amount.mulUint(quantity)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: amount, Currency: m.Currency}, nil
+}
+
+// MultiplyBy multiplies the amount by a decimal factor like a rate and rounds the result with the rounding mode
+func (m Money) MultiplyBy(factor Decimal, mode RoundingMode) (Money, error) {
+	amount, err := m.Amount.mul(factor)
+	if err != nil {
+		return Money{}, err
+	}
+	amount, err = amount.round(m.Currency.Exponent(), mode)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: amount, Currency: m.Currency}, nil
+}
+
+// Prorate is the share of the money in proportion to the part of the whole, like the discount of some items of a basket, it is rounded half up
+func (m Money) Prorate(part Money, whole Money) (Money, error) {
+	if m.Currency != part.Currency || m.Currency != whole.Currency {
+		return Money{}, errors.WithDetails(ErrCurrencyMismatch, "currency", m.Currency, "partCurrency", part.Currency, "wholeCurrency", whole.Currency)
+	}
+	if whole.IsZero() {
+		return Money{}, errors.New("money can't be prorated on a zero whole")
+	}
+
+	amount, err := m.Amount.mulDiv(part.Amount, whole.Amount, m.Currency.Exponent(), RoundHalfUp)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: amount, Currency: m.Currency}, nil
+}
+
+// Compare returns -1, 0 or 1 when the money is less than, equal to or greater than the other money
+func (m Money) Compare(other Money) (int, error) {
+	if m.Currency != other.Currency {
+		return 0, errors.WithDetails(ErrCurrencyMismatch, "currency", m.Currency, "otherCurrency", other.Currency)
+	}
+
+	return m.Amount.Cmp(other.Amount), nil
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount.IsZero()
+}
+
+func (m Money) IsPositive() bool {
+	return m.Amount.Sign() > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount.Sign() < 0
+}
+
+func (m Money) String() string {
+	return fmt.Sprintf("%s %s", m.Amount, m.Currency)
+}
+
+// UnmarshalJSON validates the currency and rounds the amount like the constructors, a zero amount without a currency is the zero value
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var raw struct {
+		Amount   Decimal  `json:"amount"`
+		Currency Currency `json:"currency"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Currency == "" && raw.Amount.IsZero() {
+		*m = Money{}
+		return nil
+	}
+
+	money, err := NewMoney(raw.Amount, raw.Currency)
+	if err != nil {
+		return err
+	}
+	*m = money
+
+	return nil
+}
+
+// SumMoney adds up the amounts, all of them should be in the currency
+func SumMoney(currency Currency, amounts ...Money) (Money, error) {
+	sum := ZeroMoney(currency)
+	for _, amount := range amounts {
+		var err error
+		if sum, err = sum.Add(amount); err != nil {
+			return Money{}, err
+		}
+	}
+
+	return sum, nil
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"emperror.dev/errors"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_New_Money(t *testing.T) {
+	m, err := ParseMoney("12.505", "usd")
+	require.NoError(t, err)
+	assert.Equal(t, "12.51 USD", m.String())
+
+	m, err = ParseMoney("1200.4", "JPY")
+	require.NoError(t, err)
+	assert.Equal(t, "1200 JPY", m.String())
+
+	m, err = NewMoneyFromFloat(0.1+0.2, USD)
+	require.NoError(t, err)
+	assert.Equal(t, "0.30 USD", m.String())
+
+	_, err = ParseMoney("10", "XXX")
+	assert.Error(t, err)
+	assert.Panics(t, func() { MustParseMoney("10", "XXX") })
+}
+
+func Test_Money_Arithmetic(t *testing.T) {
+	price, _ := ParseMoney("19.99", "USD")
+	shipping, _ := ParseMoney("5.01", "USD")
+
+	items, err := price.Multiply(3)
+	require.NoError(t, err)
+	total, err := items.Add(shipping)
+	require.NoError(t, err)
+	assert.Equal(t, "64.98 USD", total.String())
+
+	rest, err := total.Subtract(price)
+	require.NoError(t, err)
+	assert.Equal(t, "44.99 USD", rest.String())
+
+	rate, _ := ParseDecimal("0.15")
+	tax, err := total.MultiplyBy(rate, RoundHalfUp)
+	require.NoError(t, err)
+	assert.Equal(t, "9.75 USD", tax.String())
+	tax, err = total.MultiplyBy(rate, RoundDown)
+	require.NoError(t, err)
+	assert.Equal(t, "9.74 USD", tax.String())
+
+	euros, _ := ParseMoney("1", "EUR")
+	_, err = total.Add(euros)
+	assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+	_, err = total.Compare(euros)
+	assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+
+	sum, err := SumMoney(USD, price, price, shipping)
+	require.NoError(t, err)
+	assert.Equal(t, "44.99 USD", sum.String())
+}
+
+func Test_Money_Out_Of_Range(t *testing.T) {
+	price := MustParseMoney("1000000", "USD")
+
+	_, err := price.Multiply(1 << 40)
+	assert.True(t, errors.Is(err, ErrDecimalOutOfRange))
+	_, err = price.Multiply(^uint64(0))
+	assert.True(t, errors.Is(err, ErrDecimalOutOfRange))
+
+	max := MustParseMoney("5000000000000000000", "JPY")
+	_, err = max.Add(max)
+	assert.True(t, errors.Is(err, ErrDecimalOutOfRange))
+
+	var decoded Money
+	err = json.Unmarshal([]byte(`{"amount":1e17,"currency":"USD"}`), &decoded)
+	assert.True(t, errors.Is(err, ErrDecimalOutOfRange))
+}
+
+func Test_Money_No_Rounding_Drift(t *testing.T) {
+	cent, _ := ParseMoney("0.01", "USD")
+
+	sum := ZeroMoney(USD)
+	for i := 0; i < 1000; i++ {
+		sum, _ = sum.Add(cent)
+	}
+
+	expected, _ := ParseMoney("10", "USD")
+	assert.Equal(t, expected, sum)
+}
+
+func Test_Money_Prorate(t *testing.T) {
+	discount, _ := ParseMoney("10", "USD")
+	basket, _ := ParseMoney("30", "USD")
+	items, _ := ParseMoney("10", "USD")
+
+	share, err := discount.Prorate(items, basket)
+	require.NoError(t, err)
+	assert.Equal(t, "3.33 USD", share.String())
+
+	share, err = discount.Prorate(basket, basket)
+	require.NoError(t, err)
+	assert.Equal(t, discount, share)
+
+	_, err = discount.Prorate(items, ZeroMoney(USD))
+	assert.Error(t, err)
+
+	euros, _ := ParseMoney("1", "EUR")
+	_, err = discount.Prorate(euros, basket)
+	assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+}
+
+func Test_Money_Json(t *testing.T) {
+	m, _ := ParseMoney("12.5", "EUR")
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":"12.50","currency":"EUR"}`, string(data))
+
+	var decoded Money
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":12.505,"currency":"EUR"}`), &decoded))
+	assert.Equal(t, "12.51 EUR", decoded.String())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":"1","currency":""}`), &decoded))
+
+	data, err = json.Marshal(Money{})
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, Money{}, decoded)
+}
+
+func Test_Currency_Sql(t *testing.T) {
+	value, err := EUR.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", value)
+
+	var scanned Currency
+	require.NoError(t, scanned.Scan([]byte("USD")))
+	assert.Equal(t, USD, scanned)
+
+	require.NoError(t, scanned.Scan("   "))
+	assert.Equal(t, Currency(""), scanned)
+
+	assert.Error(t, scanned.Scan(12))
+}
@@ -0,0 +1,73 @@
+package core
+
+import "context"
+
+const (
+	CorrelationIdMetadataKey = "correlation-id"
+	UserIdMetadataKey        = "user-id"
+)
+
+type correlationIdCtxKey struct{}
+
+type userIdCtxKey struct{}
+
+type userEmailCtxKey struct{}
+
+type accessTokenCtxKey struct{}
+
+// ContextWithCorrelationId returns a context which carries the correlation id of the request
+func ContextWithCorrelationId(ctx context.Context, correlationId string) context.Context {
+	return context.WithValue(ctx, correlationIdCtxKey{}, correlationId)
+}
+
+// ContextWithUserId returns a context which carries the id of the user who sent the request
+func ContextWithUserId(ctx context.Context, userId string) context.Context {
+	return context.WithValue(ctx, userIdCtxKey{}, userId)
+}
+
+// ContextWithUserEmail returns a context which carries the email of the authenticated user who sent the request
+func ContextWithUserEmail(ctx context.Context, userEmail string) context.Context {
+	return context.WithValue(ctx, userEmailCtxKey{}, userEmail)
+}
+
+// ContextWithAccessToken returns a context which carries the verified access token of the user, the grpc clients forward it to the other services
+func ContextWithAccessToken(ctx context.Context, accessToken string) context.Context {
+	return context.WithValue(ctx, accessTokenCtxKey{}, accessToken)
+}
+
+func GetCorrelationId(ctx context.Context) string {
+	correlationId, _ := ctx.Value(correlationIdCtxKey{}).(string)
+	return correlationId
+}
+
+func GetUserId(ctx context.Context) string {
+	userId, _ := ctx.Value(userIdCtxKey{}).(string)
+	return userId
+}
+
+// GetUserEmail is empty when the request is not sent by an authenticated user
+func GetUserEmail(ctx context.Context) string {
+	userEmail, _ := ctx.Value(userEmailCtxKey{}).(string)
+	return userEmail
+}
+
+func GetAccessToken(ctx context.Context) string {
+	accessToken, _ := ctx.Value(accessTokenCtxKey{}).(string)
+	return accessToken
+}
+
+// MetadataFromContext returns the metadata of the request for the stored events, it is nil when the context carries no metadata
+func MetadataFromContext(ctx context.Context) Metadata {
+	var metadata Metadata
+
+	if correlationId := GetCorrelationId(ctx); correlationId != "" {
+		metadata = FromMetadata(metadata)
+		metadata.SetValue(CorrelationIdMetadataKey, correlationId)
+	}
+	if userId := GetUserId(ctx); userId != "" {
+		metadata = FromMetadata(metadata)
+		metadata.SetValue(UserIdMetadataKey, userId)
+	}
+
+	return metadata
+}
@@ -0,0 +1,7 @@
+package serializer
+
+const (
+	JsonContentType     = "application/json"
+	ProtobufContentType = "application/x-protobuf"
+	MsgPackContentType  = "application/x-msgpack"
+)
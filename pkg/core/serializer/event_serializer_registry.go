@@ -0,0 +1,132 @@
+package serializer
+
+import (
+	"emperror.dev/errors"
+	"mime"
+	"reflect"
+	"sync"
+)
+
+// EventSerializerRegistry is an EventSerializer which negotiates the serializer by the content type, it deserializes with the serializer of the
+// incoming content type and serializes each message type with its registered content type or with the default serializer.
+type EventSerializerRegistry struct {
+	defaultSerializer EventSerializer
+	serializers       map[string]EventSerializer
+	typeContentTypes  map[reflect.Type]string
+	mu                sync.RWMutex
+}
+
+func NewEventSerializerRegistry(defaultSerializer EventSerializer, serializers ...EventSerializer) *EventSerializerRegistry {
+	registry := &EventSerializerRegistry{
+		defaultSerializer: defaultSerializer,
+		serializers:       map[string]EventSerializer{defaultSerializer.ContentType(): defaultSerializer},
+		typeContentTypes:  make(map[reflect.Type]string),
+	}
+
+	for _, s := range serializers {
+		registry.serializers[s.ContentType()] = s
+	}
+
+	return registry
+}
+
+// Register adds a serializer for its content type, an existing serializer for the content type will be replaced
+func (r *EventSerializerRegistry) Register(eventSerializer EventSerializer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.serializers[eventSerializer.ContentType()] = eventSerializer
+}
+
+// RegisterMessageContentType sets the content type that the messages with the same type as `message` will be serialized with
+func (r *EventSerializerRegistry) RegisterMessageContentType(message interface{}, contentType string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.serializers[contentType]; !ok {
+		return errors.Errorf("there is no serializer registered for contentType: %s", contentType)
+	}
+	r.typeContentTypes[reflect.TypeOf(message)] = contentType
+
+	return nil
+}
+
+// SerializerByContentType returns the serializer of the content type, an empty content type resolves to the default serializer
+func (r *EventSerializerRegistry) SerializerByContentType(contentType string) (EventSerializer, error) {
+	if contentType == "" {
+		return r.defaultSerializer, nil
+	}
+
+	// content types like `application/json; charset=utf-8` are negotiated by their media type
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		contentType = mediaType
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	s, ok := r.serializers[contentType]
+	if !ok {
+		return nil, errors.Errorf("contentType: %s is not supported", contentType)
+	}
+
+	return s, nil
+}
+
+func (r *EventSerializerRegistry) Serialize(event interface{}) (*EventSerializationResult, error) {
+	if event == nil {
+		return r.defaultSerializer.Serialize(event)
+	}
+
+	r.mu.RLock()
+	contentType := r.typeContentTypes[reflect.TypeOf(event)]
+	r.mu.RUnlock()
+
+	s, err := r.SerializerByContentType(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Serialize(event)
+}
+
+func (r *EventSerializerRegistry) Deserialize(data []byte, eventType string, contentType string) (interface{}, error) {
+	s, err := r.SerializerByContentType(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Deserialize(data, eventType, s.ContentType())
+}
+
+func (r *EventSerializerRegistry) DeserializeType(data []byte, eventType reflect.Type, contentType string) (interface{}, error) {
+	s, err := r.SerializerByContentType(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DeserializeType(data, eventType, s.ContentType())
+}
+
+func (r *EventSerializerRegistry) DeserializeMessage(data []byte, eventType string, contentType string) (interface{}, error) {
+	s, err := r.SerializerByContentType(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DeserializeMessage(data, eventType, s.ContentType())
+}
+
+func (r *EventSerializerRegistry) DeserializeEvent(data []byte, eventType string, contentType string) (interface{}, error) {
+	s, err := r.SerializerByContentType(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DeserializeEvent(data, eventType, s.ContentType())
+}
+
+// ContentType returns the content type of the default serializer
+func (r *EventSerializerRegistry) ContentType() string {
+	return r.defaultSerializer.ContentType()
+}
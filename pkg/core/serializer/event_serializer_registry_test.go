@@ -0,0 +1,86 @@
+package serializer_test
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/msgpack"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/protobuf"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"reflect"
+	"testing"
+)
+
+func Test_Serialize_With_Message_Type_Content_Type(t *testing.T) {
+	registry := newTestRegistry()
+	assert.NoError(t, registry.RegisterMessageContentType(&RegistryTestMessage{}, serializer.MsgPackContentType))
+
+	serialized, err := registry.Serialize(newRegistryTestMessage("msgpack"))
+	assert.NoError(t, err)
+	assert.Equal(t, serializer.MsgPackContentType, serialized.ContentType)
+
+	serialized, err = registry.Serialize(&OtherRegistryTestMessage{Message: types.NewMessage(uuid.NewV4().String())})
+	assert.NoError(t, err)
+	assert.Equal(t, serializer.JsonContentType, serialized.ContentType)
+
+	err = registry.RegisterMessageContentType(&RegistryTestMessage{}, "application/xml")
+	assert.Error(t, err)
+}
+
+func Test_Deserialize_By_Content_Type(t *testing.T) {
+	registry := newTestRegistry()
+	messageType := reflect.TypeOf(&RegistryTestMessage{})
+
+	for _, s := range []serializer.EventSerializer{json.NewJsonEventSerializer(), msgpack.NewMsgPackEventSerializer()} {
+		serialized, err := s.Serialize(newRegistryTestMessage(s.ContentType()))
+		assert.NoError(t, err)
+
+		deserialized, err := registry.DeserializeType(serialized.Data, messageType, serialized.ContentType)
+		assert.NoError(t, err)
+		assert.Equal(t, s.ContentType(), deserialized.(*RegistryTestMessage).Data)
+	}
+
+	serialized, err := json.NewJsonEventSerializer().Serialize(newRegistryTestMessage("charset"))
+	assert.NoError(t, err)
+	deserialized, err := registry.DeserializeType(serialized.Data, messageType, "application/json; charset=utf-8")
+	assert.NoError(t, err)
+	assert.Equal(t, "charset", deserialized.(*RegistryTestMessage).Data)
+
+	_, err = registry.DeserializeType(serialized.Data, messageType, "application/xml")
+	assert.Error(t, err)
+}
+
+func Test_Protobuf_Round_Trip(t *testing.T) {
+	registry := newTestRegistry()
+	assert.NoError(t, registry.RegisterMessageContentType(&wrapperspb.StringValue{}, serializer.ProtobufContentType))
+
+	serialized, err := registry.Serialize(wrapperspb.String("protobuf"))
+	assert.NoError(t, err)
+	assert.Equal(t, serializer.ProtobufContentType, serialized.ContentType)
+
+	deserialized, err := registry.DeserializeType(serialized.Data, reflect.TypeOf(&wrapperspb.StringValue{}), serialized.ContentType)
+	assert.NoError(t, err)
+	assert.Equal(t, "protobuf", deserialized.(*wrapperspb.StringValue).GetValue())
+
+	_, err = protobuf.NewProtobufEventSerializer().Serialize(newRegistryTestMessage("not protobuf"))
+	assert.Error(t, err)
+}
+
+type RegistryTestMessage struct {
+	*types.Message
+	Data string `json:"data"`
+}
+
+type OtherRegistryTestMessage struct {
+	*types.Message
+}
+
+func newRegistryTestMessage(data string) *RegistryTestMessage {
+	return &RegistryTestMessage{Message: types.NewMessage(uuid.NewV4().String()), Data: data}
+}
+
+func newTestRegistry() *serializer.EventSerializerRegistry {
+	return serializer.NewEventSerializerRegistry(json.NewJsonEventSerializer(), msgpack.NewMsgPackEventSerializer(), protobuf.NewProtobufEventSerializer())
+}
@@ -0,0 +1,89 @@
+package msgpack
+
+import (
+	"bytes"
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	"github.com/vmihailenco/msgpack/v5"
+	"reflect"
+)
+
+// MsgPackEventSerializer serializes the events with MessagePack, the `json` struct tags are used for the field names, so the events don't need extra tags
+type MsgPackEventSerializer struct {
+}
+
+func NewMsgPackEventSerializer() *MsgPackEventSerializer {
+	return &MsgPackEventSerializer{}
+}
+
+func (s *MsgPackEventSerializer) Serialize(event interface{}) (*serializer.EventSerializationResult, error) {
+	if event == nil {
+		return &serializer.EventSerializationResult{Data: nil, ContentType: s.ContentType()}, nil
+	}
+
+	eventType := typeMapper.GetTypeName(event)
+
+	var buf bytes.Buffer
+	encoder := msgpack.NewEncoder(&buf)
+	encoder.SetCustomStructTag("json")
+
+	if err := encoder.Encode(event); err != nil {
+		return nil, errors.WrapIff(err, "msgpack.Encode type: %s", eventType)
+	}
+
+	return &serializer.EventSerializationResult{Data: buf.Bytes(), ContentType: s.ContentType()}, nil
+}
+
+func (s *MsgPackEventSerializer) Deserialize(data []byte, eventType string, contentType string) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	return s.unmarshal(data, typeMapper.InstanceByTypeName(eventType), eventType, contentType)
+}
+
+func (s *MsgPackEventSerializer) DeserializeType(data []byte, eventType reflect.Type, contentType string) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	return s.unmarshal(data, typeMapper.InstanceByType(eventType), typeMapper.GetTypeNameByType(eventType), contentType)
+}
+
+func (s *MsgPackEventSerializer) DeserializeMessage(data []byte, eventType string, contentType string) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	return s.unmarshal(data, typeMapper.InstanceByTypeNameAndImplementedInterface[types.IMessage](eventType), eventType, contentType)
+}
+
+func (s *MsgPackEventSerializer) DeserializeEvent(data []byte, eventType string, contentType string) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	return s.unmarshal(data, typeMapper.InstanceByTypeNameAndImplementedInterface[core.IEvent](eventType), eventType, contentType)
+}
+
+func (s *MsgPackEventSerializer) ContentType() string {
+	return serializer.MsgPackContentType
+}
+
+func (s *MsgPackEventSerializer) unmarshal(data []byte, targetEventPointer interface{}, eventType string, contentType string) (interface{}, error) {
+	if contentType != s.ContentType() {
+		return nil, errors.Errorf("contentType: %s is not supported", contentType)
+	}
+
+	decoder := msgpack.NewDecoder(bytes.NewReader(data))
+	decoder.SetCustomStructTag("json")
+
+	if err := decoder.Decode(targetEventPointer); err != nil {
+		return nil, errors.WrapIff(err, "msgpack.Decode type: %s", eventType)
+	}
+
+	return targetEventPointer, nil
+}
@@ -0,0 +1,92 @@
+package protobuf
+
+import (
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	"google.golang.org/protobuf/proto"
+	"reflect"
+)
+
+// ProtobufEventSerializer serializes the events which are generated protobuf messages, the event types should implement `proto.Message`
+type ProtobufEventSerializer struct {
+}
+
+func NewProtobufEventSerializer() *ProtobufEventSerializer {
+	return &ProtobufEventSerializer{}
+}
+
+func (s *ProtobufEventSerializer) Serialize(event interface{}) (*serializer.EventSerializationResult, error) {
+	if event == nil {
+		return &serializer.EventSerializationResult{Data: nil, ContentType: s.ContentType()}, nil
+	}
+
+	eventType := typeMapper.GetTypeName(event)
+
+	protoMessage, ok := event.(proto.Message)
+	if !ok {
+		return nil, errors.Errorf("event type: %s is not a protobuf message", eventType)
+	}
+
+	data, err := proto.Marshal(protoMessage)
+	if err != nil {
+		return nil, errors.WrapIff(err, "proto.Marshal type: %s", eventType)
+	}
+
+	return &serializer.EventSerializationResult{Data: data, ContentType: s.ContentType()}, nil
+}
+
+func (s *ProtobufEventSerializer) Deserialize(data []byte, eventType string, contentType string) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	return s.unmarshal(data, typeMapper.InstanceByTypeName(eventType), eventType, contentType)
+}
+
+func (s *ProtobufEventSerializer) DeserializeType(data []byte, eventType reflect.Type, contentType string) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	return s.unmarshal(data, typeMapper.InstanceByType(eventType), typeMapper.GetTypeNameByType(eventType), contentType)
+}
+
+func (s *ProtobufEventSerializer) DeserializeMessage(data []byte, eventType string, contentType string) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	return s.unmarshal(data, typeMapper.InstanceByTypeNameAndImplementedInterface[types.IMessage](eventType), eventType, contentType)
+}
+
+func (s *ProtobufEventSerializer) DeserializeEvent(data []byte, eventType string, contentType string) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	return s.unmarshal(data, typeMapper.InstanceByTypeNameAndImplementedInterface[core.IEvent](eventType), eventType, contentType)
+}
+
+func (s *ProtobufEventSerializer) ContentType() string {
+	return serializer.ProtobufContentType
+}
+
+func (s *ProtobufEventSerializer) unmarshal(data []byte, targetEventPointer interface{}, eventType string, contentType string) (interface{}, error) {
+	if contentType != s.ContentType() {
+		return nil, errors.Errorf("contentType: %s is not supported", contentType)
+	}
+
+	protoMessage, ok := targetEventPointer.(proto.Message)
+	if !ok {
+		return nil, errors.Errorf("event type: %s is not a protobuf message", eventType)
+	}
+
+	if err := proto.Unmarshal(data, protoMessage); err != nil {
+		return nil, errors.WrapIff(err, "proto.Unmarshal type: %s", eventType)
+	}
+
+	return protoMessage, nil
+}
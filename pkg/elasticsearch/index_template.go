@@ -0,0 +1,21 @@
+package elasticsearch
+
+import (
+	"context"
+	"emperror.dev/errors"
+	v7 "github.com/olivere/elastic/v7"
+)
+
+// PutIndexTemplate creates or replaces a composable index template, the template is applied to the indexes created after it
+func PutIndexTemplate(ctx context.Context, client *v7.Client, name string, template interface{}) error {
+	res, err := client.IndexPutIndexTemplate(name).BodyJson(template).Do(ctx)
+	if err != nil {
+		return errors.WrapIf(err, "IndexPutIndexTemplate")
+	}
+
+	if !res.Acknowledged {
+		return errors.Errorf("index template %s is not acknowledged", name)
+	}
+
+	return nil
+}
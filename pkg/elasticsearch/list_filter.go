@@ -0,0 +1,55 @@
+package elasticsearch
+
+import (
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	v7 "github.com/olivere/elastic/v7"
+	"strings"
+)
+
+// ApplyListFilter adds the conditions of the list filter to the query as filter clauses, the string fields should be keyword fields
+func ApplyListFilter(query v7.Query, listFilter *utils.ListFilter) v7.Query {
+	if len(listFilter.Conditions) == 0 {
+		return query
+	}
+
+	boolQuery := v7.NewBoolQuery()
+	if query != nil {
+		boolQuery.Must(query)
+	}
+
+	for _, condition := range listFilter.Conditions {
+		switch condition.Comparison {
+		case utils.NotEqualComparison:
+			boolQuery.MustNot(v7.NewTermQuery(condition.Field, condition.Values[0]))
+		case utils.GreaterThanComparison:
+			boolQuery.Filter(v7.NewRangeQuery(condition.Field).Gt(condition.Values[0]))
+		case utils.LessThanComparison:
+			boolQuery.Filter(v7.NewRangeQuery(condition.Field).Lt(condition.Values[0]))
+		case utils.ContainsComparison:
+			boolQuery.Filter(v7.NewWildcardQuery(condition.Field, "*"+escapeWildcard(fmt.Sprint(condition.Values[0]))+"*").CaseInsensitive(true))
+		case utils.InComparison:
+			boolQuery.Filter(v7.NewTermsQuery(condition.Field, condition.Values...))
+		case utils.BetweenComparison:
+			boolQuery.Filter(v7.NewRangeQuery(condition.Field).Gte(condition.Values[0]).Lte(condition.Values[1]))
+		default:
+			boolQuery.Filter(v7.NewTermQuery(condition.Field, condition.Values[0]))
+		}
+	}
+
+	return boolQuery
+}
+
+// SortersFromListFilter returns the sorters of the list filter
+func SortersFromListFilter(listFilter *utils.ListFilter) []v7.Sorter {
+	sorters := make([]v7.Sorter, 0, len(listFilter.Sorts))
+	for _, sort := range listFilter.Sorts {
+		sorters = append(sorters, v7.NewFieldSort(sort.Field).Order(!sort.Descending))
+	}
+
+	return sorters
+}
+
+func escapeWildcard(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`).Replace(value)
+}
@@ -0,0 +1,56 @@
+package elasticsearch
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"encoding/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	v7 "github.com/olivere/elastic/v7"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Paginate runs the query against the index and returns the page of the list query, a nil query matches all the documents.
+// The sorts of the list query take precedence over the default sorters
+func Paginate[T any](ctx context.Context, listQuery *utils.ListQuery, client *v7.Client, index string, query v7.Query, sorters ...v7.Sorter) (*utils.ListResult[T], error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "elasticsearch.Paginate")
+	defer span.Finish()
+
+	listFilter, err := listQuery.GetListFilter()
+	if err != nil {
+		tracing.TraceErr(span, err)
+		return nil, errors.WrapIf(err, "GetListFilter")
+	}
+
+	query = ApplyListFilter(query, listFilter)
+	if query == nil {
+		query = v7.NewMatchAllQuery()
+	}
+	if len(listFilter.Sorts) > 0 {
+		sorters = SortersFromListFilter(listFilter)
+	}
+
+	searchResult, err := client.Search(index).
+		Query(query).
+		SortBy(sorters...).
+		From(listQuery.GetOffset()).
+		Size(listQuery.GetLimit()).
+		TrackTotalHits(true).
+		Do(ctx)
+	if err != nil {
+		tracing.TraceErr(span, err)
+		return nil, errors.WrapIf(err, "Search")
+	}
+
+	items := make([]T, 0, len(searchResult.Hits.Hits))
+	for _, hit := range searchResult.Hits.Hits {
+		var item T
+		if err := json.Unmarshal(hit.Source, &item); err != nil {
+			tracing.TraceErr(span, err)
+			return nil, errors.WrapIf(err, "json.Unmarshal")
+		}
+		items = append(items, item)
+	}
+
+	return utils.NewListResult[T](items, listQuery.GetSize(), listQuery.GetPage(), searchResult.TotalHits()), nil
+}
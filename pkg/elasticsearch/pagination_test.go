@@ -0,0 +1,71 @@
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	v7 "github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type testDocument struct {
+	Title string `json:"title"`
+}
+
+func Test_Paginate(t *testing.T) {
+	var searchBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &searchBody)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"hits":{"total":{"value":25,"relation":"eq"},"hits":[{"_id":"1","_source":{"title":"book"}},{"_id":"2","_source":{"title":"pen"}}]}}`))
+	}))
+	defer server.Close()
+
+	client, err := v7.NewSimpleClient(v7.SetURL(server.URL))
+	require.NoError(t, err)
+
+	result, err := Paginate[*testDocument](context.Background(), utils.NewListQuery(10, 3), client, "documents", v7.NewMatchQuery("title", "book"))
+	require.NoError(t, err)
+
+	assert.Equal(t, float64(20), searchBody["from"])
+	assert.Equal(t, float64(10), searchBody["size"])
+	assert.Equal(t, int64(25), result.TotalItems)
+	assert.Equal(t, 3, result.TotalPage)
+	assert.Len(t, result.Items, 2)
+	assert.Equal(t, "pen", result.Items[1].Title)
+}
+
+func Test_Paginate_With_List_Filter(t *testing.T) {
+	var searchBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &searchBody)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"hits":{"total":{"value":0,"relation":"eq"},"hits":[]}}`))
+	}))
+	defer server.Close()
+
+	client, err := v7.NewSimpleClient(v7.SetURL(server.URL))
+	require.NoError(t, err)
+
+	listQuery := utils.NewListQuery(10, 1)
+	listQuery.Filters = []*utils.FilterModel{{Field: "price", Comparison: utils.BetweenComparison, Value: "10,20"}}
+	listQuery.OrderBy = "price desc"
+	require.NoError(t, listQuery.Validate(utils.QueryFields{"price": {Name: "price", Type: utils.NumberField, Sortable: true}}))
+
+	_, err = Paginate[*testDocument](context.Background(), listQuery, client, "documents", nil, v7.NewFieldSort("title"))
+	require.NoError(t, err)
+
+	query, _ := json.Marshal(searchBody["query"])
+	sort, _ := json.Marshal(searchBody["sort"])
+	assert.JSONEq(t, `{"bool":{"filter":{"range":{"price":{"from":10,"include_lower":true,"include_upper":true,"to":20}}}}}`, string(query))
+	assert.JSONEq(t, `[{"price":{"order":"desc"}}]`, string(sort))
+}
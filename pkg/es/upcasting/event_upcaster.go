@@ -0,0 +1,86 @@
+package upcasting
+
+import (
+	"bytes"
+	"emperror.dev/errors"
+	"encoding/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
+	"sync"
+)
+
+// EventUpcaster converts the stored data of an old event shape to the current shape of the event, the upcasters run on every read of the
+// event type, so they should leave the data of the current shape unchanged.
+type EventUpcaster interface {
+	EventType() string
+	Upcast(data []byte) ([]byte, error)
+}
+
+type jsonEventUpcaster struct {
+	eventType string
+	upcast    func(event map[string]interface{}) error
+}
+
+// NewJsonEventUpcaster creates an upcaster which changes the decoded json object of the event in place, the json numbers are decoded as
+// json.Number to keep their precision.
+func NewJsonEventUpcaster(eventType string, upcast func(event map[string]interface{}) error) EventUpcaster {
+	return &jsonEventUpcaster{eventType: eventType, upcast: upcast}
+}
+
+func (j *jsonEventUpcaster) EventType() string {
+	return j.eventType
+}
+
+func (j *jsonEventUpcaster) Upcast(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var event map[string]interface{}
+	if err := decoder.Decode(&event); err != nil {
+		return nil, errors.WrapIf(err, "[jsonEventUpcaster_Upcast] error in decoding the event")
+	}
+	if err := j.upcast(event); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(event)
+}
+
+// EventUpcasterRegistry keeps the upcasters of the event types, the upcasters of an event type run in their registration order.
+type EventUpcasterRegistry struct {
+	upcasters map[string][]EventUpcaster
+	mu        sync.RWMutex
+}
+
+func NewEventUpcasterRegistry() *EventUpcasterRegistry {
+	return &EventUpcasterRegistry{upcasters: make(map[string][]EventUpcaster)}
+}
+
+func (r *EventUpcasterRegistry) Register(upcasters ...EventUpcaster) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, upcaster := range upcasters {
+		r.upcasters[upcaster.EventType()] = append(r.upcasters[upcaster.EventType()], upcaster)
+	}
+}
+
+// Upcast runs the upcasters of the event type on the json event data, the data of the other content types is returned unchanged
+func (r *EventUpcasterRegistry) Upcast(data []byte, eventType string, contentType string) ([]byte, error) {
+	if r == nil || contentType != serializer.JsonContentType {
+		return data, nil
+	}
+
+	r.mu.RLock()
+	upcasters := r.upcasters[eventType]
+	r.mu.RUnlock()
+
+	for _, upcaster := range upcasters {
+		upcasted, err := upcaster.Upcast(data)
+		if err != nil {
+			return nil, errors.WrapIff(err, "error in upcasting the event type %s", eventType)
+		}
+		data = upcasted
+	}
+
+	return data, nil
+}
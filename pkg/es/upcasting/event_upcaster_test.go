@@ -0,0 +1,67 @@
+package upcasting
+
+import (
+	"encoding/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_Upcast_Json_Event(t *testing.T) {
+	registry := NewEventUpcasterRegistry()
+	registry.Register(
+		NewJsonEventUpcaster("*TestEventV1", func(event map[string]interface{}) error {
+			if price, ok := event["price"].(json.Number); ok {
+				event["price"] = map[string]interface{}{"amount": price.String(), "currency": "USD"}
+			}
+			return nil
+		}),
+		NewJsonEventUpcaster("*TestEventV1", func(event map[string]interface{}) error {
+			event["upcasted"] = true
+			return nil
+		}),
+	)
+
+	upcasted, err := registry.Upcast([]byte(`{"price":19.99}`), "*TestEventV1", serializer.JsonContentType)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"price":{"amount":"19.99","currency":"USD"},"upcasted":true}`, string(upcasted))
+
+	// the current shape of the event isn't changed
+	upcasted, err = registry.Upcast(upcasted, "*TestEventV1", serializer.JsonContentType)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"price":{"amount":"19.99","currency":"USD"},"upcasted":true}`, string(upcasted))
+}
+
+func Test_Upcast_Skips_Other_Events(t *testing.T) {
+	registry := NewEventUpcasterRegistry()
+	registry.Register(NewJsonEventUpcaster("*TestEventV1", func(event map[string]interface{}) error {
+		event["upcasted"] = true
+		return nil
+	}))
+
+	data := []byte(`{"price":19.99}`)
+
+	upcasted, err := registry.Upcast(data, "*OtherEventV1", serializer.JsonContentType)
+	require.NoError(t, err)
+	assert.Equal(t, data, upcasted)
+
+	upcasted, err = registry.Upcast(data, "*TestEventV1", serializer.MsgPackContentType)
+	require.NoError(t, err)
+	assert.Equal(t, data, upcasted)
+
+	var nilRegistry *EventUpcasterRegistry
+	upcasted, err = nilRegistry.Upcast(data, "*TestEventV1", serializer.JsonContentType)
+	require.NoError(t, err)
+	assert.Equal(t, data, upcasted)
+}
+
+func Test_Upcast_Invalid_Json(t *testing.T) {
+	registry := NewEventUpcasterRegistry()
+	registry.Register(NewJsonEventUpcaster("*TestEventV1", func(event map[string]interface{}) error {
+		return nil
+	}))
+
+	_, err := registry.Upcast([]byte(`{`), "*TestEventV1", serializer.JsonContentType)
+	assert.Error(t, err)
+}
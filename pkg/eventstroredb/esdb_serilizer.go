@@ -13,6 +13,7 @@ import (
 	readPosition "github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/models/stream_position/read_position"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/models/stream_position/truncatePosition"
 	expectedStreamVersion "github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/models/stream_version"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/upcasting"
 	esErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/eventstroredb/errors"
 	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
 	uuid2 "github.com/satori/go.uuid"
@@ -20,15 +21,21 @@ import (
 	"strings"
 )
 
+// EventContentTypeMetadataKey is the metadata key which keeps the content type of the binary events
+const EventContentTypeMetadataKey = "event-content-type"
+
 type EsdbSerializer struct {
 	metadataSerializer serializer.MetadataSerializer
 	eventSerializer    serializer.EventSerializer
+	upcasters          *upcasting.EventUpcasterRegistry
 }
 
-func NewEsdbSerializer(metadataSerializer serializer.MetadataSerializer, eventSerializer serializer.EventSerializer) *EsdbSerializer {
+// NewEsdbSerializer creates the esdb serializer, the upcasters convert the stored old shapes of the events before deserializing them and can be nil
+func NewEsdbSerializer(metadataSerializer serializer.MetadataSerializer, eventSerializer serializer.EventSerializer, upcasters *upcasting.EventUpcasterRegistry) *EsdbSerializer {
 	return &EsdbSerializer{
 		metadataSerializer: metadataSerializer,
 		eventSerializer:    eventSerializer,
+		upcasters:          upcasters,
 	}
 }
 
@@ -38,20 +45,11 @@ func (e *EsdbSerializer) StreamEventToEventData(streamEvent *models.StreamEvent)
 		return *new(esdb.EventData), err
 	}
 
-	metadataSerializationResult, err := e.metadataSerializer.Serialize(streamEvent.Metadata)
+	metadataSerializationResult, err := e.metadataSerializer.Serialize(withContentType(streamEvent.Metadata, eventSerializationResult.ContentType))
 	if err != nil {
 		return *new(esdb.EventData), err
 	}
 
-	var contentType esdb.ContentType
-
-	switch eventSerializationResult.ContentType {
-	case "application/json":
-		contentType = esdb.JsonContentType
-	default:
-		contentType = esdb.BinaryContentType
-	}
-
 	id, err := uuid.FromString(streamEvent.EventID.String())
 	if err != nil {
 		return *new(esdb.EventData), err
@@ -61,7 +59,7 @@ func (e *EsdbSerializer) StreamEventToEventData(streamEvent *models.StreamEvent)
 		EventType:   typeMapper.GetTypeName(streamEvent.Event),
 		Data:        eventSerializationResult.Data,
 		Metadata:    metadataSerializationResult,
-		ContentType: contentType,
+		ContentType: toEsdbContentType(eventSerializationResult.ContentType),
 	}, nil
 }
 
@@ -94,13 +92,14 @@ func (e *EsdbSerializer) StreamTruncatePositionToInt64(truncatePosition truncate
 	return uint64(truncatePosition.Value())
 }
 
-func (e *EsdbSerializer) EsdbReadStreamToResolvedEvents(stream *esdb.ReadStream) ([]*esdb.ResolvedEvent, error) {
+func (e *EsdbSerializer) EsdbReadStreamToResolvedEvents(stream *esdb.ReadStream, streamId string) ([]*esdb.ResolvedEvent, error) {
 	var events []*esdb.ResolvedEvent
 
 	for {
 		event, err := stream.Recv()
 		if errors.Is(err, esdb.ErrStreamNotFound) {
-			return nil, esErrors.NewStreamNotFoundError(err, event.Event.StreamID)
+			// the received event is nil when the stream is not found
+			return nil, esErrors.NewStreamNotFoundError(err, streamId)
 		}
 		if errors.Is(err, io.EOF) {
 			break
@@ -120,12 +119,18 @@ func (e *EsdbSerializer) EsdbPositionToStreamReadPosition(position esdb.Position
 }
 
 func (e *EsdbSerializer) ResolvedEventToStreamEvent(resolveEvent *esdb.ResolvedEvent) (*models.StreamEvent, error) {
-	deserializedEvent, err := e.eventSerializer.DeserializeEvent(resolveEvent.Event.Data, resolveEvent.Event.EventType, resolveEvent.Event.ContentType)
+	deserializedMeta, err := e.metadataSerializer.Deserialize(resolveEvent.Event.UserMetadata)
 	if err != nil {
 		return nil, err
 	}
 
-	deserializedMeta, err := e.metadataSerializer.Deserialize(resolveEvent.Event.UserMetadata)
+	contentType := eventContentType(resolveEvent.Event, deserializedMeta)
+	data, err := e.upcasters.Upcast(resolveEvent.Event.Data, resolveEvent.Event.EventType, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	deserializedEvent, err := e.eventSerializer.DeserializeEvent(data, resolveEvent.Event.EventType, contentType)
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +175,7 @@ func (e *EsdbSerializer) Serialize(data interface{}, metadata core.Metadata) (*e
 		return nil, err
 	}
 
-	serializedMeta, err := e.metadataSerializer.Serialize(metadata)
+	serializedMeta, err := e.metadataSerializer.Serialize(withContentType(metadata, serializedData.ContentType))
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +185,7 @@ func (e *EsdbSerializer) Serialize(data interface{}, metadata core.Metadata) (*e
 		EventID:     id,
 		EventType:   typeMapper.GetTypeName(data),
 		Data:        serializedData.Data,
-		ContentType: esdb.JsonContentType,
+		ContentType: toEsdbContentType(serializedData.ContentType),
 		Metadata:    serializedMeta,
 	}, nil
 }
@@ -190,12 +195,18 @@ func (e *EsdbSerializer) Deserialize(resolveEvent *esdb.ResolvedEvent) (interfac
 	data := resolveEvent.Event.Data
 	meta := resolveEvent.Event.UserMetadata
 
-	payload, err := e.eventSerializer.DeserializeEvent(data, eventType, resolveEvent.Event.ContentType)
+	metadata, err := e.metadataSerializer.Deserialize(meta)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	metadata, err := e.metadataSerializer.Deserialize(meta)
+	contentType := eventContentType(resolveEvent.Event, metadata)
+	data, err = e.upcasters.Upcast(data, eventType, contentType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payload, err := e.eventSerializer.DeserializeEvent(data, eventType, contentType)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -212,3 +223,38 @@ func (e *EsdbSerializer) DomainEventToStreamEvent(domainEvent domain.IDomainEven
 		Position: position,
 	}
 }
+
+// eventstore only distinguishes json and binary events, so the content type of the binary events is kept in the event metadata
+func toEsdbContentType(contentType string) esdb.ContentType {
+	if contentType == serializer.JsonContentType {
+		return esdb.JsonContentType
+	}
+
+	return esdb.BinaryContentType
+}
+
+func withContentType(metadata core.Metadata, contentType string) core.Metadata {
+	if contentType == serializer.JsonContentType {
+		return metadata
+	}
+
+	meta := core.Metadata{}
+	for k, v := range metadata {
+		meta[k] = v
+	}
+	meta.SetValue(EventContentTypeMetadataKey, contentType)
+
+	return meta
+}
+
+func eventContentType(recordedEvent *esdb.RecordedEvent, metadata core.Metadata) string {
+	if recordedEvent.ContentType == serializer.JsonContentType {
+		return recordedEvent.ContentType
+	}
+
+	if contentType, ok := metadata[EventContentTypeMetadataKey].(string); ok && contentType != "" {
+		return contentType
+	}
+
+	return recordedEvent.ContentType
+}
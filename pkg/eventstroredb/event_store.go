@@ -143,7 +143,7 @@ func (e *eventStoreDbEventStore) ReadEvents(
 
 	defer readStream.Close()
 
-	resolvedEvents, err := e.serializer.EsdbReadStreamToResolvedEvents(readStream)
+	resolvedEvents, err := e.serializer.EsdbReadStreamToResolvedEvents(readStream, streamName.String())
 	if err != nil {
 		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[eventStoreDbEventStore_ReadEvents.EsdbReadStreamToResolvedEvents] error in converting to resolved events"))
 	}
@@ -201,7 +201,7 @@ func (e *eventStoreDbEventStore) ReadEventsBackwards(streamName streamName.Strea
 
 	defer readStream.Close()
 
-	resolvedEvents, err := e.serializer.EsdbReadStreamToResolvedEvents(readStream)
+	resolvedEvents, err := e.serializer.EsdbReadStreamToResolvedEvents(readStream, streamName.String())
 	if err != nil {
 		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[eventStoreDbEventStore_ReadEvents.EsdbReadStreamToResolvedEvents] error in converting to resolved events"))
 	}
@@ -0,0 +1,86 @@
+package eventstroredb
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"github.com/EventStore/EventStore-Client-Go/esdb"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"io"
+	"math"
+	"strings"
+)
+
+type esdbProjectionReplayer struct {
+	db             *esdb.Client
+	log            logger.Logger
+	esdbSerializer *EsdbSerializer
+}
+
+// EsdbProjectionReplayer replays the events of the event store to a projection, so its read model can be rebuilt from the event store
+type EsdbProjectionReplayer interface {
+	// Replay reads the events of the streams with the prefixes from the start of $all and processes them with the projection, it returns the
+	// number of the replayed events. The projection should be idempotent on the stream versions, because the subscription can project the same events while they are replayed
+	Replay(ctx context.Context, prefixes []string, projection projection.IProjection) (int64, error)
+}
+
+func NewEsdbProjectionReplayer(log logger.Logger, db *esdb.Client, esdbSerializer *EsdbSerializer) EsdbProjectionReplayer {
+	return &esdbProjectionReplayer{db: db, log: log, esdbSerializer: esdbSerializer}
+}
+
+func (r *esdbProjectionReplayer) Replay(ctx context.Context, prefixes []string, projection projection.IProjection) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "esdbProjectionReplayer.Replay")
+	span.LogFields(log.Object("Prefixes", prefixes))
+	defer span.Finish()
+
+	stream, err := r.db.ReadAll(ctx, esdb.ReadAllOptions{Direction: esdb.Forwards, From: esdb.Start{}}, uint64(math.MaxUint64))
+	if err != nil {
+		return 0, errors.WrapIf(err, "[esdbProjectionReplayer_Replay.ReadAll] error in reading $all")
+	}
+	defer stream.Close()
+
+	checkpointEventType := typeMapper.GetFullTypeName(CheckpointStored{})
+
+	var replayed int64
+	for {
+		resolvedEvent, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return replayed, errors.WrapIf(err, "[esdbProjectionReplayer_Replay.Recv] error in reading the next event of $all")
+		}
+
+		event := resolvedEvent.Event
+		if event == nil || len(event.Data) == 0 || event.EventType == checkpointEventType || !hasAnyPrefix(event.StreamID, prefixes) {
+			continue
+		}
+
+		streamEvent, err := r.esdbSerializer.ResolvedEventToStreamEvent(resolvedEvent)
+		if err != nil {
+			return replayed, errors.WrapIf(err, "[esdbProjectionReplayer_Replay.ResolvedEventToStreamEvent] failed to convert resolved event to stream event")
+		}
+
+		if err := projection.ProcessEvent(ctx, streamEvent); err != nil {
+			return replayed, errors.WrapIf(err, "[esdbProjectionReplayer_Replay.ProcessEvent] error in processing projection")
+		}
+		replayed++
+	}
+
+	r.log.Infof("[esdbProjectionReplayer.Replay] %d events of the streams with prefixes %v replayed", replayed, prefixes)
+
+	return replayed, nil
+}
+
+func hasAnyPrefix(streamId string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(streamId, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
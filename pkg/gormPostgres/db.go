@@ -12,7 +12,6 @@ import (
 	"go.uber.org/zap"
 	gorm_postgres "gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"strings"
 )
 
 type Config struct {
@@ -139,39 +138,23 @@ func Paginate[T any](ctx context.Context, listQuery *utils.ListQuery, db *gorm.D
 
 	span, ctx := opentracing.StartSpanFromContext(ctx, "gorm.Paginate")
 
+	listFilter, err := listQuery.GetListFilter()
+	if err != nil {
+		tracing.TraceErr(span, err)
+		return nil, errors.WrapIf(err, "error in getting the list filter.")
+	}
+
 	var items []T
+	query := ApplyListFilter(db.WithContext(ctx).Model(&items), listFilter).Session(&gorm.Session{})
+
 	var totalRows int64
-	db.Model(items).Count(&totalRows)
-
-	// generate where query
-	query := db.Offset(listQuery.GetOffset()).Limit(listQuery.GetLimit()).Order(listQuery.GetOrderBy())
-
-	if listQuery.Filters != nil {
-		for _, filter := range listQuery.Filters {
-			column := filter.Field
-			action := filter.Comparison
-			value := filter.Value
-
-			switch action {
-			case "equals":
-				whereQuery := fmt.Sprintf("%s = ?", column)
-				query = query.Where(whereQuery, value)
-				break
-			case "contains":
-				whereQuery := fmt.Sprintf("%s LIKE ?", column)
-				query = query.Where(whereQuery, "%"+value+"%")
-				break
-			case "in":
-				whereQuery := fmt.Sprintf("%s IN (?)", column)
-				queryArray := strings.Split(value, ",")
-				query = query.Where(whereQuery, queryArray)
-				break
-
-			}
-		}
+	if err := query.Count(&totalRows).Error; err != nil {
+		tracing.TraceErr(span, err)
+		return nil, errors.WrapIf(err, "error in counting products.")
 	}
 
-	if err := query.Find(&items).Error; err != nil {
+	query = ApplyListSort(query, listFilter)
+	if err := query.Offset(listQuery.GetOffset()).Limit(listQuery.GetLimit()).Find(&items).Error; err != nil {
 		tracing.TraceErr(span, err)
 		return nil, errors.WrapIf(err, "error in finding products.")
 	}
@@ -0,0 +1,48 @@
+package gormPostgres
+
+import (
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
+)
+
+// ApplyListFilter adds the conditions of the list filter to the query, the fields are the whitelisted column names of the list query
+func ApplyListFilter(db *gorm.DB, listFilter *utils.ListFilter) *gorm.DB {
+	for _, condition := range listFilter.Conditions {
+		column := clause.Column{Name: condition.Field}
+
+		switch condition.Comparison {
+		case utils.NotEqualComparison:
+			db = db.Where(clause.Neq{Column: column, Value: condition.Values[0]})
+		case utils.GreaterThanComparison:
+			db = db.Where(clause.Gt{Column: column, Value: condition.Values[0]})
+		case utils.LessThanComparison:
+			db = db.Where(clause.Lt{Column: column, Value: condition.Values[0]})
+		case utils.ContainsComparison:
+			db = db.Where(clause.Expr{SQL: "? ILIKE ?", Vars: []interface{}{column, "%" + escapeLike(fmt.Sprint(condition.Values[0])) + "%"}})
+		case utils.InComparison:
+			db = db.Where(clause.IN{Column: column, Values: condition.Values})
+		case utils.BetweenComparison:
+			db = db.Where(clause.Expr{SQL: "? BETWEEN ? AND ?", Vars: []interface{}{column, condition.Values[0], condition.Values[1]}})
+		default:
+			db = db.Where(clause.Eq{Column: column, Value: condition.Values[0]})
+		}
+	}
+
+	return db
+}
+
+// ApplyListSort adds the sorts of the list filter to the query
+func ApplyListSort(db *gorm.DB, listFilter *utils.ListFilter) *gorm.DB {
+	for _, sort := range listFilter.Sorts {
+		db = db.Order(clause.OrderByColumn{Column: clause.Column{Name: sort.Field}, Desc: sort.Descending})
+	}
+
+	return db
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
@@ -0,0 +1,35 @@
+package gormPostgres
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gorm_postgres "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type testProduct struct {
+	Name  string
+	Price float64
+}
+
+func Test_Apply_List_Filter(t *testing.T) {
+	db, err := gorm.Open(gorm_postgres.New(gorm_postgres.Config{DSN: "host=localhost"}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	require.NoError(t, err)
+
+	listFilter := &utils.ListFilter{
+		Conditions: []*utils.FilterCondition{
+			{Field: "name", Comparison: utils.ContainsComparison, Values: []interface{}{"50%"}},
+			{Field: "price", Comparison: utils.BetweenComparison, Values: []interface{}{10.0, 20.0}},
+			{Field: "name", Comparison: utils.InComparison, Values: []interface{}{"a", "b"}},
+		},
+		Sorts: []*utils.SortField{{Field: "price", Descending: true}},
+	}
+
+	var products []*testProduct
+	stmt := ApplyListSort(ApplyListFilter(db.Model(&products), listFilter), listFilter).Find(&products).Statement
+
+	assert.Equal(t, `SELECT * FROM "test_products" WHERE "name" ILIKE $1 AND ("price" BETWEEN $2 AND $3) AND "name" IN ($4,$5) ORDER BY "price" DESC`, stmt.SQL.String())
+	assert.Equal(t, []interface{}{`%50\%%`, 10.0, 20.0, "a", "b"}, stmt.Vars)
+}
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"emperror.dev/errors"
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpcOpentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type GrpcClientConfig struct {
+	Address string `mapstructure:"address" env:"Address"`
+}
+
+// NewGrpcClient dials the configured address, the connection is established lazily by the first call
+func NewGrpcClient(config *GrpcClientConfig) (*grpc.ClientConn, error) {
+	conn, err := grpc.Dial(config.Address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(grpcMiddleware.ChainUnaryClient(
+			grpcOpentracing.UnaryClientInterceptor(),
+			metadataUnaryClientInterceptor),
+		),
+		grpc.WithStreamInterceptor(grpcMiddleware.ChainStreamClient(
+			grpcOpentracing.StreamClientInterceptor(),
+			metadataStreamClientInterceptor),
+		),
+	)
+	if err != nil {
+		return nil, errors.WrapIf(err, "grpc.Dial")
+	}
+
+	return conn, nil
+}
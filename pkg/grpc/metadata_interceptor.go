@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/auth"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/grpc/grpcErrors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"strings"
+)
+
+const (
+	correlationIdHeader = "x-correlation-id"
+	authorizationHeader = "authorization"
+)
+
+// metadataUnaryServerInterceptor puts the correlation id and the user identity of the verified access token of the incoming grpc metadata into the context of the call
+func metadataUnaryServerInterceptor(config *auth.JwtConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := contextWithIncomingMetadata(ctx, config)
+		if err != nil {
+			return nil, grpcErrors.ErrGrpcResponse(err)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// metadataStreamServerInterceptor puts the incoming metadata into the context of the stream, the handlers read it with stream.Context()
+func metadataStreamServerInterceptor(config *auth.JwtConfig) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := contextWithIncomingMetadata(stream.Context(), config)
+		if err != nil {
+			return grpcErrors.ErrGrpcResponse(err)
+		}
+
+		wrapped := grpcMiddleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+
+		return handler(srv, wrapped)
+	}
+}
+
+// contextWithIncomingMetadata reads the user identity only from a verified bearer token, a call without a token is anonymous and a call with an invalid token is rejected
+func contextWithIncomingMetadata(ctx context.Context, config *auth.JwtConfig) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+
+	if values := md.Get(correlationIdHeader); len(values) > 0 && values[0] != "" {
+		ctx = core.ContextWithCorrelationId(ctx, values[0])
+	}
+
+	values := md.Get(authorizationHeader)
+	if config == nil || len(values) == 0 || values[0] == "" {
+		return ctx, nil
+	}
+
+	accessToken := strings.TrimPrefix(values[0], auth.BearerScheme+" ")
+	if accessToken == values[0] {
+		return nil, customErrors.NewUnAuthorizedError("[contextWithIncomingMetadata] the authorization metadata is not a bearer token")
+	}
+
+	claims, err := auth.ParseAccessToken(config, accessToken)
+	if err != nil {
+		return nil, customErrors.NewUnAuthorizedErrorWrap(err, "[contextWithIncomingMetadata.ParseAccessToken] the access token is not valid")
+	}
+
+	ctx = core.ContextWithUserId(ctx, claims.Subject)
+	ctx = core.ContextWithUserEmail(ctx, claims.Email)
+	ctx = core.ContextWithAccessToken(ctx, accessToken)
+
+	return ctx, nil
+}
+
+// metadataUnaryClientInterceptor propagates the correlation id and the access token of the context to the outgoing grpc metadata
+func metadataUnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(contextWithOutgoingMetadata(ctx), method, req, reply, cc, opts...)
+}
+
+// metadataStreamClientInterceptor propagates the correlation id and the access token of the context to the metadata of the outgoing stream
+func metadataStreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(contextWithOutgoingMetadata(ctx), desc, cc, method, opts...)
+}
+
+// contextWithOutgoingMetadata forwards the access token instead of the user identity, so the called service verifies the identity itself
+func contextWithOutgoingMetadata(ctx context.Context) context.Context {
+	if correlationId := core.GetCorrelationId(ctx); correlationId != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, correlationIdHeader, correlationId)
+	}
+	if accessToken := core.GetAccessToken(ctx); accessToken != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, authorizationHeader, auth.BearerScheme+" "+accessToken)
+	}
+
+	return ctx
+}
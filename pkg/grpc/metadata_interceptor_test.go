@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/auth"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+var jwtConfig = &auth.JwtConfig{SigningKey: "test-signing-key", Issuer: "store"}
+
+func Test_Metadata_Server_Interceptor(t *testing.T) {
+	var userEmail string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		userEmail = core.GetUserEmail(ctx)
+		return nil, nil
+	}
+
+	call := func(md metadata.MD) error {
+		userEmail = ""
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		_, err := metadataUnaryServerInterceptor(jwtConfig)(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+		return err
+	}
+
+	t.Run("spoofed user metadata is ignored", func(t *testing.T) {
+		err := call(metadata.Pairs("x-user-id", "victim", "x-user-email", "victim@store.com"))
+
+		require.NoError(t, err)
+		assert.Empty(t, userEmail)
+	})
+
+	t.Run("verified access token sets the user email", func(t *testing.T) {
+		accessToken, err := auth.NewAccessToken(jwtConfig, &auth.UserClaims{Email: "customer@store.com"}, time.Hour)
+		require.NoError(t, err)
+
+		err = call(metadata.Pairs(authorizationHeader, auth.BearerScheme+" "+accessToken, "x-user-email", "victim@store.com"))
+
+		require.NoError(t, err)
+		assert.Equal(t, "customer@store.com", userEmail)
+	})
+
+	t.Run("access token signed with another key is rejected", func(t *testing.T) {
+		accessToken, err := auth.NewAccessToken(&auth.JwtConfig{SigningKey: "attacker-key", Issuer: "store"}, &auth.UserClaims{Email: "victim@store.com"}, time.Hour)
+		require.NoError(t, err)
+
+		err = call(metadata.Pairs(authorizationHeader, auth.BearerScheme+" "+accessToken))
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Empty(t, userEmail)
+	})
+
+	t.Run("access token without expiry is rejected", func(t *testing.T) {
+		accessToken, err := auth.NewAccessToken(jwtConfig, &auth.UserClaims{Email: "customer@store.com"}, 0)
+		require.NoError(t, err)
+
+		err = call(metadata.Pairs(authorizationHeader, auth.BearerScheme+" "+accessToken))
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Empty(t, userEmail)
+	})
+}
+
+func Test_Metadata_Client_Interceptor_Forwards_Access_Token(t *testing.T) {
+	ctx := core.ContextWithUserEmail(context.Background(), "customer@store.com")
+	ctx = core.ContextWithAccessToken(ctx, "access-token")
+
+	md, _ := metadata.FromOutgoingContext(contextWithOutgoingMetadata(ctx))
+
+	assert.Equal(t, []string{auth.BearerScheme + " access-token"}, md.Get(authorizationHeader))
+	assert.Empty(t, md.Get("x-user-email"))
+}
@@ -8,6 +8,7 @@ import (
 	grpcCtxTags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	grpcOpentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpcPrometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/auth"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
@@ -26,6 +27,8 @@ const (
 type GrpcConfig struct {
 	Port        string `mapstructure:"port" env:"Port"`
 	Development bool   `mapstructure:"development" env:"Development"`
+	// Jwt verifies the access tokens of the calls, the calls carry no user identity when it is not configured
+	Jwt *auth.JwtConfig `mapstructure:"jwt" envPrefix:"Jwt_"`
 }
 
 type GrpcServer interface {
@@ -52,8 +55,16 @@ func NewGrpcServer(config *GrpcConfig, logger logger.Logger) *grpcServer {
 			grpcCtxTags.UnaryServerInterceptor(),
 			grpcOpentracing.UnaryServerInterceptor(),
 			grpcPrometheus.UnaryServerInterceptor,
+			metadataUnaryServerInterceptor(config.Jwt),
 			grpcRecovery.UnaryServerInterceptor()),
 		),
+		grpc.StreamInterceptor(grpcMiddleware.ChainStreamServer(
+			grpcCtxTags.StreamServerInterceptor(),
+			grpcOpentracing.StreamServerInterceptor(),
+			grpcPrometheus.StreamServerInterceptor,
+			metadataStreamServerInterceptor(config.Jwt),
+			grpcRecovery.StreamServerInterceptor()),
+		),
 	)
 
 	return &grpcServer{server: s, config: config, log: logger}
@@ -1,5 +1,7 @@
 package customEcho
 
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/auth"
+
 type EchoHttpConfig struct {
 	Port                string   `mapstructure:"port" validate:"required" env:"Port"`
 	Development         bool     `mapstructure:"development" env:"Development"`
@@ -8,4 +10,6 @@ type EchoHttpConfig struct {
 	IgnoreLogUrls       []string `mapstructure:"ignoreLogUrls"`
 	Timeout             int      `mapstructure:"timeout" env:"Timeout"`
 	Host                string   `mapstructure:"host" env:"Host"`
+	// Jwt verifies the access tokens of the requests, the requests carry no user identity when it is not configured
+	Jwt *auth.JwtConfig `mapstructure:"jwt" envPrefix:"Jwt_"`
 }
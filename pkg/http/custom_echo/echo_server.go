@@ -6,8 +6,11 @@ import (
 	"github.com/brpaz/echozap"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/auth"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/constants"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo/custom_hadnlers"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
 	"go.uber.org/zap"
 	"strings"
@@ -95,8 +98,12 @@ func (s *echoHttpServer) SetupDefaultMiddlewares() {
 		},
 	}))
 
+	// a panic in a handler is returned to the problem handler as an internal server error instead of crashing the server
+	s.echo.Use(middleware.Recover())
 	s.echo.Use(middleware.BodyLimit(constants.BodyLimit))
 	s.echo.Use(middleware.RequestID())
+	s.echo.Use(requestMetadata)
+	s.echo.Use(authentication(s.config.Jwt))
 	s.echo.Use(middleware.Logger())
 	s.echo.Use(middleware.GzipWithConfig(middleware.GzipConfig{
 		Level: constants.GzipLevel,
@@ -127,3 +134,51 @@ func apiVersion(next echo.HandlerFunc) echo.HandlerFunc {
 		return next(c)
 	}
 }
+
+// requestMetadata puts the correlation id of the request into its context, the request id is the correlation id when the client doesn't send one
+func requestMetadata(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+
+		correlationId := req.Header.Get(echo.HeaderXCorrelationID)
+		if correlationId == "" {
+			correlationId = GetRequestID(c)
+		}
+
+		ctx := core.ContextWithCorrelationId(req.Context(), correlationId)
+		c.SetRequest(req.WithContext(ctx))
+
+		return next(c)
+	}
+}
+
+// authentication puts the user identity of the verified bearer token into the context of the request, a request without a token is anonymous and a request with an invalid token is rejected
+func authentication(config *auth.JwtConfig) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			authorization := req.Header.Get(echo.HeaderAuthorization)
+			if config == nil || authorization == "" {
+				return next(c)
+			}
+
+			accessToken := strings.TrimPrefix(authorization, auth.BearerScheme+" ")
+			if accessToken == authorization {
+				return customErrors.NewUnAuthorizedError("[authentication] the authorization header is not a bearer token")
+			}
+
+			claims, err := auth.ParseAccessToken(config, accessToken)
+			if err != nil {
+				return customErrors.NewUnAuthorizedErrorWrap(err, "[authentication.ParseAccessToken] the access token is not valid")
+			}
+
+			ctx := core.ContextWithUserId(req.Context(), claims.Subject)
+			ctx = core.ContextWithUserEmail(ctx, claims.Email)
+			ctx = core.ContextWithAccessToken(ctx, accessToken)
+			c.SetRequest(req.WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package customEcho
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/auth"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo/custom_hadnlers"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var jwtConfig = &auth.JwtConfig{SigningKey: "test-signing-key", Issuer: "store"}
+
+func Test_Authentication(t *testing.T) {
+	var userEmail string
+	handler := authentication(jwtConfig)(func(c echo.Context) error {
+		userEmail = core.GetUserEmail(c.Request().Context())
+		return nil
+	})
+
+	serve := func(setHeaders func(header http.Header)) error {
+		userEmail = ""
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/orders/me", nil)
+		setHeaders(req.Header)
+		return handler(echo.New().NewContext(req, httptest.NewRecorder()))
+	}
+
+	t.Run("spoofed user headers are ignored", func(t *testing.T) {
+		err := serve(func(header http.Header) {
+			header.Set("X-User-Id", "victim")
+			header.Set("X-User-Email", "victim@store.com")
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, userEmail)
+	})
+
+	t.Run("verified access token sets the user email", func(t *testing.T) {
+		accessToken, err := auth.NewAccessToken(jwtConfig, &auth.UserClaims{Email: "customer@store.com"}, time.Hour)
+		require.NoError(t, err)
+
+		err = serve(func(header http.Header) {
+			header.Set(echo.HeaderAuthorization, auth.BearerScheme+" "+accessToken)
+			header.Set("X-User-Email", "victim@store.com")
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "customer@store.com", userEmail)
+	})
+
+	t.Run("access token signed with another key is rejected", func(t *testing.T) {
+		accessToken, err := auth.NewAccessToken(&auth.JwtConfig{SigningKey: "attacker-key", Issuer: "store"}, &auth.UserClaims{Email: "victim@store.com"}, time.Hour)
+		require.NoError(t, err)
+
+		err = serve(func(header http.Header) {
+			header.Set(echo.HeaderAuthorization, auth.BearerScheme+" "+accessToken)
+		})
+
+		assert.True(t, customErrors.IsUnAuthorizedError(err))
+		assert.Empty(t, userEmail)
+	})
+
+	t.Run("access token without expiry is rejected", func(t *testing.T) {
+		accessToken, err := auth.NewAccessToken(jwtConfig, &auth.UserClaims{Email: "customer@store.com"}, 0)
+		require.NoError(t, err)
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/orders/me", nil)
+		req.Header.Set(echo.HeaderAuthorization, auth.BearerScheme+" "+accessToken)
+		e := echo.New()
+		e.HTTPErrorHandler = customHadnlers.ProblemHandler
+		e.Use(authentication(jwtConfig))
+		e.GET("/api/v1/orders/me", func(c echo.Context) error {
+			return c.NoContent(http.StatusOK)
+		})
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("unsigned access token is rejected", func(t *testing.T) {
+		// {"alg":"none"} with the claims {"email":"victim@store.com","iss":"store"}
+		accessToken := "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0.eyJlbWFpbCI6InZpY3RpbUBzdG9yZS5jb20iLCJpc3MiOiJzdG9yZSJ9."
+
+		err := serve(func(header http.Header) {
+			header.Set(echo.HeaderAuthorization, auth.BearerScheme+" "+accessToken)
+		})
+
+		assert.True(t, customErrors.IsUnAuthorizedError(err))
+		assert.Empty(t, userEmail)
+	})
+}
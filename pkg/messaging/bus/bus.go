@@ -2,9 +2,16 @@ package bus
 
 import (
 	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
 )
 
 type Bus interface {
 	Start(ctx context.Context) error
 	Stop(ctx context.Context) error
+	// Pause stops all the consumers from receiving new messages, for example during a maintenance of the database
+	Pause(ctx context.Context) error
+	Resume(ctx context.Context) error
+	// Drain stops all the consumers from receiving new messages and waits for their in-flight messages to be handled
+	Drain(ctx context.Context) error
+	Status() []consumer.ConsumerStatus
 }
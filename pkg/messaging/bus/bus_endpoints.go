@@ -0,0 +1,39 @@
+package bus
+
+import (
+	"github.com/labstack/echo/v4"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"net/http"
+)
+
+// MapBusRoutes maps the admin endpoints for inspecting the consumers state and pausing, resuming and draining the consumers on the group
+func MapBusRoutes(group *echo.Group, bus Bus) {
+	group.GET("", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, bus.Status())
+	})
+
+	group.POST("/pause", func(c echo.Context) error {
+		if err := bus.Pause(c.Request().Context()); err != nil {
+			return customErrors.NewApplicationErrorWrap(err, "[busEndpoints_Pause] error in pausing consumers")
+		}
+
+		return c.JSON(http.StatusOK, bus.Status())
+	})
+
+	group.POST("/resume", func(c echo.Context) error {
+		if err := bus.Resume(c.Request().Context()); err != nil {
+			return customErrors.NewApplicationErrorWrap(err, "[busEndpoints_Resume] error in resuming consumers")
+		}
+
+		return c.JSON(http.StatusOK, bus.Status())
+	})
+
+	// drain waits for the in-flight messages until the request is canceled or timed out
+	group.POST("/drain", func(c echo.Context) error {
+		if err := bus.Drain(c.Request().Context()); err != nil {
+			return customErrors.NewApplicationErrorWrap(err, "[busEndpoints_Drain] error in draining consumers")
+		}
+
+		return c.JSON(http.StatusOK, bus.Status())
+	})
+}
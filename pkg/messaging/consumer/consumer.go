@@ -7,4 +7,10 @@ import (
 type Consumer interface {
 	Consume(ctx context.Context) error
 	UnConsume(ctx context.Context) error
+	// Pause stops receiving new messages until Resume is called, the in-flight messages are handled completely
+	Pause(ctx context.Context) error
+	Resume(ctx context.Context) error
+	// Drain stops receiving new messages and waits for the in-flight messages to be handled
+	Drain(ctx context.Context) error
+	Status() ConsumerStatus
 }
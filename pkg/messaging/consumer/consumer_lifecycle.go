@@ -0,0 +1,173 @@
+package consumer
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ConsumerLifecycle keeps the state of a consumer and tracks its worker goroutines and in-flight deliveries, so a consumer can be paused,
+// resumed and drained without polling. The transport specific subscribe and cancel operations are passed to the state transitions.
+type ConsumerLifecycle struct {
+	name     string
+	mu       sync.Mutex
+	state    ConsumerState
+	inFlight int64
+
+	activeMu sync.Mutex
+	active   int
+	idle     chan struct{}
+}
+
+func NewConsumerLifecycle(name string) *ConsumerLifecycle {
+	return &ConsumerLifecycle{name: name, state: Idle}
+}
+
+// Start subscribes the consumer, a paused consumer stays paused when it is started again for example after reconnecting to the broker
+func (l *ConsumerLifecycle) Start(subscribe func() error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.state == Paused {
+		return nil
+	}
+
+	if err := subscribe(); err != nil {
+		return err
+	}
+	l.state = Running
+
+	return nil
+}
+
+// Pause cancels the subscription so no new delivery is received, the in-flight deliveries are handled completely
+func (l *ConsumerLifecycle) Pause(cancel func() error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	switch l.state {
+	case Paused:
+		return nil
+	case Running:
+		if err := cancel(); err != nil {
+			return err
+		}
+		l.state = Paused
+		return nil
+	default:
+		return errors.Errorf("consumer %s can't be paused in %s state", l.name, l.state)
+	}
+}
+
+// Resume subscribes a paused consumer again
+func (l *ConsumerLifecycle) Resume(subscribe func() error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	switch l.state {
+	case Running:
+		return nil
+	case Paused:
+		if err := subscribe(); err != nil {
+			return err
+		}
+		l.state = Running
+		return nil
+	default:
+		return errors.Errorf("consumer %s can't be resumed in %s state", l.name, l.state)
+	}
+}
+
+// Drain cancels the subscription and waits until the workers and the in-flight deliveries are finished or the ctx is done
+func (l *ConsumerLifecycle) Drain(ctx context.Context, cancel func() error) error {
+	l.mu.Lock()
+	if l.state == Running {
+		if err := cancel(); err != nil {
+			l.mu.Unlock()
+			return err
+		}
+	}
+	l.state = Draining
+	l.mu.Unlock()
+
+	if err := l.wait(ctx); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.state == Draining {
+		l.state = Stopped
+	}
+
+	return nil
+}
+
+// Go runs a worker goroutine of the subscription, draining waits for the workers to exit
+func (l *ConsumerLifecycle) Go(worker func()) {
+	l.add()
+	go func() {
+		defer l.done()
+		worker()
+	}()
+}
+
+// BeginDelivery should be called before handling a delivery and EndDelivery after the delivery is handled
+func (l *ConsumerLifecycle) BeginDelivery() {
+	atomic.AddInt64(&l.inFlight, 1)
+	l.add()
+}
+
+func (l *ConsumerLifecycle) EndDelivery() {
+	atomic.AddInt64(&l.inFlight, -1)
+	l.done()
+}
+
+func (l *ConsumerLifecycle) State() ConsumerState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.state
+}
+
+func (l *ConsumerLifecycle) Status() ConsumerStatus {
+	return ConsumerStatus{Name: l.name, State: l.State(), InFlight: atomic.LoadInt64(&l.inFlight)}
+}
+
+func (l *ConsumerLifecycle) add() {
+	l.activeMu.Lock()
+	defer l.activeMu.Unlock()
+
+	if l.active == 0 {
+		l.idle = make(chan struct{})
+	}
+	l.active++
+}
+
+func (l *ConsumerLifecycle) done() {
+	l.activeMu.Lock()
+	defer l.activeMu.Unlock()
+
+	l.active--
+	if l.active == 0 {
+		close(l.idle)
+	}
+}
+
+func (l *ConsumerLifecycle) wait(ctx context.Context) error {
+	l.activeMu.Lock()
+	if l.active == 0 {
+		l.activeMu.Unlock()
+		return nil
+	}
+	idle := l.idle
+	l.activeMu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
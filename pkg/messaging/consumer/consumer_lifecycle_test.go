@@ -0,0 +1,85 @@
+package consumer
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_Lifecycle_Pause_And_Resume(t *testing.T) {
+	lifecycle := NewConsumerLifecycle("test_queue")
+	subscriptions := 0
+	subscribe := func() error {
+		subscriptions++
+		return nil
+	}
+	cancel := func() error {
+		subscriptions--
+		return nil
+	}
+
+	assert.Error(t, lifecycle.Pause(cancel))
+	assert.Error(t, lifecycle.Resume(subscribe))
+
+	assert.NoError(t, lifecycle.Start(subscribe))
+	assert.Equal(t, Running, lifecycle.State())
+
+	assert.NoError(t, lifecycle.Pause(cancel))
+	assert.NoError(t, lifecycle.Pause(cancel))
+	assert.Equal(t, Paused, lifecycle.State())
+	assert.Equal(t, 0, subscriptions)
+
+	// starting again after reconnecting keeps the consumer paused
+	assert.NoError(t, lifecycle.Start(subscribe))
+	assert.Equal(t, Paused, lifecycle.State())
+	assert.Equal(t, 0, subscriptions)
+
+	assert.NoError(t, lifecycle.Resume(subscribe))
+	assert.NoError(t, lifecycle.Resume(subscribe))
+	assert.Equal(t, Running, lifecycle.State())
+	assert.Equal(t, 1, subscriptions)
+}
+
+func Test_Lifecycle_Drain_Waits_For_In_Flight_Deliveries(t *testing.T) {
+	lifecycle := NewConsumerLifecycle("test_queue")
+	assert.NoError(t, lifecycle.Start(func() error { return nil }))
+
+	lifecycle.BeginDelivery()
+	assert.Equal(t, int64(1), lifecycle.Status().InFlight)
+
+	drained := make(chan error)
+	go func() {
+		drained <- lifecycle.Drain(context.Background(), func() error { return nil })
+	}()
+
+	select {
+	case <-drained:
+		t.Fatal("drain finished before the in-flight delivery")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.Equal(t, Draining, lifecycle.State())
+	assert.Error(t, lifecycle.Resume(func() error { return nil }))
+
+	lifecycle.EndDelivery()
+	assert.NoError(t, <-drained)
+	assert.Equal(t, ConsumerStatus{Name: "test_queue", State: Stopped, InFlight: 0}, lifecycle.Status())
+}
+
+func Test_Lifecycle_Drain_Times_Out(t *testing.T) {
+	lifecycle := NewConsumerLifecycle("test_queue")
+	assert.NoError(t, lifecycle.Start(func() error { return nil }))
+
+	worker := make(chan struct{})
+	lifecycle.Go(func() { <-worker })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, lifecycle.Drain(ctx, func() error { return nil }), context.DeadlineExceeded)
+	assert.Equal(t, Draining, lifecycle.State())
+
+	close(worker)
+	assert.NoError(t, lifecycle.Drain(context.Background(), func() error { return nil }))
+	assert.Equal(t, Stopped, lifecycle.State())
+}
@@ -0,0 +1,18 @@
+package consumer
+
+type ConsumerState string
+
+const (
+	// Idle consumer is created but has not started consuming yet
+	Idle     ConsumerState = "idle"
+	Running  ConsumerState = "running"
+	Paused   ConsumerState = "paused"
+	Draining ConsumerState = "draining"
+	Stopped  ConsumerState = "stopped"
+)
+
+type ConsumerStatus struct {
+	Name     string        `json:"name"`
+	State    ConsumerState `json:"state"`
+	InFlight int64         `json:"inFlight"`
+}
@@ -0,0 +1,102 @@
+package inmemory
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/utils"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+)
+
+type InMemoryConsumer[T types.IMessage] struct {
+	transport       *Transport
+	topic           string
+	queue           string
+	handler         consumer.ConsumerHandler[T]
+	eventSerializer serializer.EventSerializer
+	logger          logger.Logger
+	subscriptionId  uint64
+	lifecycle       *consumer.ConsumerLifecycle
+}
+
+// NewInMemoryConsumer creates a consumer for message type T, by default it subscribes to the same topic name that the producers use for T.
+func NewInMemoryConsumer[T types.IMessage](transport *Transport, handler consumer.ConsumerHandler[T], eventSerializer serializer.EventSerializer, logger logger.Logger) consumer.Consumer {
+	return NewInMemoryConsumerWithTopicName[T](transport, utils.GetTopicOrExchangeName(*new(T)), handler, eventSerializer, logger)
+}
+
+func NewInMemoryConsumerWithTopicName[T types.IMessage](transport *Transport, topic string, handler consumer.ConsumerHandler[T], eventSerializer serializer.EventSerializer, logger logger.Logger) consumer.Consumer {
+	return NewInMemoryConsumerWithQueueName[T](transport, topic, "", handler, eventSerializer, logger)
+}
+
+// NewInMemoryConsumerWithQueueName creates a consumer which also receives the messages published only to its queue, like a RabbitMQ queue bound to the topic exchange
+func NewInMemoryConsumerWithQueueName[T types.IMessage](transport *Transport, topic string, queue string, handler consumer.ConsumerHandler[T], eventSerializer serializer.EventSerializer, logger logger.Logger) consumer.Consumer {
+	return &InMemoryConsumer[T]{transport: transport, topic: topic, queue: queue, handler: handler, eventSerializer: eventSerializer, logger: logger, lifecycle: consumer.NewConsumerLifecycle(topic)}
+}
+
+func (c *InMemoryConsumer[T]) Consume(ctx context.Context) error {
+	if c.transport == nil {
+		return errors.New("transport is nil")
+	}
+
+	return c.lifecycle.Start(c.subscribe)
+}
+
+func (c *InMemoryConsumer[T]) subscribe() error {
+	if c.subscriptionId == 0 {
+		c.subscriptionId = c.transport.subscribe(c.topic, c.queue, c.handle)
+	}
+
+	return nil
+}
+
+func (c *InMemoryConsumer[T]) unsubscribe() error {
+	if c.subscriptionId != 0 {
+		c.transport.unsubscribe(c.topic, c.subscriptionId)
+		c.subscriptionId = 0
+	}
+
+	return nil
+}
+
+// Pause unsubscribes the consumer, the transport doesn't keep the messages so the messages published while the consumer is paused are not delivered to it
+func (c *InMemoryConsumer[T]) Pause(ctx context.Context) error {
+	return c.lifecycle.Pause(c.unsubscribe)
+}
+
+func (c *InMemoryConsumer[T]) Resume(ctx context.Context) error {
+	return c.lifecycle.Resume(c.subscribe)
+}
+
+func (c *InMemoryConsumer[T]) Drain(ctx context.Context) error {
+	return c.lifecycle.Drain(ctx, c.unsubscribe)
+}
+
+func (c *InMemoryConsumer[T]) Status() consumer.ConsumerStatus {
+	return c.lifecycle.Status()
+}
+
+func (c *InMemoryConsumer[T]) UnConsume(ctx context.Context) error {
+	return c.Drain(ctx)
+}
+
+func (c *InMemoryConsumer[T]) handle(ctx context.Context, envelope *Envelope) error {
+	c.lifecycle.BeginDelivery()
+	defer c.lifecycle.EndDelivery()
+
+	deserialized, err := c.eventSerializer.DeserializeType(envelope.Data, typeMapper.GetTypeFromGeneric[T](), envelope.ContentType)
+	if err != nil {
+		return errors.WrapIf(err, "[InMemoryConsumer.handle] error in deserializing message")
+	}
+
+	message, ok := deserialized.(T)
+	if !ok {
+		return errors.Errorf("[InMemoryConsumer.handle] message type %s can't be converted to %s", envelope.MessageType, typeMapper.GetTypeNameByType(typeMapper.GetTypeFromGeneric[T]()))
+	}
+
+	consumeContext := types.NewMessageConsumeContext[T](message, envelope.Metadata, envelope.ContentType, envelope.MessageType, envelope.Created, envelope.Tag, envelope.MessageId, envelope.CorrelationId)
+
+	return c.handler.Handle(ctx, consumeContext)
+}
@@ -0,0 +1,52 @@
+package inmemory
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Paused_Consumer_Does_Not_Receive_Messages(t *testing.T) {
+	ctx := context.Background()
+	transport := NewInMemoryTransport()
+	serializer := json.NewJsonEventSerializer()
+	producer := NewInMemoryProducer(transport, serializer, defaultLogger.Logger)
+
+	handler := &inMemoryTestHandler{}
+	c := NewInMemoryConsumer[*InMemoryTestMessage](transport, handler, serializer, defaultLogger.Logger)
+	assert.Equal(t, consumer.Idle, c.Status().State)
+	assert.NoError(t, c.Consume(ctx))
+
+	assert.NoError(t, producer.Publish(ctx, &InMemoryTestMessage{Message: types.NewMessage(uuid.NewV4().String())}, nil))
+	assert.Equal(t, 1, handler.handled)
+
+	assert.NoError(t, c.Pause(ctx))
+	assert.Equal(t, consumer.Paused, c.Status().State)
+	assert.NoError(t, producer.Publish(ctx, &InMemoryTestMessage{Message: types.NewMessage(uuid.NewV4().String())}, nil))
+	assert.Equal(t, 1, handler.handled)
+
+	assert.NoError(t, c.Resume(ctx))
+	assert.NoError(t, producer.Publish(ctx, &InMemoryTestMessage{Message: types.NewMessage(uuid.NewV4().String())}, nil))
+	assert.Equal(t, 2, handler.handled)
+
+	assert.NoError(t, c.UnConsume(ctx))
+	assert.Equal(t, consumer.Stopped, c.Status().State)
+}
+
+type InMemoryTestMessage struct {
+	*types.Message
+}
+
+type inMemoryTestHandler struct {
+	handled int
+}
+
+func (h *inMemoryTestHandler) Handle(ctx context.Context, consumeContext types.IMessageConsumeContext[*InMemoryTestMessage]) error {
+	h.handled++
+	return nil
+}
@@ -0,0 +1,80 @@
+package inmemory
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/producer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/utils"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+)
+
+type inMemoryProducer struct {
+	transport       *Transport
+	eventSerializer serializer.EventSerializer
+	logger          logger.Logger
+}
+
+func NewInMemoryProducer(transport *Transport, eventSerializer serializer.EventSerializer, logger logger.Logger) producer.Producer {
+	return &inMemoryProducer{transport: transport, eventSerializer: eventSerializer, logger: logger}
+}
+
+func (p *inMemoryProducer) Publish(ctx context.Context, message types.IMessage, metadata core.Metadata) error {
+	return p.PublishWithTopicName(ctx, message, metadata, "")
+}
+
+func (p *inMemoryProducer) PublishWithTopicName(ctx context.Context, message types.IMessage, metadata core.Metadata, topicOrExchangeName string) error {
+	envelope, err := p.envelope(message, metadata)
+	if err != nil {
+		return err
+	}
+
+	topic := topicOrExchangeName
+	if topic == "" {
+		topic = utils.GetTopicOrExchangeName(message)
+	}
+
+	// like a real broker, consumer failures don't fail the publisher
+	for _, err := range p.transport.publish(ctx, topic, envelope) {
+		p.logger.Errorf("[inMemoryProducer.PublishWithTopicName] error in handling message %s on topic %s: %v", envelope.MessageId, topic, err)
+	}
+
+	return nil
+}
+
+func (p *inMemoryProducer) PublishToQueue(ctx context.Context, message types.IMessage, metadata core.Metadata, queueName string) error {
+	envelope, err := p.envelope(message, metadata)
+	if err != nil {
+		return err
+	}
+
+	for _, err := range p.transport.publishToQueue(ctx, queueName, envelope) {
+		p.logger.Errorf("[inMemoryProducer.PublishToQueue] error in handling message %s on queue %s: %v", envelope.MessageId, queueName, err)
+	}
+
+	return nil
+}
+
+func (p *inMemoryProducer) envelope(message types.IMessage, metadata core.Metadata) (*Envelope, error) {
+	if message.GetEventTypeName() == "" {
+		message.SetEventTypeName(typeMapper.GetTypeName(message))
+	}
+	metadata = utils.GetMessageMetadata(message, metadata)
+
+	serializedObj, err := p.eventSerializer.Serialize(message)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Envelope{
+		MessageId:     message.GeMessageId(),
+		CorrelationId: message.GetCorrelationId(),
+		MessageType:   message.GetEventTypeName(),
+		ContentType:   serializedObj.ContentType,
+		Data:          serializedObj.Data,
+		Metadata:      metadata,
+		Created:       message.GetCreated(),
+	}, nil
+}
@@ -0,0 +1,102 @@
+package inmemory
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"sync"
+	"time"
+)
+
+// Envelope is a serialized message traveling through the in-memory transport, messages are serialized like a real broker so producers and consumers don't share message instances.
+type Envelope struct {
+	MessageId     string
+	CorrelationId string
+	MessageType   string
+	ContentType   string
+	Data          []byte
+	Metadata      core.Metadata
+	Created       time.Time
+	Tag           uint64
+}
+
+type subscriptionHandler func(ctx context.Context, envelope *Envelope) error
+
+type subscription struct {
+	id      uint64
+	queue   string
+	handler subscriptionHandler
+}
+
+// Transport is an in-process message transport for tests and local runs without a broker, each published envelope is delivered synchronously
+// to all the consumers subscribed to the message topic, or only to the consumers of a queue when it is published to the queue.
+type Transport struct {
+	mu             sync.RWMutex
+	subscriptions  map[string][]*subscription
+	subscriptionId uint64
+	tag            uint64
+}
+
+func NewInMemoryTransport() *Transport {
+	return &Transport{subscriptions: make(map[string][]*subscription)}
+}
+
+func (t *Transport) subscribe(topic string, queue string, handler subscriptionHandler) uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.subscriptionId++
+	t.subscriptions[topic] = append(t.subscriptions[topic], &subscription{id: t.subscriptionId, queue: queue, handler: handler})
+
+	return t.subscriptionId
+}
+
+func (t *Transport) unsubscribe(topic string, id uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	subscriptions := t.subscriptions[topic]
+	for i, s := range subscriptions {
+		if s.id == id {
+			t.subscriptions[topic] = append(subscriptions[:i:i], subscriptions[i+1:]...)
+			return
+		}
+	}
+}
+
+func (t *Transport) publish(ctx context.Context, topic string, envelope *Envelope) []error {
+	t.mu.Lock()
+	t.tag++
+	envelope.Tag = t.tag
+	subscriptions := append([]*subscription(nil), t.subscriptions[topic]...)
+	t.mu.Unlock()
+
+	return deliver(ctx, subscriptions, envelope)
+}
+
+func (t *Transport) publishToQueue(ctx context.Context, queue string, envelope *Envelope) []error {
+	t.mu.Lock()
+	t.tag++
+	envelope.Tag = t.tag
+	var subscriptions []*subscription
+	for _, topicSubscriptions := range t.subscriptions {
+		for _, s := range topicSubscriptions {
+			if s.queue != "" && s.queue == queue {
+				subscriptions = append(subscriptions, s)
+			}
+		}
+	}
+	t.mu.Unlock()
+
+	return deliver(ctx, subscriptions, envelope)
+}
+
+func deliver(ctx context.Context, subscriptions []*subscription, envelope *Envelope) []error {
+	var errs []error
+	for _, s := range subscriptions {
+		if err := s.handler(ctx, envelope); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,137 @@
+package messageRegistry
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MessageContract maps a message type to its stable wire name, so the go type can be renamed or moved without breaking the other services.
+type MessageContract struct {
+	// Name is the stable name of the message contract like `catalogs.product_created`
+	Name    string
+	Version int
+	// Aliases are the other wire names which are resolved to this contract, like the go type names that older producers publish
+	Aliases []string
+	Type    reflect.Type
+}
+
+// WireName is the name of the contract with its version which producers stamp into the message type, like `catalogs.product_created.v1`
+func (c *MessageContract) WireName() string {
+	return fmt.Sprintf("%s.v%d", c.Name, c.Version)
+}
+
+// MessageRegistry keeps the message contracts of a service, producers stamp the contract wire name into the message type and consumers resolve
+// the message type of a delivery through the registry instead of the go type names.
+type MessageRegistry struct {
+	mu     sync.RWMutex
+	byType map[reflect.Type]*MessageContract
+	byName map[string]*MessageContract
+}
+
+func NewMessageRegistry() *MessageRegistry {
+	return &MessageRegistry{byType: make(map[reflect.Type]*MessageContract), byName: make(map[string]*MessageContract)}
+}
+
+// Register adds the contract of message type T, it fails when T is already registered or when the wire name or one of the aliases
+// is already used by another message type, so conflicts are found on the startup.
+func Register[T types.IMessage](r *MessageRegistry, name string, version int, aliases ...string) error {
+	if name == "" {
+		return errors.New("message contract name is required")
+	}
+	if version < 1 {
+		return errors.Errorf("message contract %s version should be greater than zero", name)
+	}
+
+	contract := &MessageContract{Name: name, Version: version, Aliases: aliases, Type: typeMapper.GetTypeFromGeneric[T]()}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, exists := r.byType[contract.Type]; exists {
+		return errors.Errorf("message type %s is already registered as %s", contract.Type, existing.WireName())
+	}
+
+	names := append([]string{contract.WireName()}, aliases...)
+	for _, wireName := range names {
+		if existing, exists := r.byName[normalize(wireName)]; exists {
+			return errors.Errorf("wire name %s of message type %s is already used by message type %s", wireName, contract.Type, existing.Type)
+		}
+	}
+
+	r.byType[contract.Type] = contract
+	for _, wireName := range names {
+		r.byName[normalize(wireName)] = contract
+	}
+
+	// name based deserialization, like the poison message replay, resolves the wire name through the type mapper
+	typeMapper.RegisterTypeWithKey(contract.WireName(), contract.Type)
+
+	return nil
+}
+
+// WireName returns the wire name of the message type, a nil registry has no contract
+func (r *MessageRegistry) WireName(message types.IMessage) (string, bool) {
+	contract, ok := r.ContractByType(reflect.TypeOf(message))
+	if !ok {
+		return "", false
+	}
+
+	return contract.WireName(), true
+}
+
+func (r *MessageRegistry) ContractByType(typ reflect.Type) (*MessageContract, bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	contract, ok := r.byType[typ]
+
+	return contract, ok
+}
+
+// Resolve returns the contract of a wire name or an alias, the pointer prefix of the go type names (`*ProductCreatedV1`) is ignored
+func (r *MessageRegistry) Resolve(wireName string) (*MessageContract, bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	contract, ok := r.byName[normalize(wireName)]
+
+	return contract, ok
+}
+
+// Contracts returns the registered contracts ordered by their wire names
+func (r *MessageRegistry) Contracts() []*MessageContract {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	contracts := make([]*MessageContract, 0, len(r.byType))
+	for _, contract := range r.byType {
+		contracts = append(contracts, contract)
+	}
+	sort.Slice(contracts, func(i, j int) bool {
+		return contracts[i].WireName() < contracts[j].WireName()
+	})
+
+	return contracts
+}
+
+func normalize(wireName string) string {
+	return strings.TrimPrefix(wireName, "*")
+}
@@ -0,0 +1,65 @@
+package messageRegistry
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type RegistryCreatedMessage struct {
+	*types.Message
+}
+
+type RegistryUpdatedMessage struct {
+	*types.Message
+}
+
+func Test_Register_And_Resolve_Contract(t *testing.T) {
+	registry := NewMessageRegistry()
+	err := Register[*RegistryCreatedMessage](registry, "tests.registry_created", 1, "RegistryCreatedMessage")
+	assert.NoError(t, err)
+
+	wireName, ok := registry.WireName(&RegistryCreatedMessage{Message: types.NewMessage(uuid.NewV4().String())})
+	assert.True(t, ok)
+	assert.Equal(t, "tests.registry_created.v1", wireName)
+
+	for _, name := range []string{"tests.registry_created.v1", "RegistryCreatedMessage", "*RegistryCreatedMessage"} {
+		contract, ok := registry.Resolve(name)
+		assert.True(t, ok, name)
+		assert.Equal(t, typeMapper.GetTypeFromGeneric[*RegistryCreatedMessage](), contract.Type)
+	}
+
+	_, ok = registry.Resolve("tests.registry_created.v2")
+	assert.False(t, ok)
+	assert.Equal(t, typeMapper.GetTypeFromGeneric[*RegistryCreatedMessage](), typeMapper.TypeByName("tests.registry_created.v1"))
+}
+
+func Test_Register_Conflicts_Fail(t *testing.T) {
+	registry := NewMessageRegistry()
+	assert.NoError(t, Register[*RegistryCreatedMessage](registry, "tests.registry_conflict", 1, "RegistryConflictAlias"))
+
+	// the same type twice
+	assert.Error(t, Register[*RegistryCreatedMessage](registry, "tests.registry_conflict_other", 1))
+	// the wire name of another type
+	assert.Error(t, Register[*RegistryUpdatedMessage](registry, "tests.registry_conflict", 1))
+	// the alias of another type
+	assert.Error(t, Register[*RegistryUpdatedMessage](registry, "tests.registry_conflict_updated", 1, "RegistryConflictAlias"))
+	// invalid contracts
+	assert.Error(t, Register[*RegistryUpdatedMessage](registry, "", 1))
+	assert.Error(t, Register[*RegistryUpdatedMessage](registry, "tests.registry_conflict_updated", 0))
+
+	assert.NoError(t, Register[*RegistryUpdatedMessage](registry, "tests.registry_conflict_updated", 2))
+	assert.Len(t, registry.Contracts(), 2)
+}
+
+func Test_Nil_Registry_Has_No_Contracts(t *testing.T) {
+	var registry *MessageRegistry
+
+	_, ok := registry.WireName(&RegistryCreatedMessage{Message: types.NewMessage(uuid.NewV4().String())})
+	assert.False(t, ok)
+	_, ok = registry.Resolve("tests.registry_created.v1")
+	assert.False(t, ok)
+	assert.Empty(t, registry.Contracts())
+}
@@ -0,0 +1,59 @@
+package payload
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
+	"time"
+)
+
+// DefaultBlobSweepInterval is the interval of deleting the expired blobs when it isn't configured
+const DefaultBlobSweepInterval = time.Hour
+
+// DeleteExpiredBlobs deletes the blobs which are older than the retention of the options at now
+func DeleteExpiredBlobs(ctx context.Context, options *PayloadOptions, now time.Time) (int, error) {
+	retention := options.BlobRetention
+	if retention <= 0 {
+		retention = DefaultBlobRetention
+	}
+
+	return options.BlobStore.DeleteExpired(ctx, now.Add(-retention))
+}
+
+// NewBlobRetentionWorker deletes the expired blobs of the blob store periodically until the worker stops, it does nothing when there is no blob store
+func NewBlobRetentionWorker(options *PayloadOptions, logger logger.Logger) web.Worker {
+	if options == nil || options.BlobStore == nil {
+		return web.NewBackgroundWorker(nil, nil)
+	}
+
+	sweepInterval := options.BlobSweepInterval
+	if sweepInterval <= 0 {
+		sweepInterval = DefaultBlobSweepInterval
+	}
+	stop := make(chan struct{})
+
+	return web.NewBackgroundWorker(func(ctx context.Context) error {
+		ticker := time.NewTicker(sweepInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				deleted, err := DeleteExpiredBlobs(ctx, options, time.Now())
+				if err != nil && ctx.Err() == nil {
+					logger.Errorf("[BlobRetentionWorker.DeleteExpiredBlobs] error in deleting the expired blobs: {%v}", err)
+				}
+				if deleted > 0 {
+					logger.Infof("[BlobRetentionWorker.DeleteExpiredBlobs] %d expired blobs deleted", deleted)
+				}
+			case <-stop:
+				return nil
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}, func(ctx context.Context) error {
+		close(stop)
+		return nil
+	})
+}
@@ -0,0 +1,26 @@
+package payload
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"time"
+)
+
+var ErrBlobNotFound = errors.New("blob not found")
+
+// DefaultBlobRetention is the retention of the blobs when it isn't configured
+const DefaultBlobRetention = 7 * 24 * time.Hour
+
+// BlobStore keeps the large message bodies, the blobs are not deleted after consuming because a message could have several consumers,
+// so they are deleted by the BlobRetentionWorker after the retention.
+//
+// A blob must outlive every copy of its message: the message waiting in its queue, its redeliveries and its poison copy, which keeps the
+// claim-check header and is decoded again when it is replayed. So the retention should be longer than the message ttl of the queues and
+// the time the poison messages are kept before they are replayed or removed, a message whose blob is deleted can't be decoded anymore.
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+	// DeleteExpired deletes the blobs which are put before the expiry time, it returns the number of the deleted blobs
+	DeleteExpired(ctx context.Context, expiredBefore time.Time) (int, error)
+}
@@ -0,0 +1,77 @@
+package payload
+
+import (
+	"bytes"
+	"compress/gzip"
+	"emperror.dev/errors"
+	"github.com/klauspost/compress/zstd"
+	"io"
+)
+
+const (
+	GzipCompression = "gzip"
+	ZstdCompression = "zstd"
+)
+
+func compress(algorithm string, data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	switch algorithm {
+	case GzipCompression:
+		writer := gzip.NewWriter(&buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, errors.WrapIf(err, "error in gzip compression")
+		}
+		if err := writer.Close(); err != nil {
+			return nil, errors.WrapIf(err, "error in gzip compression")
+		}
+	case ZstdCompression:
+		writer, err := zstd.NewWriter(&buf)
+		if err != nil {
+			return nil, errors.WrapIf(err, "error in zstd compression")
+		}
+		if _, err := writer.Write(data); err != nil {
+			return nil, errors.WrapIf(err, "error in zstd compression")
+		}
+		if err := writer.Close(); err != nil {
+			return nil, errors.WrapIf(err, "error in zstd compression")
+		}
+	default:
+		return nil, errors.Errorf("compression %s is not supported", algorithm)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decompress(algorithm string, data []byte) ([]byte, error) {
+	switch algorithm {
+	case GzipCompression:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.WrapIf(err, "error in gzip decompression")
+		}
+		defer reader.Close()
+
+		decompressed, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, errors.WrapIf(err, "error in gzip decompression")
+		}
+
+		return decompressed, nil
+	case ZstdCompression:
+		reader, err := zstd.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.WrapIf(err, "error in zstd decompression")
+		}
+		defer reader.Close()
+
+		decompressed, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, errors.WrapIf(err, "error in zstd decompression")
+		}
+
+		return decompressed, nil
+	default:
+		return nil, errors.Errorf("compression %s is not supported", algorithm)
+	}
+}
@@ -0,0 +1,117 @@
+package payload
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type fileSystemBlobStore struct {
+	rootPath string
+}
+
+// NewFileSystemBlobStore creates a BlobStore which keeps each blob in a file under the root path, the root path should be shared between the producers and consumers
+func NewFileSystemBlobStore(rootPath string) (BlobStore, error) {
+	if err := os.MkdirAll(rootPath, 0o755); err != nil {
+		return nil, errors.WrapIff(err, "error in creating blob store directory %s", rootPath)
+	}
+
+	return &fileSystemBlobStore{rootPath: rootPath}, nil
+}
+
+func (s *fileSystemBlobStore) Put(ctx context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	// write to a temp file and rename it, so a consumer never reads a partially written blob
+	tempPath := path + ".tmp"
+	if err := os.WriteFile(tempPath, data, 0o644); err != nil {
+		return errors.WrapIff(err, "error in writing blob %s", key)
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		return errors.WrapIff(err, "error in writing blob %s", key)
+	}
+
+	return nil
+}
+
+func (s *fileSystemBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.WithStack(ErrBlobNotFound)
+	}
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in reading blob %s", key)
+	}
+
+	return data, nil
+}
+
+func (s *fileSystemBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.WrapIff(err, "error in deleting blob %s", key)
+	}
+
+	return nil
+}
+
+// DeleteExpired deletes the blob files by their modification time, the temp files of the interrupted writes are deleted too
+func (s *fileSystemBlobStore) DeleteExpired(ctx context.Context, expiredBefore time.Time) (int, error) {
+	entries, err := os.ReadDir(s.rootPath)
+	if err != nil {
+		return 0, errors.WrapIff(err, "error in reading blob store directory %s", s.rootPath)
+	}
+
+	deleted := 0
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return deleted, ctx.Err()
+		}
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return deleted, errors.WrapIff(err, "error in reading blob %s", entry.Name())
+		}
+		if !info.ModTime().Before(expiredBefore) {
+			continue
+		}
+
+		err = os.Remove(filepath.Join(s.rootPath, entry.Name()))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return deleted, errors.WrapIff(err, "error in deleting blob %s", entry.Name())
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+func (s *fileSystemBlobStore) path(key string) (string, error) {
+	// keys come from the message headers, so they shouldn't be able to point outside the root path
+	if key == "" || filepath.Base(key) != key {
+		return "", errors.Errorf("blob key %s is not valid", key)
+	}
+
+	return filepath.Join(s.rootPath, key), nil
+}
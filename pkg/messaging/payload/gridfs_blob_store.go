@@ -0,0 +1,84 @@
+package payload
+
+import (
+	"bytes"
+	"context"
+	"emperror.dev/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type gridFSBlobStore struct {
+	bucket *gridfs.Bucket
+}
+
+// NewGridFSBlobStore creates a BlobStore on a mongo GridFS bucket, the blob key is used as the file id
+func NewGridFSBlobStore(db *mongo.Database, bucketName string) (BlobStore, error) {
+	bucket, err := gridfs.NewBucket(db, options.GridFSBucket().SetName(bucketName))
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in creating gridfs bucket %s", bucketName)
+	}
+
+	return &gridFSBlobStore{bucket: bucket}, nil
+}
+
+func (s *gridFSBlobStore) Put(ctx context.Context, key string, data []byte) error {
+	err := s.bucket.UploadFromStreamWithID(key, key, bytes.NewReader(data))
+	if err != nil {
+		return errors.WrapIff(err, "error in uploading blob %s", key)
+	}
+
+	return nil
+}
+
+func (s *gridFSBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	_, err := s.bucket.DownloadToStream(key, &buf)
+	if errors.Is(err, gridfs.ErrFileNotFound) {
+		return nil, errors.WithStack(ErrBlobNotFound)
+	}
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in downloading blob %s", key)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *gridFSBlobStore) Delete(ctx context.Context, key string) error {
+	err := s.bucket.Delete(key)
+	if err != nil && !errors.Is(err, gridfs.ErrFileNotFound) {
+		return errors.WrapIff(err, "error in deleting blob %s", key)
+	}
+
+	return nil
+}
+
+// DeleteExpired deletes the blob files by their upload date. A ttl index on the files collection isn't used, because it would leave the chunks
+// of the deleted files behind
+func (s *gridFSBlobStore) DeleteExpired(ctx context.Context, expiredBefore time.Time) (int, error) {
+	cursor, err := s.bucket.Find(bson.M{"uploadDate": bson.M{"$lt": expiredBefore}})
+	if err != nil {
+		return 0, errors.WrapIf(err, "error in finding the expired blobs")
+	}
+
+	var files []struct {
+		Id string `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &files); err != nil {
+		return 0, errors.WrapIf(err, "error in finding the expired blobs")
+	}
+
+	deleted := 0
+	for _, file := range files {
+		if err := s.Delete(ctx, file.Id); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
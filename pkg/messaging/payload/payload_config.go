@@ -0,0 +1,77 @@
+package payload
+
+import (
+	"emperror.dev/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+	"time"
+)
+
+const (
+	FileSystemBlobStore = "filesystem"
+	GridFSBlobStore     = "gridfs"
+)
+
+type PayloadConfig struct {
+	CompressionThreshold int              `mapstructure:"compressionThreshold"`
+	Compression          string           `mapstructure:"compression"`
+	ClaimCheckThreshold  int              `mapstructure:"claimCheckThreshold"`
+	BlobStore            *BlobStoreConfig `mapstructure:"blobStore"`
+}
+
+type BlobStoreConfig struct {
+	// Type is `filesystem` or `gridfs`
+	Type string `mapstructure:"type"`
+	// Path is the root directory of the filesystem blob store
+	Path string `mapstructure:"path"`
+	// Bucket is the GridFS bucket name of the gridfs blob store
+	Bucket string `mapstructure:"bucket"`
+	// Retention is how long a blob is kept after it is put, it should be longer than the message ttl of the queues and the time the poison
+	// messages are kept for replay. DefaultBlobRetention is used when it is zero
+	Retention time.Duration `mapstructure:"retention"`
+	// SweepInterval is the interval of deleting the expired blobs, DefaultBlobSweepInterval is used when it is zero
+	SweepInterval time.Duration `mapstructure:"sweepInterval"`
+}
+
+// NewPayloadOptions creates the payload options from the config, the mongo database is only needed for the gridfs blob store. a nil config disables the payload encoding.
+func NewPayloadOptions(cfg *PayloadConfig, db *mongo.Database) (*PayloadOptions, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	payloadOptions := &PayloadOptions{
+		CompressionThreshold: cfg.CompressionThreshold,
+		Compression:          cfg.Compression,
+		ClaimCheckThreshold:  cfg.ClaimCheckThreshold,
+	}
+	if payloadOptions.Compression == "" {
+		payloadOptions.Compression = GzipCompression
+	}
+
+	if cfg.BlobStore == nil {
+		return payloadOptions, nil
+	}
+	payloadOptions.BlobRetention = cfg.BlobStore.Retention
+	payloadOptions.BlobSweepInterval = cfg.BlobStore.SweepInterval
+
+	switch cfg.BlobStore.Type {
+	case FileSystemBlobStore:
+		blobStore, err := NewFileSystemBlobStore(cfg.BlobStore.Path)
+		if err != nil {
+			return nil, err
+		}
+		payloadOptions.BlobStore = blobStore
+	case GridFSBlobStore:
+		if db == nil {
+			return nil, errors.New("mongo database is required for the gridfs blob store")
+		}
+		blobStore, err := NewGridFSBlobStore(db, cfg.BlobStore.Bucket)
+		if err != nil {
+			return nil, err
+		}
+		payloadOptions.BlobStore = blobStore
+	default:
+		return nil, errors.Errorf("blob store type %s is not supported", cfg.BlobStore.Type)
+	}
+
+	return payloadOptions, nil
+}
@@ -0,0 +1,69 @@
+package payload
+
+import (
+	"context"
+	"emperror.dev/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Encode compresses the body when it is larger than the compression threshold and puts it in the blob store when it is larger than the claim-check threshold,
+// it returns the body that should be published and the headers that describe how the body is encoded.
+func Encode(ctx context.Context, options *PayloadOptions, body []byte) ([]byte, map[string]interface{}, error) {
+	headers := make(map[string]interface{})
+	if options == nil {
+		return body, headers, nil
+	}
+
+	encoded := body
+
+	if options.CompressionThreshold > 0 && len(body) > options.CompressionThreshold {
+		compressed, err := compress(options.Compression, body)
+		if err != nil {
+			return nil, nil, err
+		}
+		encoded = compressed
+		headers[CompressionHeader] = options.Compression
+	}
+
+	if options.ClaimCheckThreshold > 0 && len(body) > options.ClaimCheckThreshold {
+		if options.BlobStore == nil {
+			return nil, nil, errors.New("blob store is not configured for the claim-check")
+		}
+
+		key := uuid.NewV4().String()
+		if err := options.BlobStore.Put(ctx, key, encoded); err != nil {
+			return nil, nil, err
+		}
+		encoded = []byte{}
+		headers[ClaimCheckHeader] = key
+	}
+
+	return encoded, headers, nil
+}
+
+// Decode rehydrates the body of a claim-check message from the blob store and decompresses it, bodies without payload headers are returned as they are.
+func Decode(ctx context.Context, options *PayloadOptions, body []byte, headers map[string]interface{}) ([]byte, error) {
+	decoded := body
+
+	if key, ok := headers[ClaimCheckHeader].(string); ok && key != "" {
+		if options == nil || options.BlobStore == nil {
+			return nil, errors.Errorf("blob store is not configured for the claim-check %s", key)
+		}
+
+		data, err := options.BlobStore.Get(ctx, key)
+		if err != nil {
+			return nil, errors.WrapIff(err, "error in getting claim-check %s", key)
+		}
+		decoded = data
+	}
+
+	if algorithm, ok := headers[CompressionHeader].(string); ok && algorithm != "" {
+		decompressed, err := decompress(algorithm, decoded)
+		if err != nil {
+			return nil, err
+		}
+		decoded = decompressed
+	}
+
+	return decoded, nil
+}
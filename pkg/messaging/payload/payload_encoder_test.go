@@ -0,0 +1,97 @@
+package payload
+
+import (
+	"bytes"
+	"context"
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_Small_Payload_Is_Not_Encoded(t *testing.T) {
+	body := []byte("small body")
+
+	encoded, headers, err := Encode(context.Background(), &PayloadOptions{CompressionThreshold: 1024, Compression: GzipCompression}, body)
+	assert.NoError(t, err)
+	assert.Equal(t, body, encoded)
+	assert.Empty(t, headers)
+}
+
+func Test_Compressed_Payload_Round_Trip(t *testing.T) {
+	body := bytes.Repeat([]byte("long product description "), 1000)
+
+	for _, compression := range []string{GzipCompression, ZstdCompression} {
+		options := &PayloadOptions{CompressionThreshold: 1024, Compression: compression}
+
+		encoded, headers, err := Encode(context.Background(), options, body)
+		assert.NoError(t, err)
+		assert.Less(t, len(encoded), len(body))
+		assert.Equal(t, compression, headers[CompressionHeader])
+
+		decoded, err := Decode(context.Background(), options, encoded, headers)
+		assert.NoError(t, err)
+		assert.Equal(t, body, decoded)
+	}
+}
+
+func Test_Claim_Check_Payload_Round_Trip(t *testing.T) {
+	blobStore, err := NewFileSystemBlobStore(t.TempDir())
+	assert.NoError(t, err)
+	options := &PayloadOptions{CompressionThreshold: 1024, Compression: ZstdCompression, ClaimCheckThreshold: 4096, BlobStore: blobStore}
+	body := bytes.Repeat([]byte("media metadata "), 1000)
+
+	encoded, headers, err := Encode(context.Background(), options, body)
+	assert.NoError(t, err)
+	assert.Empty(t, encoded)
+	assert.NotEmpty(t, headers[ClaimCheckHeader])
+
+	decoded, err := Decode(context.Background(), options, encoded, headers)
+	assert.NoError(t, err)
+	assert.Equal(t, body, decoded)
+
+	assert.NoError(t, blobStore.Delete(context.Background(), headers[ClaimCheckHeader].(string)))
+	_, err = Decode(context.Background(), options, encoded, headers)
+	assert.True(t, errors.Is(err, ErrBlobNotFound))
+}
+
+func Test_Claim_Check_Without_Blob_Store_Fails(t *testing.T) {
+	_, _, err := Encode(context.Background(), &PayloadOptions{ClaimCheckThreshold: 1}, []byte("body"))
+	assert.Error(t, err)
+
+	_, err = Decode(context.Background(), nil, nil, map[string]interface{}{ClaimCheckHeader: "key"})
+	assert.Error(t, err)
+}
+
+func Test_File_System_Blob_Store_Rejects_Invalid_Keys(t *testing.T) {
+	blobStore, err := NewFileSystemBlobStore(t.TempDir())
+	assert.NoError(t, err)
+
+	_, err = blobStore.Get(context.Background(), "../secret")
+	assert.Error(t, err)
+}
+
+func Test_Expired_Blobs_Are_Deleted_After_Retention(t *testing.T) {
+	rootPath := t.TempDir()
+	blobStore, err := NewFileSystemBlobStore(rootPath)
+	assert.NoError(t, err)
+	options := &PayloadOptions{BlobStore: blobStore, BlobRetention: 24 * time.Hour}
+	now := time.Now()
+
+	assert.NoError(t, blobStore.Put(context.Background(), "expired", []byte("expired body")))
+	assert.NoError(t, blobStore.Put(context.Background(), "retained", []byte("retained body")))
+	assert.NoError(t, os.Chtimes(filepath.Join(rootPath, "expired"), now.Add(-25*time.Hour), now.Add(-25*time.Hour)))
+	assert.NoError(t, os.Chtimes(filepath.Join(rootPath, "retained"), now.Add(-23*time.Hour), now.Add(-23*time.Hour)))
+
+	deleted, err := DeleteExpiredBlobs(context.Background(), options, now)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	_, err = blobStore.Get(context.Background(), "expired")
+	assert.True(t, errors.Is(err, ErrBlobNotFound))
+	data, err := blobStore.Get(context.Background(), "retained")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("retained body"), data)
+}
@@ -0,0 +1,36 @@
+package payload
+
+import "time"
+
+const (
+	// CompressionHeader keeps the compression algorithm of a compressed message body
+	CompressionHeader = "x-payload-compression"
+	// ClaimCheckHeader keeps the blob key of a message body that is stored in the blob store, the published body is empty
+	ClaimCheckHeader = "x-payload-claim-check"
+)
+
+// PayloadOptions configures the compression and claim-check of the message bodies, a zero threshold disables the feature.
+type PayloadOptions struct {
+	// CompressionThreshold is the body size in bytes that bodies larger than it will be compressed
+	CompressionThreshold int
+	// Compression is the compression algorithm, `gzip` or `zstd`
+	Compression string
+	// ClaimCheckThreshold is the body size in bytes that bodies larger than it will be stored in the BlobStore and only their reference will be published
+	ClaimCheckThreshold int
+	BlobStore           BlobStore
+	// BlobRetention is how long a blob is kept after it is put, see BlobStore for how long a blob should outlive its message
+	BlobRetention time.Duration
+	// BlobSweepInterval is the interval of deleting the expired blobs
+	BlobSweepInterval time.Duration
+}
+
+func NewDefaultPayloadOptions(blobStore BlobStore) *PayloadOptions {
+	return &PayloadOptions{
+		CompressionThreshold: 64 * 1024,
+		Compression:          GzipCompression,
+		ClaimCheckThreshold:  1024 * 1024,
+		BlobStore:            blobStore,
+		BlobRetention:        DefaultBlobRetention,
+		BlobSweepInterval:    DefaultBlobSweepInterval,
+	}
+}
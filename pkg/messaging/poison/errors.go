@@ -0,0 +1,7 @@
+package poison
+
+import "emperror.dev/errors"
+
+var (
+	ErrPoisonMessageNotFound = errors.New("poison message not found")
+)
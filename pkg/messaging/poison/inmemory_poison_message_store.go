@@ -0,0 +1,67 @@
+package poison
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"sort"
+	"sync"
+)
+
+type inMemoryPoisonMessageStore struct {
+	mu       sync.Mutex
+	messages map[string]*PoisonMessage
+}
+
+func NewInMemoryPoisonMessageStore() PoisonMessageStore {
+	return &inMemoryPoisonMessageStore{messages: make(map[string]*PoisonMessage)}
+}
+
+func (s *inMemoryPoisonMessageStore) Add(ctx context.Context, message *PoisonMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages[message.Id] = message
+
+	return nil
+}
+
+func (s *inMemoryPoisonMessageStore) Get(ctx context.Context, id string) (*PoisonMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	message, exists := s.messages[id]
+	if !exists {
+		return nil, errors.WithStack(ErrPoisonMessageNotFound)
+	}
+
+	return message, nil
+}
+
+func (s *inMemoryPoisonMessageStore) GetAll(ctx context.Context, source string) ([]*PoisonMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	messages := make([]*PoisonMessage, 0)
+	for _, message := range s.messages {
+		if source == "" || message.Source == source {
+			messages = append(messages, message)
+		}
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].FailedAt.Before(messages[j].FailedAt)
+	})
+
+	return messages, nil
+}
+
+func (s *inMemoryPoisonMessageStore) Remove(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.messages[id]; !exists {
+		return errors.WithStack(ErrPoisonMessageNotFound)
+	}
+	delete(s.messages, id)
+
+	return nil
+}
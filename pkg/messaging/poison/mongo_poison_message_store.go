@@ -0,0 +1,71 @@
+package poison
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoPoisonMessageStore struct {
+	collection *mongo.Collection
+}
+
+func NewMongoPoisonMessageStore(collection *mongo.Collection) PoisonMessageStore {
+	return &mongoPoisonMessageStore{collection: collection}
+}
+
+func (m *mongoPoisonMessageStore) Add(ctx context.Context, message *PoisonMessage) error {
+	_, err := m.collection.InsertOne(ctx, message, &options.InsertOneOptions{})
+	if err != nil {
+		return errors.WrapIf(err, "[mongoPoisonMessageStore_Add.InsertOne] error in inserting poison message")
+	}
+
+	return nil
+}
+
+func (m *mongoPoisonMessageStore) Get(ctx context.Context, id string) (*PoisonMessage, error) {
+	var message PoisonMessage
+	err := m.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&message)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errors.WithStack(ErrPoisonMessageNotFound)
+	}
+	if err != nil {
+		return nil, errors.WrapIf(err, "[mongoPoisonMessageStore_Get.FindOne] error in getting poison message")
+	}
+
+	return &message, nil
+}
+
+func (m *mongoPoisonMessageStore) GetAll(ctx context.Context, source string) ([]*PoisonMessage, error) {
+	filter := bson.M{}
+	if source != "" {
+		filter["source"] = source
+	}
+
+	cursor, err := m.collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "failedAt", Value: 1}}))
+	if err != nil {
+		return nil, errors.WrapIf(err, "[mongoPoisonMessageStore_GetAll.Find] error in finding poison messages")
+	}
+	defer cursor.Close(ctx)
+
+	messages := make([]*PoisonMessage, 0)
+	if err := cursor.All(ctx, &messages); err != nil {
+		return nil, errors.WrapIf(err, "[mongoPoisonMessageStore_GetAll.All] error in decoding poison messages")
+	}
+
+	return messages, nil
+}
+
+func (m *mongoPoisonMessageStore) Remove(ctx context.Context, id string) error {
+	result, err := m.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return errors.WrapIf(err, "[mongoPoisonMessageStore_Remove.DeleteOne] error in deleting poison message")
+	}
+	if result.DeletedCount == 0 {
+		return errors.WithStack(ErrPoisonMessageNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package poison
+
+import (
+	"time"
+)
+
+// SchedulerSource is the source of the scheduled messages which couldn't be deserialized before their delivery, they never reached a queue
+// so they are replayed to their exchange
+const SchedulerSource = "message_scheduler"
+
+// PoisonMessage is a delivery which couldn't be deserialized, it keeps the raw body and headers so it can be inspected and replayed after fixing the type mapping.
+type PoisonMessage struct {
+	Id string `json:"id" bson:"_id"`
+	// Source is the queue that the message was consumed from
+	Source        string                 `json:"source" bson:"source"`
+	Exchange      string                 `json:"exchange" bson:"exchange"`
+	RoutingKey    string                 `json:"routingKey" bson:"routingKey"`
+	MessageType   string                 `json:"messageType" bson:"messageType"`
+	ContentType   string                 `json:"contentType" bson:"contentType"`
+	MessageId     string                 `json:"messageId" bson:"messageId"`
+	CorrelationId string                 `json:"correlationId" bson:"correlationId"`
+	Headers       map[string]interface{} `json:"headers" bson:"headers"`
+	Body          []byte                 `json:"body" bson:"body"`
+	Error         string                 `json:"error" bson:"error"`
+	FailedAt      time.Time              `json:"failedAt" bson:"failedAt"`
+}
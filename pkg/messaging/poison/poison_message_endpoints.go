@@ -0,0 +1,70 @@
+package poison
+
+import (
+	"emperror.dev/errors"
+	"github.com/labstack/echo/v4"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"net/http"
+)
+
+type ReplayAllResponseDto struct {
+	Replayed int `json:"replayed"`
+}
+
+// MapPoisonMessageRoutes maps the inspection and replay endpoints of the poison messages on the group
+func MapPoisonMessageRoutes(group *echo.Group, replayer *PoisonMessageReplayer) {
+	// GET ?source=queue lists the poison messages
+	group.GET("", func(c echo.Context) error {
+		messages, err := replayer.GetAll(c.Request().Context(), c.QueryParam("source"))
+		if err != nil {
+			return errors.WithMessage(err, "[poisonMessageEndpoints_GetAll] error in getting poison messages")
+		}
+
+		return c.JSON(http.StatusOK, messages)
+	})
+
+	group.GET("/:id", func(c echo.Context) error {
+		message, err := replayer.Get(c.Request().Context(), c.Param("id"))
+		if err != nil {
+			return toHttpError(err, "[poisonMessageEndpoints_Get] error in getting poison message")
+		}
+
+		return c.JSON(http.StatusOK, message)
+	})
+
+	group.POST("/:id/replay", func(c echo.Context) error {
+		err := replayer.Replay(c.Request().Context(), c.Param("id"))
+		if err != nil {
+			return toHttpError(err, "[poisonMessageEndpoints_Replay] error in replaying poison message")
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	// POST /replay?source=queue replays all the poison messages of the queue
+	group.POST("/replay", func(c echo.Context) error {
+		replayed, err := replayer.ReplayAll(c.Request().Context(), c.QueryParam("source"))
+		if err != nil {
+			return customErrors.NewApplicationErrorWrap(err, "[poisonMessageEndpoints_ReplayAll] some poison messages couldn't be replayed")
+		}
+
+		return c.JSON(http.StatusOK, &ReplayAllResponseDto{Replayed: replayed})
+	})
+
+	group.DELETE("/:id", func(c echo.Context) error {
+		err := replayer.Delete(c.Request().Context(), c.Param("id"))
+		if err != nil {
+			return toHttpError(err, "[poisonMessageEndpoints_Delete] error in deleting poison message")
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	})
+}
+
+func toHttpError(err error, message string) error {
+	if errors.Is(err, ErrPoisonMessageNotFound) {
+		return customErrors.NewNotFoundErrorWrap(err, message)
+	}
+
+	return customErrors.NewApplicationErrorWrap(err, message)
+}
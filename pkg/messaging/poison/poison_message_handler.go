@@ -0,0 +1,30 @@
+package poison
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+)
+
+// PoisonMessageHandler receives the deliveries that couldn't be deserialized, the consumer acknowledges the delivery when it returns nil
+// and rejects it otherwise, so it goes to the dead letter exchange of the queue if there is one.
+type PoisonMessageHandler interface {
+	Handle(ctx context.Context, message *PoisonMessage) error
+}
+
+type storePoisonMessageHandler struct {
+	store   PoisonMessageStore
+	metrics *PoisonMessageMetrics
+	logger  logger.Logger
+}
+
+// NewStorePoisonMessageHandler keeps the poison messages in the store, metrics is optional
+func NewStorePoisonMessageHandler(store PoisonMessageStore, metrics *PoisonMessageMetrics, logger logger.Logger) PoisonMessageHandler {
+	return &storePoisonMessageHandler{store: store, metrics: metrics, logger: logger}
+}
+
+func (h *storePoisonMessageHandler) Handle(ctx context.Context, message *PoisonMessage) error {
+	h.metrics.poisoned(message)
+	h.logger.Errorf("[PoisonMessageHandler.Handle] poison message %s with type %s from %s: %s", message.Id, message.MessageType, message.Source, message.Error)
+
+	return h.store.Add(ctx, message)
+}
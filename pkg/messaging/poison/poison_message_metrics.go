@@ -0,0 +1,40 @@
+package poison
+
+import (
+	"fmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+type PoisonMessageMetrics struct {
+	PoisonMessages   *prometheus.CounterVec
+	ReplayedMessages *prometheus.CounterVec
+}
+
+// NewPoisonMessageMetrics registers the poison message counters, it should be called once per service
+func NewPoisonMessageMetrics(serviceName string) *PoisonMessageMetrics {
+	return &PoisonMessageMetrics{
+		PoisonMessages: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_poison_messages_total", serviceName),
+			Help: "The total number of messages which couldn't be deserialized",
+		}, []string{"source", "message_type"}),
+		ReplayedMessages: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_replayed_poison_messages_total", serviceName),
+			Help: "The total number of replayed poison messages",
+		}, []string{"source", "message_type"}),
+	}
+}
+
+func (m *PoisonMessageMetrics) poisoned(message *PoisonMessage) {
+	if m == nil {
+		return
+	}
+	m.PoisonMessages.WithLabelValues(message.Source, message.MessageType).Inc()
+}
+
+func (m *PoisonMessageMetrics) replayed(message *PoisonMessage) {
+	if m == nil {
+		return
+	}
+	m.ReplayedMessages.WithLabelValues(message.Source, message.MessageType).Inc()
+}
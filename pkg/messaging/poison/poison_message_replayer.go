@@ -0,0 +1,126 @@
+package poison
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/producer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+)
+
+// PoisonMessageReplayer inspects the stored poison messages and replays them after the type mapping is fixed. A replayed message is
+// deserialized with the current type mapping and published again only to its source queue, the other queues bound to its exchange
+// already handled it.
+type PoisonMessageReplayer struct {
+	store           PoisonMessageStore
+	producer        producer.Producer
+	eventSerializer serializer.EventSerializer
+	payloadOptions  *payload.PayloadOptions
+	metrics         *PoisonMessageMetrics
+	logger          logger.Logger
+}
+
+// NewPoisonMessageReplayer creates a replayer, payloadOptions should be the same as the consumers to rehydrate the claim-check messages and metrics is optional
+func NewPoisonMessageReplayer(store PoisonMessageStore, producer producer.Producer, eventSerializer serializer.EventSerializer, payloadOptions *payload.PayloadOptions, metrics *PoisonMessageMetrics, logger logger.Logger) *PoisonMessageReplayer {
+	return &PoisonMessageReplayer{store: store, producer: producer, eventSerializer: eventSerializer, payloadOptions: payloadOptions, metrics: metrics, logger: logger}
+}
+
+func (r *PoisonMessageReplayer) GetAll(ctx context.Context, source string) ([]*PoisonMessage, error) {
+	return r.store.GetAll(ctx, source)
+}
+
+func (r *PoisonMessageReplayer) Get(ctx context.Context, id string) (*PoisonMessage, error) {
+	return r.store.Get(ctx, id)
+}
+
+// Delete discards a poison message without replaying it
+func (r *PoisonMessageReplayer) Delete(ctx context.Context, id string) error {
+	return r.store.Remove(ctx, id)
+}
+
+// Replay publishes the poison message again and removes it from the store, the message stays in the store when it still can't be deserialized
+func (r *PoisonMessageReplayer) Replay(ctx context.Context, id string) error {
+	poisonMessage, err := r.store.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if typeMapper.TypeByNameAndImplementedInterface[types.IMessage](poisonMessage.MessageType) == nil {
+		return errors.Errorf("[PoisonMessageReplayer_Replay] message type %s is still not mapped to a message type", poisonMessage.MessageType)
+	}
+
+	body, err := payload.Decode(ctx, r.payloadOptions, poisonMessage.Body, poisonMessage.Headers)
+	if err != nil {
+		return errors.WrapIf(err, "[PoisonMessageReplayer_Replay.Decode] error in decoding poison message payload")
+	}
+
+	contentType := poisonMessage.ContentType
+	if contentType == "" {
+		contentType = r.eventSerializer.ContentType()
+	}
+
+	deserialized, err := r.eventSerializer.DeserializeMessage(body, poisonMessage.MessageType, contentType)
+	if err != nil {
+		return errors.WrapIf(err, "[PoisonMessageReplayer_Replay.DeserializeMessage] poison message still can't be deserialized")
+	}
+	message, ok := deserialized.(types.IMessage)
+	if !ok {
+		return errors.Errorf("[PoisonMessageReplayer_Replay] deserialized type %T is not a message", deserialized)
+	}
+
+	// payload headers belong to the old body, the producer encodes the payload again
+	metadata := core.Metadata{}
+	for k, v := range poisonMessage.Headers {
+		if k == payload.CompressionHeader || k == payload.ClaimCheckHeader {
+			continue
+		}
+		metadata[k] = v
+	}
+
+	destination, err := r.publish(ctx, poisonMessage, message, metadata)
+	if err != nil {
+		return errors.WrapIf(err, "[PoisonMessageReplayer_Replay.publish] error in publishing poison message")
+	}
+
+	if err := r.store.Remove(ctx, id); err != nil {
+		return err
+	}
+	r.metrics.replayed(poisonMessage)
+	r.logger.Infof("[PoisonMessageReplayer.Replay] poison message %s with type %s replayed to %s", poisonMessage.Id, poisonMessage.MessageType, destination)
+
+	return nil
+}
+
+// publish sends the message to the source queue through the default exchange, the scheduled messages never reached a queue so they go to their exchange
+func (r *PoisonMessageReplayer) publish(ctx context.Context, poisonMessage *PoisonMessage, message types.IMessage, metadata core.Metadata) (string, error) {
+	if poisonMessage.Source == SchedulerSource {
+		return poisonMessage.Exchange, r.producer.PublishWithTopicName(ctx, message, metadata, poisonMessage.Exchange)
+	}
+
+	return poisonMessage.Source, r.producer.PublishToQueue(ctx, message, metadata, poisonMessage.Source)
+}
+
+// ReplayAll replays the poison messages of a source queue, an empty source replays the messages of all the queues. it returns the number of replayed messages
+// and the errors of the messages which still can't be replayed
+func (r *PoisonMessageReplayer) ReplayAll(ctx context.Context, source string) (int, error) {
+	messages, err := r.store.GetAll(ctx, source)
+	if err != nil {
+		return 0, err
+	}
+
+	replayed := 0
+	var errs []error
+	for _, message := range messages {
+		if err := r.Replay(ctx, message.Id); err != nil {
+			errs = append(errs, errors.WithMessagef(err, "poison message %s", message.Id))
+			continue
+		}
+		replayed++
+	}
+
+	return replayed, errors.Combine(errs...)
+}
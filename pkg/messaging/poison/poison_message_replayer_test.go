@@ -0,0 +1,135 @@
+package poison
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/inmemory"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_Store_Handler_Keeps_Poison_Messages_By_Source(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryPoisonMessageStore()
+	handler := NewStorePoisonMessageHandler(store, nil, defaultLogger.Logger)
+
+	assert.NoError(t, handler.Handle(ctx, &PoisonMessage{Id: "1", Source: "first_queue", FailedAt: time.Now()}))
+	assert.NoError(t, handler.Handle(ctx, &PoisonMessage{Id: "2", Source: "second_queue", FailedAt: time.Now()}))
+
+	messages, err := store.GetAll(ctx, "first_queue")
+	assert.NoError(t, err)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, "1", messages[0].Id)
+
+	messages, err = store.GetAll(ctx, "")
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+
+	assert.NoError(t, store.Remove(ctx, "1"))
+	_, err = store.Get(ctx, "1")
+	assert.ErrorIs(t, err, ErrPoisonMessageNotFound)
+}
+
+func Test_Replay_Poison_Message_After_Fixing_Type_Mapping(t *testing.T) {
+	ctx := context.Background()
+	transport := inmemory.NewInMemoryTransport()
+	serializer := json.NewJsonEventSerializer()
+	store := NewInMemoryPoisonMessageStore()
+	replayer := NewPoisonMessageReplayer(store, inmemory.NewInMemoryProducer(transport, serializer, defaultLogger.Logger), serializer, nil, nil, defaultLogger.Logger)
+
+	var received []*PoisonTestMessage
+	c := inmemory.NewInMemoryConsumerWithQueueName[*PoisonTestMessage](transport, "poison_test_exchange", "poison_test_queue", &poisonTestHandler{handle: func(m *PoisonTestMessage) {
+		received = append(received, m)
+	}}, serializer, defaultLogger.Logger)
+	assert.NoError(t, c.Consume(ctx))
+
+	serialized, err := serializer.Serialize(&PoisonTestMessage{Message: types.NewMessage(uuid.NewV4().String()), Data: "replayed"})
+	assert.NoError(t, err)
+
+	// the producer published the message with a type name that the consumer doesn't know
+	messageType := "*PoisonTestRenamedMessage"
+	assert.NoError(t, store.Add(ctx, &PoisonMessage{
+		Id:          uuid.NewV4().String(),
+		Source:      "poison_test_queue",
+		Exchange:    "poison_test_exchange",
+		MessageType: messageType,
+		ContentType: serialized.ContentType,
+		Body:        serialized.Data,
+		FailedAt:    time.Now(),
+	}))
+
+	replayed, err := replayer.ReplayAll(ctx, "poison_test_queue")
+	assert.Error(t, err)
+	assert.Equal(t, 0, replayed)
+	assert.Empty(t, received)
+
+	messages, err := replayer.GetAll(ctx, "poison_test_queue")
+	assert.NoError(t, err)
+	assert.Len(t, messages, 1)
+
+	typeMapper.RegisterTypeWithKey(messageType, reflect.TypeOf(&PoisonTestMessage{}))
+
+	assert.NoError(t, replayer.Replay(ctx, messages[0].Id))
+	assert.Len(t, received, 1)
+	assert.Equal(t, "replayed", received[0].Data)
+
+	messages, err = replayer.GetAll(ctx, "")
+	assert.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+type PoisonTestMessage struct {
+	*types.Message
+	Data string
+}
+
+type poisonTestHandler struct {
+	handle func(message *PoisonTestMessage)
+}
+
+func (h *poisonTestHandler) Handle(ctx context.Context, consumeContext types.IMessageConsumeContext[*PoisonTestMessage]) error {
+	h.handle(consumeContext.Message())
+	return nil
+}
+
+func Test_Replay_Poison_Message_Only_To_Source_Queue(t *testing.T) {
+	ctx := context.Background()
+	transport := inmemory.NewInMemoryTransport()
+	serializer := json.NewJsonEventSerializer()
+	store := NewInMemoryPoisonMessageStore()
+	replayer := NewPoisonMessageReplayer(store, inmemory.NewInMemoryProducer(transport, serializer, defaultLogger.Logger), serializer, nil, nil, defaultLogger.Logger)
+
+	// both queues are bound to the exchange and only the source queue failed to deserialize the message
+	receivedByQueue := map[string]int{}
+	for _, queue := range []string{"poison_source_queue", "poison_other_queue"} {
+		queue := queue
+		c := inmemory.NewInMemoryConsumerWithQueueName[*PoisonTestMessage](transport, "poison_shared_exchange", queue, &poisonTestHandler{handle: func(m *PoisonTestMessage) {
+			receivedByQueue[queue]++
+		}}, serializer, defaultLogger.Logger)
+		assert.NoError(t, c.Consume(ctx))
+	}
+
+	serialized, err := serializer.Serialize(&PoisonTestMessage{Message: types.NewMessage(uuid.NewV4().String()), Data: "replayed"})
+	assert.NoError(t, err)
+
+	id := uuid.NewV4().String()
+	assert.NoError(t, store.Add(ctx, &PoisonMessage{
+		Id:          id,
+		Source:      "poison_source_queue",
+		Exchange:    "poison_shared_exchange",
+		MessageType: typeMapper.GetTypeName(&PoisonTestMessage{}),
+		ContentType: serialized.ContentType,
+		Body:        serialized.Data,
+		FailedAt:    time.Now(),
+	}))
+
+	assert.NoError(t, replayer.Replay(ctx, id))
+	assert.Equal(t, 1, receivedByQueue["poison_source_queue"])
+	assert.Equal(t, 0, receivedByQueue["poison_other_queue"])
+}
@@ -0,0 +1,15 @@
+package poison
+
+import (
+	"context"
+)
+
+type PoisonMessageStore interface {
+	Add(ctx context.Context, message *PoisonMessage) error
+	// Get returns ErrPoisonMessageNotFound when there is no message with the id
+	Get(ctx context.Context, id string) (*PoisonMessage, error)
+	// GetAll returns the messages of a source queue ordered by their failure time, an empty source returns the messages of all the queues
+	GetAll(ctx context.Context, source string) ([]*PoisonMessage, error)
+	// Remove returns ErrPoisonMessageNotFound when there is no message with the id
+	Remove(ctx context.Context, id string) error
+}
@@ -4,9 +4,20 @@ import (
 	"context"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"time"
 )
 
 type Producer interface {
 	Publish(ctx context.Context, message types.IMessage, metadata core.Metadata) error
 	PublishWithTopicName(ctx context.Context, message types.IMessage, metadata core.Metadata, topicOrExchangeName string) error
+	// PublishToQueue publishes the message only to a queue, the other queues bound to the exchange of the message don't receive it
+	PublishToQueue(ctx context.Context, message types.IMessage, metadata core.Metadata, queueName string) error
+}
+
+// ScheduledProducer publishes messages in a later time, the returned token can be used for canceling a scheduled message before its delivery.
+type ScheduledProducer interface {
+	Producer
+	ScheduleAt(ctx context.Context, message types.IMessage, metadata core.Metadata, deliverAt time.Time) (string, error)
+	ScheduleAfter(ctx context.Context, message types.IMessage, metadata core.Metadata, delay time.Duration) (string, error)
+	CancelScheduled(ctx context.Context, token string) error
 }
@@ -0,0 +1,7 @@
+package scheduler
+
+import "emperror.dev/errors"
+
+var (
+	ErrScheduledMessageNotFound = errors.New("scheduled message not found, it is already delivered or canceled")
+)
@@ -0,0 +1,60 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type inMemoryScheduledMessageStore struct {
+	mu       sync.Mutex
+	messages map[string]*ScheduledMessage
+}
+
+func NewInMemoryScheduledMessageStore() ScheduledMessageStore {
+	return &inMemoryScheduledMessageStore{messages: make(map[string]*ScheduledMessage)}
+}
+
+func (s *inMemoryScheduledMessageStore) Add(ctx context.Context, message *ScheduledMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages[message.Token] = message
+
+	return nil
+}
+
+func (s *inMemoryScheduledMessageStore) Remove(ctx context.Context, token string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.messages[token]; !exists {
+		return false, nil
+	}
+	delete(s.messages, token)
+
+	return true, nil
+}
+
+func (s *inMemoryScheduledMessageStore) LeaseDue(ctx context.Context, now time.Time, lease time.Duration) (*ScheduledMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due *ScheduledMessage
+	for _, message := range s.messages {
+		if message.DeliverAt.After(now) || message.LeasedUntil.After(now) {
+			continue
+		}
+		if due == nil || message.DeliverAt.Before(due.DeliverAt) {
+			due = message
+		}
+	}
+
+	if due == nil {
+		return nil, nil
+	}
+	due.LeasedUntil = now.Add(lease)
+	leased := *due
+
+	return &leased, nil
+}
@@ -0,0 +1,197 @@
+package scheduler
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/poison"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/producer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+const (
+	// dispatchLease is how long a due message is hidden from the other dispatches while it is published
+	dispatchLease = 30 * time.Second
+	// PoisonMessageSource is the source of the scheduled messages which are moved to the poison message store
+	PoisonMessageSource = poison.SchedulerSource
+)
+
+// MessageScheduler is a producer decorator that keeps scheduled messages in a store until their delivery time and then publishes them with the inner
+// producer, so it works with any transport (RabbitMQ or in-memory). Due messages are dispatched by `DispatchDue`, usually from the scheduler worker.
+type MessageScheduler struct {
+	producer        producer.Producer
+	store           ScheduledMessageStore
+	eventSerializer serializer.EventSerializer
+	poisonHandler   poison.PoisonMessageHandler
+	logger          logger.Logger
+}
+
+// NewMessageScheduler creates a scheduler, poisonHandler receives the scheduled messages which can't be deserialized anymore and it is optional,
+// without it these messages stay in the scheduled message store.
+func NewMessageScheduler(producer producer.Producer, store ScheduledMessageStore, eventSerializer serializer.EventSerializer, poisonHandler poison.PoisonMessageHandler, logger logger.Logger) *MessageScheduler {
+	return &MessageScheduler{producer: producer, store: store, eventSerializer: eventSerializer, poisonHandler: poisonHandler, logger: logger}
+}
+
+func (s *MessageScheduler) Publish(ctx context.Context, message types.IMessage, metadata core.Metadata) error {
+	return s.producer.Publish(ctx, message, metadata)
+}
+
+func (s *MessageScheduler) PublishWithTopicName(ctx context.Context, message types.IMessage, metadata core.Metadata, topicOrExchangeName string) error {
+	return s.producer.PublishWithTopicName(ctx, message, metadata, topicOrExchangeName)
+}
+
+func (s *MessageScheduler) PublishToQueue(ctx context.Context, message types.IMessage, metadata core.Metadata, queueName string) error {
+	return s.producer.PublishToQueue(ctx, message, metadata, queueName)
+}
+
+func (s *MessageScheduler) ScheduleAt(ctx context.Context, message types.IMessage, metadata core.Metadata, deliverAt time.Time) (string, error) {
+	return s.ScheduleWithTopicName(ctx, message, metadata, deliverAt, "")
+}
+
+func (s *MessageScheduler) ScheduleAfter(ctx context.Context, message types.IMessage, metadata core.Metadata, delay time.Duration) (string, error) {
+	return s.ScheduleWithTopicName(ctx, message, metadata, time.Now().Add(delay), "")
+}
+
+func (s *MessageScheduler) ScheduleWithTopicName(ctx context.Context, message types.IMessage, metadata core.Metadata, deliverAt time.Time, topicOrExchangeName string) (string, error) {
+	if message == nil {
+		return "", errors.New("message is nil")
+	}
+
+	if message.GetEventTypeName() == "" {
+		message.SetEventTypeName(typeMapper.GetTypeName(message))
+	}
+
+	serializedObj, err := s.eventSerializer.Serialize(message)
+	if err != nil {
+		return "", errors.WrapIf(err, "[MessageScheduler_ScheduleWithTopicName.Serialize] error in serializing message")
+	}
+
+	scheduledMessage := &ScheduledMessage{
+		Token:               uuid.NewV4().String(),
+		MessageId:           message.GeMessageId(),
+		MessageType:         message.GetEventTypeName(),
+		ContentType:         serializedObj.ContentType,
+		Data:                serializedObj.Data,
+		Metadata:            metadata,
+		TopicOrExchangeName: topicOrExchangeName,
+		DeliverAt:           deliverAt.UTC(),
+		ScheduledAt:         time.Now().UTC(),
+	}
+
+	if err := s.store.Add(ctx, scheduledMessage); err != nil {
+		return "", err
+	}
+
+	s.logger.Infof("[MessageScheduler.ScheduleWithTopicName] message %s with type %s scheduled for %s with token %s", scheduledMessage.MessageId, scheduledMessage.MessageType, scheduledMessage.DeliverAt, scheduledMessage.Token)
+
+	return scheduledMessage.Token, nil
+}
+
+func (s *MessageScheduler) CancelScheduled(ctx context.Context, token string) error {
+	removed, err := s.store.Remove(ctx, token)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return errors.WithMessagef(ErrScheduledMessageNotFound, "token: %s", token)
+	}
+
+	return nil
+}
+
+// DispatchDue publishes all the scheduled messages which their delivery time is passed and returns the number of published messages. A message is leased
+// while it is published and removed only after a successful publish, so a crash or a failed publish delivers it again after the lease and the delivery
+// is at-least-once.
+func (s *MessageScheduler) DispatchDue(ctx context.Context) (int, error) {
+	dispatched := 0
+
+	for {
+		if ctx.Err() != nil {
+			return dispatched, ctx.Err()
+		}
+
+		scheduledMessage, err := s.store.LeaseDue(ctx, time.Now().UTC(), dispatchLease)
+		if err != nil {
+			return dispatched, err
+		}
+		if scheduledMessage == nil {
+			return dispatched, nil
+		}
+
+		message, err := s.deserialize(scheduledMessage)
+		if err != nil {
+			// retrying can't fix an unknown type or a broken payload, so the message is moved to the poison messages to replay it after fixing the type mapping
+			if poisonErr := s.poison(ctx, scheduledMessage, err); poisonErr != nil {
+				s.logger.Errorf("[MessageScheduler.DispatchDue] scheduled message %s with token %s can't be deserialized and it is kept in the store: %v", scheduledMessage.MessageId, scheduledMessage.Token, errors.Combine(err, poisonErr))
+			}
+			continue
+		}
+
+		err = s.producer.PublishWithTopicName(ctx, message, scheduledMessage.Metadata, scheduledMessage.TopicOrExchangeName)
+		if err != nil {
+			// the message stays leased in the store and it will be published again after its lease
+			return dispatched, errors.WrapIf(err, "[MessageScheduler_DispatchDue.PublishWithTopicName] error in publishing scheduled message")
+		}
+
+		// a message which is canceled during its publish is already removed
+		if _, err := s.store.Remove(ctx, scheduledMessage.Token); err != nil {
+			return dispatched, errors.WrapIf(err, "[MessageScheduler_DispatchDue.Remove] error in removing published scheduled message")
+		}
+
+		dispatched++
+	}
+}
+
+func (s *MessageScheduler) poison(ctx context.Context, scheduledMessage *ScheduledMessage, err error) error {
+	if s.poisonHandler == nil {
+		return errors.New("there is no poison message handler")
+	}
+
+	headers := make(map[string]interface{}, len(scheduledMessage.Metadata))
+	for k, v := range scheduledMessage.Metadata {
+		headers[k] = v
+	}
+
+	poisonMessage := &poison.PoisonMessage{
+		Id:          uuid.NewV4().String(),
+		Source:      PoisonMessageSource,
+		Exchange:    scheduledMessage.TopicOrExchangeName,
+		MessageType: scheduledMessage.MessageType,
+		ContentType: scheduledMessage.ContentType,
+		MessageId:   scheduledMessage.MessageId,
+		Headers:     headers,
+		Body:        scheduledMessage.Data,
+		Error:       err.Error(),
+		FailedAt:    time.Now(),
+	}
+	if err := s.poisonHandler.Handle(ctx, poisonMessage); err != nil {
+		return err
+	}
+
+	_, err = s.store.Remove(ctx, scheduledMessage.Token)
+
+	return err
+}
+
+func (s *MessageScheduler) deserialize(scheduledMessage *ScheduledMessage) (types.IMessage, error) {
+	if typeMapper.TypeByNameAndImplementedInterface[types.IMessage](scheduledMessage.MessageType) == nil {
+		return nil, errors.Errorf("message type %s is not registered", scheduledMessage.MessageType)
+	}
+
+	deserialized, err := s.eventSerializer.DeserializeMessage(scheduledMessage.Data, scheduledMessage.MessageType, scheduledMessage.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	message, ok := deserialized.(types.IMessage)
+	if !ok {
+		return nil, errors.Errorf("deserialized type %T is not a message", deserialized)
+	}
+
+	return message, nil
+}
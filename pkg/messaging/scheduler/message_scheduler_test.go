@@ -0,0 +1,170 @@
+package scheduler
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/inmemory"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/poison"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_Scheduled_Message_Published_After_Delivery_Time(t *testing.T) {
+	scheduler, handler := newTestScheduler(t)
+
+	_, err := scheduler.ScheduleAt(context.Background(), newScheduledTestMessage("reminder"), nil, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	_, err = scheduler.ScheduleAfter(context.Background(), newScheduledTestMessage("cancel unpaid order"), nil, -time.Second)
+	assert.NoError(t, err)
+
+	dispatched, err := scheduler.DispatchDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, dispatched)
+	assert.Equal(t, []string{"cancel unpaid order"}, handler.received)
+}
+
+func Test_Canceled_Scheduled_Message_Is_Not_Published(t *testing.T) {
+	scheduler, handler := newTestScheduler(t)
+
+	token, err := scheduler.ScheduleAfter(context.Background(), newScheduledTestMessage("cancel unpaid order"), nil, -time.Second)
+	assert.NoError(t, err)
+
+	err = scheduler.CancelScheduled(context.Background(), token)
+	assert.NoError(t, err)
+
+	dispatched, err := scheduler.DispatchDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, dispatched)
+	assert.Empty(t, handler.received)
+
+	err = scheduler.CancelScheduled(context.Background(), token)
+	assert.True(t, errors.Is(err, ErrScheduledMessageNotFound))
+}
+
+func Test_Scheduler_Worker_Publishes_Due_Messages(t *testing.T) {
+	scheduler, handler := newTestScheduler(t)
+
+	_, err := scheduler.ScheduleAfter(context.Background(), newScheduledTestMessage("reminder"), nil, 50*time.Millisecond)
+	assert.NoError(t, err)
+
+	worker := NewMessageSchedulerWorker(scheduler, 10*time.Millisecond, defaultLogger.Logger)
+	worker.Start(context.Background())
+	defer worker.Stop(context.Background())
+
+	assert.Eventually(t, func() bool {
+		return len(handler.receivedMessages()) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func Test_Scheduled_Message_Is_Kept_Until_It_Is_Published(t *testing.T) {
+	store := NewInMemoryScheduledMessageStore()
+	scheduler := NewMessageScheduler(failingProducer{}, store, json.NewJsonEventSerializer(), nil, defaultLogger.Logger)
+
+	_, err := scheduler.ScheduleAfter(context.Background(), newScheduledTestMessage("cancel unpaid order"), nil, -time.Second)
+	assert.NoError(t, err)
+
+	dispatched, err := scheduler.DispatchDue(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 0, dispatched)
+
+	// the message is leased by the failed dispatch and it is due again after the lease
+	leased, err := store.LeaseDue(context.Background(), time.Now().UTC(), dispatchLease)
+	assert.NoError(t, err)
+	assert.Nil(t, leased)
+
+	leased, err = store.LeaseDue(context.Background(), time.Now().UTC().Add(dispatchLease+time.Second), dispatchLease)
+	assert.NoError(t, err)
+	assert.NotNil(t, leased)
+}
+
+func Test_Undeserializable_Scheduled_Message_Is_Moved_To_Poison_Messages(t *testing.T) {
+	store := NewInMemoryScheduledMessageStore()
+	poisonStore := poison.NewInMemoryPoisonMessageStore()
+	poisonHandler := poison.NewStorePoisonMessageHandler(poisonStore, nil, defaultLogger.Logger)
+	scheduler := NewMessageScheduler(failingProducer{}, store, json.NewJsonEventSerializer(), poisonHandler, defaultLogger.Logger)
+
+	err := store.Add(context.Background(), &ScheduledMessage{
+		Token:       uuid.NewV4().String(),
+		MessageId:   uuid.NewV4().String(),
+		MessageType: "removed_message_v1",
+		Data:        []byte(`{}`),
+		DeliverAt:   time.Now().UTC().Add(-time.Second),
+	})
+	assert.NoError(t, err)
+
+	dispatched, err := scheduler.DispatchDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, dispatched)
+
+	poisonMessages, err := poisonStore.GetAll(context.Background(), PoisonMessageSource)
+	assert.NoError(t, err)
+	assert.Len(t, poisonMessages, 1)
+	assert.Equal(t, "removed_message_v1", poisonMessages[0].MessageType)
+
+	leased, err := store.LeaseDue(context.Background(), time.Now().UTC().Add(dispatchLease+time.Second), dispatchLease)
+	assert.NoError(t, err)
+	assert.Nil(t, leased)
+}
+
+type ScheduledTestMessage struct {
+	*types.Message
+	Data string
+}
+
+func newScheduledTestMessage(data string) *ScheduledTestMessage {
+	return &ScheduledTestMessage{Message: types.NewMessage(uuid.NewV4().String()), Data: data}
+}
+
+type scheduledTestHandler struct {
+	received []string
+	mu       sync.Mutex
+}
+
+func (h *scheduledTestHandler) Handle(ctx context.Context, consumeContext types.IMessageConsumeContext[*ScheduledTestMessage]) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.received = append(h.received, consumeContext.Message().Data)
+
+	return nil
+}
+
+func (h *scheduledTestHandler) receivedMessages() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]string(nil), h.received...)
+}
+
+func newTestScheduler(t *testing.T) (*MessageScheduler, *scheduledTestHandler) {
+	serializer := json.NewJsonEventSerializer()
+	transport := inmemory.NewInMemoryTransport()
+	handler := &scheduledTestHandler{}
+
+	consumer := inmemory.NewInMemoryConsumer[*ScheduledTestMessage](transport, handler, serializer, defaultLogger.Logger)
+	assert.NoError(t, consumer.Consume(context.Background()))
+
+	producer := inmemory.NewInMemoryProducer(transport, serializer, defaultLogger.Logger)
+
+	return NewMessageScheduler(producer, NewInMemoryScheduledMessageStore(), serializer, nil, defaultLogger.Logger), handler
+}
+
+type failingProducer struct{}
+
+func (p failingProducer) Publish(ctx context.Context, message types.IMessage, metadata core.Metadata) error {
+	return errors.New("broker is not available")
+}
+
+func (p failingProducer) PublishWithTopicName(ctx context.Context, message types.IMessage, metadata core.Metadata, topicOrExchangeName string) error {
+	return errors.New("broker is not available")
+}
+
+func (p failingProducer) PublishToQueue(ctx context.Context, message types.IMessage, metadata core.Metadata, queueName string) error {
+	return errors.New("broker is not available")
+}
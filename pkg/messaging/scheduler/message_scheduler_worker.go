@@ -0,0 +1,43 @@
+package scheduler
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
+	"time"
+)
+
+const defaultPollInterval = time.Second
+
+// NewMessageSchedulerWorker polls the scheduler store and publishes the due messages until the worker stops.
+func NewMessageSchedulerWorker(scheduler *MessageScheduler, pollInterval time.Duration, logger logger.Logger) web.Worker {
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+	stop := make(chan struct{})
+
+	return web.NewBackgroundWorker(func(ctx context.Context) error {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				dispatched, err := scheduler.DispatchDue(ctx)
+				if err != nil && ctx.Err() == nil {
+					logger.Errorf("[MessageSchedulerWorker.DispatchDue] error in dispatching scheduled messages: {%v}", err)
+				}
+				if dispatched > 0 {
+					logger.Infof("[MessageSchedulerWorker.DispatchDue] %d scheduled messages published", dispatched)
+				}
+			case <-stop:
+				return nil
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}, func(ctx context.Context) error {
+		close(stop)
+		return nil
+	})
+}
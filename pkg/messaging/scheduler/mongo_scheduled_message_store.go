@@ -0,0 +1,58 @@
+package scheduler
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type mongoScheduledMessageStore struct {
+	collection *mongo.Collection
+}
+
+func NewMongoScheduledMessageStore(collection *mongo.Collection) ScheduledMessageStore {
+	return &mongoScheduledMessageStore{collection: collection}
+}
+
+func (m *mongoScheduledMessageStore) Add(ctx context.Context, message *ScheduledMessage) error {
+	_, err := m.collection.InsertOne(ctx, message, &options.InsertOneOptions{})
+	if err != nil {
+		return errors.WrapIf(err, "[mongoScheduledMessageStore_Add.InsertOne] error in inserting scheduled message")
+	}
+
+	return nil
+}
+
+func (m *mongoScheduledMessageStore) Remove(ctx context.Context, token string) (bool, error) {
+	result, err := m.collection.DeleteOne(ctx, bson.M{"_id": token})
+	if err != nil {
+		return false, errors.WrapIf(err, "[mongoScheduledMessageStore_Remove.DeleteOne] error in deleting scheduled message")
+	}
+
+	return result.DeletedCount > 0, nil
+}
+
+func (m *mongoScheduledMessageStore) LeaseDue(ctx context.Context, now time.Time, lease time.Duration) (*ScheduledMessage, error) {
+	ops := options.FindOneAndUpdate().SetSort(bson.D{{Key: "deliverAt", Value: 1}}).SetReturnDocument(options.After)
+
+	// the messages scheduled before leasing was added don't have a lease
+	filter := bson.M{
+		"deliverAt": bson.M{"$lte": now},
+		"$or":       bson.A{bson.M{"leasedUntil": bson.M{"$exists": false}}, bson.M{"leasedUntil": bson.M{"$lte": now}}},
+	}
+	update := bson.M{"$set": bson.M{"leasedUntil": now.Add(lease)}}
+
+	var message ScheduledMessage
+	err := m.collection.FindOneAndUpdate(ctx, filter, update, ops).Decode(&message)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.WrapIf(err, "[mongoScheduledMessageStore_LeaseDue.FindOneAndUpdate] error in leasing due scheduled message")
+	}
+
+	return &message, nil
+}
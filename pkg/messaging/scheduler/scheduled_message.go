@@ -0,0 +1,21 @@
+package scheduler
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"time"
+)
+
+// ScheduledMessage is a serialized message waiting for its delivery time, `Token` identifies it for cancellation.
+type ScheduledMessage struct {
+	Token               string        `json:"token" bson:"_id"`
+	MessageId           string        `json:"messageId" bson:"messageId"`
+	MessageType         string        `json:"messageType" bson:"messageType"`
+	ContentType         string        `json:"contentType" bson:"contentType"`
+	Data                []byte        `json:"data" bson:"data"`
+	Metadata            core.Metadata `json:"metadata,omitempty" bson:"metadata,omitempty"`
+	TopicOrExchangeName string        `json:"topicOrExchangeName,omitempty" bson:"topicOrExchangeName,omitempty"`
+	DeliverAt           time.Time     `json:"deliverAt" bson:"deliverAt"`
+	ScheduledAt         time.Time     `json:"scheduledAt" bson:"scheduledAt"`
+	// LeasedUntil is the end of the lease of a message in dispatch, it isn't due again before it
+	LeasedUntil time.Time `json:"leasedUntil" bson:"leasedUntil"`
+}
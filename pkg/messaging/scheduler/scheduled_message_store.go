@@ -0,0 +1,16 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+type ScheduledMessageStore interface {
+	Add(ctx context.Context, message *ScheduledMessage) error
+	// Remove deletes a scheduled message by its token and reports whether it was still scheduled.
+	Remove(ctx context.Context, token string) (bool, error)
+	// LeaseDue returns the earliest message with a passed delivery time which isn't leased and leases it until `now + lease`, it returns nil when there is
+	// no due message. leasing is atomic, so several scheduler instances can share a store, and a leased message is kept until it is removed after a successful
+	// publish. if the lease expires because the dispatcher crashed or couldn't publish the message, it becomes due again.
+	LeaseDue(ctx context.Context, now time.Time, lease time.Duration) (*ScheduledMessage, error)
+}
@@ -3,8 +3,11 @@ package utils
 import (
 	"github.com/ahmetb/go-linq/v3"
 	"github.com/iancoleman/strcase"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	messageHeader "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_header"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
 	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	uuid "github.com/satori/go.uuid"
 	"reflect"
 )
 
@@ -58,3 +61,26 @@ func GetAllMessageTypes() []reflect.Type {
 	return squares
 }
 
+// GetMessageMetadata fills the standard message headers in the metadata, it should be called after setting the message event type name
+func GetMessageMetadata(message types.IMessage, metadata core.Metadata) core.Metadata {
+	metadata = core.FromMetadata(metadata)
+
+	if metadata.ExistsKey(messageHeader.MessageId) == false {
+		metadata.SetValue(messageHeader.MessageId, message.GeMessageId())
+	}
+
+	if metadata.ExistsKey(messageHeader.Created) == false {
+		metadata.SetValue(messageHeader.Created, message.GetCreated())
+	}
+
+	if metadata.ExistsKey(messageHeader.CorrelationId) == false {
+		cid := uuid.NewV4().String()
+		metadata.SetValue(messageHeader.CorrelationId, cid)
+		message.SetCorrelationId(cid)
+	}
+
+	metadata.SetValue(messageHeader.Name, GetMessageName(message))
+	metadata.SetValue(messageHeader.Type, message.GetEventTypeName())
+
+	return metadata
+}
@@ -0,0 +1,67 @@
+package mongodb
+
+import (
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"regexp"
+)
+
+// ApplyListFilter combines the base filter with the conditions of the list filter
+func ApplyListFilter(filter interface{}, listFilter *utils.ListFilter) interface{} {
+	if len(listFilter.Conditions) == 0 {
+		return filter
+	}
+
+	conditions := bson.A{}
+	if filter != nil {
+		conditions = append(conditions, filter)
+	}
+	for _, condition := range listFilter.Conditions {
+		conditions = append(conditions, conditionFilter(condition))
+	}
+
+	return bson.D{{Key: "$and", Value: conditions}}
+}
+
+// SortFromListFilter returns the sort document of the list filter, nil when there is no sort
+func SortFromListFilter(listFilter *utils.ListFilter) interface{} {
+	if len(listFilter.Sorts) == 0 {
+		return nil
+	}
+
+	sort := bson.D{}
+	for _, s := range listFilter.Sorts {
+		direction := 1
+		if s.Descending {
+			direction = -1
+		}
+		sort = append(sort, bson.E{Key: s.Field, Value: direction})
+	}
+
+	return sort
+}
+
+func conditionFilter(condition *utils.FilterCondition) bson.D {
+	var value interface{}
+
+	switch condition.Comparison {
+	case utils.NotEqualComparison:
+		value = bson.M{"$ne": condition.Values[0]}
+	case utils.GreaterThanComparison:
+		value = bson.M{"$gt": condition.Values[0]}
+	case utils.LessThanComparison:
+		value = bson.M{"$lt": condition.Values[0]}
+	case utils.ContainsComparison:
+		value = primitive.Regex{Pattern: regexp.QuoteMeta(fmt.Sprint(condition.Values[0])), Options: "i"}
+	case utils.InComparison:
+		value = bson.M{"$in": condition.Values}
+	case utils.BetweenComparison:
+		value = bson.M{"$gte": condition.Values[0], "$lte": condition.Values[1]}
+	default:
+		value = condition.Values[0]
+	}
+
+	return bson.D{{Key: condition.Field, Value: value}}
+}
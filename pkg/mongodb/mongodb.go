@@ -94,6 +94,13 @@ func (m *MongoDb) Migrate() error {
 func Paginate[T any](ctx context.Context, listQuery *utils.ListQuery, collection *mongo.Collection, filter interface{}) (*utils.ListResult[T], error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "mongodb.Paginate")
 
+	listFilter, err := listQuery.GetListFilter()
+	if err != nil {
+		tracing.TraceErr(span, err)
+		return nil, errors.WrapIf(err, "GetListFilter")
+	}
+
+	filter = ApplyListFilter(filter, listFilter)
 	if filter == nil {
 		filter = bson.D{}
 	}
@@ -110,6 +117,7 @@ func Paginate[T any](ctx context.Context, listQuery *utils.ListQuery, collection
 	cursor, err := collection.Find(ctx, filter, &options.FindOptions{
 		Limit: &limit,
 		Skip:  &skip,
+		Sort:  SortFromListFilter(listFilter),
 	})
 	if err != nil {
 		tracing.TraceErr(span, err)
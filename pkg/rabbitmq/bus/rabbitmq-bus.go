@@ -47,7 +47,7 @@ func (r *rabbitMQBus) Stop(ctx context.Context) error {
 
 			err := c.UnConsume(ctx)
 			if err != nil {
-				r.logger.Error("error in the unconsuming")
+				r.logger.Errorf("error in the unconsuming: %v", err)
 			}
 		}(c)
 	}
@@ -55,3 +55,52 @@ func (r *rabbitMQBus) Stop(ctx context.Context) error {
 
 	return nil
 }
+
+func (r *rabbitMQBus) Pause(ctx context.Context) error {
+	var errs []error
+	for _, c := range r.consumers {
+		if err := c.Pause(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Combine(errs...)
+}
+
+func (r *rabbitMQBus) Resume(ctx context.Context) error {
+	var errs []error
+	for _, c := range r.consumers {
+		if err := c.Resume(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Combine(errs...)
+}
+
+// Drain drains the consumers concurrently, so the ctx deadline applies to all of them together
+func (r *rabbitMQBus) Drain(ctx context.Context) error {
+	waitGroup := sync.WaitGroup{}
+	waitGroup.Add(len(r.consumers))
+
+	errs := make([]error, len(r.consumers))
+	for i, c := range r.consumers {
+		go func(i int, c consumer.Consumer) {
+			defer waitGroup.Done()
+
+			errs[i] = c.Drain(ctx)
+		}(i, c)
+	}
+	waitGroup.Wait()
+
+	return errors.Combine(errs...)
+}
+
+func (r *rabbitMQBus) Status() []consumer.ConsumerStatus {
+	statuses := make([]consumer.ConsumerStatus, 0, len(r.consumers))
+	for _, c := range r.consumers {
+		statuses = append(statuses, c.Status())
+	}
+
+	return statuses
+}
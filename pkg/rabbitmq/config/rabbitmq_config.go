@@ -1,26 +1,89 @@
 package config
 
 import (
-	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
+	"net"
+	"net/url"
+	"strconv"
 	"time"
 )
 
+const (
+	RoundRobinFailover = "round_robin"
+	RandomFailover     = "random"
+)
+
 type RabbitMQConfig struct {
 	RabbitMqHostOptions *RabbitMqHostOptions
 	DeliveryMode        bool
 	Persisted           bool
 	AppId               string
+	Topology            *RabbitMQTopology      `mapstructure:"topology"`
+	Payload             *payload.PayloadConfig `mapstructure:"payload"`
+	// QueueMetricsInterval is the interval of collecting the queues backlog metrics
+	QueueMetricsInterval time.Duration `mapstructure:"queueMetricsInterval"`
 }
 
 type RabbitMqHostOptions struct {
-	HostName    string    `mapstructure:"hostName"`
-	VirtualHost string    `mapstructure:"virtualHost"`
-	Port        int       `mapstructure:"port"`
-	UserName    string    `mapstructure:"userName"`
-	Password    string    `mapstructure:"password"`
-	RetryDelay  time.Time `mapstructure:"retryDelay"`
+	HostName string `mapstructure:"hostName"`
+	// Hosts are the `host:port` addresses of the cluster nodes, when it is empty HostName and Port are used
+	Hosts []string `mapstructure:"hosts"`
+	// FailoverStrategy is the order of trying the hosts on connecting, `round_robin` (default) or `random`
+	FailoverStrategy string              `mapstructure:"failoverStrategy"`
+	VirtualHost      string              `mapstructure:"virtualHost"`
+	Port             int                 `mapstructure:"port"`
+	UserName         string              `mapstructure:"userName"`
+	Password         string              `mapstructure:"password"`
+	RetryDelay       time.Time           `mapstructure:"retryDelay"`
+	ReconnectDelay   time.Duration       `mapstructure:"reconnectDelay"`
+	ConnectionName   string              `mapstructure:"connectionName"`
+	Heartbeat        time.Duration       `mapstructure:"heartbeat"`
+	Tls              *RabbitMqTlsOptions `mapstructure:"tls"`
+}
+
+type RabbitMqTlsOptions struct {
+	Enabled            bool   `mapstructure:"enabled"`
+	CaCertFile         string `mapstructure:"caCertFile"`
+	ClientCertFile     string `mapstructure:"clientCertFile"`
+	ClientKeyFile      string `mapstructure:"clientKeyFile"`
+	ServerName         string `mapstructure:"serverName"`
+	InsecureSkipVerify bool   `mapstructure:"insecureSkipVerify"`
+}
+
+// Addresses returns the `host:port` addresses of all the nodes
+func (h *RabbitMqHostOptions) Addresses() []string {
+	if len(h.Hosts) > 0 {
+		return h.Hosts
+	}
+
+	return []string{net.JoinHostPort(h.HostName, strconv.Itoa(h.Port))}
 }
 
+func (h *RabbitMqHostOptions) TlsEnabled() bool {
+	return h.Tls != nil && h.Tls.Enabled
+}
+
+// EndPoint returns the amqp uri of the first node with the credentials and the virtual host
 func (h *RabbitMqHostOptions) EndPoint() string {
-	return fmt.Sprintf("amqp://%s:%d", h.HostName, h.Port)
+	return h.EndPointOf(h.Addresses()[0])
+}
+
+// EndPointOf returns the amqp uri of a node address with the credentials and the virtual host
+func (h *RabbitMqHostOptions) EndPointOf(address string) string {
+	scheme := "amqp"
+	if h.TlsEnabled() {
+		scheme = "amqps"
+	}
+
+	uri := url.URL{Scheme: scheme, Host: address}
+	if h.VirtualHost != "" {
+		// the default virtual host `/` should be escaped in the uri path
+		uri.Path = "/" + h.VirtualHost
+		uri.RawPath = "/" + url.PathEscape(h.VirtualHost)
+	}
+	if h.UserName != "" {
+		uri.User = url.UserPassword(h.UserName, h.Password)
+	}
+
+	return uri.String()
 }
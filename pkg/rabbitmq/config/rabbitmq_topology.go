@@ -0,0 +1,111 @@
+package config
+
+// RabbitMQTopology describes the exchanges, queues and bindings of a service, it will be declared at startup and validated against the registered consumers and producers.
+type RabbitMQTopology struct {
+	Exchanges []*ExchangeTopology `mapstructure:"exchanges" json:"exchanges,omitempty" yaml:"exchanges,omitempty"`
+	Queues    []*QueueTopology    `mapstructure:"queues" json:"queues,omitempty" yaml:"queues,omitempty"`
+	Bindings  []*BindingTopology  `mapstructure:"bindings" json:"bindings,omitempty" yaml:"bindings,omitempty"`
+}
+
+type ExchangeTopology struct {
+	Name       string         `mapstructure:"name" json:"name" yaml:"name"`
+	Type       string         `mapstructure:"type" json:"type" yaml:"type"`
+	Durable    bool           `mapstructure:"durable" json:"durable" yaml:"durable"`
+	AutoDelete bool           `mapstructure:"autoDelete" json:"autoDelete" yaml:"autoDelete"`
+	Internal   bool           `mapstructure:"internal" json:"internal" yaml:"internal"`
+	Args       map[string]any `mapstructure:"args" json:"args,omitempty" yaml:"args,omitempty"`
+}
+
+type QueueTopology struct {
+	Name                 string `mapstructure:"name" json:"name" yaml:"name"`
+	Durable              bool   `mapstructure:"durable" json:"durable" yaml:"durable"`
+	AutoDelete           bool   `mapstructure:"autoDelete" json:"autoDelete" yaml:"autoDelete"`
+	Exclusive            bool   `mapstructure:"exclusive" json:"exclusive" yaml:"exclusive"`
+	DeadLetterExchange   string `mapstructure:"deadLetterExchange" json:"deadLetterExchange,omitempty" yaml:"deadLetterExchange,omitempty"`
+	DeadLetterRoutingKey string `mapstructure:"deadLetterRoutingKey" json:"deadLetterRoutingKey,omitempty" yaml:"deadLetterRoutingKey,omitempty"`
+	// MessageTTL is in milliseconds
+	MessageTTL int64          `mapstructure:"messageTtl" json:"messageTtl,omitempty" yaml:"messageTtl,omitempty"`
+	MaxLength  int64          `mapstructure:"maxLength" json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	Args       map[string]any `mapstructure:"args" json:"args,omitempty" yaml:"args,omitempty"`
+}
+
+type BindingTopology struct {
+	Exchange   string         `mapstructure:"exchange" json:"exchange" yaml:"exchange"`
+	Queue      string         `mapstructure:"queue" json:"queue" yaml:"queue"`
+	RoutingKey string         `mapstructure:"routingKey" json:"routingKey" yaml:"routingKey"`
+	Args       map[string]any `mapstructure:"args" json:"args,omitempty" yaml:"args,omitempty"`
+}
+
+// Arguments returns the queue `x-` arguments, including the dead letter, ttl and max length settings
+func (q *QueueTopology) Arguments() map[string]any {
+	args := make(map[string]any, len(q.Args)+4)
+	for k, v := range q.Args {
+		args[k] = v
+	}
+	if q.DeadLetterExchange != "" {
+		args["x-dead-letter-exchange"] = q.DeadLetterExchange
+	}
+	if q.DeadLetterRoutingKey != "" {
+		args["x-dead-letter-routing-key"] = q.DeadLetterRoutingKey
+	}
+	if q.MessageTTL > 0 {
+		args["x-message-ttl"] = q.MessageTTL
+	}
+	if q.MaxLength > 0 {
+		args["x-max-length"] = q.MaxLength
+	}
+	if len(args) == 0 {
+		return nil
+	}
+
+	return args
+}
+
+func (t *RabbitMQTopology) Exchange(name string) *ExchangeTopology {
+	if t == nil {
+		return nil
+	}
+	for _, e := range t.Exchanges {
+		if e.Name == name {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func (t *RabbitMQTopology) Queue(name string) *QueueTopology {
+	if t == nil {
+		return nil
+	}
+	for _, q := range t.Queues {
+		if q.Name == name {
+			return q
+		}
+	}
+
+	return nil
+}
+
+func (t *RabbitMQTopology) Binding(exchange string, queue string, routingKey string) *BindingTopology {
+	if t == nil {
+		return nil
+	}
+	for _, b := range t.Bindings {
+		if b.Exchange == exchange && b.Queue == queue && b.RoutingKey == routingKey {
+			return b
+		}
+	}
+
+	return nil
+}
+
+// QueueArguments returns the declared arguments of a queue, consumers should declare their queue with the same arguments otherwise the broker rejects the declaration
+func (t *RabbitMQTopology) QueueArguments(name string) map[string]any {
+	q := t.Queue(name)
+	if q == nil {
+		return nil
+	}
+
+	return q.Arguments()
+}
@@ -0,0 +1,24 @@
+package consumer
+
+import (
+	messageHeader "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_header"
+	"github.com/rabbitmq/amqp091-go"
+	"time"
+)
+
+// messageCreated returns the creation time of the message from the `created` header and falls back to the publishing timestamp, amqp timestamps have
+// seconds precision so the end-to-end lag is accurate to a second
+func messageCreated(delivery amqp091.Delivery) time.Time {
+	switch created := delivery.Headers[messageHeader.Created].(type) {
+	case time.Time:
+		return created
+	case string:
+		if t, err := time.Parse(time.RFC3339Nano, created); err == nil {
+			return t
+		}
+	case int64:
+		return time.Unix(created, 0)
+	}
+
+	return delivery.Timestamp
+}
@@ -2,8 +2,12 @@ package options
 
 import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/poison"
 	types2 "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/metrics"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/types"
 )
 
@@ -18,6 +22,14 @@ type RabbitMQConsumerOptions struct {
 	BindingOptions  *RabbitMQBindingOptions
 	QueueOptions    *RabbitMQQueueOptions
 	ExchangeOptions *RabbitMQExchangeOptions
+	// PayloadOptions rehydrates the claim-check and compressed message bodies
+	PayloadOptions *payload.PayloadOptions
+	// PoisonMessageHandler receives the deliveries that couldn't be deserialized, without it they are rejected
+	PoisonMessageHandler poison.PoisonMessageHandler
+	// Metrics records the processing duration and the end-to-end lag of the handled deliveries
+	Metrics *metrics.RabbitMQMetrics
+	// MessageRegistry resolves the message type of the deliveries by their wire names, without it the go type names are used
+	MessageRegistry *messageRegistry.MessageRegistry
 }
 
 func NewDefaultRabbitMQConsumerOptions[T types2.IMessage]() *RabbitMQConsumerOptions {
@@ -1,7 +1,11 @@
 package options
 
 import (
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/poison"
 	types2 "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/metrics"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/types"
 )
 
@@ -104,6 +108,26 @@ func (b *RabbitMQConsumerOptionsBuilder[T]) WithBindingArgs(args map[string]any)
 	return b
 }
 
+func (b *RabbitMQConsumerOptionsBuilder[T]) WithPayloadOptions(payloadOptions *payload.PayloadOptions) *RabbitMQConsumerOptionsBuilder[T] {
+	b.rabbitmqConsumerOptions.PayloadOptions = payloadOptions
+	return b
+}
+
+func (b *RabbitMQConsumerOptionsBuilder[T]) WithPoisonMessageHandler(handler poison.PoisonMessageHandler) *RabbitMQConsumerOptionsBuilder[T] {
+	b.rabbitmqConsumerOptions.PoisonMessageHandler = handler
+	return b
+}
+
+func (b *RabbitMQConsumerOptionsBuilder[T]) WithMetrics(metrics *metrics.RabbitMQMetrics) *RabbitMQConsumerOptionsBuilder[T] {
+	b.rabbitmqConsumerOptions.Metrics = metrics
+	return b
+}
+
+func (b *RabbitMQConsumerOptionsBuilder[T]) WithMessageRegistry(registry *messageRegistry.MessageRegistry) *RabbitMQConsumerOptionsBuilder[T] {
+	b.rabbitmqConsumerOptions.MessageRegistry = registry
+	return b
+}
+
 func (b *RabbitMQConsumerOptionsBuilder[T]) Build() *RabbitMQConsumerOptions {
 	return b.rabbitmqConsumerOptions
 }
@@ -0,0 +1,61 @@
+package options
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/poison"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/metrics"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/types"
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// UnknownMessageHandlerFunc handles deliveries whose `Type` has no registered handler on a multi type consumer.
+// returning nil acknowledges the delivery and returning an error rejects it without requeue (it will go to the DLX if the queue has one).
+type UnknownMessageHandlerFunc func(ctx context.Context, delivery amqp091.Delivery) error
+
+type RabbitMQMultiTypeConsumerOptions struct {
+	*consumer.ConsumerOptions
+	// ConcurrencyLimit should stay 1 when deliveries on the queue have to be handled in order.
+	ConcurrencyLimit int
+	// The prefetch count tells the Rabbit connection how many messages to retrieve from the server per request.
+	PrefetchCount         int
+	AutoAck               bool
+	NoLocal               bool
+	NoWait                bool
+	QueueOptions          *RabbitMQQueueOptions
+	UnknownMessageHandler UnknownMessageHandlerFunc
+	// PayloadOptions rehydrates the claim-check and compressed message bodies
+	PayloadOptions *payload.PayloadOptions
+	// PoisonMessageHandler receives the deliveries that couldn't be deserialized, without it they are rejected
+	PoisonMessageHandler poison.PoisonMessageHandler
+	// Metrics records the processing duration and the end-to-end lag of the handled deliveries
+	Metrics *metrics.RabbitMQMetrics
+	// MessageRegistry resolves the message type of the deliveries by their wire names, without it the go type names are used
+	MessageRegistry *messageRegistry.MessageRegistry
+}
+
+// RabbitMQTypeBindingOptions binds the shared queue of a multi type consumer to the exchange of one message type.
+type RabbitMQTypeBindingOptions struct {
+	ExchangeOptions *RabbitMQExchangeOptions
+	BindingOptions  *RabbitMQBindingOptions
+}
+
+func NewDefaultRabbitMQMultiTypeConsumerOptions(queueName string) *RabbitMQMultiTypeConsumerOptions {
+	return &RabbitMQMultiTypeConsumerOptions{
+		ConsumerOptions:  &consumer.ConsumerOptions{ExitOnError: false, ConsumerId: ""},
+		ConcurrencyLimit: 1,
+		PrefetchCount:    4, //how many messages we can handle at once
+		NoLocal:          false,
+		NoWait:           true,
+		QueueOptions:     &RabbitMQQueueOptions{Durable: true, Name: queueName},
+	}
+}
+
+func NewDefaultRabbitMQTypeBindingOptions(exchangeName string, routingKey string) *RabbitMQTypeBindingOptions {
+	return &RabbitMQTypeBindingOptions{
+		ExchangeOptions: &RabbitMQExchangeOptions{Durable: true, Type: types.ExchangeTopic, Name: exchangeName},
+		BindingOptions:  &RabbitMQBindingOptions{RoutingKey: routingKey},
+	}
+}
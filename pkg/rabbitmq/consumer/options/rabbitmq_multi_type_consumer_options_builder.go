@@ -0,0 +1,100 @@
+package options
+
+import (
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/poison"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/metrics"
+)
+
+type RabbitMQMultiTypeConsumerOptionsBuilder struct {
+	rabbitmqConsumerOptions *RabbitMQMultiTypeConsumerOptions
+}
+
+func NewRabbitMQMultiTypeConsumerOptionsBuilder(queueName string) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	return &RabbitMQMultiTypeConsumerOptionsBuilder{rabbitmqConsumerOptions: NewDefaultRabbitMQMultiTypeConsumerOptions(queueName)}
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithExitOnError(exitOnError bool) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.ExitOnError = exitOnError
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithAutoAck(ack bool) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.AutoAck = ack
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithNoLocal(noLocal bool) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.NoLocal = noLocal
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithNoWait(noWait bool) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.NoWait = noWait
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithConcurrencyLimit(limit int) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.ConcurrencyLimit = limit
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithPrefetchCount(count int) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.PrefetchCount = count
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithConsumerId(consumerId string) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.ConsumerId = consumerId
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithDurable(durable bool) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.QueueOptions.Durable = durable
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithAutoDeleteQueue(autoDelete bool) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.QueueOptions.AutoDelete = autoDelete
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithExclusiveQueue(exclusive bool) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.QueueOptions.Exclusive = exclusive
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithQueueArgs(args map[string]any) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.QueueOptions.Args = args
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithUnknownMessageHandler(handler UnknownMessageHandlerFunc) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.UnknownMessageHandler = handler
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithPayloadOptions(payloadOptions *payload.PayloadOptions) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.PayloadOptions = payloadOptions
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithPoisonMessageHandler(handler poison.PoisonMessageHandler) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.PoisonMessageHandler = handler
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithMetrics(metrics *metrics.RabbitMQMetrics) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.Metrics = metrics
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) WithMessageRegistry(registry *messageRegistry.MessageRegistry) *RabbitMQMultiTypeConsumerOptionsBuilder {
+	b.rabbitmqConsumerOptions.MessageRegistry = registry
+	return b
+}
+
+func (b *RabbitMQMultiTypeConsumerOptionsBuilder) Build() *RabbitMQMultiTypeConsumerOptions {
+	return b.rabbitmqConsumerOptions
+}
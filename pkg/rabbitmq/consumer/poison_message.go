@@ -0,0 +1,36 @@
+package consumer
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/poison"
+	"github.com/rabbitmq/amqp091-go"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+func newPoisonMessage(queueName string, delivery amqp091.Delivery, err error) *poison.PoisonMessage {
+	return &poison.PoisonMessage{
+		Id:            uuid.NewV4().String(),
+		Source:        queueName,
+		Exchange:      delivery.Exchange,
+		RoutingKey:    delivery.RoutingKey,
+		MessageType:   delivery.Type,
+		ContentType:   delivery.ContentType,
+		MessageId:     delivery.MessageId,
+		CorrelationId: delivery.CorrelationId,
+		Headers:       delivery.Headers,
+		Body:          delivery.Body,
+		Error:         err.Error(),
+		FailedAt:      time.Now(),
+	}
+}
+
+// handlePoisonMessage passes a delivery which couldn't be deserialized to the poison message handler, it reports whether the handler kept the message
+// so the delivery can be acknowledged, otherwise it should be rejected
+func handlePoisonMessage(ctx context.Context, handler poison.PoisonMessageHandler, queueName string, delivery amqp091.Delivery, err error) bool {
+	if handler == nil {
+		return false
+	}
+
+	return handler.Handle(ctx, newPoisonMessage(queueName, delivery, err)) == nil
+}
@@ -3,16 +3,21 @@ package consumer
 import (
 	"context"
 	"emperror.dev/errors"
+	"fmt"
 	"github.com/avast/retry-go"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
 	types2 "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/config"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/consumer/options"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/rabbitmqErrors"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/types"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
 	"github.com/rabbitmq/amqp091-go"
+	uuid "github.com/satori/go.uuid"
 	"reflect"
 	"time"
 )
@@ -31,7 +36,9 @@ type RabbitMQConsumer[T types2.IMessage] struct {
 	connection              types.IConnection
 	handler                 consumer.ConsumerHandler[T]
 	channel                 *amqp091.Channel
-	deliveryRoutines        chan struct{} // chan should init before using channel
+	consumerTag             string
+	lifecycle               *consumer.ConsumerLifecycle
+	ctx                     context.Context // ctx of Consume, the handlers run with it after resuming
 	eventSerializer         serializer.EventSerializer
 	logger                  logger.Logger
 	ErrChan                 chan error
@@ -44,9 +51,9 @@ func NewRabbitMQConsumer[T types2.IMessage](connection types.IConnection, builde
 	}
 
 	consumerConfig := builder.Build()
-	deliveryRoutines := make(chan struct{}, consumerConfig.ConcurrencyLimit)
+	queueName := consumerConfig.QueueOptions.Name
 
-	cons := &RabbitMQConsumer[T]{rabbitmqConsumerOptions: consumerConfig, deliveryRoutines: deliveryRoutines, ErrChan: make(chan error), connection: connection, handler: handler, eventSerializer: eventSerializer, logger: logger}
+	cons := &RabbitMQConsumer[T]{rabbitmqConsumerOptions: consumerConfig, consumerTag: consumerTag(consumerConfig.ConsumerId, queueName), lifecycle: consumer.NewConsumerLifecycle(queueName), ErrChan: make(chan error), connection: connection, handler: handler, eventSerializer: eventSerializer, logger: logger}
 
 	return cons, nil
 }
@@ -105,9 +112,16 @@ func (r *RabbitMQConsumer[T]) Consume(ctx context.Context) error {
 		return err
 	}
 
+	r.ctx = ctx
+
+	return r.lifecycle.Start(r.subscribe)
+}
+
+// subscribe starts receiving the deliveries of the queue on the consumer channel
+func (r *RabbitMQConsumer[T]) subscribe() error {
 	msgs, err := r.channel.Consume(
 		r.rabbitmqConsumerOptions.QueueOptions.Name,
-		r.rabbitmqConsumerOptions.ConsumerId,
+		r.consumerTag,
 		r.rabbitmqConsumerOptions.AutoAck, //When autoAck (also known as noAck) is true, the server will acknowledge deliveries to this consumer prior to writing the delivery to the network. When autoAck is true, the consumer should not call Delivery.Ack.
 		r.rabbitmqConsumerOptions.QueueOptions.Exclusive,
 		r.rabbitmqConsumerOptions.NoLocal,
@@ -123,49 +137,57 @@ func (r *RabbitMQConsumer[T]) Consume(ctx context.Context) error {
 	//https://medium.com/@dhanushgopinath/automatically-recovering-rabbitmq-connections-in-go-applications-7795a605ca59
 	for i := 0; i < r.rabbitmqConsumerOptions.ConcurrencyLimit; i++ {
 		r.logger.Infof("Processing messages on thread %d", i)
-		go func() {
-			for {
-				select {
-				case msg, ok := <-msgs:
-					if !ok {
-						r.logger.Error("consumer connection dropped")
-						return
-					}
-					//https://github.com/streadway/amqp/blob/2aa28536587a0090d8280eed56c75867ce7e93ec/delivery.go#L62
-					r.handleReceived(ctx, msg, r.handler)
-				}
+		r.lifecycle.Go(func() {
+			// deliveries channel is closed after canceling the subscription or dropping the connection
+			for msg := range msgs {
+				//https://github.com/streadway/amqp/blob/2aa28536587a0090d8280eed56c75867ce7e93ec/delivery.go#L62
+				r.handleReceived(r.ctx, msg, r.handler)
+			}
+			if r.lifecycle.State() == consumer.Running {
+				r.logger.Error("consumer connection dropped")
 			}
-		}()
+		})
 	}
 
 	return nil
 }
 
-func (r *RabbitMQConsumer[T]) UnConsume(ctx context.Context) error {
-	if r.channel != nil && r.channel.IsClosed() == false {
-		err := r.channel.Cancel(r.rabbitmqConsumerOptions.ConsumerId, false)
-		if err != nil {
-			return err
-		}
-		r.channel.Close()
+// cancel stops the deliveries of the subscription, the deliveries which are already received are still handled
+func (r *RabbitMQConsumer[T]) cancel() error {
+	if r.channel == nil || r.channel.IsClosed() {
+		return nil
 	}
 
-	done := make(chan struct{}, 1)
+	return r.channel.Cancel(r.consumerTag, false)
+}
 
-	go func() {
-		for {
-			if len(r.deliveryRoutines) == 0 {
-				done <- struct{}{}
-			}
-		}
-	}()
+func (r *RabbitMQConsumer[T]) Pause(ctx context.Context) error {
+	return r.lifecycle.Pause(r.cancel)
+}
 
-	select {
-	case <-done:
-		return nil
-	case <-ctx.Done():
-		return ctx.Err()
+func (r *RabbitMQConsumer[T]) Resume(ctx context.Context) error {
+	return r.lifecycle.Resume(r.subscribe)
+}
+
+func (r *RabbitMQConsumer[T]) Drain(ctx context.Context) error {
+	return r.lifecycle.Drain(ctx, r.cancel)
+}
+
+func (r *RabbitMQConsumer[T]) Status() consumer.ConsumerStatus {
+	return r.lifecycle.Status()
+}
+
+// UnConsume drains the consumer and closes its channel after the in-flight deliveries are acknowledged
+func (r *RabbitMQConsumer[T]) UnConsume(ctx context.Context) error {
+	if err := r.Drain(ctx); err != nil {
+		return err
+	}
+
+	if r.channel != nil && r.channel.IsClosed() == false {
+		return r.channel.Close()
 	}
+
+	return nil
 }
 
 func (r *RabbitMQConsumer[T]) reConsumeOnDropConnection(ctx context.Context) {
@@ -190,11 +212,14 @@ func (r *RabbitMQConsumer[T]) reConsumeOnDropConnection(ctx context.Context) {
 
 func (r *RabbitMQConsumer[T]) handleReceived(ctx context.Context, delivery amqp091.Delivery, handler consumer.ConsumerHandler[T]) {
 	// for ensuring our handler execute completely after shutdown
-	r.deliveryRoutines <- struct{}{}
-
-	defer func() { <-r.deliveryRoutines }()
+	r.lifecycle.BeginDelivery()
+	defer r.lifecycle.EndDelivery()
 
-	consumeContext := r.createConsumeContext(delivery)
+	consumeContext, err := r.createConsumeContext(ctx, delivery)
+	if err != nil {
+		r.handlePoison(ctx, delivery, err)
+		return
+	}
 
 	var ack func()
 	var nack func()
@@ -216,10 +241,12 @@ func (r *RabbitMQConsumer[T]) handleReceived(ctx context.Context, delivery amqp0
 		}
 	}
 
-	r.handle(ctx, ack, nack, consumeContext, handler)
+	started := time.Now()
+	err = r.handle(ctx, ack, nack, consumeContext, handler)
+	r.rabbitmqConsumerOptions.Metrics.ObserveHandled(r.rabbitmqConsumerOptions.QueueOptions.Name, delivery.Type, started, messageCreated(delivery), err)
 }
 
-func (r *RabbitMQConsumer[T]) handle(ctx context.Context, ack func(), nack func(), messageConsumeContext types2.IMessageConsumeContext[T], handler consumer.ConsumerHandler[T]) {
+func (r *RabbitMQConsumer[T]) handle(ctx context.Context, ack func(), nack func(), messageConsumeContext types2.IMessageConsumeContext[T], handler consumer.ConsumerHandler[T]) error {
 	err := retry.Do(func() error {
 		err := handler.Handle(ctx, messageConsumeContext)
 		return err
@@ -233,36 +260,99 @@ func (r *RabbitMQConsumer[T]) handle(ctx context.Context, ack func(), nack func(
 	} else if err == nil && ack != nil && r.rabbitmqConsumerOptions.AutoAck == false {
 		ack()
 	}
+
+	return err
+}
+
+// handlePoison never calls the handler for a delivery which couldn't be deserialized, it is acknowledged when the poison message handler keeps it and rejected otherwise
+func (r *RabbitMQConsumer[T]) handlePoison(ctx context.Context, delivery amqp091.Delivery, err error) {
+	r.logger.Errorf("[RabbitMQConsumer.handlePoison] error in deserializing message type %s with content type %s: %v", delivery.Type, delivery.ContentType, err)
+
+	kept := handlePoisonMessage(ctx, r.rabbitmqConsumerOptions.PoisonMessageHandler, r.rabbitmqConsumerOptions.QueueOptions.Name, delivery, err)
+
+	// if auto-ack is enabled we should not call Ack methods manually it could create some unexpected errors
+	if r.rabbitmqConsumerOptions.AutoAck {
+		return
+	}
+
+	if kept {
+		if err := delivery.Ack(false); err != nil {
+			r.logger.Errorf("error sending ACK to RabbitMQ consumer: %v", err)
+		}
+		return
+	}
+
+	if err := delivery.Reject(false); err != nil {
+		r.logger.Errorf("error in sending Reject to RabbitMQ consumer: %v", err)
+	}
 }
 
-func (r *RabbitMQConsumer[T]) createConsumeContext(delivery amqp091.Delivery) types2.IMessageConsumeContext[T] {
-	message := r.deserializeData(delivery.ContentType, delivery.Type, delivery.Body)
+func (r *RabbitMQConsumer[T]) createConsumeContext(ctx context.Context, delivery amqp091.Delivery) (types2.IMessageConsumeContext[T], error) {
+	// rehydrates the claim-check and compressed bodies before deserializing
+	body, err := payload.Decode(ctx, r.rabbitmqConsumerOptions.PayloadOptions, delivery.Body, delivery.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := r.deserializeData(delivery.ContentType, delivery.Type, body)
+	if err != nil {
+		return nil, err
+	}
+
 	var metadata core.Metadata
 	if delivery.Headers != nil {
 		metadata = core.MapToMetadata(delivery.Headers)
 	}
 	consumeContext := types2.NewMessageConsumeContext[T](message, metadata, delivery.ContentType, delivery.Type, delivery.Timestamp, delivery.DeliveryTag, delivery.MessageId, delivery.CorrelationId)
 
-	return consumeContext
+	return consumeContext, nil
 }
 
-func (r *RabbitMQConsumer[T]) deserializeData(contentType string, eventType string, body []byte) T {
+func (r *RabbitMQConsumer[T]) deserializeData(contentType string, eventType string, body []byte) (T, error) {
+	// messages without content type are serialized with the default serializer
 	if contentType == "" {
-		contentType = "application/json"
+		contentType = r.eventSerializer.ContentType()
 	}
-	if body == nil || len(body) == 0 {
-		return *new(T)
+	if len(body) == 0 {
+		return *new(T), errors.New("message body is empty")
 	}
 
-	if contentType == "application/json" {
-		//deserialize, err := r.eventSerializer.DeserializeType(body, typeMapper.GetTypeFromGeneric[T](), contentType)
-		deserialize, err := r.eventSerializer.DeserializeMessage(body, eventType, contentType) // or this to explicit type deserialization --> r.eventSerializer.DeserializeType(body, typeMapper.GetTypeFromGeneric[T](), contentType) / jsonSerializer.UnmarshalT[T](body)
-		if err != nil {
-			return *new(T)
-		}
+	var deserialize interface{}
+	var err error
+	if contract, ok := r.rabbitmqConsumerOptions.MessageRegistry.Resolve(eventType); ok {
+		deserialize, err = r.eventSerializer.DeserializeType(body, contract.Type, contentType)
+	} else {
+		deserialize, err = r.eventSerializer.DeserializeMessage(body, eventType, contentType) // or this to explicit type deserialization --> r.eventSerializer.DeserializeType(body, typeMapper.GetTypeFromGeneric[T](), contentType)
+	}
+	if err != nil {
+		return *new(T), err
+	}
+
+	message, ok := deserialize.(T)
+	if !ok {
+		return *new(T), errors.Errorf("deserialized type %T is not %s", deserialize, typeMapper.GetTypeNameByType(typeMapper.GetTypeFromGeneric[T]()))
+	}
+
+	return message, nil
+}
+
+// Topology returns the exchange, queue and binding that this consumer declares
+func (r *RabbitMQConsumer[T]) Topology() *config.RabbitMQTopology {
+	exchangeOptions := r.rabbitmqConsumerOptions.ExchangeOptions
+	queueOptions := r.rabbitmqConsumerOptions.QueueOptions
+
+	return &config.RabbitMQTopology{
+		Exchanges: []*config.ExchangeTopology{{Name: exchangeOptions.Name, Type: string(exchangeOptions.Type), Durable: exchangeOptions.Durable, AutoDelete: exchangeOptions.AutoDelete, Args: exchangeOptions.Args}},
+		Queues:    []*config.QueueTopology{{Name: queueOptions.Name, Durable: queueOptions.Durable, AutoDelete: queueOptions.AutoDelete, Exclusive: queueOptions.Exclusive, Args: queueOptions.Args}},
+		Bindings:  []*config.BindingTopology{{Exchange: exchangeOptions.Name, Queue: queueOptions.Name, RoutingKey: r.rabbitmqConsumerOptions.BindingOptions.RoutingKey, Args: r.rabbitmqConsumerOptions.BindingOptions.Args}},
+	}
+}
 
-		return deserialize.(T)
+// consumerTag generates a unique tag when the consumer id is not set, because a subscription can only be canceled by its tag
+func consumerTag(consumerId string, queueName string) string {
+	if consumerId != "" {
+		return consumerId
 	}
 
-	return *new(T)
+	return fmt.Sprintf("%s_%s", queueName, uuid.NewV4().String())
 }
@@ -3,7 +3,9 @@ package consumer
 import (
 	"context"
 	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/msgpack"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
 	types2 "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
@@ -13,8 +15,11 @@ import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/producer"
 	options2 "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/producer/options"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/types"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
+	"github.com/rabbitmq/amqp091-go"
 	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
 	"testing"
 	"time"
 )
@@ -87,6 +92,45 @@ func Test_Consume_Message(t *testing.T) {
 	fmt.Println(conn.IsConnected())
 }
 
+func Test_Consume_Message_With_Negotiated_Content_Type(t *testing.T) {
+	serializerRegistry := serializer.NewEventSerializerRegistry(json.NewJsonEventSerializer(), msgpack.NewMsgPackEventSerializer())
+
+	var received []*ProducerConsumerMessage
+	c, err := NewRabbitMQConsumer[*ProducerConsumerMessage](nil, nil, serializerRegistry, defaultLogger.Logger, &multiTypeTestHandler[*ProducerConsumerMessage]{handle: func(m *ProducerConsumerMessage) error {
+		received = append(received, m)
+		return nil
+	}})
+	assert.NoError(t, err)
+	rabbitmqConsumer := c.(*RabbitMQConsumer[*ProducerConsumerMessage])
+
+	message := NewProducerConsumerMessage("msgpack message")
+	serialized, err := msgpack.NewMsgPackEventSerializer().Serialize(message)
+	assert.NoError(t, err)
+
+	ack := &fakeAcknowledger{}
+	rabbitmqConsumer.handleReceived(context.Background(), amqp091.Delivery{Acknowledger: ack, Type: typeMapper.GetTypeName(message), ContentType: serialized.ContentType, Body: serialized.Data}, rabbitmqConsumer.handler)
+
+	assert.Len(t, received, 1)
+	assert.Equal(t, "msgpack message", received[0].Data)
+	assert.Equal(t, 1, ack.acks)
+}
+
+func Test_Consume_Message_With_Unsupported_Content_Type_Is_Rejected(t *testing.T) {
+	handled := false
+	c, err := NewRabbitMQConsumer[*ProducerConsumerMessage](nil, nil, json.NewJsonEventSerializer(), defaultLogger.Logger, &multiTypeTestHandler[*ProducerConsumerMessage]{handle: func(m *ProducerConsumerMessage) error {
+		handled = true
+		return nil
+	}})
+	assert.NoError(t, err)
+	rabbitmqConsumer := c.(*RabbitMQConsumer[*ProducerConsumerMessage])
+
+	ack := &fakeAcknowledger{}
+	rabbitmqConsumer.handleReceived(context.Background(), amqp091.Delivery{Acknowledger: ack, Type: typeMapper.GetTypeName(&ProducerConsumerMessage{}), ContentType: "application/xml", Body: []byte("<data/>")}, rabbitmqConsumer.handler)
+
+	assert.False(t, handled)
+	assert.Equal(t, 1, ack.rejects)
+}
+
 type ProducerConsumerMessage struct {
 	*types2.Message
 	Data string
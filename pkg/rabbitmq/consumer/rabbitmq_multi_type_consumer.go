@@ -0,0 +1,412 @@
+package consumer
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"github.com/avast/retry-go"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
+	types2 "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/consumer/options"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/rabbitmqErrors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/types"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	"github.com/rabbitmq/amqp091-go"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// messageDispatcher keeps the registered message type and a type-erased call to its generic ConsumerHandler[T]
+type messageDispatcher struct {
+	messageType reflect.Type
+	binding     *options.RabbitMQTypeBindingOptions
+	handle      func(ctx context.Context, message types2.IMessage, delivery amqp091.Delivery) error
+}
+
+// RabbitMQMultiTypeConsumer consumes several message types from a single queue. The queue is bound to the exchange
+// of each registered message type and every delivery is dispatched to its handler based on `delivery.Type`.
+type RabbitMQMultiTypeConsumer struct {
+	rabbitmqConsumerOptions *options.RabbitMQMultiTypeConsumerOptions
+	connection              types.IConnection
+	dispatchers             map[string]*messageDispatcher
+	channel                 *amqp091.Channel
+	consumerTag             string
+	lifecycle               *consumer.ConsumerLifecycle
+	ctx                     context.Context // ctx of Consume, the handlers run with it after resuming
+	eventSerializer         serializer.EventSerializer
+	logger                  logger.Logger
+}
+
+func NewRabbitMQMultiTypeConsumer(connection types.IConnection, queueName string, builderFunc func(builder *options.RabbitMQMultiTypeConsumerOptionsBuilder), eventSerializer serializer.EventSerializer, logger logger.Logger) (*RabbitMQMultiTypeConsumer, error) {
+	if queueName == "" {
+		return nil, errors.New("queue name is required for a multi type consumer")
+	}
+
+	builder := options.NewRabbitMQMultiTypeConsumerOptionsBuilder(queueName)
+	if builderFunc != nil {
+		builderFunc(builder)
+	}
+
+	consumerOptions := builder.Build()
+
+	return &RabbitMQMultiTypeConsumer{
+		rabbitmqConsumerOptions: consumerOptions,
+		consumerTag:             consumerTag(consumerOptions.ConsumerId, queueName),
+		lifecycle:               consumer.NewConsumerLifecycle(queueName),
+		connection:              connection,
+		dispatchers:             make(map[string]*messageDispatcher),
+		eventSerializer:         eventSerializer,
+		logger:                  logger,
+	}, nil
+}
+
+// AddHandler registers a handler for message type T and binds the consumer queue to the exchange of T. By default, exchange name and routing key come from the message type name
+// the same way as the producer does, `bindingFunc` can override them.
+func AddHandler[T types2.IMessage](c *RabbitMQMultiTypeConsumer, handler consumer.ConsumerHandler[T], bindingFunc func(binding *options.RabbitMQTypeBindingOptions)) error {
+	if handler == nil {
+		return errors.New("handler is nil")
+	}
+
+	messageType := typeMapper.GetTypeFromGeneric[T]()
+	typeName := messageTypeKey(typeMapper.GetTypeNameByType(messageType))
+	if _, exists := c.dispatchers[typeName]; exists {
+		return errors.Errorf("a handler for message type %s is already registered", typeName)
+	}
+
+	binding := options.NewDefaultRabbitMQTypeBindingOptions(utils.GetTopicOrExchangeName(*new(T)), utils.GetRoutingKey(*new(T)))
+	if bindingFunc != nil {
+		bindingFunc(binding)
+	}
+
+	c.dispatchers[typeName] = &messageDispatcher{
+		messageType: messageType,
+		binding:     binding,
+		handle: func(ctx context.Context, message types2.IMessage, delivery amqp091.Delivery) error {
+			var metadata core.Metadata
+			if delivery.Headers != nil {
+				metadata = core.MapToMetadata(delivery.Headers)
+			}
+			consumeContext := types2.NewMessageConsumeContext[T](message.(T), metadata, delivery.ContentType, delivery.Type, delivery.Timestamp, delivery.DeliveryTag, delivery.MessageId, delivery.CorrelationId)
+
+			return handler.Handle(ctx, consumeContext)
+		},
+	}
+
+	return nil
+}
+
+func (r *RabbitMQMultiTypeConsumer) Consume(ctx context.Context) error {
+	if r.connection == nil {
+		return errors.New("connection is nil")
+	}
+
+	if len(r.dispatchers) == 0 {
+		return errors.Errorf("no handler registered for the queue %s", r.rabbitmqConsumerOptions.QueueOptions.Name)
+	}
+
+	r.reConsumeOnDropConnection(ctx)
+
+	// get a new channel on the connection - channel is unique for each consumer
+	ch, err := r.connection.Channel()
+	if err != nil {
+		return rabbitmqErrors.ErrDisconnected
+	}
+	r.channel = ch
+
+	prefetchCount := r.rabbitmqConsumerOptions.ConcurrencyLimit * r.rabbitmqConsumerOptions.PrefetchCount
+	if err := r.channel.Qos(prefetchCount, 0, false); err != nil {
+		return err
+	}
+
+	queueOptions := r.rabbitmqConsumerOptions.QueueOptions
+	_, err = r.channel.QueueDeclare(
+		queueOptions.Name,
+		queueOptions.Durable,
+		queueOptions.AutoDelete,
+		queueOptions.Exclusive,
+		r.rabbitmqConsumerOptions.NoWait,
+		queueOptions.Args)
+	if err != nil {
+		return err
+	}
+
+	for _, dispatcher := range r.dispatchers {
+		exchangeOptions := dispatcher.binding.ExchangeOptions
+		err = r.channel.ExchangeDeclare(
+			exchangeOptions.Name,
+			string(exchangeOptions.Type),
+			exchangeOptions.Durable,
+			exchangeOptions.AutoDelete,
+			false,
+			r.rabbitmqConsumerOptions.NoWait,
+			exchangeOptions.Args)
+		if err != nil {
+			return err
+		}
+
+		err = r.channel.QueueBind(
+			queueOptions.Name,
+			dispatcher.binding.BindingOptions.RoutingKey,
+			exchangeOptions.Name,
+			r.rabbitmqConsumerOptions.NoWait,
+			dispatcher.binding.BindingOptions.Args)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.ctx = ctx
+
+	return r.lifecycle.Start(r.subscribe)
+}
+
+// subscribe starts receiving the deliveries of the queue on the consumer channel
+func (r *RabbitMQMultiTypeConsumer) subscribe() error {
+	queueOptions := r.rabbitmqConsumerOptions.QueueOptions
+	msgs, err := r.channel.Consume(
+		queueOptions.Name,
+		r.consumerTag,
+		r.rabbitmqConsumerOptions.AutoAck,
+		queueOptions.Exclusive,
+		r.rabbitmqConsumerOptions.NoLocal,
+		r.rabbitmqConsumerOptions.NoWait,
+		nil)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < r.rabbitmqConsumerOptions.ConcurrencyLimit; i++ {
+		r.logger.Infof("Processing messages of queue %s on thread %d", queueOptions.Name, i)
+		r.lifecycle.Go(func() {
+			// deliveries channel is closed after canceling the subscription or dropping the connection
+			for msg := range msgs {
+				r.handleReceived(r.ctx, msg)
+			}
+			if r.lifecycle.State() == consumer.Running {
+				r.logger.Error("consumer connection dropped")
+			}
+		})
+	}
+
+	return nil
+}
+
+// cancel stops the deliveries of the subscription, the deliveries which are already received are still handled
+func (r *RabbitMQMultiTypeConsumer) cancel() error {
+	if r.channel == nil || r.channel.IsClosed() {
+		return nil
+	}
+
+	return r.channel.Cancel(r.consumerTag, false)
+}
+
+func (r *RabbitMQMultiTypeConsumer) Pause(ctx context.Context) error {
+	return r.lifecycle.Pause(r.cancel)
+}
+
+func (r *RabbitMQMultiTypeConsumer) Resume(ctx context.Context) error {
+	return r.lifecycle.Resume(r.subscribe)
+}
+
+func (r *RabbitMQMultiTypeConsumer) Drain(ctx context.Context) error {
+	return r.lifecycle.Drain(ctx, r.cancel)
+}
+
+func (r *RabbitMQMultiTypeConsumer) Status() consumer.ConsumerStatus {
+	return r.lifecycle.Status()
+}
+
+// UnConsume drains the consumer and closes its channel after the in-flight deliveries are acknowledged
+func (r *RabbitMQMultiTypeConsumer) UnConsume(ctx context.Context) error {
+	if err := r.Drain(ctx); err != nil {
+		return err
+	}
+
+	if r.channel != nil && r.channel.IsClosed() == false {
+		return r.channel.Close()
+	}
+
+	return nil
+}
+
+func (r *RabbitMQMultiTypeConsumer) reConsumeOnDropConnection(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case reconnect := <-r.connection.ReconnectedChannel():
+				if reflect.ValueOf(reconnect).IsValid() {
+					r.logger.Info("reconsume_on_drop_connection started")
+					err := r.Consume(ctx)
+					if err != nil {
+						r.logger.Errorf("reconsume_on_drop_connection finished with error: %v", err)
+						continue
+					}
+					r.logger.Info("reconsume_on_drop_connection finished successfully")
+					return
+				}
+			}
+		}
+	}()
+}
+
+func (r *RabbitMQMultiTypeConsumer) handleReceived(ctx context.Context, delivery amqp091.Delivery) {
+	// for ensuring our handler execute completely after shutdown
+	r.lifecycle.BeginDelivery()
+	defer r.lifecycle.EndDelivery()
+
+	dispatcher, ok := r.dispatcher(delivery.Type)
+	if !ok {
+		r.handleUnknown(ctx, delivery)
+		return
+	}
+
+	message, err := r.deserializeData(ctx, delivery, dispatcher.messageType)
+	if err != nil {
+		r.logger.Errorf("[RabbitMQMultiTypeConsumer.handleReceived] error in deserializing message with type %s: %v", delivery.Type, err)
+		r.handlePoison(ctx, delivery, err)
+		return
+	}
+
+	started := time.Now()
+	err = retry.Do(func() error {
+		return dispatcher.handle(ctx, message, delivery)
+	}, append(retryOptions, retry.Context(ctx))...)
+	r.rabbitmqConsumerOptions.Metrics.ObserveHandled(r.rabbitmqConsumerOptions.QueueOptions.Name, delivery.Type, started, messageCreated(delivery), err)
+
+	if err != nil {
+		r.logger.Errorf("[RabbitMQMultiTypeConsumer.handleReceived] error in handling message with type %s, prepare for nacking message: %v", delivery.Type, err)
+		r.nack(delivery)
+		return
+	}
+
+	r.ack(delivery)
+}
+
+func (r *RabbitMQMultiTypeConsumer) handleUnknown(ctx context.Context, delivery amqp091.Delivery) {
+	if r.rabbitmqConsumerOptions.UnknownMessageHandler == nil {
+		r.logger.Errorf("[RabbitMQMultiTypeConsumer.handleUnknown] no handler registered for message type %s on queue %s", delivery.Type, r.rabbitmqConsumerOptions.QueueOptions.Name)
+		r.handlePoison(ctx, delivery, errors.Errorf("no handler registered for message type %s", delivery.Type))
+		return
+	}
+
+	if err := r.rabbitmqConsumerOptions.UnknownMessageHandler(ctx, delivery); err != nil {
+		r.logger.Errorf("[RabbitMQMultiTypeConsumer.handleUnknown] error in handling unknown message type %s: %v", delivery.Type, err)
+		r.reject(delivery)
+		return
+	}
+
+	r.ack(delivery)
+}
+
+// handlePoison acknowledges a delivery which couldn't be deserialized when the poison message handler keeps it and rejects it otherwise
+func (r *RabbitMQMultiTypeConsumer) handlePoison(ctx context.Context, delivery amqp091.Delivery, err error) {
+	if handlePoisonMessage(ctx, r.rabbitmqConsumerOptions.PoisonMessageHandler, r.rabbitmqConsumerOptions.QueueOptions.Name, delivery, err) {
+		r.ack(delivery)
+		return
+	}
+
+	r.reject(delivery)
+}
+
+func (r *RabbitMQMultiTypeConsumer) deserializeData(ctx context.Context, delivery amqp091.Delivery, messageType reflect.Type) (types2.IMessage, error) {
+	// messages without content type are serialized with the default serializer
+	contentType := delivery.ContentType
+	if contentType == "" {
+		contentType = r.eventSerializer.ContentType()
+	}
+
+	// rehydrates the claim-check and compressed bodies before deserializing
+	body, err := payload.Decode(ctx, r.rabbitmqConsumerOptions.PayloadOptions, delivery.Body, delivery.Headers)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) == 0 {
+		return nil, errors.New("message body is empty")
+	}
+
+	deserialized, err := r.eventSerializer.DeserializeType(body, messageType, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	message, ok := deserialized.(types2.IMessage)
+	if !ok {
+		return nil, errors.Errorf("deserialized type %T is not a message", deserialized)
+	}
+
+	return message, nil
+}
+
+// if auto-ack is enabled we should not call Ack methods manually it could create some unexpected errors
+func (r *RabbitMQMultiTypeConsumer) ack(delivery amqp091.Delivery) {
+	if r.rabbitmqConsumerOptions.AutoAck {
+		return
+	}
+	if err := delivery.Ack(false); err != nil {
+		r.logger.Errorf("error sending ACK to RabbitMQ consumer: %v", err)
+	}
+}
+
+func (r *RabbitMQMultiTypeConsumer) nack(delivery amqp091.Delivery) {
+	if r.rabbitmqConsumerOptions.AutoAck {
+		return
+	}
+	if err := delivery.Nack(false, true); err != nil {
+		r.logger.Errorf("error in sending Nack to RabbitMQ consumer: %v", err)
+	}
+}
+
+func (r *RabbitMQMultiTypeConsumer) reject(delivery amqp091.Delivery) {
+	if r.rabbitmqConsumerOptions.AutoAck {
+		return
+	}
+	if err := delivery.Reject(false); err != nil {
+		r.logger.Errorf("error in sending Reject to RabbitMQ consumer: %v", err)
+	}
+}
+
+// dispatcher finds the dispatcher of a delivery type, the wire names are resolved through the message registry and the other types are matched by the go type names
+func (r *RabbitMQMultiTypeConsumer) dispatcher(deliveryType string) (*messageDispatcher, bool) {
+	if contract, ok := r.rabbitmqConsumerOptions.MessageRegistry.Resolve(deliveryType); ok {
+		deliveryType = typeMapper.GetTypeNameByType(contract.Type)
+	}
+
+	dispatcher, ok := r.dispatchers[messageTypeKey(deliveryType)]
+
+	return dispatcher, ok
+}
+
+// messageTypeKey normalizes the type name because producers publish the pointer type name (`*ProductCreatedV1`) in `delivery.Type`
+func messageTypeKey(typeName string) string {
+	return strings.TrimPrefix(typeName, "*")
+}
+
+// Topology returns the shared queue and the exchanges and bindings of all the registered message types
+func (r *RabbitMQMultiTypeConsumer) Topology() *config.RabbitMQTopology {
+	queueOptions := r.rabbitmqConsumerOptions.QueueOptions
+	topology := &config.RabbitMQTopology{
+		Queues: []*config.QueueTopology{{Name: queueOptions.Name, Durable: queueOptions.Durable, AutoDelete: queueOptions.AutoDelete, Exclusive: queueOptions.Exclusive, Args: queueOptions.Args}},
+	}
+
+	typeNames := make([]string, 0, len(r.dispatchers))
+	for typeName := range r.dispatchers {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+
+	for _, typeName := range typeNames {
+		binding := r.dispatchers[typeName].binding
+		exchangeOptions := binding.ExchangeOptions
+		topology.Exchanges = append(topology.Exchanges, &config.ExchangeTopology{Name: exchangeOptions.Name, Type: string(exchangeOptions.Type), Durable: exchangeOptions.Durable, AutoDelete: exchangeOptions.AutoDelete, Args: exchangeOptions.Args})
+		topology.Bindings = append(topology.Bindings, &config.BindingTopology{Exchange: exchangeOptions.Name, Queue: queueOptions.Name, RoutingKey: binding.BindingOptions.RoutingKey, Args: binding.BindingOptions.Args})
+	}
+
+	return topology
+}
@@ -0,0 +1,272 @@
+package consumer
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	messageHeader "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_header"
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/poison"
+	types2 "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/consumer/options"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	"github.com/rabbitmq/amqp091-go"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_MultiType_Consumer_Dispatch_By_Type(t *testing.T) {
+	c, err := NewRabbitMQMultiTypeConsumer(nil, "multi_type_queue", nil, json.NewJsonEventSerializer(), defaultLogger.Logger)
+	assert.NoError(t, err)
+
+	var firstMessages []*MultiTypeFirstMessage
+	var secondMessages []*MultiTypeSecondMessage
+
+	err = AddHandler[*MultiTypeFirstMessage](c, &multiTypeTestHandler[*MultiTypeFirstMessage]{handle: func(m *MultiTypeFirstMessage) error {
+		firstMessages = append(firstMessages, m)
+		return nil
+	}}, nil)
+	assert.NoError(t, err)
+
+	err = AddHandler[*MultiTypeSecondMessage](c, &multiTypeTestHandler[*MultiTypeSecondMessage]{handle: func(m *MultiTypeSecondMessage) error {
+		secondMessages = append(secondMessages, m)
+		return nil
+	}}, nil)
+	assert.NoError(t, err)
+
+	ack := &fakeAcknowledger{}
+	c.handleReceived(context.Background(), newTestDelivery(t, ack, &MultiTypeFirstMessage{Message: types2.NewMessage(uuid.NewV4().String()), Data: "first"}))
+	c.handleReceived(context.Background(), newTestDelivery(t, ack, &MultiTypeSecondMessage{Message: types2.NewMessage(uuid.NewV4().String()), Count: 2}))
+
+	assert.Len(t, firstMessages, 1)
+	assert.Equal(t, "first", firstMessages[0].Data)
+	assert.Len(t, secondMessages, 1)
+	assert.Equal(t, 2, secondMessages[0].Count)
+	assert.Equal(t, 2, ack.acks)
+}
+
+func Test_MultiType_Consumer_Dispatch_By_Wire_Name(t *testing.T) {
+	registry := messageRegistry.NewMessageRegistry()
+	assert.NoError(t, messageRegistry.Register[*MultiTypeFirstMessage](registry, "tests.multi_type_first", 1, "LegacyFirstMessage"))
+
+	c, err := NewRabbitMQMultiTypeConsumer(nil, "multi_type_queue", func(builder *options.RabbitMQMultiTypeConsumerOptionsBuilder) {
+		builder.WithMessageRegistry(registry)
+	}, json.NewJsonEventSerializer(), defaultLogger.Logger)
+	assert.NoError(t, err)
+
+	var firstMessages []*MultiTypeFirstMessage
+	err = AddHandler[*MultiTypeFirstMessage](c, &multiTypeTestHandler[*MultiTypeFirstMessage]{handle: func(m *MultiTypeFirstMessage) error {
+		firstMessages = append(firstMessages, m)
+		return nil
+	}}, nil)
+	assert.NoError(t, err)
+
+	ack := &fakeAcknowledger{}
+	for _, wireName := range []string{"tests.multi_type_first.v1", "LegacyFirstMessage", "*MultiTypeFirstMessage"} {
+		delivery := newTestDelivery(t, ack, &MultiTypeFirstMessage{Message: types2.NewMessage(uuid.NewV4().String()), Data: wireName})
+		delivery.Type = wireName
+		c.handleReceived(context.Background(), delivery)
+	}
+
+	assert.Len(t, firstMessages, 3)
+	assert.Equal(t, 3, ack.acks)
+}
+
+func Test_MultiType_Consumer_Binds_Exchange_Per_Type(t *testing.T) {
+	c, err := NewRabbitMQMultiTypeConsumer(nil, "multi_type_queue", nil, json.NewJsonEventSerializer(), defaultLogger.Logger)
+	assert.NoError(t, err)
+
+	err = AddHandler[*MultiTypeFirstMessage](c, &multiTypeTestHandler[*MultiTypeFirstMessage]{}, nil)
+	assert.NoError(t, err)
+	err = AddHandler[*MultiTypeSecondMessage](c, &multiTypeTestHandler[*MultiTypeSecondMessage]{}, func(binding *options.RabbitMQTypeBindingOptions) {
+		binding.BindingOptions.RoutingKey = "custom_key"
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "multi_type_first_message", c.dispatchers["MultiTypeFirstMessage"].binding.ExchangeOptions.Name)
+	assert.Equal(t, "multi_type_first_message", c.dispatchers["MultiTypeFirstMessage"].binding.BindingOptions.RoutingKey)
+	assert.Equal(t, "custom_key", c.dispatchers["MultiTypeSecondMessage"].binding.BindingOptions.RoutingKey)
+
+	err = AddHandler[*MultiTypeFirstMessage](c, &multiTypeTestHandler[*MultiTypeFirstMessage]{}, nil)
+	assert.Error(t, err)
+}
+
+func Test_MultiType_Consumer_Unknown_Type_Goes_To_Fallback(t *testing.T) {
+	var unknownTypes []string
+	c, err := NewRabbitMQMultiTypeConsumer(nil, "multi_type_queue", func(builder *options.RabbitMQMultiTypeConsumerOptionsBuilder) {
+		builder.WithUnknownMessageHandler(func(ctx context.Context, delivery amqp091.Delivery) error {
+			unknownTypes = append(unknownTypes, delivery.Type)
+			return nil
+		})
+	}, json.NewJsonEventSerializer(), defaultLogger.Logger)
+	assert.NoError(t, err)
+	err = AddHandler[*MultiTypeFirstMessage](c, &multiTypeTestHandler[*MultiTypeFirstMessage]{}, nil)
+	assert.NoError(t, err)
+
+	ack := &fakeAcknowledger{}
+	c.handleReceived(context.Background(), newTestDelivery(t, ack, &MultiTypeSecondMessage{Message: types2.NewMessage(uuid.NewV4().String())}))
+
+	assert.Equal(t, []string{"*MultiTypeSecondMessage"}, unknownTypes)
+	assert.Equal(t, 1, ack.acks)
+}
+
+func Test_MultiType_Consumer_Unknown_Type_Without_Fallback_Is_Rejected(t *testing.T) {
+	c, err := NewRabbitMQMultiTypeConsumer(nil, "multi_type_queue", nil, json.NewJsonEventSerializer(), defaultLogger.Logger)
+	assert.NoError(t, err)
+	err = AddHandler[*MultiTypeFirstMessage](c, &multiTypeTestHandler[*MultiTypeFirstMessage]{}, nil)
+	assert.NoError(t, err)
+
+	ack := &fakeAcknowledger{}
+	c.handleReceived(context.Background(), newTestDelivery(t, ack, &MultiTypeSecondMessage{Message: types2.NewMessage(uuid.NewV4().String())}))
+
+	assert.Equal(t, 0, ack.acks)
+	assert.Equal(t, 1, ack.rejects)
+}
+
+func Test_MultiType_Consumer_Handler_Error_Nacks(t *testing.T) {
+	c, err := NewRabbitMQMultiTypeConsumer(nil, "multi_type_queue", nil, json.NewJsonEventSerializer(), defaultLogger.Logger)
+	assert.NoError(t, err)
+	err = AddHandler[*MultiTypeFirstMessage](c, &multiTypeTestHandler[*MultiTypeFirstMessage]{handle: func(m *MultiTypeFirstMessage) error {
+		return errors.New("handler failed")
+	}}, nil)
+	assert.NoError(t, err)
+
+	ack := &fakeAcknowledger{}
+	c.handleReceived(context.Background(), newTestDelivery(t, ack, &MultiTypeFirstMessage{Message: types2.NewMessage(uuid.NewV4().String())}))
+
+	assert.Equal(t, 0, ack.acks)
+	assert.Equal(t, 1, ack.nacks)
+}
+
+func Test_MultiType_Consumer_Rehydrates_Claim_Check_Payload(t *testing.T) {
+	blobStore, err := payload.NewFileSystemBlobStore(t.TempDir())
+	assert.NoError(t, err)
+	payloadOptions := &payload.PayloadOptions{CompressionThreshold: 64, Compression: payload.GzipCompression, ClaimCheckThreshold: 128, BlobStore: blobStore}
+
+	c, err := NewRabbitMQMultiTypeConsumer(nil, "multi_type_queue", func(builder *options.RabbitMQMultiTypeConsumerOptionsBuilder) {
+		builder.WithPayloadOptions(payloadOptions)
+	}, json.NewJsonEventSerializer(), defaultLogger.Logger)
+	assert.NoError(t, err)
+
+	var received []*MultiTypeFirstMessage
+	err = AddHandler[*MultiTypeFirstMessage](c, &multiTypeTestHandler[*MultiTypeFirstMessage]{handle: func(m *MultiTypeFirstMessage) error {
+		received = append(received, m)
+		return nil
+	}}, nil)
+	assert.NoError(t, err)
+
+	ack := &fakeAcknowledger{}
+	delivery := newTestDelivery(t, ack, &MultiTypeFirstMessage{Message: types2.NewMessage(uuid.NewV4().String()), Data: strings.Repeat("large description ", 100)})
+	body, headers, err := payload.Encode(context.Background(), payloadOptions, delivery.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, headers, payload.ClaimCheckHeader)
+	delivery.Body = body
+	delivery.Headers = headers
+
+	c.handleReceived(context.Background(), delivery)
+
+	assert.Len(t, received, 1)
+	assert.Equal(t, strings.Repeat("large description ", 100), received[0].Data)
+	assert.Equal(t, 1, ack.acks)
+}
+
+func Test_MultiType_Consumer_Undeserializable_Delivery_Goes_To_Poison_Store(t *testing.T) {
+	store := poison.NewInMemoryPoisonMessageStore()
+	c, err := NewRabbitMQMultiTypeConsumer(nil, "multi_type_queue", func(builder *options.RabbitMQMultiTypeConsumerOptionsBuilder) {
+		builder.WithPoisonMessageHandler(poison.NewStorePoisonMessageHandler(store, nil, defaultLogger.Logger))
+	}, json.NewJsonEventSerializer(), defaultLogger.Logger)
+	assert.NoError(t, err)
+
+	handled := 0
+	err = AddHandler[*MultiTypeFirstMessage](c, &multiTypeTestHandler[*MultiTypeFirstMessage]{handle: func(m *MultiTypeFirstMessage) error {
+		handled++
+		return nil
+	}}, nil)
+	assert.NoError(t, err)
+
+	ack := &fakeAcknowledger{}
+	delivery := newTestDelivery(t, ack, &MultiTypeFirstMessage{Message: types2.NewMessage(uuid.NewV4().String())})
+	delivery.Body = []byte("{not json")
+	delivery.Exchange = "multi_type_first_message"
+	c.handleReceived(context.Background(), delivery)
+
+	assert.Equal(t, 0, handled)
+	assert.Equal(t, 1, ack.acks)
+
+	messages, err := store.GetAll(context.Background(), "multi_type_queue")
+	assert.NoError(t, err)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, "*MultiTypeFirstMessage", messages[0].MessageType)
+	assert.Equal(t, "multi_type_first_message", messages[0].Exchange)
+	assert.Equal(t, []byte("{not json"), messages[0].Body)
+	assert.NotEmpty(t, messages[0].Error)
+}
+
+func Test_Message_Created_From_Headers(t *testing.T) {
+	created := time.Now().Add(-time.Minute).Truncate(time.Second)
+
+	assert.Equal(t, created, messageCreated(amqp091.Delivery{Headers: amqp091.Table{messageHeader.Created: created}}))
+	assert.True(t, created.Equal(messageCreated(amqp091.Delivery{Headers: amqp091.Table{messageHeader.Created: created.Format(time.RFC3339Nano)}})))
+	// falls back to the publishing timestamp
+	assert.Equal(t, created, messageCreated(amqp091.Delivery{Timestamp: created}))
+}
+
+type MultiTypeFirstMessage struct {
+	*types2.Message
+	Data string
+}
+
+type MultiTypeSecondMessage struct {
+	*types2.Message
+	Count int
+}
+
+type multiTypeTestHandler[T types2.IMessage] struct {
+	handle func(message T) error
+}
+
+func (h *multiTypeTestHandler[T]) Handle(ctx context.Context, consumeContext types2.IMessageConsumeContext[T]) error {
+	if h.handle == nil {
+		return nil
+	}
+	return h.handle(consumeContext.Message())
+}
+
+type fakeAcknowledger struct {
+	acks    int
+	nacks   int
+	rejects int
+}
+
+func (f *fakeAcknowledger) Ack(tag uint64, multiple bool) error {
+	f.acks++
+	return nil
+}
+
+func (f *fakeAcknowledger) Nack(tag uint64, multiple bool, requeue bool) error {
+	f.nacks++
+	return nil
+}
+
+func (f *fakeAcknowledger) Reject(tag uint64, requeue bool) error {
+	f.rejects++
+	return nil
+}
+
+func newTestDelivery(t *testing.T, acknowledger amqp091.Acknowledger, message types2.IMessage) amqp091.Delivery {
+	serialized, err := json.NewJsonEventSerializer().Serialize(message)
+	assert.NoError(t, err)
+
+	return amqp091.Delivery{
+		Acknowledger: acknowledger,
+		Type:         typeMapper.GetTypeName(message),
+		ContentType:  serialized.ContentType,
+		MessageId:    message.GeMessageId(),
+		Body:         serialized.Data,
+	}
+}
@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
+	"github.com/rabbitmq/amqp091-go"
+	"sync"
+	"time"
+)
+
+const defaultQueueMetricsInterval = 15 * time.Second
+
+type queueMetricsWorker struct {
+	connection types.IConnection
+	queues     []string
+	consumers  []consumer.Consumer
+	metrics    *RabbitMQMetrics
+	interval   time.Duration
+	logger     logger.Logger
+	done       chan struct{}
+	stopOnce   sync.Once
+}
+
+// NewQueueMetricsWorker periodically declares the queues of the topology and the consumers passively and exports their backlog. a passive declaration
+// never creates a queue. AMQP only reports the ready messages and the consumers count of a queue, so the unacked messages gauge is the number of
+// in-flight deliveries of this instance consumers.
+func NewQueueMetricsWorker(connection types.IConnection, topology *config.RabbitMQTopology, consumers []consumer.Consumer, metrics *RabbitMQMetrics, interval time.Duration, logger logger.Logger) web.Worker {
+	if interval <= 0 {
+		interval = defaultQueueMetricsInterval
+	}
+
+	w := &queueMetricsWorker{connection: connection, queues: queueNames(topology, consumers), consumers: consumers, metrics: metrics, interval: interval, logger: logger, done: make(chan struct{})}
+
+	return web.NewBackgroundWorker(w.run, w.stop)
+}
+
+func (w *queueMetricsWorker) run(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.collect()
+
+		select {
+		case <-ticker.C:
+		case <-w.done:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (w *queueMetricsWorker) stop(ctx context.Context) error {
+	w.stopOnce.Do(func() { close(w.done) })
+
+	return nil
+}
+
+func (w *queueMetricsWorker) collect() {
+	unacked := make(map[string]int64)
+	for _, c := range w.consumers {
+		status := c.Status()
+		unacked[status.Name] += status.InFlight
+	}
+	for queue, inFlight := range unacked {
+		w.metrics.QueueUnackedMessages.WithLabelValues(queue).Set(float64(inFlight))
+	}
+
+	if w.connection == nil || w.connection.IsClosed() {
+		w.logger.Warn("[queueMetricsWorker.collect] rabbitmq connection is closed, skipping queues metrics")
+		return
+	}
+
+	var channel *amqp091.Channel
+	defer func() {
+		if channel != nil && !channel.IsClosed() {
+			_ = channel.Close()
+		}
+	}()
+
+	for _, queue := range w.queues {
+		// the broker closes the channel when a passive declaration fails, so a new channel is opened for the next queues
+		if channel == nil || channel.IsClosed() {
+			var err error
+			channel, err = w.connection.Channel()
+			if err != nil {
+				w.logger.Errorf("[queueMetricsWorker.collect] error in opening channel: %v", err)
+				return
+			}
+		}
+
+		q, err := channel.QueueDeclarePassive(queue, false, false, false, false, nil)
+		if err != nil {
+			w.logger.Warnf("[queueMetricsWorker.collect] error in inspecting queue %s: %v", queue, err)
+			continue
+		}
+
+		w.metrics.QueueReadyMessages.WithLabelValues(queue).Set(float64(q.Messages))
+		w.metrics.QueueConsumers.WithLabelValues(queue).Set(float64(q.Consumers))
+	}
+}
+
+func queueNames(topology *config.RabbitMQTopology, consumers []consumer.Consumer) []string {
+	var names []string
+	exists := make(map[string]bool)
+	add := func(name string) {
+		if name != "" && !exists[name] {
+			exists[name] = true
+			names = append(names, name)
+		}
+	}
+
+	if topology != nil {
+		for _, queue := range topology.Queues {
+			add(queue.Name)
+		}
+	}
+	for _, c := range consumers {
+		add(c.Status().Name)
+	}
+
+	return names
+}
@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"fmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"time"
+)
+
+// RabbitMQMetrics keeps the queues backlog gauges and the consumers processing latency and end-to-end lag histograms
+type RabbitMQMetrics struct {
+	QueueReadyMessages   *prometheus.GaugeVec
+	QueueUnackedMessages *prometheus.GaugeVec
+	QueueConsumers       *prometheus.GaugeVec
+
+	ConsumerProcessingDuration *prometheus.HistogramVec
+	ConsumerEndToEndLag        *prometheus.HistogramVec
+}
+
+// NewRabbitMQMetrics registers the rabbitmq metrics, it should be called once per service
+func NewRabbitMQMetrics(serviceName string) *RabbitMQMetrics {
+	return &RabbitMQMetrics{
+		QueueReadyMessages: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: fmt.Sprintf("%s_rabbitmq_queue_ready_messages", serviceName),
+			Help: "The number of messages ready to be delivered in the queue",
+		}, []string{"queue"}),
+		QueueUnackedMessages: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: fmt.Sprintf("%s_rabbitmq_queue_unacked_messages", serviceName),
+			Help: "The number of messages of the queue which are delivered to this service instance and not acknowledged yet",
+		}, []string{"queue"}),
+		QueueConsumers: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: fmt.Sprintf("%s_rabbitmq_queue_consumers", serviceName),
+			Help: "The number of consumers of the queue",
+		}, []string{"queue"}),
+		ConsumerProcessingDuration: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    fmt.Sprintf("%s_rabbitmq_consumer_processing_duration_seconds", serviceName),
+			Help:    "The duration of handling a message by the consumer",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"queue", "message_type", "result"}),
+		ConsumerEndToEndLag: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    fmt.Sprintf("%s_rabbitmq_consumer_end_to_end_lag_seconds", serviceName),
+			Help:    "The time from creating a message to handling it by the consumer",
+			Buckets: prometheus.ExponentialBuckets(0.005, 2, 16),
+		}, []string{"queue", "message_type"}),
+	}
+}
+
+// ObserveHandled records the processing duration of a delivery and its end-to-end lag when the message creation time is known, it is nil-safe
+// so the consumers without metrics don't need to check it
+func (m *RabbitMQMetrics) ObserveHandled(queue string, messageType string, started time.Time, created time.Time, err error) {
+	if m == nil {
+		return
+	}
+
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+
+	handled := time.Now()
+	m.ConsumerProcessingDuration.WithLabelValues(queue, messageType, result).Observe(handled.Sub(started).Seconds())
+	if !created.IsZero() {
+		m.ConsumerEndToEndLag.WithLabelValues(queue, messageType).Observe(handled.Sub(created).Seconds())
+	}
+}
@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/config"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var testMetrics = NewRabbitMQMetrics("rabbitmq_metrics_test")
+
+func Test_Observe_Handled_Records_Latency_And_Lag(t *testing.T) {
+	testMetrics.ObserveHandled("test_queue", "*TestMessage", time.Now().Add(-time.Second), time.Now().Add(-time.Minute), nil)
+	testMetrics.ObserveHandled("test_queue", "*TestMessage", time.Now(), time.Time{}, errors.New("handler failed"))
+
+	assert.Equal(t, 2, testutil.CollectAndCount(testMetrics.ConsumerProcessingDuration))
+	// the message without creation time has no lag
+	assert.Equal(t, 1, testutil.CollectAndCount(testMetrics.ConsumerEndToEndLag))
+
+	var nilMetrics *RabbitMQMetrics
+	nilMetrics.ObserveHandled("test_queue", "*TestMessage", time.Now(), time.Now(), nil)
+}
+
+func Test_Queue_Metrics_Worker_Exports_Consumers_In_Flight_Deliveries(t *testing.T) {
+	consumers := []consumer.Consumer{
+		&fakeConsumer{status: consumer.ConsumerStatus{Name: "products", InFlight: 2}},
+		&fakeConsumer{status: consumer.ConsumerStatus{Name: "products", InFlight: 1}},
+		&fakeConsumer{status: consumer.ConsumerStatus{Name: "orders"}},
+	}
+	topology := &config.RabbitMQTopology{Queues: []*config.QueueTopology{{Name: "products_dead_letters"}, {Name: "products"}}}
+
+	w := &queueMetricsWorker{queues: queueNames(topology, consumers), consumers: consumers, metrics: testMetrics, logger: defaultLogger.Logger}
+	assert.Equal(t, []string{"products_dead_letters", "products", "orders"}, w.queues)
+
+	// without a connection only the consumers in-flight deliveries are exported
+	w.collect()
+	assert.Equal(t, float64(3), testutil.ToFloat64(testMetrics.QueueUnackedMessages.WithLabelValues("products")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(testMetrics.QueueUnackedMessages.WithLabelValues("orders")))
+}
+
+type fakeConsumer struct {
+	status consumer.ConsumerStatus
+}
+
+func (f *fakeConsumer) Consume(ctx context.Context) error   { return nil }
+func (f *fakeConsumer) UnConsume(ctx context.Context) error { return nil }
+func (f *fakeConsumer) Pause(ctx context.Context) error     { return nil }
+func (f *fakeConsumer) Resume(ctx context.Context) error    { return nil }
+func (f *fakeConsumer) Drain(ctx context.Context) error     { return nil }
+func (f *fakeConsumer) Status() consumer.ConsumerStatus     { return f.status }
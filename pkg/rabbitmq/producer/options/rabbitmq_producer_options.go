@@ -1,11 +1,17 @@
 package options
 
 import (
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/types"
 )
 
 type RabbitMQProducerOptions struct {
 	ExchangeOptions *RabbitMQExchangeOptions
+	// PayloadOptions compresses and claim-checks the large message bodies, nil disables it
+	PayloadOptions *payload.PayloadOptions
+	// MessageRegistry gives the wire names which are stamped into the message type, without it the go type names are used
+	MessageRegistry *messageRegistry.MessageRegistry
 }
 
 func NewDefaultRabbitMQProducerOptions() *RabbitMQProducerOptions {
@@ -1,6 +1,10 @@
 package options
 
-import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/types"
+import (
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/types"
+)
 
 type RabbitMQProducerOptionsBuilder struct {
 	rabbitmqProducerOptions *RabbitMQProducerOptions
@@ -30,6 +34,16 @@ func (b *RabbitMQProducerOptionsBuilder) WithExchangeArgs(args map[string]any) *
 	return b
 }
 
+func (b *RabbitMQProducerOptionsBuilder) WithPayloadOptions(payloadOptions *payload.PayloadOptions) *RabbitMQProducerOptionsBuilder {
+	b.rabbitmqProducerOptions.PayloadOptions = payloadOptions
+	return b
+}
+
+func (b *RabbitMQProducerOptionsBuilder) WithMessageRegistry(registry *messageRegistry.MessageRegistry) *RabbitMQProducerOptionsBuilder {
+	b.rabbitmqProducerOptions.MessageRegistry = registry
+	return b
+}
+
 func (b *RabbitMQProducerOptionsBuilder) Build() *RabbitMQProducerOptions {
 	return b.rabbitmqProducerOptions
 }
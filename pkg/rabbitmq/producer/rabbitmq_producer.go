@@ -7,7 +7,7 @@ import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
-	messageHeader "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_header"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/producer"
 	types2 "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/utils"
@@ -15,7 +15,6 @@ import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/types"
 	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
 	"github.com/rabbitmq/amqp091-go"
-	uuid "github.com/satori/go.uuid"
 	"time"
 )
 
@@ -39,6 +38,20 @@ func (r *rabbitMQProducer) Publish(ctx context.Context, message types2.IMessage,
 }
 
 func (r *rabbitMQProducer) PublishWithTopicName(ctx context.Context, message types2.IMessage, metadata core.Metadata, topicOrExchangeName string) error {
+	exchange := topicOrExchangeName
+	if exchange == "" {
+		exchange = utils.GetTopicOrExchangeName(message)
+	}
+
+	return r.publish(ctx, message, metadata, exchange, utils.GetRoutingKey(message))
+}
+
+// PublishToQueue publishes the message through the default exchange, it routes the message by the queue name only to the queue
+func (r *rabbitMQProducer) PublishToQueue(ctx context.Context, message types2.IMessage, metadata core.Metadata, queueName string) error {
+	return r.publish(ctx, message, metadata, "", queueName)
+}
+
+func (r *rabbitMQProducer) publish(ctx context.Context, message types2.IMessage, metadata core.Metadata, exchange string, routingKey string) error {
 	//https://github.com/rabbitmq/rabbitmq-tutorials/blob/master/go/publisher_confirms.go
 	if r.connection == nil {
 		return errors.New("connection is nil")
@@ -55,29 +68,39 @@ func (r *rabbitMQProducer) PublishWithTopicName(ctx context.Context, message typ
 	}
 	defer channel.Close()
 
-	if message.GetEventTypeName() == "" {
+	if wireName, ok := r.rabbitmqProducerOptions.MessageRegistry.WireName(message); ok {
+		// the contract wire name doesn't change by renaming or moving the go type
+		message.SetEventTypeName(wireName)
+	} else if message.GetEventTypeName() == "" {
 		message.SetEventTypeName(typeMapper.GetTypeName(message)) // just message type name not full type name because in other side package name for type could be different)
 	}
-	metadata = getMetadata(message, metadata)
+	metadata = utils.GetMessageMetadata(message, metadata)
 
 	serializedObj, err := r.eventSerializer.Serialize(message)
 	if err != nil {
 		return err
 	}
 
-	fmt.Println(string(serializedObj.Data))
-
-	var exchange string
-
-	if topicOrExchangeName != "" {
-		exchange = topicOrExchangeName
-	} else {
-		exchange = utils.GetTopicOrExchangeName(message)
+	body, payloadHeaders, err := payload.Encode(ctx, r.rabbitmqProducerOptions.PayloadOptions, serializedObj.Data)
+	if err != nil {
+		return errors.WrapIf(err, "error in encoding message payload")
+	}
+	headers := make(map[string]interface{}, len(metadata)+len(payloadHeaders))
+	for k, v := range core.MetadataToMap(metadata) {
+		headers[k] = v
+	}
+	for k, v := range payloadHeaders {
+		headers[k] = v
 	}
 
-	err = r.ensureExchange(channel, exchange)
-	if err != nil {
-		return err
+	fmt.Println(string(serializedObj.Data))
+
+	// the default exchange can't be declared
+	if exchange != "" {
+		err = r.ensureExchange(channel, exchange)
+		if err != nil {
+			return err
+		}
 	}
 
 	if err := channel.Confirm(false); err != nil {
@@ -91,17 +114,17 @@ func (r *rabbitMQProducer) PublishWithTopicName(ctx context.Context, message typ
 		CorrelationId: message.GetCorrelationId(),
 		MessageId:     message.GeMessageId(),
 		Timestamp:     time.Now(),
-		Headers:       core.MetadataToMap(metadata),
+		Headers:       headers,
 		Type:          message.GetEventTypeName(), //typeMapper.GetTypeName(message) - just message type name not full type name because in other side package name for type could be different
 		ContentType:   serializedObj.ContentType,
-		Body:          serializedObj.Data,
+		Body:          body,
 		DeliveryMode:  2,
 	}
 
 	err = channel.PublishWithContext(
 		ctx,
 		exchange,
-		utils.GetRoutingKey(message),
+		routingKey,
 		true,
 		false,
 		props,
@@ -117,29 +140,6 @@ func (r *rabbitMQProducer) PublishWithTopicName(ctx context.Context, message typ
 	return nil
 }
 
-func getMetadata(message types2.IMessage, metadata core.Metadata) core.Metadata {
-	metadata = core.FromMetadata(metadata)
-
-	if metadata.ExistsKey(messageHeader.MessageId) == false {
-		metadata.SetValue(messageHeader.MessageId, message.GeMessageId())
-	}
-
-	if metadata.ExistsKey(messageHeader.Created) == false {
-		metadata.SetValue(messageHeader.Created, message.GetCreated())
-	}
-
-	if metadata.ExistsKey(messageHeader.CorrelationId) == false {
-		cid := uuid.NewV4().String()
-		metadata.SetValue(messageHeader.CorrelationId, cid)
-		message.SetCorrelationId(cid)
-	}
-
-	metadata.SetValue(messageHeader.Name, utils.GetMessageName(message))
-	metadata.SetValue(messageHeader.Type, message.GetEventTypeName())
-
-	return metadata
-}
-
 func (r *rabbitMQProducer) ensureExchange(channel *amqp091.Channel, exchangeName string) error {
 	err := channel.ExchangeDeclare(
 		exchangeName,
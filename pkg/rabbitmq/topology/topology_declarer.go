@@ -0,0 +1,62 @@
+package topology
+
+import (
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	types2 "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/rabbitmqErrors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/types"
+)
+
+// DeclareTopology declares all the exchanges, queues and bindings of the topology on the broker, declarations are idempotent when they are not changed.
+func DeclareTopology(connection types.IConnection, topology *config.RabbitMQTopology) error {
+	if topology == nil {
+		return nil
+	}
+	if connection == nil {
+		return errors.New("connection is nil")
+	}
+
+	channel, err := connection.Channel()
+	if err != nil {
+		return rabbitmqErrors.ErrDisconnected
+	}
+	defer channel.Close()
+
+	for _, e := range topology.Exchanges {
+		err = channel.ExchangeDeclare(e.Name, e.Type, e.Durable, e.AutoDelete, e.Internal, false, e.Args)
+		if err != nil {
+			return errors.WrapIff(err, "error in declaring exchange %s", e.Name)
+		}
+	}
+
+	for _, q := range topology.Queues {
+		_, err = channel.QueueDeclare(q.Name, q.Durable, q.AutoDelete, q.Exclusive, false, q.Arguments())
+		if err != nil {
+			return errors.WrapIff(err, "error in declaring queue %s", q.Name)
+		}
+	}
+
+	for _, b := range topology.Bindings {
+		err = channel.QueueBind(b.Queue, b.RoutingKey, b.Exchange, false, b.Args)
+		if err != nil {
+			return errors.WrapIff(err, "error in binding queue %s to exchange %s with routing key %s", b.Queue, b.Exchange, b.RoutingKey)
+		}
+	}
+
+	return nil
+}
+
+// ConfigureTopology validates the topology against the consumers and produced messages and declares it, the service should not start when it fails.
+func ConfigureTopology(connection types.IConnection, topology *config.RabbitMQTopology, consumers []consumer.Consumer, producedMessages []types2.IMessage) error {
+	if topology == nil {
+		return nil
+	}
+
+	if err := Validate(topology, consumers, producedMessages); err != nil {
+		return errors.WrapIf(err, "rabbitmq topology is not valid")
+	}
+
+	return DeclareTopology(connection, topology)
+}
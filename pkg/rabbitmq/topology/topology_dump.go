@@ -0,0 +1,64 @@
+package topology
+
+import (
+	"emperror.dev/errors"
+	"encoding/json"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/config"
+	"gopkg.in/yaml.v3"
+	"strings"
+)
+
+const (
+	JsonFormat    = "json"
+	YamlFormat    = "yaml"
+	MermaidFormat = "mermaid"
+)
+
+// Dump writes the topology in json, yaml or as a mermaid flowchart diagram
+func Dump(topology *config.RabbitMQTopology, format string) ([]byte, error) {
+	if topology == nil {
+		topology = &config.RabbitMQTopology{}
+	}
+
+	switch strings.ToLower(format) {
+	case JsonFormat:
+		return json.MarshalIndent(topology, "", "  ")
+	case YamlFormat:
+		return yaml.Marshal(topology)
+	case MermaidFormat:
+		return []byte(mermaid(topology)), nil
+	default:
+		return nil, errors.Errorf("topology format %s is not supported, supported formats are json, yaml and mermaid", format)
+	}
+}
+
+func mermaid(topology *config.RabbitMQTopology) string {
+	sb := strings.Builder{}
+	sb.WriteString("flowchart LR\n")
+
+	for _, e := range topology.Exchanges {
+		sb.WriteString(fmt.Sprintf("    %s{{\"%s (%s)\"}}\n", nodeId("exchange", e.Name), e.Name, e.Type))
+	}
+	for _, q := range topology.Queues {
+		sb.WriteString(fmt.Sprintf("    %s[(\"%s\")]\n", nodeId("queue", q.Name), q.Name))
+	}
+	for _, b := range topology.Bindings {
+		if b.RoutingKey == "" {
+			sb.WriteString(fmt.Sprintf("    %s --> %s\n", nodeId("exchange", b.Exchange), nodeId("queue", b.Queue)))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("    %s -->|%s| %s\n", nodeId("exchange", b.Exchange), b.RoutingKey, nodeId("queue", b.Queue)))
+	}
+	for _, q := range topology.Queues {
+		if q.DeadLetterExchange != "" {
+			sb.WriteString(fmt.Sprintf("    %s -.->|dead letter| %s\n", nodeId("queue", q.Name), nodeId("exchange", q.DeadLetterExchange)))
+		}
+	}
+
+	return sb.String()
+}
+
+func nodeId(kind string, name string) string {
+	return kind + "_" + strings.NewReplacer(".", "_", "-", "_", " ", "_").Replace(name)
+}
@@ -0,0 +1,135 @@
+package topology
+
+import (
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/config"
+	"reflect"
+)
+
+// TopologyProvider is implemented by the consumers which declare their own exchanges, queues and bindings
+type TopologyProvider interface {
+	Topology() *config.RabbitMQTopology
+}
+
+// Validate checks the declared topology is consistent and contains everything the registered consumers declare, with the same settings,
+// and an exchange for each produced message. All the problems are returned together.
+func Validate(declared *config.RabbitMQTopology, consumers []consumer.Consumer, producedMessages []types.IMessage) error {
+	if declared == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, q := range declared.Queues {
+		if q.DeadLetterExchange != "" && declared.Exchange(q.DeadLetterExchange) == nil {
+			errs = append(errs, errors.Errorf("dead letter exchange %s of queue %s is not declared", q.DeadLetterExchange, q.Name))
+		}
+	}
+
+	for _, b := range declared.Bindings {
+		if declared.Exchange(b.Exchange) == nil {
+			errs = append(errs, errors.Errorf("exchange %s of binding to queue %s is not declared", b.Exchange, b.Queue))
+		}
+		if declared.Queue(b.Queue) == nil {
+			errs = append(errs, errors.Errorf("queue %s of binding from exchange %s is not declared", b.Queue, b.Exchange))
+		}
+	}
+
+	for _, c := range consumers {
+		provider, ok := c.(TopologyProvider)
+		if !ok {
+			continue
+		}
+		errs = append(errs, validateConsumerTopology(declared, provider.Topology())...)
+	}
+
+	for _, message := range producedMessages {
+		exchangeName := utils.GetTopicOrExchangeName(message)
+		if declared.Exchange(exchangeName) == nil {
+			errs = append(errs, errors.Errorf("exchange %s for produced message %s is not declared", exchangeName, utils.GetMessageName(message)))
+		}
+	}
+
+	return errors.Combine(errs...)
+}
+
+func validateConsumerTopology(declared *config.RabbitMQTopology, consumerTopology *config.RabbitMQTopology) []error {
+	var errs []error
+
+	for _, e := range consumerTopology.Exchanges {
+		declaredExchange := declared.Exchange(e.Name)
+		if declaredExchange == nil {
+			errs = append(errs, errors.Errorf("exchange %s used by a consumer is not declared", e.Name))
+			continue
+		}
+		if declaredExchange.Type != e.Type || declaredExchange.Durable != e.Durable || declaredExchange.AutoDelete != e.AutoDelete || !equalArgs(declaredExchange.Args, e.Args) {
+			errs = append(errs, errors.Errorf("exchange %s declared by a consumer doesn't match with the topology", e.Name))
+		}
+	}
+
+	for _, q := range consumerTopology.Queues {
+		declaredQueue := declared.Queue(q.Name)
+		if declaredQueue == nil {
+			errs = append(errs, errors.Errorf("queue %s used by a consumer is not declared", q.Name))
+			continue
+		}
+		if declaredQueue.Durable != q.Durable || declaredQueue.AutoDelete != q.AutoDelete || declaredQueue.Exclusive != q.Exclusive || !equalArgs(declaredQueue.Arguments(), q.Arguments()) {
+			errs = append(errs, errors.Errorf("queue %s declared by a consumer doesn't match with the topology", q.Name))
+		}
+	}
+
+	for _, b := range consumerTopology.Bindings {
+		if declared.Binding(b.Exchange, b.Queue, b.RoutingKey) == nil {
+			errs = append(errs, errors.Errorf("binding from exchange %s to queue %s with routing key %s used by a consumer is not declared", b.Exchange, b.Queue, b.RoutingKey))
+		}
+	}
+
+	return errs
+}
+
+func equalArgs(a map[string]any, b map[string]any) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// EffectiveTopology merges the declared topology with the implicit declarations of the consumers, which is what the broker will have after startup.
+func EffectiveTopology(declared *config.RabbitMQTopology, consumers []consumer.Consumer) *config.RabbitMQTopology {
+	effective := &config.RabbitMQTopology{}
+	merge(effective, declared)
+
+	for _, c := range consumers {
+		if provider, ok := c.(TopologyProvider); ok {
+			merge(effective, provider.Topology())
+		}
+	}
+
+	return effective
+}
+
+func merge(target *config.RabbitMQTopology, source *config.RabbitMQTopology) {
+	if source == nil {
+		return
+	}
+
+	for _, e := range source.Exchanges {
+		if target.Exchange(e.Name) == nil {
+			target.Exchanges = append(target.Exchanges, e)
+		}
+	}
+	for _, q := range source.Queues {
+		if target.Queue(q.Name) == nil {
+			target.Queues = append(target.Queues, q)
+		}
+	}
+	for _, b := range source.Bindings {
+		if target.Binding(b.Exchange, b.Queue, b.RoutingKey) == nil {
+			target.Bindings = append(target.Bindings, b)
+		}
+	}
+}
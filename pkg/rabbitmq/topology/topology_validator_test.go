@@ -0,0 +1,102 @@
+package topology
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/config"
+	rabbitmqConsumer "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/consumer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/consumer/options"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func Test_Validate_Topology_Matches_Consumers(t *testing.T) {
+	declared := newTestTopology()
+	consumers := newTestConsumers(t, declared)
+
+	err := Validate(declared, consumers, []types.IMessage{&TopologyTestMessage{}})
+	assert.NoError(t, err)
+}
+
+func Test_Validate_Topology_Reports_Missing_Declarations(t *testing.T) {
+	declared := newTestTopology()
+	consumers := newTestConsumers(t, declared)
+
+	declared.Bindings = nil
+	declared.Queues[0].DeadLetterExchange = "missing_dlx"
+
+	err := Validate(declared, consumers, []types.IMessage{&TopologyOtherTestMessage{}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "dead letter exchange missing_dlx")
+	assert.Contains(t, err.Error(), "binding from exchange topology_test_message to queue test_queue")
+	assert.Contains(t, err.Error(), "exchange topology_other_test_message for produced message")
+	// queue arguments changed and the consumer still declares the old arguments
+	assert.Contains(t, err.Error(), "queue test_queue declared by a consumer doesn't match")
+}
+
+func Test_Effective_Topology_Includes_Consumer_Declarations(t *testing.T) {
+	consumers := newTestConsumers(t, nil)
+
+	effective := EffectiveTopology(&config.RabbitMQTopology{Exchanges: []*config.ExchangeTopology{{Name: "other", Type: "fanout", Durable: true}}}, consumers)
+
+	assert.NotNil(t, effective.Exchange("other"))
+	assert.NotNil(t, effective.Exchange("topology_test_message"))
+	assert.NotNil(t, effective.Queue("test_queue"))
+	assert.NotNil(t, effective.Binding("topology_test_message", "test_queue", "topology_test_message"))
+}
+
+func Test_Dump_Topology(t *testing.T) {
+	topology := newTestTopology()
+
+	for _, format := range []string{JsonFormat, YamlFormat, MermaidFormat} {
+		dump, err := Dump(topology, format)
+		assert.NoError(t, err)
+		assert.True(t, strings.Contains(string(dump), "test_queue"), format)
+	}
+
+	_, err := Dump(topology, "xml")
+	assert.Error(t, err)
+}
+
+type TopologyTestMessage struct {
+	*types.Message
+}
+
+type TopologyOtherTestMessage struct {
+	*types.Message
+}
+
+type topologyTestHandler struct{}
+
+func (h *topologyTestHandler) Handle(ctx context.Context, consumeContext types.IMessageConsumeContext[*TopologyTestMessage]) error {
+	return nil
+}
+
+func newTestTopology() *config.RabbitMQTopology {
+	return &config.RabbitMQTopology{
+		Exchanges: []*config.ExchangeTopology{
+			{Name: "topology_test_message", Type: "topic", Durable: true},
+			{Name: "test_queue_dlx", Type: "fanout", Durable: true},
+		},
+		Queues: []*config.QueueTopology{
+			{Name: "test_queue", Durable: true, DeadLetterExchange: "test_queue_dlx"},
+		},
+		Bindings: []*config.BindingTopology{
+			{Exchange: "topology_test_message", Queue: "test_queue", RoutingKey: "topology_test_message"},
+		},
+	}
+}
+
+func newTestConsumers(t *testing.T, declared *config.RabbitMQTopology) []consumer.Consumer {
+	c, err := rabbitmqConsumer.NewRabbitMQMultiTypeConsumer(nil, "test_queue", func(builder *options.RabbitMQMultiTypeConsumerOptionsBuilder) {
+		builder.WithQueueArgs(declared.QueueArguments("test_queue"))
+	}, json.NewJsonEventSerializer(), defaultLogger.Logger)
+	assert.NoError(t, err)
+	assert.NoError(t, rabbitmqConsumer.AddHandler[*TopologyTestMessage](c, &topologyTestHandler{}, nil))
+
+	return []consumer.Consumer{c}
+}
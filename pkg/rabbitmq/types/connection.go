@@ -3,15 +3,20 @@ package types
 import (
 	"context"
 	"emperror.dev/errors"
-	"fmt"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/config"
 	"github.com/rabbitmq/amqp091-go"
+	"sync"
+	"time"
 )
 
 type internalConnection struct {
-	cfg *config.RabbitMQConfig
-	*amqp091.Connection
+	cfg               *config.RabbitMQConfig
+	amqpConfig        amqp091.Config
+	hosts             *hostSelector
+	mu                sync.RWMutex
+	connection        *amqp091.Connection
+	address           string
 	isConnected       bool
 	errConnectionChan chan error
 	errChannelChan    chan error
@@ -28,6 +33,8 @@ type IConnection interface {
 	NotifyClose(receiver chan *amqp091.Error) chan *amqp091.Error
 	Raw() *amqp091.Connection
 	ErrorConnectionChannel() chan error
+	// ReconnectedChannel is closed after the connection is reconnected to one of the nodes, so all the consumers and producers waiting on it
+	// are notified. after each reconnection it returns a new channel for waiting on the next one.
 	ReconnectedChannel() chan struct{}
 }
 
@@ -37,14 +44,26 @@ func NewRabbitMQConnection(ctx context.Context, cfg *config.RabbitMQConfig) (ICo
 		return nil, errors.New("rabbitmq host options is nil")
 	}
 
+	amqpConfig, err := newAmqpConfig(cfg.RabbitMqHostOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts, err := newHostSelector(cfg.RabbitMqHostOptions)
+	if err != nil {
+		return nil, err
+	}
+
 	c := &internalConnection{
 		cfg:               cfg,
+		amqpConfig:        amqpConfig,
+		hosts:             hosts,
 		errConnectionChan: make(chan error),
 		//errChannelChan:    make(chan error),
 		reconnectedChan: make(chan struct{}),
 	}
 
-	err := c.connect()
+	err = c.connect()
 	if err != nil {
 		return nil, err
 	}
@@ -55,23 +74,39 @@ func NewRabbitMQConnection(ctx context.Context, cfg *config.RabbitMQConfig) (ICo
 }
 
 func (c *internalConnection) Close() error {
-	return c.Connection.Close()
+	return c.Raw().Close()
+}
+
+func (c *internalConnection) IsClosed() bool {
+	connection := c.Raw()
+
+	return connection == nil || connection.IsClosed()
 }
 
 func (c *internalConnection) IsConnected() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	return c.isConnected
 }
 
+func (c *internalConnection) NotifyClose(receiver chan *amqp091.Error) chan *amqp091.Error {
+	return c.Raw().NotifyClose(receiver)
+}
+
 func (c *internalConnection) ErrorConnectionChannel() chan error {
 	return c.errConnectionChan
 }
 
 func (c *internalConnection) ReconnectedChannel() chan struct{} {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	return c.reconnectedChan
 }
 
 func (c *internalConnection) ReConnect() error {
-	if c.Connection.IsClosed() == false {
+	if c.IsClosed() == false {
 		return nil
 	}
 
@@ -79,11 +114,19 @@ func (c *internalConnection) ReConnect() error {
 }
 
 func (c *internalConnection) Raw() *amqp091.Connection {
-	return c.Connection
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.connection
 }
 
 func (c *internalConnection) Channel() (*amqp091.Channel, error) {
-	ch, err := c.Connection.Channel()
+	connection := c.Raw()
+	if connection == nil {
+		return nil, errors.New("rabbitmq connection is not established")
+	}
+
+	ch, err := connection.Channel()
 	//notifyChannelClose := ch.NotifyClose(make(chan *amqp091.Error))
 	//go func() {
 	//	<-notifyChannelClose //Listen to notifyChannelClose
@@ -93,25 +136,52 @@ func (c *internalConnection) Channel() (*amqp091.Channel, error) {
 	return ch, err
 }
 
+// connect tries the nodes in the failover order and connects to the first available one
 func (c *internalConnection) connect() error {
-	conn, err := amqp091.Dial(c.cfg.RabbitMqHostOptions.EndPoint())
-	if err != nil {
-		return errors.WrapIf(err, fmt.Sprintf("Error in creating rabbitmq connection with %s", c.cfg.RabbitMqHostOptions.EndPoint()))
-	}
+	var errs []error
+	for _, address := range c.hosts.order() {
+		conn, err := c.dial(address)
+		if err != nil {
+			errs = append(errs, errors.WrapIff(err, "Error in creating rabbitmq connection with %s", address))
+			continue
+		}
+
+		c.hosts.connected(address)
+		c.mu.Lock()
+		c.connection = conn
+		c.address = address
+		c.isConnected = true
+		c.mu.Unlock()
+
+		//https://stackoverflow.com/questions/41991926/how-to-detect-dead-rabbitmq-connection
+		notifyClose := conn.NotifyClose(make(chan *amqp091.Error, 1))
+
+		go func() {
+			//Listen to NotifyClose, the channel is closed without an error when the connection is closed gracefully
+			closeErr := <-notifyClose
+			c.mu.Lock()
+			c.isConnected = false
+			c.mu.Unlock()
+			if closeErr == nil {
+				return
+			}
+			c.errConnectionChan <- errors.Errorf("Connection Closed: %s", closeErr.Error())
+		}()
 
-	c.Connection = conn
-	c.isConnected = true
+		return nil
+	}
 
-	//https://stackoverflow.com/questions/41991926/how-to-detect-dead-rabbitmq-connection
-	notifyClose := c.Connection.NotifyClose(make(chan *amqp091.Error))
+	return errors.Combine(errs...)
+}
 
-	go func() {
-		<-notifyClose //Listen to NotifyClose
-		c.isConnected = false
-		c.errConnectionChan <- errors.New("Connection Closed")
-	}()
+func (c *internalConnection) dial(address string) (*amqp091.Connection, error) {
+	amqpConfig := c.amqpConfig
+	if amqpConfig.TLSClientConfig != nil {
+		// DialConfig sets the server name of the tls config to the node host when it is empty, so each node needs its own tls config
+		amqpConfig.TLSClientConfig = amqpConfig.TLSClientConfig.Clone()
+	}
 
-	return nil
+	return amqp091.DialConfig(c.cfg.RabbitMqHostOptions.EndPointOf(address), amqpConfig)
 }
 
 func (c *internalConnection) handleReconnecting(ctx context.Context) {
@@ -119,14 +189,10 @@ func (c *internalConnection) handleReconnecting(ctx context.Context) {
 		select {
 		case err := <-c.errConnectionChan:
 			if err != nil {
-				defaultLogger.Logger.Info("Rabbitmq Connection Reconnecting started")
-				err := c.connect()
-				if err != nil {
-					continue
+				defaultLogger.Logger.Infof("Rabbitmq Connection Reconnecting started: %v", err)
+				if !c.reconnect(ctx) {
+					return
 				}
-				defaultLogger.Logger.Info("Rabbitmq Connection Reconnected")
-				c.isConnected = true
-				c.reconnectedChan <- struct{}{}
 				continue
 			}
 		case <-ctx.Done():
@@ -135,3 +201,33 @@ func (c *internalConnection) handleReconnecting(ctx context.Context) {
 		}
 	}
 }
+
+// reconnect retries connecting to the nodes until it is connected or the ctx is done
+func (c *internalConnection) reconnect(ctx context.Context) bool {
+	reconnectDelay := c.cfg.RabbitMqHostOptions.ReconnectDelay
+	if reconnectDelay == 0 {
+		reconnectDelay = defaultReconnectDelay
+	}
+
+	for {
+		err := c.connect()
+		if err == nil {
+			break
+		}
+		defaultLogger.Logger.Errorf("Rabbitmq Connection Reconnecting failed, retrying in %s: %v", reconnectDelay, err)
+
+		select {
+		case <-time.After(reconnectDelay):
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	c.mu.Lock()
+	defaultLogger.Logger.Infof("Rabbitmq Connection Reconnected to %s", c.address)
+	close(c.reconnectedChan)
+	c.reconnectedChan = make(chan struct{})
+	c.mu.Unlock()
+
+	return true
+}
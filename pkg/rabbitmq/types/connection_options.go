@@ -0,0 +1,137 @@
+package types
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/config"
+	"github.com/rabbitmq/amqp091-go"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	defaultHeartbeat      = 10 * time.Second
+	defaultReconnectDelay = 5 * time.Second
+	defaultLocale         = "en_US"
+	defaultVirtualHost    = "/"
+)
+
+// newAmqpConfig creates the dial config of the connection, the credentials and the virtual host are set explicitly instead of parsing them from the uri
+func newAmqpConfig(hostOptions *config.RabbitMqHostOptions) (amqp091.Config, error) {
+	amqpConfig := amqp091.Config{
+		Vhost:      hostOptions.VirtualHost,
+		Heartbeat:  hostOptions.Heartbeat,
+		Locale:     defaultLocale,
+		Properties: amqp091.NewConnectionProperties(),
+	}
+
+	if amqpConfig.Vhost == "" {
+		amqpConfig.Vhost = defaultVirtualHost
+	}
+	if amqpConfig.Heartbeat == 0 {
+		amqpConfig.Heartbeat = defaultHeartbeat
+	}
+	if hostOptions.UserName != "" {
+		amqpConfig.SASL = []amqp091.Authentication{&amqp091.PlainAuth{Username: hostOptions.UserName, Password: hostOptions.Password}}
+	}
+	if hostOptions.ConnectionName != "" {
+		amqpConfig.Properties.SetClientConnectionName(hostOptions.ConnectionName)
+	}
+
+	if hostOptions.TlsEnabled() {
+		tlsConfig, err := newTlsConfig(hostOptions.Tls)
+		if err != nil {
+			return amqp091.Config{}, err
+		}
+		amqpConfig.TLSClientConfig = tlsConfig
+	}
+
+	return amqpConfig, nil
+}
+
+func newTlsConfig(tlsOptions *config.RabbitMqTlsOptions) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         tlsOptions.ServerName,
+		InsecureSkipVerify: tlsOptions.InsecureSkipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	if tlsOptions.CaCertFile != "" {
+		caCert, err := os.ReadFile(tlsOptions.CaCertFile)
+		if err != nil {
+			return nil, errors.WrapIf(err, "error in reading rabbitmq ca certificate")
+		}
+
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caCert) {
+			return nil, errors.Errorf("no pem certificate found in rabbitmq ca certificate file %s", tlsOptions.CaCertFile)
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	if tlsOptions.ClientCertFile != "" || tlsOptions.ClientKeyFile != "" {
+		clientCert, err := tls.LoadX509KeyPair(tlsOptions.ClientCertFile, tlsOptions.ClientKeyFile)
+		if err != nil {
+			return nil, errors.WrapIf(err, "error in loading rabbitmq client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return tlsConfig, nil
+}
+
+// hostSelector orders the cluster nodes for connecting, with round-robin the node after the last connected node is tried first
+// so a failed node is tried last on failover.
+type hostSelector struct {
+	mu        sync.Mutex
+	strategy  string
+	addresses []string
+	next      int
+	random    *rand.Rand
+}
+
+func newHostSelector(hostOptions *config.RabbitMqHostOptions) (*hostSelector, error) {
+	strategy := hostOptions.FailoverStrategy
+	if strategy == "" {
+		strategy = config.RoundRobinFailover
+	}
+	if strategy != config.RoundRobinFailover && strategy != config.RandomFailover {
+		return nil, errors.Errorf("rabbitmq failover strategy %s is not supported", strategy)
+	}
+
+	return &hostSelector{strategy: strategy, addresses: hostOptions.Addresses(), random: rand.New(rand.NewSource(time.Now().UnixNano()))}, nil
+}
+
+func (s *hostSelector) order() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ordered := make([]string, 0, len(s.addresses))
+	if s.strategy == config.RandomFailover {
+		for _, i := range s.random.Perm(len(s.addresses)) {
+			ordered = append(ordered, s.addresses[i])
+		}
+		return ordered
+	}
+
+	for i := range s.addresses {
+		ordered = append(ordered, s.addresses[(s.next+i)%len(s.addresses)])
+	}
+
+	return ordered
+}
+
+func (s *hostSelector) connected(address string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, a := range s.addresses {
+		if a == address {
+			s.next = (i + 1) % len(s.addresses)
+			return
+		}
+	}
+}
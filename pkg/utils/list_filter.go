@@ -0,0 +1,191 @@
+package utils
+
+import (
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	EqualComparison       = "eq"
+	NotEqualComparison    = "neq"
+	GreaterThanComparison = "gt"
+	LessThanComparison    = "lt"
+	ContainsComparison    = "contains"
+	InComparison          = "in"
+	BetweenComparison     = "between"
+)
+
+type FieldType int
+
+const (
+	StringField FieldType = iota
+	NumberField
+	DateField
+	BoolField
+)
+
+var fieldComparisons = map[FieldType][]string{
+	StringField: {EqualComparison, NotEqualComparison, ContainsComparison, InComparison},
+	NumberField: {EqualComparison, NotEqualComparison, GreaterThanComparison, LessThanComparison, InComparison, BetweenComparison},
+	DateField:   {EqualComparison, NotEqualComparison, GreaterThanComparison, LessThanComparison, BetweenComparison},
+	BoolField:   {EqualComparison, NotEqualComparison},
+}
+
+// QueryField is a field which the list queries can filter and sort by, Name is the name of the field in the storage
+type QueryField struct {
+	Name     string
+	Type     FieldType
+	Sortable bool
+}
+
+// QueryFields is the whitelist of the list query fields, keyed by the field name of the api
+type QueryFields map[string]QueryField
+
+// FilterCondition is a validated filter with the storage field name and the values parsed by the field type,
+// `between` has two values and `in` has one or more values
+type FilterCondition struct {
+	Field      string
+	Comparison string
+	Values     []interface{}
+}
+
+type SortField struct {
+	Field      string
+	Descending bool
+}
+
+// ListFilter is the filters and the order by of a list query translated into the storage fields
+type ListFilter struct {
+	Conditions []*FilterCondition
+	Sorts      []*SortField
+}
+
+// Validate checks the filters and the order by of the query against the whitelisted fields and keeps their translation for the paginate functions
+func (q *ListQuery) Validate(fields QueryFields) error {
+	listFilter := &ListFilter{}
+
+	for _, filter := range q.Filters {
+		condition, err := newFilterCondition(filter, fields)
+		if err != nil {
+			return err
+		}
+		listFilter.Conditions = append(listFilter.Conditions, condition)
+	}
+
+	sorts, err := newSortFields(q.OrderBy, fields)
+	if err != nil {
+		return err
+	}
+	listFilter.Sorts = sorts
+
+	q.listFilter = listFilter
+
+	return nil
+}
+
+// GetListFilter returns the translated filters of the query, the filters and the order by of a query which is not validated are never sent to the storage
+func (q *ListQuery) GetListFilter() (*ListFilter, error) {
+	if q.listFilter != nil {
+		return q.listFilter, nil
+	}
+
+	if len(q.Filters) > 0 || q.OrderBy != "" {
+		return nil, customErrors.NewValidationError("filters and orderBy of the list query are not validated against the query fields")
+	}
+
+	return &ListFilter{}, nil
+}
+
+func newFilterCondition(filter *FilterModel, fields QueryFields) (*FilterCondition, error) {
+	field, ok := fields[filter.Field]
+	if !ok {
+		return nil, customErrors.NewValidationError(fmt.Sprintf("filtering by field '%s' is not supported", filter.Field))
+	}
+
+	if !supportsComparison(field.Type, filter.Comparison) {
+		return nil, customErrors.NewValidationError(fmt.Sprintf("comparison '%s' is not supported for field '%s'", filter.Comparison, filter.Field))
+	}
+
+	rawValues := []string{filter.Value}
+	if filter.Comparison == InComparison || filter.Comparison == BetweenComparison {
+		rawValues = strings.Split(filter.Value, ",")
+	}
+	if filter.Comparison == BetweenComparison && len(rawValues) != 2 {
+		return nil, customErrors.NewValidationError(fmt.Sprintf("comparison 'between' for field '%s' needs two comma separated values", filter.Field))
+	}
+
+	values := make([]interface{}, 0, len(rawValues))
+	for _, rawValue := range rawValues {
+		value, err := parseFilterValue(field.Type, strings.TrimSpace(rawValue))
+		if err != nil {
+			return nil, customErrors.NewValidationError(fmt.Sprintf("value '%s' is invalid for field '%s'", rawValue, filter.Field))
+		}
+		values = append(values, value)
+	}
+
+	return &FilterCondition{Field: field.Name, Comparison: filter.Comparison, Values: values}, nil
+}
+
+// newSortFields parses the order by in the `field [asc|desc], ...` form
+func newSortFields(orderBy string, fields QueryFields) ([]*SortField, error) {
+	var sorts []*SortField
+
+	for _, part := range strings.Split(orderBy, ",") {
+		terms := strings.Fields(part)
+		if len(terms) == 0 {
+			continue
+		}
+
+		field, ok := fields[terms[0]]
+		if !ok || !field.Sortable {
+			return nil, customErrors.NewValidationError(fmt.Sprintf("sorting by field '%s' is not supported", terms[0]))
+		}
+
+		sort := &SortField{Field: field.Name}
+		if len(terms) > 1 {
+			switch strings.ToLower(terms[1]) {
+			case "asc":
+			case "desc":
+				sort.Descending = true
+			default:
+				return nil, customErrors.NewValidationError(fmt.Sprintf("sort direction '%s' is invalid, it should be asc or desc", terms[1]))
+			}
+		}
+		if len(terms) > 2 {
+			return nil, customErrors.NewValidationError(fmt.Sprintf("order by '%s' is invalid", part))
+		}
+
+		sorts = append(sorts, sort)
+	}
+
+	return sorts, nil
+}
+
+func supportsComparison(fieldType FieldType, comparison string) bool {
+	for _, c := range fieldComparisons[fieldType] {
+		if c == comparison {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseFilterValue(fieldType FieldType, value string) (interface{}, error) {
+	switch fieldType {
+	case NumberField:
+		return strconv.ParseFloat(value, 64)
+	case DateField:
+		if date, err := time.Parse(time.RFC3339, value); err == nil {
+			return date, nil
+		}
+		return time.Parse("2006-01-02", value)
+	case BoolField:
+		return strconv.ParseBool(value)
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var testQueryFields = QueryFields{
+	"status":     {Name: "status", Type: StringField},
+	"totalPrice": {Name: "total_price", Type: NumberField, Sortable: true},
+	"createdAt":  {Name: "created_at", Type: DateField, Sortable: true},
+}
+
+func Test_Validate_List_Query(t *testing.T) {
+	q := NewListQuery(10, 1)
+	q.Filters = []*FilterModel{
+		{Field: "status", Comparison: InComparison, Value: "paid, completed"},
+		{Field: "totalPrice", Comparison: GreaterThanComparison, Value: "100"},
+		{Field: "createdAt", Comparison: BetweenComparison, Value: "2022-01-01,2022-02-01T10:00:00Z"},
+	}
+	q.OrderBy = "createdAt desc, totalPrice"
+
+	require.NoError(t, q.Validate(testQueryFields))
+
+	listFilter, err := q.GetListFilter()
+	require.NoError(t, err)
+
+	assert.Equal(t, []*FilterCondition{
+		{Field: "status", Comparison: InComparison, Values: []interface{}{"paid", "completed"}},
+		{Field: "total_price", Comparison: GreaterThanComparison, Values: []interface{}{100.0}},
+		{Field: "created_at", Comparison: BetweenComparison, Values: []interface{}{
+			time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2022, 2, 1, 10, 0, 0, 0, time.UTC),
+		}},
+	}, listFilter.Conditions)
+	assert.Equal(t, []*SortField{{Field: "created_at", Descending: true}, {Field: "total_price"}}, listFilter.Sorts)
+}
+
+func Test_Validate_List_Query_Invalid(t *testing.T) {
+	tests := map[string]*ListQuery{
+		"not whitelisted field":  {Filters: []*FilterModel{{Field: "password", Comparison: EqualComparison, Value: "x"}}},
+		"unsupported comparison": {Filters: []*FilterModel{{Field: "status", Comparison: GreaterThanComparison, Value: "x"}}},
+		"invalid number":         {Filters: []*FilterModel{{Field: "totalPrice", Comparison: EqualComparison, Value: "abc"}}},
+		"between one value":      {Filters: []*FilterModel{{Field: "totalPrice", Comparison: BetweenComparison, Value: "1"}}},
+		"invalid date":           {Filters: []*FilterModel{{Field: "createdAt", Comparison: GreaterThanComparison, Value: "yesterday"}}},
+		"not sortable field":     {OrderBy: "status"},
+		"invalid sort direction": {OrderBy: "totalPrice up"},
+	}
+
+	for name, q := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := q.Validate(testQueryFields)
+			assert.True(t, customErrors.IsValidationError(err))
+		})
+	}
+}
+
+func Test_Get_List_Filter_Without_Validate(t *testing.T) {
+	q := NewListQuery(10, 1)
+
+	listFilter, err := q.GetListFilter()
+	require.NoError(t, err)
+	assert.Empty(t, listFilter.Conditions)
+
+	q.OrderBy = "totalPrice"
+	_, err = q.GetListFilter()
+	assert.True(t, customErrors.IsValidationError(err))
+}
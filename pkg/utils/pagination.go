@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/serializer/jsonSerializer"
 	"math"
@@ -49,10 +50,13 @@ type FilterModel struct {
 }
 
 type ListQuery struct {
-	Size    int            `query:"size" json:"size,omitempty"`
-	Page    int            `query:"page" json:"page,omitempty"`
-	OrderBy string         `query:"orderBy" json:"orderBy,omitempty"`
-	Filters []*FilterModel `query:"filters" json:"filters,omitempty"`
+	Size    int    `query:"size" json:"size,omitempty"`
+	Page    int    `query:"page" json:"page,omitempty"`
+	OrderBy string `query:"orderBy" json:"orderBy,omitempty"`
+	// Filters are bound by GetListQueryFromCtx, echo can't bind the json filters of the query string
+	Filters []*FilterModel `json:"filters,omitempty"`
+	// listFilter is set by Validate
+	listFilter *ListFilter
 }
 
 func NewListQuery(size int, page int) *ListQuery {
@@ -79,13 +83,14 @@ func GetListQueryFromCtx(c echo.Context) (*ListQuery, error) {
 
 	//https://echo.labstack.com/guide/binding/#fast-binding-with-dedicated-helpers
 	err := echo.QueryParamsBinder(c).
+		// each filters value is a json filter, e.g. filters={"field":"totalPrice","comparison":"gt","value":"100"}
 		CustomFunc("filters", func(values []string) []error {
 			for _, v := range values {
 				if v == "" {
 					continue
 				}
 				f := &FilterModel{}
-				if err := c.Bind(f); err != nil {
+				if err := json.Unmarshal([]byte(v), f); err != nil {
 					return []error{err}
 				}
 				q.Filters = append(q.Filters, f)
@@ -96,6 +101,9 @@ func GetListQueryFromCtx(c echo.Context) (*ListQuery, error) {
 		String("page", &page).
 		String("orderBy", &orderBy).
 		BindError() // returns first binding error
+	if err != nil {
+		return nil, err
+	}
 
 	if err = q.SetPage(page); err != nil {
 		return nil, err
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/constants"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/topology"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/configurations/consumers"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/shared/configurations/infrastructure"
+	"log"
+)
+
+var format string
+
+func init() {
+	flag.StringVar(&format, "format", topology.YamlFormat, "topology output format: json, yaml or mermaid")
+}
+
+// dumps the effective rabbitmq topology of the service, the declared topology in the config merged with the consumers declarations, without connecting to the broker
+func main() {
+	flag.Parse()
+
+	env := core.ConfigAppEnv(constants.Dev)
+
+	cfg, err := config.InitConfig(env)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	infra := &infrastructure.InfrastructureConfigurations{Cfg: cfg, Log: defaultLogger.Logger, EventSerializer: json.NewJsonEventSerializer(), Metrics: &infrastructure.CatalogsServiceMetrics{}, Consumers: make([]consumer.Consumer, 0)}
+	err = consumers.ConfigConsumers(infra)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = topology.Validate(cfg.RabbitMQ.Topology, infra.Consumers, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	dump, err := topology.Dump(topology.EffectiveTopology(cfg.RabbitMQ.Topology, infra.Consumers), format)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(string(dump))
+}
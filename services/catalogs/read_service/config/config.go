@@ -52,7 +52,8 @@ type Context struct {
 }
 
 type MongoCollections struct {
-	Products string `mapstructure:"products" validate:"required" env:"Products"`
+	Products       string `mapstructure:"products" validate:"required" env:"Products"`
+	PoisonMessages string `mapstructure:"poisonMessages" validate:"required" env:"PoisonMessages"`
 }
 
 type ElasticIndexes struct {
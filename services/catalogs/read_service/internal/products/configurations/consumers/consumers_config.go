@@ -1,8 +1,10 @@
 package consumers
 
 import (
+	rabbitmqBus "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/bus"
 	rabbitmqConsumer "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/consumer"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/consumer/options"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/consts"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/delivery"
 	creatingProductIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/features/creating_product/events/integration/external/v1"
 	deletingProductIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/features/deleting_products/events/integration/external/v1"
@@ -16,38 +18,41 @@ func ConfigConsumers(infra *infrastructure.InfrastructureConfigurations) error {
 	//add custom message type mappings
 	//utils.RegisterCustomMessageTypesToRegistrty(map[string]types.IMessage{"productCreatedV1": &creatingProductIntegration.ProductCreatedV1{}})
 
-	productCreatedConsumer, err := rabbitmqConsumer.NewRabbitMQConsumer[*creatingProductIntegration.ProductCreatedV1](
+	// all product events are consumed from a single queue with one handling thread, so they will be processed in the publishing order
+	productsConsumer, err := rabbitmqConsumer.NewRabbitMQMultiTypeConsumer(
 		infra.RabbitMQConnection,
-		func(builder *options.RabbitMQConsumerOptionsBuilder[*creatingProductIntegration.ProductCreatedV1]) {},
+		consts.ProductsQueue,
+		func(builder *options.RabbitMQMultiTypeConsumerOptionsBuilder) {
+			// queue arguments should be the same as the declared topology, otherwise the broker rejects the declaration
+			builder.WithQueueArgs(infra.Cfg.RabbitMQ.Topology.QueueArguments(consts.ProductsQueue))
+			builder.WithPayloadOptions(infra.PayloadOptions)
+			// undeserializable deliveries are kept in the poison message store instead of being redelivered forever
+			builder.WithPoisonMessageHandler(infra.PoisonMessageHandler)
+			builder.WithMetrics(infra.Metrics.RabbitMQ)
+			builder.WithMessageRegistry(infra.MessageRegistry)
+		},
 		infra.EventSerializer,
-		infra.Log,
-		creatingProductIntegration.NewProductCreatedConsumer(consumerBase))
+		infra.Log)
 	if err != nil {
 		return err
 	}
-	infra.Consumers = append(infra.Consumers, productCreatedConsumer)
 
-	productDeletedConsumer, err := rabbitmqConsumer.NewRabbitMQConsumer[*deletingProductIntegration.ProductDeletedV1](
-		infra.RabbitMQConnection,
-		func(builder *options.RabbitMQConsumerOptionsBuilder[*deletingProductIntegration.ProductDeletedV1]) {},
-		infra.EventSerializer,
-		infra.Log,
-		deletingProductIntegration.NewProductDeletedConsumer(consumerBase))
+	err = rabbitmqConsumer.AddHandler[*creatingProductIntegration.ProductCreatedV1](productsConsumer, creatingProductIntegration.NewProductCreatedConsumer(consumerBase), nil)
 	if err != nil {
 		return err
 	}
-	infra.Consumers = append(infra.Consumers, productDeletedConsumer)
 
-	productUpdatedConsumer, err := rabbitmqConsumer.NewRabbitMQConsumer[*updatingProductIntegration.ProductUpdatedV1](
-		infra.RabbitMQConnection,
-		func(builder *options.RabbitMQConsumerOptionsBuilder[*updatingProductIntegration.ProductUpdatedV1]) {},
-		infra.EventSerializer,
-		infra.Log,
-		updatingProductIntegration.NewProductUpdatedConsumer(consumerBase))
+	err = rabbitmqConsumer.AddHandler[*updatingProductIntegration.ProductUpdatedV1](productsConsumer, updatingProductIntegration.NewProductUpdatedConsumer(consumerBase), nil)
+	if err != nil {
+		return err
+	}
+
+	err = rabbitmqConsumer.AddHandler[*deletingProductIntegration.ProductDeletedV1](productsConsumer, deletingProductIntegration.NewProductDeletedConsumer(consumerBase), nil)
 	if err != nil {
 		return err
 	}
-	infra.Consumers = append(infra.Consumers, productUpdatedConsumer)
+	infra.Consumers = append(infra.Consumers, productsConsumer)
+	infra.Bus = rabbitmqBus.NewRabbitMQBus(infra.Log, infra.Consumers)
 
 	return nil
 }
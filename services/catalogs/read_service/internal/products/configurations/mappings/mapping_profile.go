@@ -2,8 +2,10 @@ package mappings
 
 import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	productsService "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/contracts/proto/service_clients"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/dto"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ConfigureMappings() error {
@@ -17,5 +19,23 @@ func ConfigureMappings() error {
 		return err
 	}
 
+	// the read id is returned as the product id, it is the id which GetProductById looks up
+	err = mapper.CreateCustomMap[*dto.ProductDto, *productsService.Product](func(product *dto.ProductDto) *productsService.Product {
+		if product == nil {
+			return nil
+		}
+		return &productsService.Product{
+			ProductId:   product.Id,
+			Name:        product.Name,
+			Description: product.Description,
+			Price:       &productsService.Money{Amount: product.Price.Amount.String(), Currency: product.Price.Currency.String()},
+			CreatedAt:   timestamppb.New(product.CreatedAt),
+			UpdatedAt:   timestamppb.New(product.UpdatedAt),
+		}
+	})
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,24 @@
+package messages
+
+import (
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	createdIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/features/creating_product/events/integration/external/v1"
+	deletedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/features/deleting_products/events/integration/external/v1"
+	updatedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/features/updating_products/events/integration/external/v1"
+)
+
+// ConfigMessageContracts registers the wire names of the product integration events, they should be the same as the catalogs write service contracts
+func ConfigMessageContracts(registry *messageRegistry.MessageRegistry) error {
+	// the go type names are kept as aliases for the messages which are published before the contracts
+	err := messageRegistry.Register[*createdIntegration.ProductCreatedV1](registry, "catalogs.product_created", 1, "ProductCreatedV1")
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*updatedIntegration.ProductUpdatedV1](registry, "catalogs.product_updated", 1, "ProductUpdatedV1")
+	if err != nil {
+		return err
+	}
+
+	return messageRegistry.Register[*deletedIntegration.ProductDeletedV1](registry, "catalogs.product_deleted", 1, "ProductDeletedV1")
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"github.com/labstack/echo/v4"
 	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/bus"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/poison"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/delivery"
 	gettingProductByIdV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/features/get_product_by_id/endpoints/v1"
 	gettingProductsV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/features/getting_products/endpoints/v1"
@@ -34,5 +36,11 @@ func configV1Endpoints(echoServer customEcho.EchoHttpServer, infra *infrastructu
 		// GetProductById
 		getProductByIdEndpoint := gettingProductByIdV1.NewGetProductByIdEndpoint(productEndpointBase)
 		getProductByIdEndpoint.MapRoute()
+
+		// PoisonMessages
+		poison.MapPoisonMessageRoutes(v1.Group("/poison-messages"), infra.PoisonMessageReplayer)
+
+		// Consumers admin, pausing the consumers keeps the products events in the queue for example during a database maintenance
+		bus.MapBusRoutes(v1.Group("/admin/consumers"), infra.Bus)
 	})
 }
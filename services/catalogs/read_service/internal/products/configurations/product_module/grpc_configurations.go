@@ -2,9 +2,12 @@ package product_module
 
 import (
 	"context"
-	"fmt"
+
+	productsService "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/contracts/proto/service_clients"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/delivery/grpc"
 )
 
 func (c *productsModuleConfigurator) configGrpc(ctx context.Context) {
-	fmt.Print(c)
+	productGrpcService := grpc.NewProductGrpcService(c.InfrastructureConfigurations)
+	productsService.RegisterProductsServiceServer(c.grpcServer.GetCurrentGrpcServer(), productGrpcService)
 }
@@ -4,9 +4,11 @@ import (
 	"context"
 	grpcServer "github.com/mehdihadeli/store-golang-microservice-sample/pkg/grpc"
 	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/topology"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/configurations/consumers"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/configurations/mappings"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/configurations/mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/configurations/messages"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/contracts"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/shared/configurations/infrastructure"
 )
@@ -27,6 +29,12 @@ func (c *productsModuleConfigurator) ConfigureProductsModule(ctx context.Context
 		return err
 	}
 
+	// conflicting message contracts fail the startup
+	err = messages.ConfigMessageContracts(c.MessageRegistry)
+	if err != nil {
+		return err
+	}
+
 	err = mediatr.ConfigProductsMediator(c.InfrastructureConfigurations)
 	if err != nil {
 		return err
@@ -37,6 +45,11 @@ func (c *productsModuleConfigurator) ConfigureProductsModule(ctx context.Context
 		return err
 	}
 
+	err = topology.ConfigureTopology(c.RabbitMQConnection, c.Cfg.RabbitMQ.Topology, c.Consumers, nil)
+	if err != nil {
+		return err
+	}
+
 	if c.Cfg.DeliveryType == "grpc" {
 		c.configGrpc(ctx)
 	} else {
@@ -3,4 +3,5 @@ package consts
 const (
 	ProductIdIndex = "productId"
 	ProductId      = "productId"
+	ProductsQueue  = "catalogs_read_service_products"
 )
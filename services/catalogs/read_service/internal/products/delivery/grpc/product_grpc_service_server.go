@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/grpc/grpcErrors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	productsService "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/contracts/proto/service_clients"
+	gettingProductByIdDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/features/get_product_by_id/dtos"
+	gettingProductByIdV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/features/get_product_by_id/queries/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/shared/configurations/infrastructure"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ProductGrpcServiceServer struct {
+	*infrastructure.InfrastructureConfigurations
+	// the products are created and updated by the write service, the read service only serves the queries
+	productsService.UnimplementedProductsServiceServer
+}
+
+func NewProductGrpcService(infra *infrastructure.InfrastructureConfigurations) *ProductGrpcServiceServer {
+	return &ProductGrpcServiceServer{InfrastructureConfigurations: infra}
+}
+
+func (s *ProductGrpcServiceServer) GetProductById(ctx context.Context, req *productsService.GetProductByIdReq) (*productsService.GetProductByIdRes, error) {
+	s.Metrics.GetProductByIdGrpcRequests.Inc()
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "ProductGrpcServiceServer.GetProductById")
+	span.LogFields(log.Object("Request", req))
+	defer span.Finish()
+
+	productUUID, err := uuid.FromString(req.GetProductId())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[ProductGrpcServiceServer_GetProductById.uuid.FromString] error in converting uuid")
+		s.Log.Errorf(fmt.Sprintf("[ProductGrpcServiceServer_GetProductById.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	query := &gettingProductByIdV1.GetProductById{Id: productUUID}
+	if err := s.Validator.StructCtx(ctx, query); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[ProductGrpcServiceServer_GetProductById.StructCtx] query validation failed")
+		s.Log.Errorf(fmt.Sprintf("[ProductGrpcServiceServer_GetProductById.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	queryResult, err := mediatr.Send[*gettingProductByIdV1.GetProductById, *gettingProductByIdDtos.GetProductByIdResponseDto](ctx, query)
+	if err != nil {
+		err = errors.WithMessage(err, "[ProductGrpcServiceServer_GetProductById.Send] error in sending GetProductById")
+		s.Log.Errorw(fmt.Sprintf("[ProductGrpcServiceServer_GetProductById.Send] id: {%s}, err: %v", query.Id, tracing.TraceWithErr(span, err)), logger.Fields{"Id": query.Id})
+		return nil, grpcErrors.ErrGrpcResponse(err)
+	}
+
+	product, err := mapper.Map[*productsService.Product](queryResult.Product)
+	if err != nil {
+		err = errors.WithMessage(err, "[ProductGrpcServiceServer_GetProductById.Map] error in mapping product")
+		return nil, grpcErrors.ErrGrpcResponse(tracing.TraceWithErr(span, err))
+	}
+
+	s.Metrics.SuccessGrpcRequests.Inc()
+
+	return &productsService.GetProductByIdRes{Product: product}, nil
+}
@@ -1,15 +1,16 @@
 package dto
 
 import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"time"
 )
 
 type ProductDto struct {
-	Id          string    `json:"id"`
-	ProductId   string    `json:"productId"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Price       float64   `json:"price"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	Id          string       `json:"id"`
+	ProductId   string       `json:"productId"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Price       domain.Money `json:"price"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
 }
@@ -1,15 +1,18 @@
 package v1
 
-import "time"
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"time"
+)
 
 type CreateProduct struct {
-	ProductId   string    `validate:"required"`
-	Name        string    `validate:"required,min=3,max=250"`
-	Description string    `validate:"required,min=3,max=500"`
-	Price       float64   `validate:"required"`
+	ProductId   string `validate:"required"`
+	Name        string `validate:"required,min=3,max=250"`
+	Description string `validate:"required,min=3,max=500"`
+	Price       domain.Money
 	CreatedAt   time.Time `validate:"required"`
 }
 
-func NewCreateProduct(productId string, name string, description string, price float64, createdAt time.Time) *CreateProduct {
+func NewCreateProduct(productId string, name string, description string, price domain.Money, createdAt time.Time) *CreateProduct {
 	return &CreateProduct{ProductId: productId, Name: name, Description: description, Price: price, CreatedAt: createdAt}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/features/creating_product"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/shared/test_fixture/integration"
@@ -24,7 +25,8 @@ func Test_Create_Product_Command_Handler(t *testing.T) {
 	fixture.Run()
 	defer fixture.Cleanup()
 
-	command := NewCreateProduct(gofakeit.UUID(), gofakeit.Name(), gofakeit.AdjectiveDescriptive(), gofakeit.Price(150, 6000), time.Now())
+	price, _ := domain.NewMoneyFromFloat(gofakeit.Price(150, 6000), domain.USD)
+	command := NewCreateProduct(gofakeit.UUID(), gofakeit.Name(), gofakeit.AdjectiveDescriptive(), price, time.Now())
 	result, err := mediatr.Send[*CreateProduct, *creating_product.CreateProductResponseDto](context.Background(), command)
 
 	assert.NotNil(t, result)
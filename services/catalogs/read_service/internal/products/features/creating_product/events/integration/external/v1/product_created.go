@@ -1,15 +1,16 @@
 package v1
 
 import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
 	"time"
 )
 
 type ProductCreatedV1 struct {
 	*types.Message
-	ProductId   string    `json:"productId,omitempty"`
-	Name        string    `json:"name,omitempty"`
-	Description string    `json:"description,omitempty"`
-	Price       float64   `json:"price,omitempty"`
-	CreatedAt   time.Time `json:"createdAt"`
+	ProductId   string       `json:"productId,omitempty"`
+	Name        string       `json:"name,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Price       domain.Money `json:"price"`
+	CreatedAt   time.Time    `json:"createdAt"`
 }
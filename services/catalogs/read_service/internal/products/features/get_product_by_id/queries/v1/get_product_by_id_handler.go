@@ -46,6 +46,9 @@ func (q *GetProductByIdHandler) Handle(ctx context.Context, query *GetProductByI
 		if err != nil {
 			return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, fmt.Sprintf("[GetProductByIdHandler_Handle.GetProduct] error in getting product with id %d in the mongo repository", query.Id)))
 		}
+		if mongoProduct == nil {
+			return nil, tracing.TraceWithErr(span, customErrors.NewNotFoundError(fmt.Sprintf("[GetProductByIdHandler_Handle.GetProduct] product with id %s not found", query.Id)))
+		}
 		product = mongoProduct
 		err = q.redisRepository.PutProduct(ctx, product.ProductId, product)
 		if err != nil {
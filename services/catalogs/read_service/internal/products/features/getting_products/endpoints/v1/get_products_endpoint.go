@@ -45,7 +45,7 @@ func (ep *getProductsEndpoint) handler() echo.HandlerFunc {
 		if err != nil {
 			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[getProductsEndpoint_handler.GetListQueryFromCtx] error in getting data from query string")
 			ep.Log.Errorf(fmt.Sprintf("[getProductsEndpoint_handler.GetListQueryFromCtx] err: %v", tracing.TraceWithErr(span, badRequestErr)))
-			return err
+			return badRequestErr
 		}
 
 		request := &dtos.GetProductsRequestDto{ListQuery: listQuery}
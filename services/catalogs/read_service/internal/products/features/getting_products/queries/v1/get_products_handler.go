@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"emperror.dev/errors"
 	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
@@ -10,6 +11,7 @@ import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/contracts"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/dto"
 	gettingProductsDto "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/features/getting_products/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/models"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/log"
 )
@@ -29,6 +31,11 @@ func (c *GetProductsHandler) Handle(ctx context.Context, query *GetProducts) (*g
 	span.LogFields(log.Object("Query", query))
 	defer span.Finish()
 
+	// an invalid filter or order by keeps the status of its validation error
+	if err := query.ListQuery.Validate(models.ProductQueryFields); err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[GetProductsHandler_Handle.Validate] error in validating the list query"))
+	}
+
 	products, err := c.mongoRepository.GetAllProducts(ctx, query.ListQuery)
 	if err != nil {
 		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetProductsHandler_Handle.GetAllProducts] error in getting products in the repository"))
@@ -48,7 +48,7 @@ func (ep *searchProductsEndpoint) handler() echo.HandlerFunc {
 		if err != nil {
 			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[searchProductsEndpoint_handler.GetListQueryFromCtx] error in getting data from query string")
 			ep.Log.Errorf(fmt.Sprintf("[searchProductsEndpoint_handler.GetListQueryFromCtx] err: %v", tracing.TraceWithErr(span, badRequestErr)))
-			return err
+			return badRequestErr
 		}
 
 		request := &dtos.SearchProductsRequestDto{ListQuery: listQuery}
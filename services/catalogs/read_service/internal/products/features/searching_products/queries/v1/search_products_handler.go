@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"emperror.dev/errors"
 	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
@@ -10,6 +11,7 @@ import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/contracts"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/dto"
 	searchingProductsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/features/searching_products/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/models"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/log"
 )
@@ -29,6 +31,10 @@ func (c *SearchProductsHandler) Handle(ctx context.Context, query *SearchProduct
 	span.LogFields(log.Object("Query", query))
 	defer span.Finish()
 
+	if err := query.ListQuery.Validate(models.ProductQueryFields); err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[SearchProductsHandler_Handle.Validate] error in validating the list query"))
+	}
+
 	products, err := c.mongoRepository.SearchProducts(ctx, query.SearchText, query.ListQuery)
 	if err != nil {
 		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[SearchProductsHandler_Handle.SearchProducts] error in searching products in the repository"))
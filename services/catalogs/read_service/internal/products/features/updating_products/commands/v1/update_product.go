@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	uuid "github.com/satori/go.uuid"
 	"time"
 )
@@ -9,10 +10,10 @@ type UpdateProduct struct {
 	ProductId   uuid.UUID `validate:"required"`
 	Name        string    `validate:"required,gte=0,lte=255"`
 	Description string    `validate:"required,gte=0,lte=5000"`
-	Price       float64   `validate:"required,gte=0"`
+	Price       domain.Money
 	UpdatedAt   time.Time `validate:"required"`
 }
 
-func NewUpdateProduct(productId uuid.UUID, name string, description string, price float64) *UpdateProduct {
+func NewUpdateProduct(productId uuid.UUID, name string, description string, price domain.Money) *UpdateProduct {
 	return &UpdateProduct{ProductId: productId, Name: name, Description: description, Price: price, UpdatedAt: time.Now()}
 }
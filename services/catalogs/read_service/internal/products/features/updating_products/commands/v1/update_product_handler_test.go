@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/shared/test_fixture/integration"
 	uuid "github.com/satori/go.uuid"
@@ -27,7 +28,8 @@ func Test_Update_Product_Command_Handler(t *testing.T) {
 	if err != nil {
 		return
 	}
-	command := NewUpdateProduct(productId, gofakeit.Name(), gofakeit.AdjectiveDescriptive(), gofakeit.Price(150, 6000))
+	price, _ := domain.NewMoneyFromFloat(gofakeit.Price(150, 6000), domain.USD)
+	command := NewUpdateProduct(productId, gofakeit.Name(), gofakeit.AdjectiveDescriptive(), price)
 	result, err := mediatr.Send[*UpdateProduct, *mediatr.Unit](context.Background(), command)
 
 	assert.NoError(t, err)
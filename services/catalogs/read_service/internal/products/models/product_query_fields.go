@@ -0,0 +1,10 @@
+package models
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+
+// ProductQueryFields are the fields of the Product document which the product list queries can filter and sort by
+var ProductQueryFields = utils.QueryFields{
+	"name":      {Name: "name", Type: utils.StringField, Sortable: true},
+	"price":     {Name: "price.amount", Type: utils.NumberField, Sortable: true},
+	"createdAt": {Name: "createdAt", Type: utils.DateField, Sortable: true},
+}
@@ -8,8 +8,14 @@ import (
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/msgpack"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/protobuf"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/bus"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/poison"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/producer"
 	rabbitmqProducer "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/producer"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/producer/options"
@@ -28,6 +34,7 @@ type InfrastructureConfigurations struct {
 	RabbitMQConnection types.IConnection
 	Producer           producer.Producer
 	Consumers          []consumer.Consumer
+	Bus                bus.Bus
 	PgConn             *pgxpool.Pool
 	Gorm               *gorm.DB
 	Metrics            *CatalogsServiceMetrics
@@ -37,6 +44,11 @@ type InfrastructureConfigurations struct {
 	Redis              redis.UniversalClient
 	MiddlewareManager  cutomMiddlewares.CustomMiddlewares
 	EventSerializer    serializer.EventSerializer
+	MessageRegistry    *messageRegistry.MessageRegistry
+	PayloadOptions     *payload.PayloadOptions
+	// PoisonMessageHandler keeps the deliveries which couldn't be deserialized, PoisonMessageReplayer replays them after fixing the type mapping
+	PoisonMessageHandler  poison.PoisonMessageHandler
+	PoisonMessageReplayer *poison.PoisonMessageReplayer
 }
 
 type InfrastructureConfigurator interface {
@@ -73,6 +85,12 @@ func (ic *infrastructureConfigurator) ConfigInfrastructures(ctx context.Context)
 	cleanup = append(cleanup, mongoCleanup)
 	infrastructure.MongoClient = mongoClient
 
+	payloadOptions, err := payload.NewPayloadOptions(ic.cfg.RabbitMQ.Payload, mongoClient.Database(ic.cfg.Mongo.Db))
+	if err != nil {
+		return nil, err, nil
+	}
+	infrastructure.PayloadOptions = payloadOptions
+
 	redis, err, redisCleanup := ic.configRedis(ctx)
 	if err != nil {
 		return nil, err, nil
@@ -80,7 +98,8 @@ func (ic *infrastructureConfigurator) ConfigInfrastructures(ctx context.Context)
 	cleanup = append(cleanup, redisCleanup)
 	infrastructure.Redis = redis
 
-	infrastructure.EventSerializer = json.NewJsonEventSerializer()
+	// json is the default content type, the other serializers are negotiated by the message content type
+	infrastructure.EventSerializer = serializer.NewEventSerializerRegistry(json.NewJsonEventSerializer(), protobuf.NewProtobufEventSerializer(), msgpack.NewMsgPackEventSerializer())
 
 	connection, err := types.NewRabbitMQConnection(ctx, ic.cfg.RabbitMQ)
 	if err != nil {
@@ -91,12 +110,21 @@ func (ic *infrastructureConfigurator) ConfigInfrastructures(ctx context.Context)
 		_ = connection.Close()
 	})
 
-	mqProducer, err := rabbitmqProducer.NewRabbitMQProducer(connection, func(builder *options.RabbitMQProducerOptionsBuilder) {}, ic.log, infrastructure.EventSerializer)
+	// message contracts are registered by the modules, the producer stamps their wire names into the message type and the consumers resolve them
+	infrastructure.MessageRegistry = messageRegistry.NewMessageRegistry()
+
+	mqProducer, err := rabbitmqProducer.NewRabbitMQProducer(connection, func(builder *options.RabbitMQProducerOptionsBuilder) {
+		builder.WithMessageRegistry(infrastructure.MessageRegistry)
+	}, ic.log, infrastructure.EventSerializer)
 	if err != nil {
 		return nil, err, nil
 	}
 	infrastructure.Producer = mqProducer
 
+	poisonMessageStore := poison.NewMongoPoisonMessageStore(mongoClient.Database(ic.cfg.Mongo.Db).Collection(ic.cfg.MongoCollections.PoisonMessages))
+	infrastructure.PoisonMessageHandler = poison.NewStorePoisonMessageHandler(poisonMessageStore, metrics.PoisonMessages, ic.log)
+	infrastructure.PoisonMessageReplayer = poison.NewPoisonMessageReplayer(poisonMessageStore, mqProducer, infrastructure.EventSerializer, payloadOptions, metrics.PoisonMessages, ic.log)
+
 	if err != nil {
 		return nil, err, nil
 	}
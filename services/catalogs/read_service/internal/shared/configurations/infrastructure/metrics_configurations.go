@@ -2,6 +2,8 @@ package infrastructure
 
 import (
 	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/poison"
+	rabbitmqMetrics "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/metrics"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -32,6 +34,9 @@ type CatalogsServiceMetrics struct {
 	CreateProductKafkaMessages prometheus.Counter
 	UpdateProductKafkaMessages prometheus.Counter
 	DeleteProductKafkaMessages prometheus.Counter
+
+	PoisonMessages *poison.PoisonMessageMetrics
+	RabbitMQ       *rabbitmqMetrics.RabbitMQMetrics
 }
 
 func (ic *infrastructureConfigurator) configCatalogsMetrics() *CatalogsServiceMetrics {
@@ -118,5 +123,7 @@ func (ic *infrastructureConfigurator) configCatalogsMetrics() *CatalogsServiceMe
 			Name: fmt.Sprintf("%s_error_http_requests_total", cfg.ServiceName),
 			Help: "The total number of error http requests",
 		}),
+		PoisonMessages: poison.NewPoisonMessageMetrics(cfg.ServiceName),
+		RabbitMQ:       rabbitmqMetrics.NewRabbitMQMetrics(cfg.ServiceName),
 	}
 }
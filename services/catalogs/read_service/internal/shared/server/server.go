@@ -79,7 +79,7 @@ func (s *Server) Run() error {
 	}
 
 	backgroundWorkers := webWoker.NewWorkersRunner([]webWoker.Worker{
-		workers.NewRabbitMQWorkerWorker(infrastructureConfigurations), workers.NewMetricsWorker(infrastructureConfigurations),
+		workers.NewRabbitMQWorkerWorker(infrastructureConfigurations), workers.NewMetricsWorker(infrastructureConfigurations), workers.NewRabbitMQMetricsWorker(infrastructureConfigurations), workers.NewBlobRetentionWorker(infrastructureConfigurations),
 	})
 
 	workersErr := backgroundWorkers.Start(ctx)
@@ -6,11 +6,13 @@ import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/constants"
 	grpcServer "github.com/mehdihadeli/store-golang-microservice-sample/pkg/grpc"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/topology"
 	webWoker "github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/config"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/configurations/consumers"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/configurations/mappings"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/configurations/mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/configurations/messages"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/shared/configurations/infrastructure"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/shared/web/workers"
 	"net/http/httptest"
@@ -57,12 +59,24 @@ func NewE2ETestFixture() *E2ETestFixture {
 		return nil
 	}
 
+	err = messages.ConfigMessageContracts(infrastructures.MessageRegistry)
+	if err != nil {
+		cancel()
+		return nil
+	}
+
 	err = consumers.ConfigConsumers(infrastructures)
 	if err != nil {
 		cancel()
 		return nil
 	}
 
+	err = topology.ConfigureTopology(infrastructures.RabbitMQConnection, cfg.RabbitMQ.Topology, infrastructures.Consumers, nil)
+	if err != nil {
+		cancel()
+		return nil
+	}
+
 	grpcServer := grpcServer.NewGrpcServer(cfg.GRPC, defaultLogger.Logger)
 	httpServer := httptest.NewServer(echo)
 
@@ -4,10 +4,12 @@ import (
 	"context"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/constants"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/topology"
 	webWoker "github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/config"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/configurations/consumers"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/configurations/mappings"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/configurations/messages"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/contracts"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/products/data/repositories"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/shared/configurations/infrastructure"
@@ -40,12 +42,24 @@ func NewIntegrationTestFixture() *IntegrationTestFixture {
 		return nil
 	}
 
+	err = messages.ConfigMessageContracts(infrastructures.MessageRegistry)
+	if err != nil {
+		cancel()
+		return nil
+	}
+
 	err = consumers.ConfigConsumers(infrastructures)
 	if err != nil {
 		cancel()
 		return nil
 	}
 
+	err = topology.ConfigureTopology(infrastructures.RabbitMQConnection, cfg.RabbitMQ.Topology, infrastructures.Consumers, nil)
+	if err != nil {
+		cancel()
+		return nil
+	}
+
 	workersRunner := webWoker.NewWorkersRunner([]webWoker.Worker{
 		workers.NewRabbitMQWorkerWorker(infrastructures),
 	})
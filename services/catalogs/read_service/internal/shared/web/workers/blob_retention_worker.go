@@ -0,0 +1,11 @@
+package workers
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/shared/configurations/infrastructure"
+)
+
+func NewBlobRetentionWorker(infra *infrastructure.InfrastructureConfigurations) web.Worker {
+	return payload.NewBlobRetentionWorker(infra.PayloadOptions, infra.Log)
+}
@@ -0,0 +1,11 @@
+package workers
+
+import (
+	rabbitmqMetrics "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/metrics"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/shared/configurations/infrastructure"
+)
+
+func NewRabbitMQMetricsWorker(infra *infrastructure.InfrastructureConfigurations) web.Worker {
+	return rabbitmqMetrics.NewQueueMetricsWorker(infra.RabbitMQConnection, infra.Cfg.RabbitMQ.Topology, infra.Consumers, infra.Metrics.RabbitMQ, infra.Cfg.RabbitMQ.QueueMetricsInterval, infra.Log)
+}
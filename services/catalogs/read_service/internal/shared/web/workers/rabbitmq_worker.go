@@ -2,13 +2,12 @@ package workers
 
 import (
 	"context"
-	rabbitmqBus "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/bus"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/read_service/internal/shared/configurations/infrastructure"
 )
 
 func NewRabbitMQWorkerWorker(infra *infrastructure.InfrastructureConfigurations) web.Worker {
-	rabbitMQBus := rabbitmqBus.NewRabbitMQBus(infra.Log, infra.Consumers)
+	rabbitMQBus := infra.Bus
 
 	return web.NewBackgroundWorker(func(ctx context.Context) error {
 		err := rabbitMQBus.Start(ctx)
@@ -19,6 +19,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"time"
 )
 
 var configPath string
@@ -40,12 +41,19 @@ type Config struct {
 	Probes           probes.Config                  `mapstructure:"probes" envPrefix:"Probes_"`
 	Jaeger           *tracing.Config                `mapstructure:"jaeger" envPrefix:"Jaeger_"`
 	EventStoreConfig eventstroredb.EventStoreConfig `mapstructure:"eventStoreConfig" envPrefix:"EventStoreConfig_"`
+	StockReservation StockReservation               `mapstructure:"stockReservation" envPrefix:"StockReservation_"`
 }
 
 type Context struct {
 	Timeout int `mapstructure:"timeout" env:"Timeout"`
 }
 
+// StockReservation configures how long the stock of a submitted order stays reserved before it is paid
+type StockReservation struct {
+	Ttl                time.Duration `mapstructure:"ttl" env:"Ttl"`
+	ExpiryPollInterval time.Duration `mapstructure:"expiryPollInterval" env:"ExpiryPollInterval"`
+}
+
 func InitConfig(environment string) (*Config, error) {
 	if configPath == "" {
 		configPathFromEnv := os.Getenv(constants.ConfigPath)
@@ -0,0 +1,51 @@
+package consumers
+
+import (
+	rabbitmqConsumer "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/consumer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/consumer/options"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/consts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/delivery"
+	committingStockIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/committing_stock/events/integration/external/v1"
+	releasingStockIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/releasing_stock/events/integration/external/v1"
+	reservingStockIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/reserving_stock/events/integration/external/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/shared/configurations/infrastructure"
+)
+
+func ConfigConsumers(infra *infrastructure.InfrastructureConfiguration) error {
+	consumerBase := delivery.NewProductConsumersBase(infra)
+
+	// the order events are consumed from a single queue, so the stock of an order is released or committed after it is reserved
+	ordersConsumer, err := rabbitmqConsumer.NewRabbitMQMultiTypeConsumer(
+		infra.RabbitMQConnection,
+		consts.OrdersQueue,
+		func(builder *options.RabbitMQMultiTypeConsumerOptionsBuilder) {
+			// queue arguments should be the same as the declared topology, otherwise the broker rejects the declaration
+			builder.WithQueueArgs(infra.Cfg.RabbitMQ.Topology.QueueArguments(consts.OrdersQueue))
+			builder.WithPayloadOptions(infra.PayloadOptions)
+			builder.WithMetrics(infra.Metrics.RabbitMQ)
+			builder.WithMessageRegistry(infra.MessageRegistry)
+		},
+		infra.EventSerializer,
+		infra.Log)
+	if err != nil {
+		return err
+	}
+
+	err = rabbitmqConsumer.AddHandler[*reservingStockIntegration.OrderSubmittedV1](ordersConsumer, reservingStockIntegration.NewOrderSubmittedConsumer(consumerBase), nil)
+	if err != nil {
+		return err
+	}
+
+	err = rabbitmqConsumer.AddHandler[*committingStockIntegration.OrderPaidV1](ordersConsumer, committingStockIntegration.NewOrderPaidConsumer(consumerBase), nil)
+	if err != nil {
+		return err
+	}
+
+	err = rabbitmqConsumer.AddHandler[*releasingStockIntegration.OrderCanceledV1](ordersConsumer, releasingStockIntegration.NewOrderCanceledConsumer(consumerBase), nil)
+	if err != nil {
+		return err
+	}
+	infra.Consumers = append(infra.Consumers, ordersConsumer)
+
+	return nil
+}
@@ -24,12 +24,14 @@ func ConfigureMappings() error {
 			return nil
 		}
 		return &productsService.Product{
-			ProductId:   product.ProductId.String(),
-			Name:        product.Name,
-			Description: product.Description,
-			Price:       product.Price,
-			CreatedAt:   timestamppb.New(product.CreatedAt),
-			UpdatedAt:   timestamppb.New(product.UpdatedAt),
+			ProductId:         product.ProductId.String(),
+			Name:              product.Name,
+			Description:       product.Description,
+			Price:             &productsService.Money{Amount: product.Price.Amount.String(), Currency: product.Price.Currency.String()},
+			InventoryQuantity: int32(product.InventoryQuantity),
+			ReservedQuantity:  int32(product.ReservedQuantity),
+			CreatedAt:         timestamppb.New(product.CreatedAt),
+			UpdatedAt:         timestamppb.New(product.UpdatedAt),
 		}
 	})
 	if err != nil {
@@ -38,12 +40,14 @@ func ConfigureMappings() error {
 
 	err = mapper.CreateCustomMap(func(product *models.Product) *productsService.Product {
 		return &productsService.Product{
-			ProductId:   product.ProductId.String(),
-			Name:        product.Name,
-			Description: product.Description,
-			Price:       product.Price,
-			CreatedAt:   timestamppb.New(product.CreatedAt),
-			UpdatedAt:   timestamppb.New(product.UpdatedAt),
+			ProductId:         product.ProductId.String(),
+			Name:              product.Name,
+			Description:       product.Description,
+			Price:             &productsService.Money{Amount: product.Price.Amount.String(), Currency: product.Price.Currency.String()},
+			InventoryQuantity: int32(product.InventoryQuantity),
+			ReservedQuantity:  int32(product.ReservedQuantity),
+			CreatedAt:         timestamppb.New(product.CreatedAt),
+			UpdatedAt:         timestamppb.New(product.UpdatedAt),
 		}
 	})
 
@@ -3,20 +3,24 @@ package mediatr
 import (
 	"github.com/mehdihadeli/go-mediatr"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/contracts"
+	committingStockV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/committing_stock/commands/v1"
 	creatingProductV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/creating_product/commands/v1"
 	creatingProductsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/creating_product/dtos"
 	deletingProductV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/deleting_product/commands/v1"
+	expiringStockReservationsV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/expiring_stock_reservations/commands/v1"
 	gettingProductByIdDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/getting_product_by_id/dtos"
 	geettingProductByIdV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/getting_product_by_id/queries/v1"
 	gettingProductsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/getting_products/dtos"
 	gettingProductsV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/getting_products/queries/v1"
+	releasingStockV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/releasing_stock/commands/v1"
+	reservingStockV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/reserving_stock/commands/v1"
 	searchingProductsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/searching_product/dtos"
 	searchingProductsV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/searching_product/queries/v1"
 	updatingProductV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/updating_product/commands/v1"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/shared/configurations/infrastructure"
 )
 
-func ConfigProductsMediator(pgRepo contracts.ProductRepository, infra *infrastructure.InfrastructureConfiguration) error {
+func ConfigProductsMediator(pgRepo contracts.ProductRepository, stockRepository contracts.StockRepository, infra *infrastructure.InfrastructureConfiguration) error {
 	//https://stackoverflow.com/questions/72034479/how-to-implement-generic-interfaces
 	err := mediatr.RegisterRequestHandler[*creatingProductV1.CreateProduct, *creatingProductsDtos.CreateProductResponseDto](creatingProductV1.NewCreateProductHandler(infra.Log, infra.Cfg, pgRepo, infra.Producer))
 	if err != nil {
@@ -48,5 +52,25 @@ func ConfigProductsMediator(pgRepo contracts.ProductRepository, infra *infrastru
 		return err
 	}
 
+	err = mediatr.RegisterRequestHandler[*reservingStockV1.ReserveStock, *mediatr.Unit](reservingStockV1.NewReserveStockHandler(infra.Log, infra.Cfg, stockRepository, infra.Producer))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*committingStockV1.CommitStock, *mediatr.Unit](committingStockV1.NewCommitStockHandler(infra.Log, infra.Cfg, stockRepository))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*releasingStockV1.ReleaseStock, *mediatr.Unit](releasingStockV1.NewReleaseStockHandler(infra.Log, infra.Cfg, stockRepository))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*expiringStockReservationsV1.ExpireStockReservations, *mediatr.Unit](expiringStockReservationsV1.NewExpireStockReservationsHandler(infra.Log, infra.Cfg, stockRepository, infra.Producer))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,60 @@
+package messages
+
+import (
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	orderPaidIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/committing_stock/events/integration/external/v1"
+	createdIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/creating_product/events/integration/v1"
+	deletedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/deleting_product/events/integration/v1"
+	expiredIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/expiring_stock_reservations/events/integration/v1"
+	orderCanceledIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/releasing_stock/events/integration/external/v1"
+	orderSubmittedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/reserving_stock/events/integration/external/v1"
+	reservedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/reserving_stock/events/integration/v1"
+	updatedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/updating_product/events/integration/v1"
+)
+
+// ConfigMessageContracts registers the wire names of the product integration events, they should be the same as the catalogs read service contracts
+func ConfigMessageContracts(registry *messageRegistry.MessageRegistry) error {
+	// the go type names are kept as aliases for the messages which are published before the contracts
+	err := messageRegistry.Register[*createdIntegration.ProductCreatedV1](registry, "catalogs.product_created", 1, "ProductCreatedV1")
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*updatedIntegration.ProductUpdatedV1](registry, "catalogs.product_updated", 1, "ProductUpdatedV1")
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*deletedIntegration.ProductDeletedV1](registry, "catalogs.product_deleted", 1, "ProductDeletedV1")
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*reservedIntegration.StockReservedV1](registry, "catalogs.stock_reserved", 1)
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*reservedIntegration.StockReservationFailedV1](registry, "catalogs.stock_reservation_failed", 1)
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*expiredIntegration.StockReservationExpiredV1](registry, "catalogs.stock_reservation_expired", 1)
+	if err != nil {
+		return err
+	}
+
+	// the consumed order events have the wire names of the orders service contracts
+	err = messageRegistry.Register[*orderSubmittedIntegration.OrderSubmittedV1](registry, "orders.order_submitted", 1)
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*orderPaidIntegration.OrderPaidV1](registry, "orders.order_paid", 1)
+	if err != nil {
+		return err
+	}
+
+	return messageRegistry.Register[*orderCanceledIntegration.OrderCanceledV1](registry, "orders.order_canceled", 1)
+}
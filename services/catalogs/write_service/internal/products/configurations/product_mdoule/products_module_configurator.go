@@ -4,10 +4,19 @@ import (
 	"context"
 	grpcServer "github.com/mehdihadeli/store-golang-microservice-sample/pkg/grpc"
 	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/topology"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/configurations/consumers"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/configurations/mappings"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/configurations/mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/configurations/messages"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/contracts"
 	repositoriesImp "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/data/repositories"
+	createdIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/creating_product/events/integration/v1"
+	deletedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/deleting_product/events/integration/v1"
+	expiringStockIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/expiring_stock_reservations/events/integration/v1"
+	reservingStockIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/reserving_stock/events/integration/v1"
+	updatedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/updating_product/events/integration/v1"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/shared/configurations/infrastructure"
 )
 
@@ -23,13 +32,35 @@ func NewProductsModuleConfigurator(infrastructure *infrastructure.Infrastructure
 
 func (c *productsModuleConfigurator) ConfigureProductsModule(ctx context.Context) error {
 	productRepository := repositoriesImp.NewPostgresProductRepository(c.Log, c.Cfg, c.Gorm.DB)
+	stockRepository := repositoriesImp.NewPostgresStockRepository(c.Log, c.Cfg, c.Gorm.DB)
 
 	err := mappings.ConfigureMappings()
 	if err != nil {
 		return err
 	}
 
-	err = mediatr.ConfigProductsMediator(productRepository, c.InfrastructureConfiguration)
+	// conflicting message contracts fail the startup
+	err = messages.ConfigMessageContracts(c.MessageRegistry)
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.ConfigProductsMediator(productRepository, stockRepository, c.InfrastructureConfiguration)
+	if err != nil {
+		return err
+	}
+
+	err = consumers.ConfigConsumers(c.InfrastructureConfiguration)
+	if err != nil {
+		return err
+	}
+
+	// exchanges of the produced integration events should be declared in the topology
+	producedMessages := []types.IMessage{
+		&createdIntegration.ProductCreatedV1{}, &updatedIntegration.ProductUpdatedV1{}, &deletedIntegration.ProductDeletedV1{},
+		&reservingStockIntegration.StockReservedV1{}, &reservingStockIntegration.StockReservationFailedV1{}, &expiringStockIntegration.StockReservationExpiredV1{},
+	}
+	err = topology.ConfigureTopology(c.RabbitMQConnection, c.Cfg.RabbitMQ.Topology, c.Consumers, producedMessages)
 	if err != nil {
 		return err
 	}
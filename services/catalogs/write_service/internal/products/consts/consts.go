@@ -4,3 +4,12 @@ const (
 	ProductIdIndex = "productId"
 	ProductId      = "productId"
 )
+
+const (
+	// OrdersQueue receives the order events which change the stock of the products
+	OrdersQueue = "catalogs_write_service_orders"
+	// MaxStockReservationRetries is the number of times a reservation is retried when the stock is changed concurrently
+	MaxStockReservationRetries = 3
+	// ExpiredStockReservationsBatchSize is the number of orders which their reservations are expired in one poll
+	ExpiredStockReservationsBatchSize = 100
+)
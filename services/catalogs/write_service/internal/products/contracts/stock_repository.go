@@ -0,0 +1,21 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/models"
+	uuid "github.com/satori/go.uuid"
+)
+
+// StockRepository changes the stock of the products with optimistic concurrency, the changes of an order are done in one transaction and
+// a StockConcurrencyError is returned when one of the products is changed by another operation in the meantime.
+type StockRepository interface {
+	GetOrderReservations(ctx context.Context, orderId uuid.UUID) ([]*models.StockReservation, error)
+	// ReserveStock creates the reservations of an order, it returns a StockAlreadyReservedError when the order has reservations
+	ReserveStock(ctx context.Context, reservations []*models.StockReservation) error
+	CommitStock(ctx context.Context, orderId uuid.UUID) ([]*models.StockReservation, error)
+	// ReleaseStock releases the reserved stock of an order and marks its reservations with the status, released or expired
+	ReleaseStock(ctx context.Context, orderId uuid.UUID, status string) ([]*models.StockReservation, error)
+	GetExpiredReservationOrderIds(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/exceptions"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/models"
 	"github.com/opentracing/opentracing-go"
 	uuid "github.com/satori/go.uuid"
@@ -99,9 +100,26 @@ func (p *postgresProductRepository) UpdateProduct(ctx context.Context, updatePro
 	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresProductRepository.UpdateProduct")
 	defer span.Finish()
 
-	if err := p.gorm.Save(updateProduct).Error; err != nil {
-		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[postgresProductRepository_UpdateProduct.Save] error in updating product with id %s into the database.", updateProduct.ProductId)))
+	// the reserved stock is changed only by the stock repository, so the product is updated only if its version is not changed by a reservation
+	result := p.gorm.WithContext(ctx).
+		Model(&models.Product{}).
+		Where("product_id = ? AND version = ?", updateProduct.ProductId, updateProduct.Version).
+		Updates(map[string]interface{}{
+			"name":               updateProduct.Name,
+			"description":        updateProduct.Description,
+			"price_amount":       updateProduct.Price.Amount,
+			"price_currency":     updateProduct.Price.Currency,
+			"inventory_quantity": updateProduct.InventoryQuantity,
+			"version":            updateProduct.Version + 1,
+			"updated_at":         updateProduct.UpdatedAt,
+		})
+	if result.Error != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(result.Error, fmt.Sprintf("[postgresProductRepository_UpdateProduct.Updates] error in updating product with id %s into the database.", updateProduct.ProductId)))
 	}
+	if result.RowsAffected == 0 {
+		return nil, tracing.TraceWithErr(span, exceptions.NewStockConcurrencyError(fmt.Sprintf("[postgresProductRepository_UpdateProduct.Updates] product with id %s is changed by another operation", updateProduct.ProductId)))
+	}
+	updateProduct.Version++
 	span.LogFields(log.Object("Product", updateProduct))
 	p.log.Infow(fmt.Sprintf("[postgresProductRepository.UpdateProduct] product with id '%s' updated", updateProduct.ProductId), logger.Fields{"Product": updateProduct, "ProductId": updateProduct.ProductId})
 
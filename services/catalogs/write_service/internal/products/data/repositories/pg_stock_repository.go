@@ -0,0 +1,212 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/exceptions"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+)
+
+type postgresStockRepository struct {
+	log  logger.Logger
+	cfg  *config.Config
+	gorm *gorm.DB
+}
+
+func NewPostgresStockRepository(log logger.Logger, cfg *config.Config, gorm *gorm.DB) *postgresStockRepository {
+	return &postgresStockRepository{log: log, cfg: cfg, gorm: gorm}
+}
+
+func (p *postgresStockRepository) GetOrderReservations(ctx context.Context, orderId uuid.UUID) ([]*models.StockReservation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresStockRepository.GetOrderReservations")
+	span.LogFields(log.String("OrderId", orderId.String()))
+	defer span.Finish()
+
+	var reservations []*models.StockReservation
+	if err := p.gorm.WithContext(ctx).Where("order_id = ?", orderId).Find(&reservations).Error; err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[postgresStockRepository_GetOrderReservations.Find] error in loading the stock reservations of order %s", orderId)))
+	}
+
+	return reservations, nil
+}
+
+func (p *postgresStockRepository) ReserveStock(ctx context.Context, reservations []*models.StockReservation) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresStockRepository.ReserveStock")
+	defer span.Finish()
+
+	if len(reservations) == 0 {
+		return nil
+	}
+
+	orderId := reservations[0].OrderId
+	err := p.gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the transaction lock of the order serializes the redelivered reservations of the order, the unique index of the order products backs it up
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "stock_reservation:"+orderId.String()).Error; err != nil {
+			return errors.WrapIf(err, fmt.Sprintf("error in locking the stock reservations of order %s", orderId))
+		}
+
+		var existing int64
+		if err := tx.Model(&models.StockReservation{}).Where("order_id = ?", orderId).Count(&existing).Error; err != nil {
+			return errors.WrapIf(err, fmt.Sprintf("error in counting the stock reservations of order %s", orderId))
+		}
+		if existing > 0 {
+			return exceptions.NewStockAlreadyReservedError(fmt.Sprintf("stock of order %s is already reserved", orderId))
+		}
+
+		for _, reservation := range reservations {
+			product, err := getProduct(tx, reservation.ProductId)
+			if err != nil {
+				return err
+			}
+
+			if product.AvailableQuantity() < reservation.Quantity {
+				return exceptions.NewInsufficientStockError(fmt.Sprintf("%d items of product %s are available and %d items are ordered", product.AvailableQuantity(), product.ProductId, reservation.Quantity))
+			}
+
+			err = updateStock(tx, product, product.InventoryQuantity, product.ReservedQuantity+reservation.Quantity)
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Create(&reservations).Error
+	})
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WithMessage(err, "[postgresStockRepository_ReserveStock.Transaction] error in reserving the stock"))
+	}
+
+	p.log.Infow(fmt.Sprintf("[postgresStockRepository.ReserveStock] stock of order %s reserved", orderId), logger.Fields{"OrderId": orderId})
+
+	return nil
+}
+
+func (p *postgresStockRepository) CommitStock(ctx context.Context, orderId uuid.UUID) ([]*models.StockReservation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresStockRepository.CommitStock")
+	span.LogFields(log.String("OrderId", orderId.String()))
+	defer span.Finish()
+
+	// the committed items leave the inventory together with their reservation
+	reservations, err := p.closeReservations(ctx, orderId, models.StockCommitted, func(product *models.Product, quantity int) (int, int) {
+		return product.InventoryQuantity - quantity, product.ReservedQuantity - quantity
+	})
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[postgresStockRepository_CommitStock.closeReservations] error in committing the stock"))
+	}
+
+	p.log.Infow(fmt.Sprintf("[postgresStockRepository.CommitStock] stock of order %s committed", orderId), logger.Fields{"OrderId": orderId})
+
+	return reservations, nil
+}
+
+func (p *postgresStockRepository) ReleaseStock(ctx context.Context, orderId uuid.UUID, status string) ([]*models.StockReservation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresStockRepository.ReleaseStock")
+	span.LogFields(log.String("OrderId", orderId.String()))
+	defer span.Finish()
+
+	reservations, err := p.closeReservations(ctx, orderId, status, func(product *models.Product, quantity int) (int, int) {
+		return product.InventoryQuantity, product.ReservedQuantity - quantity
+	})
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[postgresStockRepository_ReleaseStock.closeReservations] error in releasing the stock"))
+	}
+
+	p.log.Infow(fmt.Sprintf("[postgresStockRepository.ReleaseStock] stock of order %s %s", orderId, status), logger.Fields{"OrderId": orderId})
+
+	return reservations, nil
+}
+
+func (p *postgresStockRepository) GetExpiredReservationOrderIds(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresStockRepository.GetExpiredReservationOrderIds")
+	defer span.Finish()
+
+	var orderIds []uuid.UUID
+	err := p.gorm.WithContext(ctx).
+		Model(&models.StockReservation{}).
+		Distinct("order_id").
+		Where("status = ? AND expires_at <= ?", models.StockReserved, now).
+		Limit(limit).
+		Pluck("order_id", &orderIds).Error
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[postgresStockRepository_GetExpiredReservationOrderIds.Pluck] error in loading the expired stock reservations"))
+	}
+
+	return orderIds, nil
+}
+
+// closeReservations moves the open reservations of an order to the status and changes the stock of their products, an order without open
+// reservations is already closed so nothing is changed
+func (p *postgresStockRepository) closeReservations(ctx context.Context, orderId uuid.UUID, status string, stock func(product *models.Product, quantity int) (int, int)) ([]*models.StockReservation, error) {
+	var reservations []*models.StockReservation
+
+	err := p.gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("order_id = ? AND status = ?", orderId, models.StockReserved).Find(&reservations).Error; err != nil {
+			return errors.WrapIf(err, fmt.Sprintf("error in loading the stock reservations of order %s", orderId))
+		}
+
+		for _, reservation := range reservations {
+			product, err := getProduct(tx, reservation.ProductId)
+			if err != nil {
+				return err
+			}
+
+			inventoryQuantity, reservedQuantity := stock(product, reservation.Quantity)
+			err = updateStock(tx, product, inventoryQuantity, reservedQuantity)
+			if err != nil {
+				return err
+			}
+
+			reservation.Status = status
+			if err := tx.Save(reservation).Error; err != nil {
+				return errors.WrapIf(err, fmt.Sprintf("error in updating the stock reservation %s", reservation.StockReservationId))
+			}
+		}
+
+		return nil
+	})
+
+	return reservations, err
+}
+
+func getProduct(tx *gorm.DB, productId uuid.UUID) (*models.Product, error) {
+	var product models.Product
+	if err := tx.First(&product, productId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, customErrors.NewNotFoundError(fmt.Sprintf("product with id %s not found", productId))
+		}
+
+		return nil, errors.WrapIf(err, fmt.Sprintf("can't find the product with id %s into the database.", productId))
+	}
+
+	return &product, nil
+}
+
+// updateStock changes the stock of a product if its version is not changed since it is loaded
+func updateStock(tx *gorm.DB, product *models.Product, inventoryQuantity int, reservedQuantity int) error {
+	result := tx.Model(&models.Product{}).
+		Where("product_id = ? AND version = ?", product.ProductId, product.Version).
+		Updates(map[string]interface{}{
+			"inventory_quantity": inventoryQuantity,
+			"reserved_quantity":  reservedQuantity,
+			"version":            product.Version + 1,
+			"updated_at":         time.Now(),
+		})
+	if result.Error != nil {
+		return errors.WrapIf(result.Error, fmt.Sprintf("error in updating the stock of product %s", product.ProductId))
+	}
+	if result.RowsAffected == 0 {
+		return exceptions.NewStockConcurrencyError(fmt.Sprintf("stock of product %s is changed by another operation", product.ProductId))
+	}
+
+	return nil
+}
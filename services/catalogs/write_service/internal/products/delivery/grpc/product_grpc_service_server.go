@@ -5,6 +5,7 @@ import (
 	"emperror.dev/errors"
 	"fmt"
 	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/grpc/grpcErrors"
 	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
@@ -38,7 +39,14 @@ func (s *ProductGrpcServiceServer) CreateProduct(ctx context.Context, req *produ
 	s.Metrics.CreateProductGrpcRequests.Inc()
 	defer span.Finish()
 
-	command := creatingProductV1.NewCreateProduct(req.GetName(), req.GetDescription(), req.GetPrice())
+	price, err := priceFromGrpc(req.GetPrice())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[ProductGrpcServiceServer_CreateProduct.priceFromGrpc] error in converting price")
+		s.Log.Errorf(fmt.Sprintf("[ProductGrpcServiceServer_CreateProduct.priceFromGrpc] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	command := creatingProductV1.NewCreateProduct(req.GetName(), req.GetDescription(), price, int(req.GetInventoryQuantity()))
 
 	if err := s.Validator.StructCtx(ctx, command); err != nil {
 		validationErr := customErrors.NewValidationErrorWrap(err, "[ProductGrpcServiceServer_CreateProduct.StructCtx] command validation failed")
@@ -71,7 +79,14 @@ func (s *ProductGrpcServiceServer) UpdateProduct(ctx context.Context, req *produ
 		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
 	}
 
-	command := updatingProductV1.NewUpdateProduct(productUUID, req.GetName(), req.GetDescription(), req.GetPrice())
+	price, err := priceFromGrpc(req.GetPrice())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[ProductGrpcServiceServer_UpdateProduct.priceFromGrpc] error in converting price")
+		s.Log.Errorf(fmt.Sprintf("[ProductGrpcServiceServer_UpdateProduct.priceFromGrpc] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	command := updatingProductV1.NewUpdateProduct(productUUID, req.GetName(), req.GetDescription(), price, int(req.GetInventoryQuantity()))
 
 	if err := s.Validator.StructCtx(ctx, command); err != nil {
 		validationErr := customErrors.NewValidationErrorWrap(err, "[ProductGrpcServiceServer_UpdateProduct.StructCtx] command validation failed")
@@ -127,3 +142,11 @@ func (s *ProductGrpcServiceServer) GetProductById(ctx context.Context, req *prod
 
 	return &productsService.GetProductByIdRes{Product: product}, nil
 }
+
+func priceFromGrpc(price *productsService.Money) (domain.Money, error) {
+	if price == nil {
+		return domain.Money{}, errors.New("price is required")
+	}
+
+	return domain.ParseMoney(price.GetAmount(), price.GetCurrency())
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
 	productService "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/contracts/proto/service_clients"
@@ -50,7 +51,7 @@ func TestRunner(t *testing.T) {
 
 func (p *ProductGrpcServiceTests) Test_Create_Product() {
 	request := &productService.CreateProductReq{
-		Price:       gofakeit.Price(100, 1000),
+		Price:       &productService.Money{Amount: fmt.Sprintf("%.2f", gofakeit.Price(100, 1000)), Currency: "USD"},
 		Name:        gofakeit.Name(),
 		Description: gofakeit.AdjectiveDescriptive(),
 	}
@@ -0,0 +1,21 @@
+package delivery
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/shared/configurations/infrastructure"
+)
+
+type ProductConsumersBase struct {
+	*infrastructure.InfrastructureConfiguration
+}
+
+func NewProductConsumersBase(infra *infrastructure.InfrastructureConfiguration) *ProductConsumersBase {
+	return &ProductConsumersBase{InfrastructureConfiguration: infra}
+}
+
+func (pm *ProductConsumersBase) CommitMessage() {
+	pm.Metrics.SuccessKafkaMessages.Inc()
+}
+
+func (pm *ProductConsumersBase) CommitErrMessage() {
+	pm.Metrics.ErrorKafkaMessages.Inc()
+}
@@ -1,15 +1,18 @@
 package dto
 
 import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	uuid "github.com/satori/go.uuid"
 	"time"
 )
 
 type ProductDto struct {
-	ProductId   uuid.UUID `json:"productId"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Price       float64   `json:"price"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ProductId         uuid.UUID    `json:"productId"`
+	Name              string       `json:"name"`
+	Description       string       `json:"description"`
+	Price             domain.Money `json:"price"`
+	InventoryQuantity int          `json:"inventoryQuantity"`
+	ReservedQuantity  int          `json:"reservedQuantity"`
+	CreatedAt         time.Time    `json:"createdAt"`
+	UpdatedAt         time.Time    `json:"updatedAt"`
 }
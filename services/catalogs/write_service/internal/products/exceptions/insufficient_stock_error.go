@@ -0,0 +1,39 @@
+package exceptions
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// insufficientStockError is returned when the available quantity of a product is less than the quantity an order reserves
+type insufficientStockError struct {
+	customErrors.BadRequestError
+}
+
+type InsufficientStockError interface {
+	customErrors.BadRequestError
+	IsInsufficientStockError() bool
+}
+
+func NewInsufficientStockError(message string) error {
+	bad := customErrors.NewBadRequestError(message)
+	customErr := customErrors.GetCustomError(bad).(customErrors.BadRequestError)
+	br := &insufficientStockError{
+		BadRequestError: customErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+func (err *insufficientStockError) IsInsufficientStockError() bool {
+	return true
+}
+
+func IsInsufficientStockError(err error) bool {
+	var is InsufficientStockError
+	if errors.As(err, &is) {
+		return is.IsInsufficientStockError()
+	}
+
+	return false
+}
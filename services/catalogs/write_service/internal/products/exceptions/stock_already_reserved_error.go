@@ -0,0 +1,39 @@
+package exceptions
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// stockAlreadyReservedError is returned when the stock of an order is reserved before, the reservations of an order are created only once
+type stockAlreadyReservedError struct {
+	customErrors.ConflictError
+}
+
+type StockAlreadyReservedError interface {
+	customErrors.ConflictError
+	IsStockAlreadyReservedError() bool
+}
+
+func NewStockAlreadyReservedError(message string) error {
+	conflict := customErrors.NewConflictError(message)
+	customErr := customErrors.GetCustomError(conflict).(customErrors.ConflictError)
+	ae := &stockAlreadyReservedError{
+		ConflictError: customErr,
+	}
+
+	return errors.WithStackIf(ae)
+}
+
+func (err *stockAlreadyReservedError) IsStockAlreadyReservedError() bool {
+	return true
+}
+
+func IsStockAlreadyReservedError(err error) bool {
+	var ar StockAlreadyReservedError
+	if errors.As(err, &ar) {
+		return ar.IsStockAlreadyReservedError()
+	}
+
+	return false
+}
@@ -0,0 +1,39 @@
+package exceptions
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// stockConcurrencyError is returned when the stock of a product is changed by another operation after it is loaded, the operation can be retried
+type stockConcurrencyError struct {
+	customErrors.ConflictError
+}
+
+type StockConcurrencyError interface {
+	customErrors.ConflictError
+	IsStockConcurrencyError() bool
+}
+
+func NewStockConcurrencyError(message string) error {
+	conflict := customErrors.NewConflictError(message)
+	customErr := customErrors.GetCustomError(conflict).(customErrors.ConflictError)
+	ce := &stockConcurrencyError{
+		ConflictError: customErr,
+	}
+
+	return errors.WithStackIf(ce)
+}
+
+func (err *stockConcurrencyError) IsStockConcurrencyError() bool {
+	return true
+}
+
+func IsStockConcurrencyError(err error) bool {
+	var sc StockConcurrencyError
+	if errors.As(err, &sc) {
+		return sc.IsStockConcurrencyError()
+	}
+
+	return false
+}
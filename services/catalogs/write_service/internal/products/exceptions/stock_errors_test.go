@@ -0,0 +1,33 @@
+package exceptions
+
+import (
+	"fmt"
+	httpErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Insufficient_Stock_Error(t *testing.T) {
+	err := NewInsufficientStockError("only 2 items are available")
+	assert.True(t, IsInsufficientStockError(err))
+	assert.True(t, customErrors.IsBadRequestError(err))
+	assert.False(t, IsStockConcurrencyError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
+
+func Test_Stock_Concurrency_Error(t *testing.T) {
+	err := NewStockConcurrencyError("product is changed")
+	assert.True(t, IsStockConcurrencyError(err))
+	assert.True(t, customErrors.IsConflictError(err))
+	assert.False(t, IsInsufficientStockError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
+
+func Test_Stock_Already_Reserved_Error(t *testing.T) {
+	err := NewStockAlreadyReservedError("stock of the order is already reserved")
+	assert.True(t, IsStockAlreadyReservedError(err))
+	assert.True(t, customErrors.IsConflictError(err))
+	assert.False(t, IsStockConcurrencyError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	uuid "github.com/satori/go.uuid"
+)
+
+type CommitStock struct {
+	OrderId uuid.UUID `validate:"required"`
+}
+
+func NewCommitStock(orderId uuid.UUID) *CommitStock {
+	return &CommitStock{OrderId: orderId}
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/consts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/exceptions"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type CommitStockHandler struct {
+	log             logger.Logger
+	cfg             *config.Config
+	stockRepository contracts.StockRepository
+}
+
+func NewCommitStockHandler(log logger.Logger, cfg *config.Config, stockRepository contracts.StockRepository) *CommitStockHandler {
+	return &CommitStockHandler{log: log, cfg: cfg, stockRepository: stockRepository}
+}
+
+func (c *CommitStockHandler) Handle(ctx context.Context, command *CommitStock) (*mediatr.Unit, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CommitStockHandler.Handle")
+	span.LogFields(log.String("OrderId", command.OrderId.String()))
+	defer span.Finish()
+
+	var reservations []*models.StockReservation
+	var err error
+	for attempt := 1; ; attempt++ {
+		reservations, err = c.stockRepository.CommitStock(ctx, command.OrderId)
+		if !exceptions.IsStockConcurrencyError(err) || attempt == consts.MaxStockReservationRetries {
+			break
+		}
+		c.log.Warnf("[CommitStockHandler.Handle] stock of order '%s' is changed concurrently, retrying, attempt: %d", command.OrderId, attempt)
+	}
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[CommitStockHandler_Handle.CommitStock] error in committing the stock in the repository"))
+	}
+
+	// the reservation of an order which is paid after its expiry is already released, so its stock is not committed
+	if len(reservations) == 0 {
+		c.log.Warnf("[CommitStockHandler.Handle] order '%s' has no reserved stock", command.OrderId)
+		return &mediatr.Unit{}, nil
+	}
+
+	c.log.Infow(fmt.Sprintf("[CommitStockHandler.Handle] stock of order '%s' committed", command.OrderId), logger.Fields{"OrderId": command.OrderId})
+
+	return &mediatr.Unit{}, nil
+}
@@ -0,0 +1,11 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+)
+
+// OrderPaidV1 is the part of the orders service OrderPaid integration event which is needed for committing the stock
+type OrderPaidV1 struct {
+	*types.Message
+	OrderId string `json:"orderId"`
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/delivery"
+	committingStockV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/committing_stock/commands/v1"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+type orderPaidConsumer struct {
+	*delivery.ProductConsumersBase
+}
+
+func NewOrderPaidConsumer(productConsumerBase *delivery.ProductConsumersBase) *orderPaidConsumer {
+	return &orderPaidConsumer{productConsumerBase}
+}
+
+func (c *orderPaidConsumer) Handle(ctx context.Context, consumeContext types.IMessageConsumeContext[*OrderPaidV1]) error {
+	if consumeContext.Message() == nil {
+		return nil
+	}
+	span, ctx := opentracing.StartSpanFromContext(ctx, "orderPaidConsumer.Handle")
+	span.LogFields(log.Object("Message", consumeContext.Created()))
+	defer span.Finish()
+
+	orderId, err := uuid.FromString(consumeContext.Message().OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[orderPaidConsumer_Handle.uuid.FromString] error in the converting order uuid")
+		c.Log.Errorf(fmt.Sprintf("[orderPaidConsumer_Handle.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		c.CommitErrMessage()
+
+		return badRequestErr
+	}
+
+	command := committingStockV1.NewCommitStock(orderId)
+	_, err = mediatr.Send[*committingStockV1.CommitStock, *mediatr.Unit](ctx, command)
+	if err != nil {
+		err = errors.WithMessage(err, "[orderPaidConsumer_Handle.Send] error in sending CommitStock")
+		c.Log.Errorw(fmt.Sprintf("[orderPaidConsumer_Handle.Send] id: {%s}, err: {%v}", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"OrderId": command.OrderId})
+		c.CommitErrMessage()
+
+		return err
+	}
+	c.CommitMessage()
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	uuid "github.com/satori/go.uuid"
 	"time"
 )
@@ -12,10 +13,12 @@ type CreateProduct struct {
 	ProductID   uuid.UUID `validate:"required"`
 	Name        string    `validate:"required,gte=0,lte=255"`
 	Description string    `validate:"required,gte=0,lte=5000"`
-	Price       float64   `validate:"required,gte=0"`
-	CreatedAt   time.Time `validate:"required"`
+	Price       domain.Money
+	// InventoryQuantity is the initial stock of the product
+	InventoryQuantity int       `validate:"gte=0"`
+	CreatedAt         time.Time `validate:"required"`
 }
 
-func NewCreateProduct(name string, description string, price float64) *CreateProduct {
-	return &CreateProduct{ProductID: uuid.NewV4(), Name: name, Description: description, Price: price, CreatedAt: time.Now()}
+func NewCreateProduct(name string, description string, price domain.Money, inventoryQuantity int) *CreateProduct {
+	return &CreateProduct{ProductID: uuid.NewV4(), Name: name, Description: description, Price: price, InventoryQuantity: inventoryQuantity, CreatedAt: time.Now()}
 }
@@ -35,12 +35,17 @@ func (c *CreateProductHandler) Handle(ctx context.Context, command *CreateProduc
 	span.LogFields(log.Object("Command", command))
 	defer span.Finish()
 
+	if !command.Price.IsPositive() {
+		return nil, tracing.TraceWithErr(span, customErrors.NewBadRequestError(fmt.Sprintf("[CreateProductHandler.Handle] product price should be positive, price: %s", command.Price)))
+	}
+
 	product := &models.Product{
-		ProductId:   command.ProductID,
-		Name:        command.Name,
-		Description: command.Description,
-		Price:       command.Price,
-		CreatedAt:   command.CreatedAt,
+		ProductId:         command.ProductID,
+		Name:              command.Name,
+		Description:       command.Description,
+		Price:             command.Price,
+		InventoryQuantity: command.InventoryQuantity,
+		CreatedAt:         command.CreatedAt,
 	}
 
 	createdProduct, err := c.repository.CreateProduct(ctx, product)
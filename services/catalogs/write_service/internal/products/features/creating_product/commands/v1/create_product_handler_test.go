@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/creating_product/dtos"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/shared/test_fixtures/integration"
@@ -23,7 +24,8 @@ func Test_Create_Product_Command_Handler(t *testing.T) {
 	fixture.Run()
 	defer fixture.Cleanup()
 
-	command := NewCreateProduct(gofakeit.Name(), gofakeit.AdjectiveDescriptive(), gofakeit.Price(150, 6000))
+	price, _ := domain.NewMoneyFromFloat(gofakeit.Price(150, 6000), domain.USD)
+	command := NewCreateProduct(gofakeit.Name(), gofakeit.AdjectiveDescriptive(), price, gofakeit.Number(0, 100))
 	result, err := mediatr.Send[*CreateProduct, *dtos.CreateProductResponseDto](context.Background(), command)
 
 	assert.NotNil(t, result)
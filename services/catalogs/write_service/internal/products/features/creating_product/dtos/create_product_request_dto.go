@@ -1,12 +1,16 @@
 package dtos
 
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+
 //https://echo.labstack.com/guide/binding/
 //https://echo.labstack.com/guide/request/
 //https://github.com/go-playground/validator
 
 // CreateProductRequestDto validation will handle in command level
 type CreateProductRequestDto struct {
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Price       domain.Money `json:"price"`
+	// InventoryQuantity is the initial stock of the product
+	InventoryQuantity int `json:"inventoryQuantity"`
 }
@@ -49,7 +49,7 @@ func (ep *createProductEndpoint) handler() echo.HandlerFunc {
 			return badRequestErr
 		}
 
-		command := v1.NewCreateProduct(request.Name, request.Description, request.Price)
+		command := v1.NewCreateProduct(request.Name, request.Description, request.Price, request.InventoryQuantity)
 		if err := ep.Validator.StructCtx(ctx, command); err != nil {
 			validationErr := customErrors.NewValidationErrorWrap(err, "[createProductEndpoint_handler.StructCtx] command validation failed")
 			ep.Log.Errorf(fmt.Sprintf("[createProductEndpoint_handler.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr)))
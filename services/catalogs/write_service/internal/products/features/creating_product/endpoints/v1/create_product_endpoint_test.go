@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/gavv/httpexpect/v2"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/delivery"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/creating_product/dtos"
@@ -23,9 +24,10 @@ func Test_Create_Product_E2E(t *testing.T) {
 	fixture.Run()
 	defer fixture.Cleanup()
 
+	price, _ := domain.NewMoneyFromFloat(gofakeit.Price(100, 1000), domain.USD)
 	request := dtos.CreateProductRequestDto{
 		Description: gofakeit.AdjectiveDescriptive(),
-		Price:       gofakeit.Price(100, 1000),
+		Price:       price,
 		Name:        gofakeit.Name(),
 	}
 
@@ -0,0 +1,12 @@
+package v1
+
+import "time"
+
+// ExpireStockReservations releases the stock of the orders which are not paid before their reservations expire
+type ExpireStockReservations struct {
+	Now time.Time `validate:"required"`
+}
+
+func NewExpireStockReservations() *ExpireStockReservations {
+	return &ExpireStockReservations{Now: time.Now()}
+}
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/producer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/consts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/contracts"
+	integrationEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/expiring_stock_reservations/events/integration/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type ExpireStockReservationsHandler struct {
+	log              logger.Logger
+	cfg              *config.Config
+	stockRepository  contracts.StockRepository
+	rabbitmqProducer producer.Producer
+}
+
+func NewExpireStockReservationsHandler(log logger.Logger, cfg *config.Config, stockRepository contracts.StockRepository, rabbitmqProducer producer.Producer) *ExpireStockReservationsHandler {
+	return &ExpireStockReservationsHandler{log: log, cfg: cfg, stockRepository: stockRepository, rabbitmqProducer: rabbitmqProducer}
+}
+
+func (c *ExpireStockReservationsHandler) Handle(ctx context.Context, command *ExpireStockReservations) (*mediatr.Unit, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ExpireStockReservationsHandler.Handle")
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	orderIds, err := c.stockRepository.GetExpiredReservationOrderIds(ctx, command.Now, consts.ExpiredStockReservationsBatchSize)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[ExpireStockReservationsHandler_Handle.GetExpiredReservationOrderIds] error in loading the expired reservations"))
+	}
+
+	for _, orderId := range orderIds {
+		// a reservation which is changed concurrently stays reserved and is expired in the next poll
+		reservations, err := c.stockRepository.ReleaseStock(ctx, orderId, models.StockExpired)
+		if err != nil {
+			c.log.Errorf("[ExpireStockReservationsHandler.Handle] error in expiring the stock reservation of order '%s', err: %v", orderId, err)
+			continue
+		}
+		if len(reservations) == 0 {
+			continue
+		}
+
+		reservationExpired := integrationEvents.NewStockReservationExpiredV1(orderId)
+
+		err = c.rabbitmqProducer.Publish(ctx, reservationExpired, nil)
+		if err != nil {
+			return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[ExpireStockReservationsHandler_Handle.PublishMessage] error in publishing StockReservationExpired integration event"))
+		}
+
+		c.log.Infow(fmt.Sprintf("[ExpireStockReservationsHandler.Handle] stock reservation of order '%s' expired", orderId), logger.Fields{"OrderId": orderId, "MessageId": reservationExpired.MessageId})
+	}
+
+	return &mediatr.Unit{}, nil
+}
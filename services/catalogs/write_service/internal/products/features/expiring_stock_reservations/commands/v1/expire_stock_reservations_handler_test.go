@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/inmemory"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
+	integrationEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/expiring_stock_reservations/events/integration/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/shared/test_fixtures/integration"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Expire_Stock_Reservations_Command_Handler(t *testing.T) {
+	test.SkipCI(t)
+	fixture := integration.NewIntegrationTestFixture()
+	defer fixture.Cleanup()
+
+	ctx := context.Background()
+	transport := inmemory.NewInMemoryTransport()
+	producer := inmemory.NewInMemoryProducer(transport, fixture.EventSerializer, fixture.Log)
+
+	err := mediatr.RegisterRequestHandler[*ExpireStockReservations, *mediatr.Unit](NewExpireStockReservationsHandler(fixture.Log, fixture.Cfg, fixture.StockRepository, producer))
+	require.NoError(t, err)
+
+	expiredHandler := &reservationExpiredHandler{}
+	require.NoError(t, inmemory.NewInMemoryConsumer[*integrationEvents.StockReservationExpiredV1](transport, expiredHandler, fixture.EventSerializer, fixture.Log).Consume(ctx))
+
+	product, err := fixture.ProductRepository.CreateProduct(ctx, &models.Product{
+		ProductId:         uuid.NewV4(),
+		Name:              gofakeit.Name(),
+		Description:       gofakeit.AdjectiveDescriptive(),
+		Price:             domain.MustParseMoney("10", "USD"),
+		InventoryQuantity: 5,
+		CreatedAt:         time.Now(),
+	})
+	require.NoError(t, err)
+
+	orderId := uuid.NewV4()
+	err = fixture.StockRepository.ReserveStock(ctx, []*models.StockReservation{{
+		StockReservationId: uuid.NewV4(),
+		OrderId:            orderId,
+		ProductId:          product.ProductId,
+		Quantity:           2,
+		Status:             models.StockReserved,
+		ExpiresAt:          time.Now().Add(-time.Minute),
+	}})
+	require.NoError(t, err)
+
+	_, err = mediatr.Send[*ExpireStockReservations, *mediatr.Unit](ctx, NewExpireStockReservations())
+	require.NoError(t, err)
+
+	reservations, err := fixture.StockRepository.GetOrderReservations(ctx, orderId)
+	require.NoError(t, err)
+	require.Len(t, reservations, 1)
+	assert.Equal(t, models.StockExpired, reservations[0].Status)
+
+	releasedProduct, err := fixture.ProductRepository.GetProductById(ctx, product.ProductId)
+	require.NoError(t, err)
+	assert.Equal(t, 0, releasedProduct.ReservedQuantity)
+	assert.Equal(t, 5, releasedProduct.InventoryQuantity)
+
+	assert.Contains(t, expiredHandler.orderIds, orderId)
+}
+
+type reservationExpiredHandler struct {
+	orderIds []uuid.UUID
+}
+
+func (h *reservationExpiredHandler) Handle(ctx context.Context, consumeContext types.IMessageConsumeContext[*integrationEvents.StockReservationExpiredV1]) error {
+	h.orderIds = append(h.orderIds, consumeContext.Message().OrderId)
+	return nil
+}
@@ -0,0 +1,15 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+type StockReservationExpiredV1 struct {
+	*types.Message
+	OrderId uuid.UUID `json:"orderId"`
+}
+
+func NewStockReservationExpiredV1(orderId uuid.UUID) *StockReservationExpiredV1 {
+	return &StockReservationExpiredV1{OrderId: orderId, Message: types.NewMessage(uuid.NewV4().String())}
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	uuid "github.com/satori/go.uuid"
+)
+
+type ReleaseStock struct {
+	OrderId uuid.UUID `validate:"required"`
+}
+
+func NewReleaseStock(orderId uuid.UUID) *ReleaseStock {
+	return &ReleaseStock{OrderId: orderId}
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/consts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/exceptions"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type ReleaseStockHandler struct {
+	log             logger.Logger
+	cfg             *config.Config
+	stockRepository contracts.StockRepository
+}
+
+func NewReleaseStockHandler(log logger.Logger, cfg *config.Config, stockRepository contracts.StockRepository) *ReleaseStockHandler {
+	return &ReleaseStockHandler{log: log, cfg: cfg, stockRepository: stockRepository}
+}
+
+func (c *ReleaseStockHandler) Handle(ctx context.Context, command *ReleaseStock) (*mediatr.Unit, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ReleaseStockHandler.Handle")
+	span.LogFields(log.String("OrderId", command.OrderId.String()))
+	defer span.Finish()
+
+	var reservations []*models.StockReservation
+	var err error
+	for attempt := 1; ; attempt++ {
+		reservations, err = c.stockRepository.ReleaseStock(ctx, command.OrderId, models.StockReleased)
+		if !exceptions.IsStockConcurrencyError(err) || attempt == consts.MaxStockReservationRetries {
+			break
+		}
+		c.log.Warnf("[ReleaseStockHandler.Handle] stock of order '%s' is changed concurrently, retrying, attempt: %d", command.OrderId, attempt)
+	}
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[ReleaseStockHandler_Handle.ReleaseStock] error in releasing the stock in the repository"))
+	}
+
+	// an order which its reservation is failed or expired has no reserved stock
+	if len(reservations) == 0 {
+		c.log.Infow(fmt.Sprintf("[ReleaseStockHandler.Handle] order '%s' has no reserved stock", command.OrderId), logger.Fields{"OrderId": command.OrderId})
+		return &mediatr.Unit{}, nil
+	}
+
+	c.log.Infow(fmt.Sprintf("[ReleaseStockHandler.Handle] stock of order '%s' released", command.OrderId), logger.Fields{"OrderId": command.OrderId})
+
+	return &mediatr.Unit{}, nil
+}
@@ -0,0 +1,11 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+)
+
+// OrderCanceledV1 is the part of the orders service OrderCanceled integration event which is needed for releasing the stock
+type OrderCanceledV1 struct {
+	*types.Message
+	OrderId string `json:"orderId"`
+}
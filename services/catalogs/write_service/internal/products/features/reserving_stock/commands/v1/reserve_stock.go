@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/reserving_stock/dtos"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type ReserveStock struct {
+	OrderId   uuid.UUID            `validate:"required"`
+	Items     []*dtos.StockItemDto `validate:"required,min=1,dive"`
+	ExpiresAt time.Time            `validate:"required"`
+}
+
+func NewReserveStock(orderId uuid.UUID, items []*dtos.StockItemDto, expiresAt time.Time) *ReserveStock {
+	return &ReserveStock{OrderId: orderId, Items: items, ExpiresAt: expiresAt}
+}
@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/producer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/consts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/exceptions"
+	integrationEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/reserving_stock/events/integration/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ReserveStockHandler struct {
+	log              logger.Logger
+	cfg              *config.Config
+	stockRepository  contracts.StockRepository
+	rabbitmqProducer producer.Producer
+}
+
+func NewReserveStockHandler(log logger.Logger, cfg *config.Config, stockRepository contracts.StockRepository, rabbitmqProducer producer.Producer) *ReserveStockHandler {
+	return &ReserveStockHandler{log: log, cfg: cfg, stockRepository: stockRepository, rabbitmqProducer: rabbitmqProducer}
+}
+
+func (c *ReserveStockHandler) Handle(ctx context.Context, command *ReserveStock) (*mediatr.Unit, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ReserveStockHandler.Handle")
+	span.LogFields(log.String("OrderId", command.OrderId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = c.stockRepository.ReserveStock(ctx, c.newReservations(command))
+		if !exceptions.IsStockConcurrencyError(err) || attempt == consts.MaxStockReservationRetries {
+			break
+		}
+		c.log.Warnf("[ReserveStockHandler.Handle] stock of order '%s' is changed concurrently, retrying the reservation, attempt: %d", command.OrderId, attempt)
+	}
+
+	// a redelivered OrderSubmitted message doesn't reserve the stock of the order twice, but the reservation may not be published yet
+	if exceptions.IsStockAlreadyReservedError(err) {
+		return c.republishReserved(ctx, command.OrderId)
+	}
+	if exceptions.IsInsufficientStockError(err) || customErrors.IsNotFoundError(err) {
+		return c.publishReservationFailed(ctx, command.OrderId, customErrors.GetCustomError(err).Message())
+	}
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[ReserveStockHandler_Handle.ReserveStock] error in reserving the stock in the repository"))
+	}
+
+	stockReserved := integrationEvents.NewStockReservedV1(command.OrderId, command.ExpiresAt)
+
+	err = c.rabbitmqProducer.Publish(ctx, stockReserved, nil)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[ReserveStockHandler_Handle.PublishMessage] error in publishing StockReserved integration event"))
+	}
+
+	c.log.Infow(fmt.Sprintf("[ReserveStockHandler.Handle] stock of order '%s' reserved until %s", command.OrderId, command.ExpiresAt), logger.Fields{"OrderId": command.OrderId, "MessageId": stockReserved.MessageId})
+
+	return &mediatr.Unit{}, nil
+}
+
+// republishReserved publishes the StockReserved event of the open reservations of an order again, the reservations which are committed, released or
+// expired are already followed by their own events
+func (c *ReserveStockHandler) republishReserved(ctx context.Context, orderId uuid.UUID) (*mediatr.Unit, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ReserveStockHandler.republishReserved")
+	defer span.Finish()
+
+	reservations, err := c.stockRepository.GetOrderReservations(ctx, orderId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[ReserveStockHandler_republishReserved.GetOrderReservations] error in loading the order reservations"))
+	}
+
+	for _, reservation := range reservations {
+		if reservation.Status != models.StockReserved {
+			continue
+		}
+
+		stockReserved := integrationEvents.NewStockReservedV1(orderId, reservation.ExpiresAt)
+		err = c.rabbitmqProducer.Publish(ctx, stockReserved, nil)
+		if err != nil {
+			return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[ReserveStockHandler_republishReserved.PublishMessage] error in publishing StockReserved integration event"))
+		}
+		c.log.Infow(fmt.Sprintf("[ReserveStockHandler.Handle] stock of order '%s' is already reserved, StockReserved is published again", orderId), logger.Fields{"OrderId": orderId, "MessageId": stockReserved.MessageId})
+
+		return &mediatr.Unit{}, nil
+	}
+
+	c.log.Infow(fmt.Sprintf("[ReserveStockHandler.Handle] stock reservation of order '%s' is already closed", orderId), logger.Fields{"OrderId": orderId})
+
+	return &mediatr.Unit{}, nil
+}
+
+func (c *ReserveStockHandler) publishReservationFailed(ctx context.Context, orderId uuid.UUID, reason string) (*mediatr.Unit, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ReserveStockHandler.publishReservationFailed")
+	defer span.Finish()
+
+	reservationFailed := integrationEvents.NewStockReservationFailedV1(orderId, reason)
+
+	err := c.rabbitmqProducer.Publish(ctx, reservationFailed, nil)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[ReserveStockHandler_publishReservationFailed.PublishMessage] error in publishing StockReservationFailed integration event"))
+	}
+
+	c.log.Infow(fmt.Sprintf("[ReserveStockHandler.Handle] stock of order '%s' couldn't be reserved, reason: %s", orderId, reason), logger.Fields{"OrderId": orderId, "MessageId": reservationFailed.MessageId})
+
+	return &mediatr.Unit{}, nil
+}
+
+// newReservations creates one reservation per product, the quantities of the repeated products of the order are added up
+func (c *ReserveStockHandler) newReservations(command *ReserveStock) []*models.StockReservation {
+	reservations := make([]*models.StockReservation, 0, len(command.Items))
+	byProduct := make(map[uuid.UUID]*models.StockReservation, len(command.Items))
+	for _, item := range command.Items {
+		if reservation, ok := byProduct[item.ProductId]; ok {
+			reservation.Quantity += item.Quantity
+			continue
+		}
+
+		reservation := &models.StockReservation{
+			StockReservationId: uuid.NewV4(),
+			OrderId:            command.OrderId,
+			ProductId:          item.ProductId,
+			Quantity:           item.Quantity,
+			Status:             models.StockReserved,
+			ExpiresAt:          command.ExpiresAt,
+		}
+		byProduct[item.ProductId] = reservation
+		reservations = append(reservations, reservation)
+	}
+
+	return reservations
+}
@@ -0,0 +1,8 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+type StockItemDto struct {
+	ProductId uuid.UUID `json:"productId" validate:"required"`
+	Quantity  int       `json:"quantity" validate:"gt=0"`
+}
@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+)
+
+// OrderSubmittedV1 is the part of the orders service OrderSubmitted integration event which is needed for reserving the stock
+type OrderSubmittedV1 struct {
+	*types.Message
+	OrderId   string             `json:"orderId"`
+	ShopItems []*OrderShopItemV1 `json:"shopItems"`
+}
+
+type OrderShopItemV1 struct {
+	ProductId string `json:"productId"`
+	Quantity  uint64 `json:"quantity"`
+}
@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/delivery"
+	reservingStockV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/reserving_stock/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/reserving_stock/dtos"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+type orderSubmittedConsumer struct {
+	*delivery.ProductConsumersBase
+}
+
+func NewOrderSubmittedConsumer(productConsumerBase *delivery.ProductConsumersBase) *orderSubmittedConsumer {
+	return &orderSubmittedConsumer{productConsumerBase}
+}
+
+func (c *orderSubmittedConsumer) Handle(ctx context.Context, consumeContext types.IMessageConsumeContext[*OrderSubmittedV1]) error {
+	if consumeContext.Message() == nil {
+		return nil
+	}
+	span, ctx := opentracing.StartSpanFromContext(ctx, "orderSubmittedConsumer.Handle")
+	span.LogFields(log.Object("Message", consumeContext.Created()))
+	defer span.Finish()
+
+	submittedOrder := consumeContext.Message()
+
+	orderId, err := uuid.FromString(submittedOrder.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[orderSubmittedConsumer_Handle.uuid.FromString] error in the converting order uuid")
+		c.Log.Errorf(fmt.Sprintf("[orderSubmittedConsumer_Handle.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		c.CommitErrMessage()
+
+		return badRequestErr
+	}
+
+	items := make([]*dtos.StockItemDto, 0, len(submittedOrder.ShopItems))
+	for _, shopItem := range submittedOrder.ShopItems {
+		productId, err := uuid.FromString(shopItem.ProductId)
+		if err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[orderSubmittedConsumer_Handle.uuid.FromString] error in the converting product uuid")
+			c.Log.Errorf(fmt.Sprintf("[orderSubmittedConsumer_Handle.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			c.CommitErrMessage()
+
+			return badRequestErr
+		}
+		items = append(items, &dtos.StockItemDto{ProductId: productId, Quantity: int(shopItem.Quantity)})
+	}
+
+	command := reservingStockV1.NewReserveStock(orderId, items, time.Now().Add(c.Cfg.StockReservation.Ttl))
+	if err := c.Validator.StructCtx(ctx, command); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[orderSubmittedConsumer_Handle.StructCtx] command validation failed")
+		c.Log.Errorf(fmt.Sprintf("[orderSubmittedConsumer_Handle.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr)))
+		c.CommitErrMessage()
+
+		return validationErr
+	}
+
+	_, err = mediatr.Send[*reservingStockV1.ReserveStock, *mediatr.Unit](ctx, command)
+	if err != nil {
+		err = errors.WithMessage(err, "[orderSubmittedConsumer_Handle.Send] error in sending ReserveStock")
+		c.Log.Errorw(fmt.Sprintf("[orderSubmittedConsumer_Handle.Send] id: {%s}, err: {%v}", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"OrderId": command.OrderId})
+		c.CommitErrMessage()
+
+		return err
+	}
+	c.CommitMessage()
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/inmemory"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/delivery"
+	reservingStockV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/reserving_stock/commands/v1"
+	integrationEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/reserving_stock/events/integration/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/shared/test_fixtures/integration"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Order_Submitted_Consumer(t *testing.T) {
+	test.SkipCI(t)
+	fixture := integration.NewIntegrationTestFixture()
+	defer fixture.Cleanup()
+
+	// the order events and the stock events are delivered by the in-process transport, so the flow is tested without the broker
+	ctx := context.Background()
+	transport := inmemory.NewInMemoryTransport()
+	producer := inmemory.NewInMemoryProducer(transport, fixture.EventSerializer, fixture.Log)
+
+	err := mediatr.RegisterRequestHandler[*reservingStockV1.ReserveStock, *mediatr.Unit](reservingStockV1.NewReserveStockHandler(fixture.Log, fixture.Cfg, fixture.StockRepository, producer))
+	require.NoError(t, err)
+
+	orderSubmittedConsumer := inmemory.NewInMemoryConsumer[*OrderSubmittedV1](transport, NewOrderSubmittedConsumer(delivery.NewProductConsumersBase(fixture.InfrastructureConfiguration)), fixture.EventSerializer, fixture.Log)
+	require.NoError(t, orderSubmittedConsumer.Consume(ctx))
+
+	stockReserved := &stockEventsHandler[*integrationEvents.StockReservedV1]{}
+	require.NoError(t, inmemory.NewInMemoryConsumer[*integrationEvents.StockReservedV1](transport, stockReserved, fixture.EventSerializer, fixture.Log).Consume(ctx))
+	reservationFailed := &stockEventsHandler[*integrationEvents.StockReservationFailedV1]{}
+	require.NoError(t, inmemory.NewInMemoryConsumer[*integrationEvents.StockReservationFailedV1](transport, reservationFailed, fixture.EventSerializer, fixture.Log).Consume(ctx))
+
+	newProduct := func(inventoryQuantity int) *models.Product {
+		product, err := fixture.ProductRepository.CreateProduct(ctx, &models.Product{
+			ProductId:         uuid.NewV4(),
+			Name:              gofakeit.Name(),
+			Description:       gofakeit.AdjectiveDescriptive(),
+			Price:             domain.MustParseMoney("10", "USD"),
+			InventoryQuantity: inventoryQuantity,
+			CreatedAt:         time.Now(),
+		})
+		require.NoError(t, err)
+
+		return product
+	}
+
+	publishOrderSubmitted := func(orderId uuid.UUID, productId uuid.UUID, quantity uint64) {
+		err := producer.Publish(ctx, &OrderSubmittedV1{
+			Message:   types.NewMessage(uuid.NewV4().String()),
+			OrderId:   orderId.String(),
+			ShopItems: []*OrderShopItemV1{{ProductId: productId.String(), Quantity: quantity}},
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	submitOrder := func(productId uuid.UUID, quantity uint64) uuid.UUID {
+		orderId := uuid.NewV4()
+		publishOrderSubmitted(orderId, productId, quantity)
+
+		return orderId
+	}
+
+	t.Run("submitted order reserves the stock", func(t *testing.T) {
+		product := newProduct(10)
+		orderId := submitOrder(product.ProductId, 3)
+
+		reservations, err := fixture.StockRepository.GetOrderReservations(ctx, orderId)
+		require.NoError(t, err)
+		require.Len(t, reservations, 1)
+		assert.Equal(t, models.StockReserved, reservations[0].Status)
+		assert.Equal(t, 3, reservations[0].Quantity)
+
+		reservedProduct, err := fixture.ProductRepository.GetProductById(ctx, product.ProductId)
+		require.NoError(t, err)
+		assert.Equal(t, 3, reservedProduct.ReservedQuantity)
+		assert.Equal(t, 7, reservedProduct.AvailableQuantity())
+
+		require.NotEmpty(t, stockReserved.messages)
+		assert.Equal(t, orderId, stockReserved.messages[len(stockReserved.messages)-1].OrderId)
+	})
+
+	t.Run("redelivered order submitted republishes the reservation", func(t *testing.T) {
+		product := newProduct(10)
+		orderId := submitOrder(product.ProductId, 3)
+		publishedCount := len(stockReserved.messages)
+
+		publishOrderSubmitted(orderId, product.ProductId, 3)
+
+		reservations, err := fixture.StockRepository.GetOrderReservations(ctx, orderId)
+		require.NoError(t, err)
+		assert.Len(t, reservations, 1)
+
+		reservedProduct, err := fixture.ProductRepository.GetProductById(ctx, product.ProductId)
+		require.NoError(t, err)
+		assert.Equal(t, 3, reservedProduct.ReservedQuantity)
+
+		require.Len(t, stockReserved.messages, publishedCount+1)
+		assert.Equal(t, orderId, stockReserved.messages[publishedCount].OrderId)
+	})
+
+	t.Run("insufficient stock fails the reservation", func(t *testing.T) {
+		product := newProduct(2)
+		orderId := submitOrder(product.ProductId, 3)
+
+		reservations, err := fixture.StockRepository.GetOrderReservations(ctx, orderId)
+		require.NoError(t, err)
+		assert.Empty(t, reservations)
+
+		require.NotEmpty(t, reservationFailed.messages)
+		failed := reservationFailed.messages[len(reservationFailed.messages)-1]
+		assert.Equal(t, orderId, failed.OrderId)
+		assert.NotEmpty(t, failed.Reason)
+	})
+}
+
+type stockEventsHandler[T types.IMessage] struct {
+	messages []T
+}
+
+func (h *stockEventsHandler[T]) Handle(ctx context.Context, consumeContext types.IMessageConsumeContext[T]) error {
+	h.messages = append(h.messages, consumeContext.Message())
+	return nil
+}
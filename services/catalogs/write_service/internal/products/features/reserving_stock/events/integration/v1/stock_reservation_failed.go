@@ -0,0 +1,16 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+type StockReservationFailedV1 struct {
+	*types.Message
+	OrderId uuid.UUID `json:"orderId"`
+	Reason  string    `json:"reason"`
+}
+
+func NewStockReservationFailedV1(orderId uuid.UUID, reason string) *StockReservationFailedV1 {
+	return &StockReservationFailedV1{OrderId: orderId, Reason: reason, Message: types.NewMessage(uuid.NewV4().String())}
+}
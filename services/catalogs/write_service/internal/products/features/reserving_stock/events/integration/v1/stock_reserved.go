@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type StockReservedV1 struct {
+	*types.Message
+	OrderId   uuid.UUID `json:"orderId"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func NewStockReservedV1(orderId uuid.UUID, expiresAt time.Time) *StockReservedV1 {
+	return &StockReservedV1{OrderId: orderId, ExpiresAt: expiresAt, Message: types.NewMessage(uuid.NewV4().String())}
+}
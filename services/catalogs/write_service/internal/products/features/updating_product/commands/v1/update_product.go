@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	uuid "github.com/satori/go.uuid"
 	"time"
 )
@@ -9,10 +10,12 @@ type UpdateProduct struct {
 	ProductID   uuid.UUID `validate:"required"`
 	Name        string    `validate:"required,gte=0,lte=255"`
 	Description string    `validate:"required,gte=0,lte=5000"`
-	Price       float64   `validate:"required,gte=0"`
-	UpdatedAt   time.Time `validate:"required"`
+	Price       domain.Money
+	// InventoryQuantity is the stock on hand, it can't be less than the reserved stock of the product
+	InventoryQuantity int       `validate:"gte=0"`
+	UpdatedAt         time.Time `validate:"required"`
 }
 
-func NewUpdateProduct(productID uuid.UUID, name string, description string, price float64) *UpdateProduct {
-	return &UpdateProduct{ProductID: productID, Name: name, Description: description, Price: price, UpdatedAt: time.Now()}
+func NewUpdateProduct(productID uuid.UUID, name string, description string, price domain.Money, inventoryQuantity int) *UpdateProduct {
+	return &UpdateProduct{ProductID: productID, Name: name, Description: description, Price: price, InventoryQuantity: inventoryQuantity, UpdatedAt: time.Now()}
 }
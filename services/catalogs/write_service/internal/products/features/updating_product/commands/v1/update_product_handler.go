@@ -12,6 +12,7 @@ import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/config"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/contracts"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/dto"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/exceptions"
 	v1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/updating_product/events/integration/v1"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/log"
@@ -34,6 +35,10 @@ func (c *UpdateProductHandler) Handle(ctx context.Context, command *UpdateProduc
 	span.LogFields(log.Object("Command", command))
 	defer span.Finish()
 
+	if !command.Price.IsPositive() {
+		return nil, tracing.TraceWithErr(span, customErrors.NewBadRequestError(fmt.Sprintf("[UpdateProductHandler_Handle] product price should be positive, price: %s", command.Price)))
+	}
+
 	product, err := c.pgRepo.GetProductById(ctx, command.ProductID)
 	if err != nil {
 		return nil, customErrors.NewApplicationErrorWrap(err, fmt.Sprintf("[UpdateProductHandler_Handle.GetProductById] error in fetching product with id %s", command.ProductID))
@@ -43,12 +48,21 @@ func (c *UpdateProductHandler) Handle(ctx context.Context, command *UpdateProduc
 		return nil, customErrors.NewNotFoundErrorWrap(err, fmt.Sprintf("[UpdateProductHandler_Handle.GetProductById] product with id %s not found", command.ProductID))
 	}
 
+	if command.InventoryQuantity < product.ReservedQuantity {
+		return nil, tracing.TraceWithErr(span, customErrors.NewBadRequestError(fmt.Sprintf("[UpdateProductHandler_Handle] inventory quantity %d is less than the reserved quantity %d of product %s", command.InventoryQuantity, product.ReservedQuantity, command.ProductID)))
+	}
+
 	product.Name = command.Name
 	product.Price = command.Price
 	product.Description = command.Description
+	product.InventoryQuantity = command.InventoryQuantity
 	product.UpdatedAt = command.UpdatedAt
 
 	updatedProduct, err := c.pgRepo.UpdateProduct(ctx, product)
+	if exceptions.IsStockConcurrencyError(err) {
+		// the stock is reserved in the meantime, the client should reload the product and retry the update
+		return nil, tracing.TraceWithErr(span, err)
+	}
 	if err != nil {
 		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[UpdateProductHandler_Handle.UpdateProduct] error in updating product in the repository"))
 	}
@@ -50,7 +50,7 @@ func (ep *updateProductEndpoint) handler() echo.HandlerFunc {
 			return badRequestErr
 		}
 
-		command := v1.NewUpdateProduct(request.ProductID, request.Name, request.Description, request.Price)
+		command := v1.NewUpdateProduct(request.ProductID, request.Name, request.Description, request.Price, request.InventoryQuantity)
 
 		if err := ep.Validator.StructCtx(ctx, command); err != nil {
 			validationErr := customErrors.NewValidationErrorWrap(err, "[updateProductEndpoint_handler.StructCtx] command validation failed")
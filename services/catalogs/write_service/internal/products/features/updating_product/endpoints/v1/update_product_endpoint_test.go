@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/gavv/httpexpect/v2"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/delivery"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/updating_product"
@@ -28,9 +29,10 @@ func Test_Update_Product_E2E(t *testing.T) {
 	if err != nil {
 		return
 	}
+	price, _ := domain.NewMoneyFromFloat(gofakeit.Price(100, 1000), domain.USD)
 	request := updating_product.UpdateProductRequestDto{
 		Description: gofakeit.AdjectiveDescriptive(),
-		Price:       gofakeit.Price(100, 1000),
+		Price:       price,
 		Name:        gofakeit.Name(),
 	}
 
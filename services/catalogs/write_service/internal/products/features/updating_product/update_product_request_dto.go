@@ -1,12 +1,16 @@
 package updating_product
 
-import uuid "github.com/satori/go.uuid"
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+)
 
 // https://echo.labstack.com/guide/binding/
 
 type UpdateProductRequestDto struct {
-	ProductID   uuid.UUID `json:"-" param:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Price       float64   `json:"price"`
+	ProductID         uuid.UUID    `json:"-" param:"id"`
+	Name              string       `json:"name"`
+	Description       string       `json:"description"`
+	Price             domain.Money `json:"price"`
+	InventoryQuantity int          `json:"inventoryQuantity"`
 }
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/serializer/jsonSerializer"
 	"time"
 
@@ -9,12 +10,22 @@ import (
 
 // Product model
 type Product struct {
-	ProductId   uuid.UUID `json:"productId" gorm:"primaryKey"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Price       float64   `json:"price"`
-	CreatedAt   time.Time `json:"createdAt"` //https://gorm.io/docs/models.html#gorm-Model
-	UpdatedAt   time.Time `json:"updatedAt"` //https://gorm.io/docs/models.html#gorm-Model
+	ProductId   uuid.UUID    `json:"productId" gorm:"primaryKey"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Price       domain.Money `json:"price" gorm:"embedded;embeddedPrefix:price_"`
+	// InventoryQuantity is the stock on hand, ReservedQuantity is the part of it which is reserved by the unpaid orders
+	InventoryQuantity int `json:"inventoryQuantity"`
+	ReservedQuantity  int `json:"reservedQuantity"`
+	// Version is incremented by each stock change, the stock is updated only when the version is not changed since the product is loaded
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"` //https://gorm.io/docs/models.html#gorm-Model
+	UpdatedAt time.Time `json:"updatedAt"` //https://gorm.io/docs/models.html#gorm-Model
+}
+
+// AvailableQuantity is the stock which can be reserved by the new orders
+func (p *Product) AvailableQuantity() int {
+	return p.InventoryQuantity - p.ReservedQuantity
 }
 
 func (p *Product) String() string {
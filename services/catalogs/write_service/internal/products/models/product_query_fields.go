@@ -0,0 +1,10 @@
+package models
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+
+// ProductQueryFields maps the fields of the product list queries to the columns of the products table
+var ProductQueryFields = utils.QueryFields{
+	"name":      {Name: "name", Type: utils.StringField, Sortable: true},
+	"price":     {Name: "price_amount", Type: utils.NumberField, Sortable: true},
+	"createdAt": {Name: "created_at", Type: utils.DateField, Sortable: true},
+}
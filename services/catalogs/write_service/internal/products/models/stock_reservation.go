@@ -0,0 +1,26 @@
+package models
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+const (
+	StockReserved  = "reserved"
+	StockCommitted = "committed"
+	StockReleased  = "released"
+	StockExpired   = "expired"
+)
+
+// StockReservation is the quantity of a product reserved for an order, it is committed when the order is paid and released when the order
+// is canceled or the reservation expires. An order has one reservation per product
+type StockReservation struct {
+	StockReservationId uuid.UUID `json:"stockReservationId" gorm:"primaryKey"`
+	OrderId            uuid.UUID `json:"orderId" gorm:"uniqueIndex:idx_stock_reservations_order_product"`
+	ProductId          uuid.UUID `json:"productId" gorm:"uniqueIndex:idx_stock_reservations_order_product"`
+	Quantity           int       `json:"quantity"`
+	Status             string    `json:"status" gorm:"index:idx_stock_reservations_expiry"`
+	ExpiresAt          time.Time `json:"expiresAt" gorm:"index:idx_stock_reservations_expiry"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
+}
@@ -7,7 +7,7 @@ import (
 
 func (c *catalogsServiceConfigurator) migrateCatalogs(gorm *gormPostgres.Gorm) error {
 	// or we could use `gorm.Migrate()`
-	err := gorm.DB.AutoMigrate(&models.Product{})
+	err := gorm.DB.AutoMigrate(&models.Product{}, &models.StockReservation{})
 	if err != nil {
 		return err
 	}
@@ -6,9 +6,13 @@ import (
 	"github.com/go-playground/validator"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/msgpack"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/protobuf"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/gormPostgres"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/payload"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/producer"
 	postgres "github.com/mehdihadeli/store-golang-microservice-sample/pkg/postgres_pgx"
 	rabbitmqProducer "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/producer"
@@ -31,6 +35,8 @@ type InfrastructureConfiguration struct {
 	CustomMiddlewares  cutomMiddlewares.CustomMiddlewares
 	RabbitMQConnection types.IConnection
 	EventSerializer    serializer.EventSerializer
+	MessageRegistry    *messageRegistry.MessageRegistry
+	PayloadOptions     *payload.PayloadOptions
 	Producer           producer.Producer
 	Consumers          []consumer.Consumer
 }
@@ -75,7 +81,8 @@ func (ic *infrastructureConfigurator) ConfigInfrastructures(ctx context.Context)
 	cleanup = append(cleanup, postgresCleanup)
 	infrastructure.Pgx = pgx
 
-	infrastructure.EventSerializer = json.NewJsonEventSerializer()
+	// json is the default content type, the other serializers are negotiated by the message content type
+	infrastructure.EventSerializer = serializer.NewEventSerializerRegistry(json.NewJsonEventSerializer(), protobuf.NewProtobufEventSerializer(), msgpack.NewMsgPackEventSerializer())
 
 	connection, err := types.NewRabbitMQConnection(ctx, ic.cfg.RabbitMQ)
 	if err != nil {
@@ -86,7 +93,20 @@ func (ic *infrastructureConfigurator) ConfigInfrastructures(ctx context.Context)
 		_ = connection.Close()
 	})
 
-	mqProducer, err := rabbitmqProducer.NewRabbitMQProducer(connection, func(builder *options.RabbitMQProducerOptionsBuilder) {}, ic.log, infrastructure.EventSerializer)
+	// large product payloads are compressed or claim-checked, write service has no mongo so only the filesystem blob store can be used
+	payloadOptions, err := payload.NewPayloadOptions(ic.cfg.RabbitMQ.Payload, nil)
+	if err != nil {
+		return nil, err, nil
+	}
+	infrastructure.PayloadOptions = payloadOptions
+
+	// message contracts are registered by the modules, the producer stamps their wire names into the message type
+	infrastructure.MessageRegistry = messageRegistry.NewMessageRegistry()
+
+	mqProducer, err := rabbitmqProducer.NewRabbitMQProducer(connection, func(builder *options.RabbitMQProducerOptionsBuilder) {
+		builder.WithPayloadOptions(payloadOptions)
+		builder.WithMessageRegistry(infrastructure.MessageRegistry)
+	}, ic.log, infrastructure.EventSerializer)
 	if err != nil {
 		return nil, err, nil
 	}
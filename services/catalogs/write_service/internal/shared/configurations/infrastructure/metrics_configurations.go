@@ -2,6 +2,7 @@ package infrastructure
 
 import (
 	"fmt"
+	rabbitmqMetrics "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/metrics"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -32,6 +33,8 @@ type CatalogsServiceMetrics struct {
 	CreateProductKafkaMessages prometheus.Counter
 	UpdateProductKafkaMessages prometheus.Counter
 	DeleteProductKafkaMessages prometheus.Counter
+
+	RabbitMQ *rabbitmqMetrics.RabbitMQMetrics
 }
 
 func (ic *infrastructureConfigurator) configCatalogsMetrics() *CatalogsServiceMetrics {
@@ -117,5 +120,6 @@ func (ic *infrastructureConfigurator) configCatalogsMetrics() *CatalogsServiceMe
 			Name: fmt.Sprintf("%s_error_http_requests_total", cfg.ServiceName),
 			Help: "The total number of error http requests",
 		}),
+		RabbitMQ: rabbitmqMetrics.NewRabbitMQMetrics(cfg.ServiceName),
 	}
 }
@@ -78,7 +78,7 @@ func (s *Server) Run() error {
 	}
 
 	backgroundWorkers := webWoker.NewWorkersRunner([]webWoker.Worker{
-		workers.NewMetricsWorker(infrastructureConfigurations),
+		workers.NewRabbitMQWorkerWorker(infrastructureConfigurations), workers.NewMetricsWorker(infrastructureConfigurations), workers.NewStockReservationExpiryWorker(infrastructureConfigurations), workers.NewBlobRetentionWorker(infrastructureConfigurations),
 	})
 
 	workersErr := backgroundWorkers.Start(ctx)
@@ -9,6 +9,7 @@ import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/config"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/configurations/mappings"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/configurations/mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/configurations/messages"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/data/repositories"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/shared/configurations/infrastructure"
 	"net/http/httptest"
@@ -44,7 +45,9 @@ func NewE2ETestFixture() *E2ETestFixture {
 
 	productRep := repositories.NewPostgresProductRepository(infrastructures.Log, cfg, infrastructures.Gorm.DB)
 
-	err := mediatr.ConfigProductsMediator(productRep, infrastructures)
+	stockRep := repositories.NewPostgresStockRepository(infrastructures.Log, cfg, infrastructures.Gorm.DB)
+
+	err := mediatr.ConfigProductsMediator(productRep, stockRep, infrastructures)
 	if err != nil {
 		cancel()
 		return nil
@@ -56,6 +59,12 @@ func NewE2ETestFixture() *E2ETestFixture {
 		return nil
 	}
 
+	err = messages.ConfigMessageContracts(infrastructures.MessageRegistry)
+	if err != nil {
+		cancel()
+		return nil
+	}
+
 	grpcServer := grpcServer.NewGrpcServer(cfg.GRPC, defaultLogger.Logger)
 	httpServer := httptest.NewServer(echo)
 
@@ -15,6 +15,7 @@ import (
 type IntegrationTestFixture struct {
 	*infrastructure.InfrastructureConfiguration
 	ProductRepository contracts.ProductRepository
+	StockRepository   contracts.StockRepository
 	workersRunner     *webWoker.WorkersRunner
 	ctx               context.Context
 	cancel            context.CancelFunc
@@ -29,6 +30,7 @@ func NewIntegrationTestFixture() *IntegrationTestFixture {
 	infrastructures, _, cleanup := c.ConfigInfrastructures(context.Background())
 
 	productRep := repositories.NewPostgresProductRepository(infrastructures.Log, cfg, infrastructures.Gorm.DB)
+	stockRep := repositories.NewPostgresStockRepository(infrastructures.Log, cfg, infrastructures.Gorm.DB)
 
 	err := mappings.ConfigureMappings()
 	if err != nil {
@@ -43,6 +45,7 @@ func NewIntegrationTestFixture() *IntegrationTestFixture {
 		},
 		InfrastructureConfiguration: infrastructures,
 		ProductRepository:           productRep,
+		StockRepository:             stockRep,
 		ctx:                         ctx,
 		cancel:                      cancel,
 	}
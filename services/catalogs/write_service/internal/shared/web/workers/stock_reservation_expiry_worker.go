@@ -0,0 +1,44 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
+	expiringStockReservationsV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/products/features/expiring_stock_reservations/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/catalogs/write_service/internal/shared/configurations/infrastructure"
+)
+
+const defaultExpiryPollInterval = 30 * time.Second
+
+// NewStockReservationExpiryWorker releases the expired stock reservations periodically until the worker stops
+func NewStockReservationExpiryWorker(infra *infrastructure.InfrastructureConfiguration) web.Worker {
+	pollInterval := infra.Cfg.StockReservation.ExpiryPollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultExpiryPollInterval
+	}
+	stop := make(chan struct{})
+
+	return web.NewBackgroundWorker(func(ctx context.Context) error {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				_, err := mediatr.Send[*expiringStockReservationsV1.ExpireStockReservations, *mediatr.Unit](ctx, expiringStockReservationsV1.NewExpireStockReservations())
+				if err != nil && ctx.Err() == nil {
+					infra.Log.Errorf("[StockReservationExpiryWorker.Send] error in expiring the stock reservations: {%v}", err)
+				}
+			case <-stop:
+				return nil
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}, func(ctx context.Context) error {
+		close(stop)
+		return nil
+	})
+}
@@ -0,0 +1,3 @@
+-- https://github.com/golang-migrate/migrate/blob/master/MIGRATIONS.md
+ALTER TABLE products DROP COLUMN IF EXISTS price_currency;
+ALTER TABLE products RENAME COLUMN price_amount TO price;
@@ -0,0 +1,3 @@
+-- https://github.com/golang-migrate/migrate/blob/master/MIGRATIONS.md
+ALTER TABLE products RENAME COLUMN price TO price_amount;
+ALTER TABLE products ADD COLUMN price_currency CHAR(3) NOT NULL DEFAULT 'USD';
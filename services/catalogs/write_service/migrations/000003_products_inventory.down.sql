@@ -0,0 +1,5 @@
+-- https://github.com/golang-migrate/migrate/blob/master/MIGRATIONS.md
+DROP TABLE IF EXISTS stock_reservations CASCADE;
+ALTER TABLE products DROP COLUMN IF EXISTS version;
+ALTER TABLE products DROP COLUMN IF EXISTS reserved_quantity;
+ALTER TABLE products DROP COLUMN IF EXISTS inventory_quantity;
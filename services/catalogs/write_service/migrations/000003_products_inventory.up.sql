@@ -0,0 +1,19 @@
+-- https://github.com/golang-migrate/migrate/blob/master/MIGRATIONS.md
+ALTER TABLE products ADD COLUMN inventory_quantity INTEGER NOT NULL DEFAULT 0 CHECK ( inventory_quantity >= 0 );
+ALTER TABLE products ADD COLUMN reserved_quantity INTEGER NOT NULL DEFAULT 0 CHECK ( reserved_quantity >= 0 AND reserved_quantity <= inventory_quantity );
+ALTER TABLE products ADD COLUMN version INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE stock_reservations
+(
+    stock_reservation_id UUID PRIMARY KEY         DEFAULT uuid_generate_v4(),
+    order_id             UUID        NOT NULL,
+    product_id           UUID        NOT NULL REFERENCES products (product_id),
+    quantity             INTEGER     NOT NULL CHECK ( quantity > 0 ),
+    status               VARCHAR(20) NOT NULL,
+    expires_at           TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at           TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at           TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_stock_reservations_order_id ON stock_reservations (order_id);
+CREATE INDEX idx_stock_reservations_expiry ON stock_reservations (status, expires_at);
@@ -0,0 +1,3 @@
+-- https://github.com/golang-migrate/migrate/blob/master/MIGRATIONS.md
+CREATE INDEX idx_stock_reservations_order_id ON stock_reservations (order_id);
+DROP INDEX IF EXISTS idx_stock_reservations_order_product;
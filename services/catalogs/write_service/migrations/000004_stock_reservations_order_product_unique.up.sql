@@ -0,0 +1,3 @@
+-- https://github.com/golang-migrate/migrate/blob/master/MIGRATIONS.md
+CREATE UNIQUE INDEX idx_stock_reservations_order_product ON stock_reservations (order_id, product_id);
+DROP INDEX IF EXISTS idx_stock_reservations_order_id;
@@ -5,7 +5,9 @@ import (
 	"flag"
 	"fmt"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/constants"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/elasticsearch"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/eventstroredb"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/gormPostgres"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/grpc"
 	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
@@ -21,6 +23,11 @@ import (
 
 var configPath string
 
+const (
+	MongoReadStore   = "mongo"
+	ElasticReadStore = "elastic"
+)
+
 func init() {
 	flag.StringVar(&configPath, "config", "", "catalogs write microservice config path")
 }
@@ -39,6 +46,17 @@ type Config struct {
 	Subscriptions    *Subscriptions                  `mapstructure:"subscriptions"`
 	Mongo            *mongodb.MongoDbConfig          `mapstructure:"mongo" envPrefix:"Mongo_"`
 	MongoCollections MongoCollections                `mapstructure:"mongoCollections" envPrefix:"MongoCollections_"`
+	// Elastic is optional, the elastic read model is projected only when it is configured
+	Elastic        *elasticsearch.Config `mapstructure:"elastic" envPrefix:"Elastic_"`
+	ElasticIndexes ElasticIndexes        `mapstructure:"elasticIndexes" envPrefix:"ElasticIndexes_"`
+	// ReadStore is the read model which serves the order queries, mongo (default) or elastic
+	ReadStore string `mapstructure:"readStore"`
+	// GormPostgres is the database of the coupons catalog
+	GormPostgres *gormPostgres.Config `mapstructure:"gormPostgres" envPrefix:"GormPostgres_"`
+	// CatalogsReadServiceClient is the grpc address of the catalogs read service, the order items are priced by its products
+	CatalogsReadServiceClient *grpc.GrpcClientConfig `mapstructure:"catalogsReadServiceClient" envPrefix:"CatalogsReadServiceClient_"`
+	// DeliverySlots is the delivery capacity of the zones, a submitted order reserves a place in the slot of its delivery time
+	DeliverySlots *DeliverySlots `mapstructure:"deliverySlots"`
 }
 
 type Context struct {
@@ -46,7 +64,13 @@ type Context struct {
 }
 
 type MongoCollections struct {
-	Orders string `mapstructure:"orders" validate:"required" env:"Orders"`
+	Orders            string `mapstructure:"orders" validate:"required" env:"Orders"`
+	ScheduledMessages string `mapstructure:"scheduledMessages" validate:"required" env:"ScheduledMessages"`
+	PoisonMessages    string `mapstructure:"poisonMessages" validate:"required" env:"PoisonMessages"`
+}
+
+type ElasticIndexes struct {
+	Orders string `mapstructure:"orders" env:"Orders"`
 }
 
 type Subscriptions struct {
@@ -58,6 +82,24 @@ type Subscription struct {
 	SubscriptionId string   `mapstructure:"subscriptionId" validate:"required"`
 }
 
+type DeliverySlots struct {
+	// BookingDays is the number of the days from today whose slots can be booked
+	BookingDays int             `mapstructure:"bookingDays"`
+	Zones       []*DeliveryZone `mapstructure:"zones"`
+}
+
+type DeliveryZone struct {
+	Zone    string            `mapstructure:"zone"`
+	Windows []*DeliveryWindow `mapstructure:"windows"`
+}
+
+// DeliveryWindow is a daily delivery window of a zone, the start and end are utc times in the `15:04` layout
+type DeliveryWindow struct {
+	Start    string `mapstructure:"start"`
+	End      string `mapstructure:"end"`
+	Capacity int    `mapstructure:"capacity"`
+}
+
 func InitConfig(env string) (*Config, error) {
 	if configPath == "" {
 		configPathFromEnv := os.Getenv(constants.ConfigPath)
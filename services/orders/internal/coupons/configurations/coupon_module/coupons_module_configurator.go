@@ -0,0 +1,43 @@
+package coupon_module
+
+import (
+	"context"
+	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/configurations/mappings"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/configurations/mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/data/repositories"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/projections"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+)
+
+type couponsModuleConfigurator struct {
+	*infrastructure.InfrastructureConfiguration
+	echoServer customEcho.EchoHttpServer
+}
+
+func NewCouponsModuleConfigurator(infrastructure *infrastructure.InfrastructureConfiguration, echoServer customEcho.EchoHttpServer) contracts.CouponsModuleConfigurator {
+	return &couponsModuleConfigurator{InfrastructureConfiguration: infrastructure, echoServer: echoServer}
+}
+
+func (c *couponsModuleConfigurator) ConfigureCouponsModule(ctx context.Context) error {
+	couponRepository := repositories.NewPostgresCouponRepository(c.Log, c.Cfg, c.Gorm.DB)
+
+	// the redemptions of the canceled orders are released by the order subscription of the event store worker
+	c.Projections = append(c.Projections, projections.NewCouponRedemptionProjection(couponRepository, c.Log))
+
+	err := mappings.ConfigureMappings()
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.ConfigCouponsMediator(couponRepository, c.InfrastructureConfiguration)
+	if err != nil {
+		return err
+	}
+
+	// coupons are managed by the back office over http only
+	c.configEndpoints(ctx)
+
+	return nil
+}
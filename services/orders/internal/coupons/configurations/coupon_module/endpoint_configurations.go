@@ -0,0 +1,46 @@
+package coupon_module
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/delivery"
+	creatingCouponV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/creating_coupon/endpoints/v1"
+	deletingCouponV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/deleting_coupon/endpoints/v1"
+	gettingCouponByIdV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/getting_coupon_by_id/endpoints/v1"
+	gettingCouponsV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/getting_coupons/endpoints/v1"
+	updatingCouponV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/updating_coupon/endpoints/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+)
+
+func (c *couponsModuleConfigurator) configEndpoints(ctx context.Context) {
+	configV1Endpoints(c.echoServer, c.InfrastructureConfiguration, ctx)
+}
+
+func configV1Endpoints(echoServer customEcho.EchoHttpServer, infra *infrastructure.InfrastructureConfiguration, ctx context.Context) {
+	echoServer.ConfigGroup("/api/v1", func(v1 *echo.Group) {
+		couponsGroup := v1.Group("/coupons")
+
+		couponEndpointBase := delivery.NewCouponEndpointBase(infra, couponsGroup)
+
+		// CreateCoupon
+		createCouponEndpoint := creatingCouponV1.NewCreateCouponEndpoint(couponEndpointBase)
+		createCouponEndpoint.MapRoute()
+
+		// UpdateCoupon
+		updateCouponEndpoint := updatingCouponV1.NewUpdateCouponEndpoint(couponEndpointBase)
+		updateCouponEndpoint.MapRoute()
+
+		// DeleteCoupon
+		deleteCouponEndpoint := deletingCouponV1.NewDeleteCouponEndpoint(couponEndpointBase)
+		deleteCouponEndpoint.MapRoute()
+
+		// GetCouponByID
+		getCouponByIdEndpoint := gettingCouponByIdV1.NewGetCouponByIdEndpoint(couponEndpointBase)
+		getCouponByIdEndpoint.MapRoute()
+
+		// GetCoupons
+		getCouponsEndpoint := gettingCouponsV1.NewGetCouponsEndpoint(couponEndpointBase)
+		getCouponsEndpoint.MapRoute()
+	})
+}
@@ -0,0 +1,16 @@
+package mappings
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/dto"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/models"
+)
+
+func ConfigureMappings() error {
+	err := mapper.CreateMap[*models.Coupon, *dto.CouponDto]()
+	if err != nil {
+		return err
+	}
+
+	return mapper.CreateMap[*dto.CouponDto, *models.Coupon]()
+}
@@ -0,0 +1,44 @@
+package mediatr
+
+import (
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/contracts"
+	creatingCouponV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/creating_coupon/commands/v1"
+	creatingCouponDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/creating_coupon/dtos"
+	deletingCouponV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/deleting_coupon/commands/v1"
+	gettingCouponByIdDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/getting_coupon_by_id/dtos"
+	gettingCouponByIdV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/getting_coupon_by_id/queries/v1"
+	gettingCouponsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/getting_coupons/dtos"
+	gettingCouponsV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/getting_coupons/queries/v1"
+	updatingCouponV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/updating_coupon/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+)
+
+func ConfigCouponsMediator(couponRepository contracts.CouponRepository, infra *infrastructure.InfrastructureConfiguration) error {
+	err := mediatr.RegisterRequestHandler[*creatingCouponV1.CreateCoupon, *creatingCouponDtos.CreateCouponResponseDto](creatingCouponV1.NewCreateCouponHandler(infra.Log, infra.Cfg, couponRepository))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*updatingCouponV1.UpdateCoupon, *mediatr.Unit](updatingCouponV1.NewUpdateCouponHandler(infra.Log, infra.Cfg, couponRepository))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*deletingCouponV1.DeleteCoupon, *mediatr.Unit](deletingCouponV1.NewDeleteCouponHandler(infra.Log, infra.Cfg, couponRepository))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*gettingCouponByIdV1.GetCouponById, *gettingCouponByIdDtos.GetCouponByIdResponseDto](gettingCouponByIdV1.NewGetCouponByIdHandler(infra.Log, infra.Cfg, couponRepository))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*gettingCouponsV1.GetCoupons, *gettingCouponsDtos.GetCouponsResponseDto](gettingCouponsV1.NewGetCouponsHandler(infra.Log, infra.Cfg, couponRepository))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
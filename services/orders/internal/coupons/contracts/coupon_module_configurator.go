@@ -0,0 +1,7 @@
+package contracts
+
+import "context"
+
+type CouponsModuleConfigurator interface {
+	ConfigureCouponsModule(ctx context.Context) error
+}
@@ -0,0 +1,24 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/models"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CouponRepository interface {
+	GetAllCoupons(ctx context.Context, listQuery *utils.ListQuery) (*utils.ListResult[*models.Coupon], error)
+	GetCouponById(ctx context.Context, uuid uuid.UUID) (*models.Coupon, error)
+	GetCouponByCode(ctx context.Context, code string) (*models.Coupon, error)
+	CreateCoupon(ctx context.Context, coupon *models.Coupon) (*models.Coupon, error)
+	UpdateCoupon(ctx context.Context, coupon *models.Coupon) (*models.Coupon, error)
+	DeleteCouponByID(ctx context.Context, uuid uuid.UUID) error
+	// Redeem records the redemption of the coupon by an order when the customer has not reached the usage limit of the coupon, the redemptions
+	// of a customer are serialized, so the concurrent orders of the customer can't exceed the limit. It reports whether the redemption is created,
+	// a retried redemption of the same order returns false.
+	Redeem(ctx context.Context, coupon *models.Coupon, redemption *models.CouponRedemption) (bool, error)
+	// ReleaseRedemption deletes the redemption of the order and reports whether it existed, releasing is idempotent
+	ReleaseRedemption(ctx context.Context, orderId uuid.UUID) (bool, error)
+}
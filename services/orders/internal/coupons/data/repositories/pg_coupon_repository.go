@@ -0,0 +1,194 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/gormPostgres"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/models"
+	domainExceptions "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/exceptions/domain"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+)
+
+type postgresCouponRepository struct {
+	log  logger.Logger
+	cfg  *config.Config
+	gorm *gorm.DB
+}
+
+func NewPostgresCouponRepository(log logger.Logger, cfg *config.Config, gorm *gorm.DB) *postgresCouponRepository {
+	return &postgresCouponRepository{log: log, cfg: cfg, gorm: gorm}
+}
+
+func (p *postgresCouponRepository) GetAllCoupons(ctx context.Context, listQuery *utils.ListQuery) (*utils.ListResult[*models.Coupon], error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresCouponRepository.GetAllCoupons")
+	defer span.Finish()
+
+	result, err := gormPostgres.Paginate[*models.Coupon](ctx, listQuery, p.gorm)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[postgresCouponRepository_GetAllCoupons.Paginate] error in the paginate"))
+	}
+
+	p.log.Infow("[postgresCouponRepository.GetAllCoupons] coupons loaded", logger.Fields{"CouponsResult": result})
+	span.LogFields(log.Object("CouponsResult", result))
+
+	return result, nil
+}
+
+func (p *postgresCouponRepository) GetCouponById(ctx context.Context, uuid uuid.UUID) (*models.Coupon, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresCouponRepository.GetCouponById")
+	span.LogFields(log.String("CouponId", uuid.String()))
+	defer span.Finish()
+
+	var coupon models.Coupon
+	if err := p.gorm.WithContext(ctx).First(&coupon, uuid).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[postgresCouponRepository_GetCouponById.First] can't find the coupon with id %s into the database.", uuid)))
+	}
+	span.LogFields(log.Object("Coupon", coupon))
+	p.log.Infow(fmt.Sprintf("[postgresCouponRepository.GetCouponById] coupon with id %s loaded", uuid.String()), logger.Fields{"Coupon": coupon, "CouponId": uuid})
+
+	return &coupon, nil
+}
+
+// GetCouponByCode loads a coupon by its case-insensitive code, the codes are stored in upper case
+func (p *postgresCouponRepository) GetCouponByCode(ctx context.Context, code string) (*models.Coupon, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresCouponRepository.GetCouponByCode")
+	span.LogFields(log.String("Code", code))
+	defer span.Finish()
+
+	var coupon models.Coupon
+	if err := p.gorm.WithContext(ctx).Where("code = ?", strings.ToUpper(strings.TrimSpace(code))).First(&coupon).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[postgresCouponRepository_GetCouponByCode.First] can't find the coupon with code %s into the database.", code)))
+	}
+	span.LogFields(log.Object("Coupon", coupon))
+
+	return &coupon, nil
+}
+
+func (p *postgresCouponRepository) CreateCoupon(ctx context.Context, coupon *models.Coupon) (*models.Coupon, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresCouponRepository.CreateCoupon")
+	defer span.Finish()
+
+	if err := p.gorm.WithContext(ctx).Create(coupon).Error; err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[postgresCouponRepository_CreateCoupon.Create] error in the inserting coupon into the database."))
+	}
+	span.LogFields(log.Object("Coupon", coupon))
+	p.log.Infow(fmt.Sprintf("[postgresCouponRepository.CreateCoupon] coupon with id '%s' created", coupon.CouponId), logger.Fields{"Coupon": coupon, "CouponId": coupon.CouponId})
+
+	return coupon, nil
+}
+
+func (p *postgresCouponRepository) UpdateCoupon(ctx context.Context, updateCoupon *models.Coupon) (*models.Coupon, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresCouponRepository.UpdateCoupon")
+	defer span.Finish()
+
+	if err := p.gorm.WithContext(ctx).Save(updateCoupon).Error; err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[postgresCouponRepository_UpdateCoupon.Save] error in updating coupon with id %s into the database.", updateCoupon.CouponId)))
+	}
+	span.LogFields(log.Object("Coupon", updateCoupon))
+	p.log.Infow(fmt.Sprintf("[postgresCouponRepository.UpdateCoupon] coupon with id '%s' updated", updateCoupon.CouponId), logger.Fields{"Coupon": updateCoupon, "CouponId": updateCoupon.CouponId})
+
+	return updateCoupon, nil
+}
+
+func (p *postgresCouponRepository) DeleteCouponByID(ctx context.Context, uuid uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresCouponRepository.DeleteCouponByID")
+	span.LogFields(log.String("CouponId", uuid.String()))
+	defer span.Finish()
+
+	if err := p.gorm.WithContext(ctx).Delete(&models.Coupon{}, uuid).Error; err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[postgresCouponRepository_DeleteCouponByID.Delete] error in the deleting coupon with id %s into the database.", uuid)))
+	}
+	p.log.Infow(fmt.Sprintf("[postgresCouponRepository.DeleteCouponByID] coupon with id %s deleted", uuid), logger.Fields{"CouponId": uuid})
+
+	return nil
+}
+
+func (p *postgresCouponRepository) Redeem(ctx context.Context, coupon *models.Coupon, redemption *models.CouponRedemption) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresCouponRepository.Redeem")
+	span.LogFields(log.String("CouponId", coupon.CouponId.String()))
+	span.LogFields(log.String("OrderId", redemption.OrderId.String()))
+	defer span.Finish()
+
+	created := false
+	err := p.gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the transaction lock of the coupon and the customer serializes the redemptions which are counted for the usage limit
+		lockKey := fmt.Sprintf("coupon_redemption:%s:%s", coupon.CouponId, redemption.AccountEmail)
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", lockKey).Error; err != nil {
+			return errors.WrapIf(err, "[postgresCouponRepository_Redeem.Exec] error in locking the coupon redemptions of the customer")
+		}
+
+		var existing models.CouponRedemption
+		err := tx.Where("order_id = ?", redemption.OrderId).First(&existing).Error
+		if err == nil {
+			if existing.CouponId == coupon.CouponId {
+				return nil
+			}
+
+			return customErrors.NewConflictError(fmt.Sprintf("order %s has redeemed another coupon", redemption.OrderId))
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.WrapIf(err, "[postgresCouponRepository_Redeem.First] error in loading the coupon redemption of the order")
+		}
+
+		if coupon.UsageLimitPerCustomer > 0 {
+			var count int64
+			err := tx.Model(&models.CouponRedemption{}).Where("coupon_id = ? AND account_email = ?", coupon.CouponId, redemption.AccountEmail).Count(&count).Error
+			if err != nil {
+				return errors.WrapIf(err, "[postgresCouponRepository_Redeem.Count] error in counting the coupon redemptions of the customer")
+			}
+			if count >= int64(coupon.UsageLimitPerCustomer) {
+				return domainExceptions.NewCouponUsageLimitExceededError(fmt.Sprintf("coupon %s is already used %d times by %s", coupon.Code, count, redemption.AccountEmail))
+			}
+		}
+
+		if err := tx.Create(redemption).Error; err != nil {
+			return errors.WrapIf(err, "[postgresCouponRepository_Redeem.Create] error in the inserting coupon redemption into the database.")
+		}
+		created = true
+
+		return nil
+	})
+	if err != nil {
+		return false, tracing.TraceWithErr(span, errors.WithMessage(err, fmt.Sprintf("[postgresCouponRepository_Redeem.Transaction] error in redeeming coupon %s by order %s", coupon.Code, redemption.OrderId)))
+	}
+	if created {
+		p.log.Infow(fmt.Sprintf("[postgresCouponRepository.Redeem] coupon with id '%s' redeemed by order '%s'", coupon.CouponId, redemption.OrderId), logger.Fields{"CouponId": coupon.CouponId, "OrderId": redemption.OrderId})
+	}
+
+	return created, nil
+}
+
+func (p *postgresCouponRepository) ReleaseRedemption(ctx context.Context, orderId uuid.UUID) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresCouponRepository.ReleaseRedemption")
+	span.LogFields(log.String("OrderId", orderId.String()))
+	defer span.Finish()
+
+	result := p.gorm.WithContext(ctx).Where("order_id = ?", orderId).Delete(&models.CouponRedemption{})
+	if result.Error != nil {
+		return false, tracing.TraceWithErr(span, errors.WrapIf(result.Error, fmt.Sprintf("[postgresCouponRepository_ReleaseRedemption.Delete] error in deleting the coupon redemption of order %s", orderId)))
+	}
+	if result.RowsAffected > 0 {
+		p.log.Infow(fmt.Sprintf("[postgresCouponRepository.ReleaseRedemption] coupon redemption of order '%s' released", orderId), logger.Fields{"OrderId": orderId})
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,15 @@
+package delivery
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+)
+
+type CouponEndpointBase struct {
+	*infrastructure.InfrastructureConfiguration
+	CouponsGroup *echo.Group
+}
+
+func NewCouponEndpointBase(infra *infrastructure.InfrastructureConfiguration, couponsGroup *echo.Group) *CouponEndpointBase {
+	return &CouponEndpointBase{CouponsGroup: couponsGroup, InfrastructureConfiguration: infra}
+}
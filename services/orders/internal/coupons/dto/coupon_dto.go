@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type CouponDto struct {
+	CouponId              uuid.UUID      `json:"couponId"`
+	Code                  string         `json:"code"`
+	Description           string         `json:"description"`
+	DiscountType          string         `json:"discountType"`
+	PercentOff            domain.Decimal `json:"percentOff"`
+	AmountOff             domain.Money   `json:"amountOff"`
+	MinimumBasket         domain.Money   `json:"minimumBasket"`
+	UsageLimitPerCustomer int            `json:"usageLimitPerCustomer"`
+	CreatedAt             time.Time      `json:"createdAt"`
+	UpdatedAt             time.Time      `json:"updatedAt"`
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// CreateCoupon creates a coupon, the discount rule is validated in the handler by the Coupon value object of the orders
+type CreateCoupon struct {
+	CouponId              uuid.UUID `validate:"required"`
+	Code                  string    `validate:"required,gte=0,lte=50"`
+	Description           string    `validate:"gte=0,lte=5000"`
+	DiscountType          string    `validate:"required,oneof=percentage fixed_amount"`
+	PercentOff            domain.Decimal
+	AmountOff             domain.Money
+	MinimumBasket         domain.Money
+	UsageLimitPerCustomer int       `validate:"gte=0"`
+	CreatedAt             time.Time `validate:"required"`
+}
+
+func NewCreateCoupon(code string, description string, discountType string, percentOff domain.Decimal, amountOff domain.Money, minimumBasket domain.Money, usageLimitPerCustomer int) *CreateCoupon {
+	return &CreateCoupon{
+		CouponId:              uuid.NewV4(),
+		Code:                  code,
+		Description:           description,
+		DiscountType:          discountType,
+		PercentOff:            percentOff,
+		AmountOff:             amountOff,
+		MinimumBasket:         minimumBasket,
+		UsageLimitPerCustomer: usageLimitPerCustomer,
+		CreatedAt:             time.Now(),
+	}
+}
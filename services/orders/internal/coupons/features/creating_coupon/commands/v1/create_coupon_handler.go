@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/creating_coupon/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type CreateCouponHandler struct {
+	log        logger.Logger
+	cfg        *config.Config
+	repository contracts.CouponRepository
+}
+
+func NewCreateCouponHandler(log logger.Logger, cfg *config.Config, repository contracts.CouponRepository) *CreateCouponHandler {
+	return &CreateCouponHandler{log: log, cfg: cfg, repository: repository}
+}
+
+func (c *CreateCouponHandler) Handle(ctx context.Context, command *CreateCoupon) (*dtos.CreateCouponResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CreateCouponHandler.Handle")
+	span.LogFields(log.String("CouponId", command.CouponId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	coupon := &models.Coupon{
+		CouponId:              command.CouponId,
+		Code:                  command.Code,
+		Description:           command.Description,
+		DiscountType:          command.DiscountType,
+		PercentOff:            command.PercentOff,
+		AmountOff:             command.AmountOff,
+		MinimumBasket:         command.MinimumBasket,
+		UsageLimitPerCustomer: command.UsageLimitPerCustomer,
+		CreatedAt:             command.CreatedAt,
+	}
+
+	rule, err := coupon.DiscountRule()
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[CreateCouponHandler_Handle.DiscountRule] coupon discount rule is not valid"))
+	}
+	coupon.Code = rule.Code()
+
+	existing, err := c.repository.GetCouponByCode(ctx, coupon.Code)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[CreateCouponHandler_Handle.GetCouponByCode] error in loading coupon by code"))
+	}
+	if existing != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewConflictError(fmt.Sprintf("[CreateCouponHandler_Handle] coupon with code %s already exists", coupon.Code)))
+	}
+
+	createdCoupon, err := c.repository.CreateCoupon(ctx, coupon)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[CreateCouponHandler_Handle.CreateCoupon] error in creating coupon in the repository"))
+	}
+
+	response := &dtos.CreateCouponResponseDto{CouponId: createdCoupon.CouponId}
+	span.LogFields(log.Object("CreateCouponResponseDto", response))
+
+	c.log.Infow(fmt.Sprintf("[CreateCouponHandler.Handle] coupon with id '%s' created", command.CouponId), logger.Fields{"CouponId": command.CouponId, "Code": coupon.Code})
+
+	return response, nil
+}
@@ -0,0 +1,14 @@
+package dtos
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+
+// CreateCouponRequestDto validation will handle in command level
+type CreateCouponRequestDto struct {
+	Code                  string         `json:"code"`
+	Description           string         `json:"description"`
+	DiscountType          string         `json:"discountType"`
+	PercentOff            domain.Decimal `json:"percentOff"`
+	AmountOff             domain.Money   `json:"amountOff"`
+	MinimumBasket         domain.Money   `json:"minimumBasket"`
+	UsageLimitPerCustomer int            `json:"usageLimitPerCustomer"`
+}
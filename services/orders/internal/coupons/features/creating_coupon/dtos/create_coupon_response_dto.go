@@ -0,0 +1,7 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+type CreateCouponResponseDto struct {
+	CouponId uuid.UUID `json:"couponId"`
+}
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/delivery"
+	creatingCouponV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/creating_coupon/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/creating_coupon/dtos"
+	"net/http"
+)
+
+type createCouponEndpoint struct {
+	*delivery.CouponEndpointBase
+}
+
+func NewCreateCouponEndpoint(endpointBase *delivery.CouponEndpointBase) *createCouponEndpoint {
+	return &createCouponEndpoint{endpointBase}
+}
+
+func (ep *createCouponEndpoint) MapRoute() {
+	ep.CouponsGroup.POST("", ep.handler())
+}
+
+// CreateCoupon
+// @Tags Coupons
+// @Summary Create coupon
+// @Description Create a percentage or a fixed amount coupon
+// @Accept json
+// @Produce json
+// @Param CreateCouponRequestDto body dtos.CreateCouponRequestDto true "Coupon data"
+// @Success 201 {object} dtos.CreateCouponResponseDto
+// @Router /api/v1/coupons [post]
+func (ep *createCouponEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.CreateCouponHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "createCouponEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.CreateCouponRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[createCouponEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[createCouponEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := creatingCouponV1.NewCreateCoupon(request.Code, request.Description, request.DiscountType, request.PercentOff, request.AmountOff, request.MinimumBasket, request.UsageLimitPerCustomer)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[createCouponEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[createCouponEndpoint_handler.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*creatingCouponV1.CreateCoupon, *dtos.CreateCouponResponseDto](ctx, command)
+		if err != nil {
+			err = errors.WithMessage(err, "[createCouponEndpoint_handler.Send] error in sending CreateCoupon")
+			ep.Log.Errorw(fmt.Sprintf("[createCouponEndpoint_handler.Send] id: {%s}, err: {%v}", command.CouponId, tracing.TraceWithErr(span, err)), logger.Fields{"CouponId": command.CouponId})
+			return err
+		}
+
+		return c.JSON(http.StatusCreated, result)
+	}
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	uuid "github.com/satori/go.uuid"
+)
+
+type DeleteCoupon struct {
+	CouponId uuid.UUID `validate:"required"`
+}
+
+func NewDeleteCoupon(couponId uuid.UUID) *DeleteCoupon {
+	return &DeleteCoupon{CouponId: couponId}
+}
@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/contracts"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type DeleteCouponHandler struct {
+	log        logger.Logger
+	cfg        *config.Config
+	repository contracts.CouponRepository
+}
+
+func NewDeleteCouponHandler(log logger.Logger, cfg *config.Config, repository contracts.CouponRepository) *DeleteCouponHandler {
+	return &DeleteCouponHandler{log: log, cfg: cfg, repository: repository}
+}
+
+func (c *DeleteCouponHandler) Handle(ctx context.Context, command *DeleteCoupon) (*mediatr.Unit, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DeleteCouponHandler.Handle")
+	span.LogFields(log.String("CouponId", command.CouponId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	coupon, err := c.repository.GetCouponById(ctx, command.CouponId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, fmt.Sprintf("[DeleteCouponHandler_Handle.GetCouponById] error in fetching coupon with id %s", command.CouponId)))
+	}
+	if coupon == nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewNotFoundError(fmt.Sprintf("[DeleteCouponHandler_Handle.GetCouponById] coupon with id %s not found", command.CouponId)))
+	}
+
+	if err := c.repository.DeleteCouponByID(ctx, command.CouponId); err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[DeleteCouponHandler_Handle.DeleteCouponByID] error in deleting coupon in the repository"))
+	}
+
+	c.log.Infow(fmt.Sprintf("[DeleteCouponHandler.Handle] coupon with id '%s' deleted", command.CouponId), logger.Fields{"CouponId": command.CouponId})
+
+	return &mediatr.Unit{}, nil
+}
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/delivery"
+	deletingCouponV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/deleting_coupon/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/deleting_coupon/dtos"
+	"net/http"
+)
+
+type deleteCouponEndpoint struct {
+	*delivery.CouponEndpointBase
+}
+
+func NewDeleteCouponEndpoint(endpointBase *delivery.CouponEndpointBase) *deleteCouponEndpoint {
+	return &deleteCouponEndpoint{endpointBase}
+}
+
+func (ep *deleteCouponEndpoint) MapRoute() {
+	ep.CouponsGroup.DELETE("/:id", ep.handler())
+}
+
+// DeleteCoupon
+// @Tags Coupons
+// @Summary Delete coupon
+// @Description Delete existing coupon
+// @Accept json
+// @Produce json
+// @Success 204
+// @Param id path string true "Coupon ID"
+// @Router /api/v1/coupons/{id} [delete]
+func (ep *deleteCouponEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.DeleteCouponHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "deleteCouponEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.DeleteCouponRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[deleteCouponEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[deleteCouponEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := deletingCouponV1.NewDeleteCoupon(request.CouponId)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[deleteCouponEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[deleteCouponEndpoint_handler.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		_, err := mediatr.Send[*deletingCouponV1.DeleteCoupon, *mediatr.Unit](ctx, command)
+		if err != nil {
+			err = errors.WithMessage(err, "[deleteCouponEndpoint_handler.Send] error in sending DeleteCoupon")
+			ep.Log.Errorw(fmt.Sprintf("[deleteCouponEndpoint_handler.Send] id: {%s}, err: {%v}", command.CouponId, tracing.TraceWithErr(span, err)), logger.Fields{"CouponId": command.CouponId})
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
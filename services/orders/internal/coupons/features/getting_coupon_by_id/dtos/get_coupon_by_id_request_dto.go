@@ -0,0 +1,8 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// GetCouponByIdRequestDto validation will handle in query level
+type GetCouponByIdRequestDto struct {
+	CouponId uuid.UUID `param:"id" json:"-"`
+}
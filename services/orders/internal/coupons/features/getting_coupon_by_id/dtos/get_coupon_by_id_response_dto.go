@@ -0,0 +1,7 @@
+package dtos
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/dto"
+
+type GetCouponByIdResponseDto struct {
+	Coupon *dto.CouponDto `json:"coupon"`
+}
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/delivery"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/getting_coupon_by_id/dtos"
+	gettingCouponByIdV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/getting_coupon_by_id/queries/v1"
+	"net/http"
+)
+
+type getCouponByIdEndpoint struct {
+	*delivery.CouponEndpointBase
+}
+
+func NewGetCouponByIdEndpoint(endpointBase *delivery.CouponEndpointBase) *getCouponByIdEndpoint {
+	return &getCouponByIdEndpoint{endpointBase}
+}
+
+func (ep *getCouponByIdEndpoint) MapRoute() {
+	ep.CouponsGroup.GET("/:id", ep.handler())
+}
+
+// GetCouponByID
+// @Tags Coupons
+// @Summary Get coupon by id
+// @Description Get coupon by id
+// @Accept json
+// @Produce json
+// @Param id path string true "Coupon ID"
+// @Success 200 {object} dtos.GetCouponByIdResponseDto
+// @Router /api/v1/coupons/{id} [get]
+func (ep *getCouponByIdEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.GetCouponByIdHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "getCouponByIdEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.GetCouponByIdRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[getCouponByIdEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[getCouponByIdEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		query := gettingCouponByIdV1.NewGetCouponById(request.CouponId)
+		if err := ep.Validator.StructCtx(ctx, query); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[getCouponByIdEndpoint_handler.StructCtx] query validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[getCouponByIdEndpoint_handler.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		queryResult, err := mediatr.Send[*gettingCouponByIdV1.GetCouponById, *dtos.GetCouponByIdResponseDto](ctx, query)
+		if err != nil {
+			err = errors.WithMessage(err, "[getCouponByIdEndpoint_handler.Send] error in sending GetCouponById")
+			ep.Log.Errorw(fmt.Sprintf("[getCouponByIdEndpoint_handler.Send] id: {%s}, err: {%v}", query.CouponId, tracing.TraceWithErr(span, err)), logger.Fields{"CouponId": query.CouponId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, queryResult)
+	}
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	uuid "github.com/satori/go.uuid"
+)
+
+type GetCouponById struct {
+	CouponId uuid.UUID `validate:"required"`
+}
+
+func NewGetCouponById(couponId uuid.UUID) *GetCouponById {
+	return &GetCouponById{CouponId: couponId}
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/dto"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/getting_coupon_by_id/dtos"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type GetCouponByIdHandler struct {
+	log        logger.Logger
+	cfg        *config.Config
+	repository contracts.CouponRepository
+}
+
+func NewGetCouponByIdHandler(log logger.Logger, cfg *config.Config, repository contracts.CouponRepository) *GetCouponByIdHandler {
+	return &GetCouponByIdHandler{log: log, cfg: cfg, repository: repository}
+}
+
+func (q *GetCouponByIdHandler) Handle(ctx context.Context, query *GetCouponById) (*dtos.GetCouponByIdResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetCouponByIdHandler.Handle")
+	span.LogFields(log.String("CouponId", query.CouponId.String()))
+	span.LogFields(log.Object("Query", query))
+	defer span.Finish()
+
+	coupon, err := q.repository.GetCouponById(ctx, query.CouponId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, fmt.Sprintf("[GetCouponByIdHandler_Handle.GetCouponById] error in getting coupon with id %s in the repository", query.CouponId)))
+	}
+	if coupon == nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewNotFoundError(fmt.Sprintf("[GetCouponByIdHandler_Handle.GetCouponById] coupon with id %s not found", query.CouponId)))
+	}
+
+	couponDto, err := mapper.Map[*dto.CouponDto](coupon)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetCouponByIdHandler_Handle.Map] error in the mapping coupon"))
+	}
+
+	q.log.Infow(fmt.Sprintf("[GetCouponByIdHandler.Handle] coupon with id: {%s} fetched", query.CouponId), logger.Fields{"CouponId": query.CouponId})
+
+	return &dtos.GetCouponByIdResponseDto{Coupon: couponDto}, nil
+}
@@ -0,0 +1,8 @@
+package dtos
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+
+// GetCouponsRequestDto validation will handle in query level
+type GetCouponsRequestDto struct {
+	*utils.ListQuery
+}
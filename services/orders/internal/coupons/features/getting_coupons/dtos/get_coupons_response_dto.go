@@ -0,0 +1,10 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/dto"
+)
+
+type GetCouponsResponseDto struct {
+	Coupons *utils.ListResult[*dto.CouponDto]
+}
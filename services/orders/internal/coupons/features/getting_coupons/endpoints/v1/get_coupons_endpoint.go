@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/delivery"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/getting_coupons/dtos"
+	gettingCouponsV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/getting_coupons/queries/v1"
+	"net/http"
+)
+
+type getCouponsEndpoint struct {
+	*delivery.CouponEndpointBase
+}
+
+func NewGetCouponsEndpoint(endpointBase *delivery.CouponEndpointBase) *getCouponsEndpoint {
+	return &getCouponsEndpoint{endpointBase}
+}
+
+func (ep *getCouponsEndpoint) MapRoute() {
+	ep.CouponsGroup.GET("", ep.handler())
+}
+
+// GetAllCoupons
+// @Tags Coupons
+// @Summary Get all coupons
+// @Description Get all coupons
+// @Accept json
+// @Produce json
+// @Param getCouponsRequestDto query dtos.GetCouponsRequestDto false "GetCouponsRequestDto"
+// @Success 200 {object} dtos.GetCouponsResponseDto
+// @Router /api/v1/coupons [get]
+func (ep *getCouponsEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.GetCouponsHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "getCouponsEndpoint.handler")
+		defer span.Finish()
+
+		listQuery, err := utils.GetListQueryFromCtx(c)
+		if err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[getCouponsEndpoint_handler.GetListQueryFromCtx] error in getting data from query string")
+			ep.Log.Errorf(fmt.Sprintf("[getCouponsEndpoint_handler.GetListQueryFromCtx] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		request := &dtos.GetCouponsRequestDto{ListQuery: listQuery}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[getCouponsEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[getCouponsEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		query := gettingCouponsV1.NewGetCoupons(request.ListQuery)
+
+		queryResult, err := mediatr.Send[*gettingCouponsV1.GetCoupons, *dtos.GetCouponsResponseDto](ctx, query)
+		if err != nil {
+			err = errors.WithMessage(err, "[getCouponsEndpoint_handler.Send] error in sending GetCoupons")
+			ep.Log.Error(fmt.Sprintf("[getCouponsEndpoint_handler.Send] err: {%v}", tracing.TraceWithErr(span, err)))
+			return err
+		}
+
+		return c.JSON(http.StatusOK, queryResult)
+	}
+}
@@ -0,0 +1,11 @@
+package v1
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+
+type GetCoupons struct {
+	*utils.ListQuery
+}
+
+func NewGetCoupons(query *utils.ListQuery) *GetCoupons {
+	return &GetCoupons{ListQuery: query}
+}
@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/dto"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/getting_coupons/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type GetCouponsHandler struct {
+	log        logger.Logger
+	cfg        *config.Config
+	repository contracts.CouponRepository
+}
+
+func NewGetCouponsHandler(log logger.Logger, cfg *config.Config, repository contracts.CouponRepository) *GetCouponsHandler {
+	return &GetCouponsHandler{log: log, cfg: cfg, repository: repository}
+}
+
+func (c *GetCouponsHandler) Handle(ctx context.Context, query *GetCoupons) (*dtos.GetCouponsResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetCouponsHandler.Handle")
+	span.LogFields(log.Object("Query", query))
+	defer span.Finish()
+
+	if err := query.ListQuery.Validate(models.CouponQueryFields); err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[GetCouponsHandler_Handle.Validate] error in validating the list query"))
+	}
+
+	coupons, err := c.repository.GetAllCoupons(ctx, query.ListQuery)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetCouponsHandler_Handle.GetAllCoupons] error in getting coupons in the repository"))
+	}
+
+	listResultDto, err := utils.ListResultToListResultDto[*dto.CouponDto](coupons)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetCouponsHandler_Handle.ListResultToListResultDto] error in the mapping ListResultToListResultDto"))
+	}
+
+	c.log.Info("[GetCouponsHandler.Handle] coupons fetched")
+
+	return &dtos.GetCouponsResponseDto{Coupons: listResultDto}, nil
+}
@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type UpdateCoupon struct {
+	CouponId              uuid.UUID `validate:"required"`
+	Code                  string    `validate:"required,gte=0,lte=50"`
+	Description           string    `validate:"gte=0,lte=5000"`
+	DiscountType          string    `validate:"required,oneof=percentage fixed_amount"`
+	PercentOff            domain.Decimal
+	AmountOff             domain.Money
+	MinimumBasket         domain.Money
+	UsageLimitPerCustomer int       `validate:"gte=0"`
+	UpdatedAt             time.Time `validate:"required"`
+}
+
+func NewUpdateCoupon(couponId uuid.UUID, code string, description string, discountType string, percentOff domain.Decimal, amountOff domain.Money, minimumBasket domain.Money, usageLimitPerCustomer int) *UpdateCoupon {
+	return &UpdateCoupon{
+		CouponId:              couponId,
+		Code:                  code,
+		Description:           description,
+		DiscountType:          discountType,
+		PercentOff:            percentOff,
+		AmountOff:             amountOff,
+		MinimumBasket:         minimumBasket,
+		UsageLimitPerCustomer: usageLimitPerCustomer,
+		UpdatedAt:             time.Now(),
+	}
+}
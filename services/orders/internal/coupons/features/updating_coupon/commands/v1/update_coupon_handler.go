@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/contracts"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// UpdateCouponHandler changes the coupon for the next orders, the orders which the coupon is already applied to keep their discount
+type UpdateCouponHandler struct {
+	log        logger.Logger
+	cfg        *config.Config
+	repository contracts.CouponRepository
+}
+
+func NewUpdateCouponHandler(log logger.Logger, cfg *config.Config, repository contracts.CouponRepository) *UpdateCouponHandler {
+	return &UpdateCouponHandler{log: log, cfg: cfg, repository: repository}
+}
+
+func (c *UpdateCouponHandler) Handle(ctx context.Context, command *UpdateCoupon) (*mediatr.Unit, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UpdateCouponHandler.Handle")
+	span.LogFields(log.String("CouponId", command.CouponId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	coupon, err := c.repository.GetCouponById(ctx, command.CouponId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, fmt.Sprintf("[UpdateCouponHandler_Handle.GetCouponById] error in fetching coupon with id %s", command.CouponId)))
+	}
+	if coupon == nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewNotFoundError(fmt.Sprintf("[UpdateCouponHandler_Handle.GetCouponById] coupon with id %s not found", command.CouponId)))
+	}
+
+	coupon.Code = command.Code
+	coupon.Description = command.Description
+	coupon.DiscountType = command.DiscountType
+	coupon.PercentOff = command.PercentOff
+	coupon.AmountOff = command.AmountOff
+	coupon.MinimumBasket = command.MinimumBasket
+	coupon.UsageLimitPerCustomer = command.UsageLimitPerCustomer
+	coupon.UpdatedAt = command.UpdatedAt
+
+	rule, err := coupon.DiscountRule()
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[UpdateCouponHandler_Handle.DiscountRule] coupon discount rule is not valid"))
+	}
+	coupon.Code = rule.Code()
+
+	existing, err := c.repository.GetCouponByCode(ctx, coupon.Code)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[UpdateCouponHandler_Handle.GetCouponByCode] error in loading coupon by code"))
+	}
+	if existing != nil && existing.CouponId != coupon.CouponId {
+		return nil, tracing.TraceWithErr(span, customErrors.NewConflictError(fmt.Sprintf("[UpdateCouponHandler_Handle] coupon with code %s already exists", coupon.Code)))
+	}
+
+	_, err = c.repository.UpdateCoupon(ctx, coupon)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[UpdateCouponHandler_Handle.UpdateCoupon] error in updating coupon in the repository"))
+	}
+
+	c.log.Infow(fmt.Sprintf("[UpdateCouponHandler.Handle] coupon with id '%s' updated", command.CouponId), logger.Fields{"CouponId": command.CouponId})
+
+	return &mediatr.Unit{}, nil
+}
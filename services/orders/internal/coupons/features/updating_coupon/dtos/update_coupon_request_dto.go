@@ -0,0 +1,18 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+// UpdateCouponRequestDto validation will handle in command level
+type UpdateCouponRequestDto struct {
+	CouponId              uuid.UUID      `json:"-" param:"id"`
+	Code                  string         `json:"code"`
+	Description           string         `json:"description"`
+	DiscountType          string         `json:"discountType"`
+	PercentOff            domain.Decimal `json:"percentOff"`
+	AmountOff             domain.Money   `json:"amountOff"`
+	MinimumBasket         domain.Money   `json:"minimumBasket"`
+	UsageLimitPerCustomer int            `json:"usageLimitPerCustomer"`
+}
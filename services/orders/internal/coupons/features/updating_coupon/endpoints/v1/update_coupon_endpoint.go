@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/delivery"
+	updatingCouponV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/updating_coupon/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/features/updating_coupon/dtos"
+	"net/http"
+)
+
+type updateCouponEndpoint struct {
+	*delivery.CouponEndpointBase
+}
+
+func NewUpdateCouponEndpoint(endpointBase *delivery.CouponEndpointBase) *updateCouponEndpoint {
+	return &updateCouponEndpoint{endpointBase}
+}
+
+func (ep *updateCouponEndpoint) MapRoute() {
+	ep.CouponsGroup.PUT("/:id", ep.handler())
+}
+
+// UpdateCoupon
+// @Tags Coupons
+// @Summary Update coupon
+// @Description Update existing coupon, the orders which the coupon is already applied to keep their discount
+// @Accept json
+// @Produce json
+// @Param UpdateCouponRequestDto body dtos.UpdateCouponRequestDto true "Coupon data"
+// @Param id path string true "Coupon ID"
+// @Success 204
+// @Router /api/v1/coupons/{id} [put]
+func (ep *updateCouponEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.UpdateCouponHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "updateCouponEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.UpdateCouponRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[updateCouponEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[updateCouponEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := updatingCouponV1.NewUpdateCoupon(request.CouponId, request.Code, request.Description, request.DiscountType, request.PercentOff, request.AmountOff, request.MinimumBasket, request.UsageLimitPerCustomer)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[updateCouponEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[updateCouponEndpoint_handler.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		_, err := mediatr.Send[*updatingCouponV1.UpdateCoupon, *mediatr.Unit](ctx, command)
+		if err != nil {
+			err = errors.WithMessage(err, "[updateCouponEndpoint_handler.Send] error in sending UpdateCoupon")
+			ep.Log.Errorw(fmt.Sprintf("[updateCouponEndpoint_handler.Send] id: {%s}, err: {%v}", command.CouponId, tracing.TraceWithErr(span, err)), logger.Fields{"CouponId": command.CouponId})
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/serializer/jsonSerializer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// Coupon model, the discount rule of a coupon is validated by the Coupon value object of the orders before it is stored
+type Coupon struct {
+	CouponId     uuid.UUID      `json:"couponId" gorm:"primaryKey"`
+	Code         string         `json:"code" gorm:"uniqueIndex"`
+	Description  string         `json:"description"`
+	DiscountType string         `json:"discountType"`
+	PercentOff   domain.Decimal `json:"percentOff"`
+	AmountOff    domain.Money   `json:"amountOff" gorm:"embedded;embeddedPrefix:amount_off_"`
+	// MinimumBasket is the minimum total price of the order items, a zero money means no minimum
+	MinimumBasket domain.Money `json:"minimumBasket" gorm:"embedded;embeddedPrefix:minimum_basket_"`
+	// UsageLimitPerCustomer is the number of the orders of a customer which can use the coupon, 0 means unlimited
+	UsageLimitPerCustomer int       `json:"usageLimitPerCustomer"`
+	CreatedAt             time.Time `json:"createdAt"`
+	UpdatedAt             time.Time `json:"updatedAt"`
+}
+
+// DiscountRule is the discount rule of the coupon which is applied to the orders
+func (c *Coupon) DiscountRule() (*value_objects.Coupon, error) {
+	return value_objects.NewCoupon(c.Code, c.DiscountType, c.PercentOff, c.AmountOff, c.MinimumBasket)
+}
+
+func (c *Coupon) String() string {
+	return jsonSerializer.PrettyPrint(c)
+}
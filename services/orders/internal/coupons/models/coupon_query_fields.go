@@ -0,0 +1,10 @@
+package models
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+
+// CouponQueryFields maps the fields of the coupon list queries to the columns of the coupons table
+var CouponQueryFields = utils.QueryFields{
+	"code":         {Name: "code", Type: utils.StringField, Sortable: true},
+	"discountType": {Name: "discount_type", Type: utils.StringField},
+	"createdAt":    {Name: "created_at", Type: utils.DateField, Sortable: true},
+}
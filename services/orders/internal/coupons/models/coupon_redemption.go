@@ -0,0 +1,15 @@
+package models
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// CouponRedemption is a coupon applied to an order, the redemptions of a customer are counted for the usage limit of the coupon
+type CouponRedemption struct {
+	CouponRedemptionId uuid.UUID `json:"couponRedemptionId" gorm:"primaryKey"`
+	CouponId           uuid.UUID `json:"couponId" gorm:"index:idx_coupon_redemptions_customer"`
+	AccountEmail       string    `json:"accountEmail" gorm:"index:idx_coupon_redemptions_customer"`
+	OrderId            uuid.UUID `json:"orderId" gorm:"uniqueIndex"`
+	RedeemedAt         time.Time `json:"redeemedAt"`
+}
@@ -0,0 +1,47 @@
+package projections
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/contracts"
+	cancelingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/domain/v1"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// couponRedemptionProjection releases the coupon redemption of a canceled order, so it isn't counted in the usage limit of the customer anymore.
+// The subscription retries an event until its projections succeed and releasing is idempotent, so a redemption is released even if the first try fails
+type couponRedemptionProjection struct {
+	couponRepository contracts.CouponRepository
+	logger           logger.Logger
+}
+
+func NewCouponRedemptionProjection(couponRepository contracts.CouponRepository, logger logger.Logger) projection.IProjection {
+	return &couponRedemptionProjection{couponRepository: couponRepository, logger: logger}
+}
+
+func (c couponRedemptionProjection) ProcessEvent(ctx context.Context, streamEvent *models.StreamEvent) error {
+	evt, ok := streamEvent.Event.(*cancelingOrderEvents.OrderCanceledV1)
+	if !ok {
+		return nil
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "couponRedemptionProjection.ProcessEvent")
+	span.LogFields(log.String("OrderId", evt.OrderId.String()))
+	defer span.Finish()
+
+	released, err := c.couponRepository.ReleaseRedemption(ctx, evt.OrderId)
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WithMessage(err, "[couponRedemptionProjection_ProcessEvent.ReleaseRedemption] error in releasing the coupon redemption of the canceled order"))
+	}
+	if released {
+		c.logger.Infow(fmt.Sprintf("[couponRedemptionProjection.ProcessEvent] coupon redemption of canceled order %s released", evt.OrderId), logger.Fields{"OrderId": evt.OrderId})
+	}
+
+	return nil
+}
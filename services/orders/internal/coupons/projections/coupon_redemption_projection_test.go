@@ -0,0 +1,40 @@
+package projections
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/contracts"
+	cancelingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/domain/v1"
+	payingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/domain/v1"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// releasingCouponRepository records the released redemptions
+type releasingCouponRepository struct {
+	contracts.CouponRepository
+	released []uuid.UUID
+}
+
+func (r *releasingCouponRepository) ReleaseRedemption(ctx context.Context, orderId uuid.UUID) (bool, error) {
+	r.released = append(r.released, orderId)
+	return true, nil
+}
+
+func Test_Coupon_Redemption_Of_Canceled_Order_Is_Released(t *testing.T) {
+	repository := &releasingCouponRepository{}
+	projection := NewCouponRedemptionProjection(repository, defaultLogger.Logger)
+	orderId := uuid.NewV4()
+
+	err := projection.ProcessEvent(context.Background(), &models.StreamEvent{Event: &payingOrderEvents.OrderPaidV1{OrderId: orderId, PaidAt: time.Now()}})
+	require.NoError(t, err)
+	assert.Empty(t, repository.released)
+
+	err = projection.ProcessEvent(context.Background(), &models.StreamEvent{Event: &cancelingOrderEvents.OrderCanceledV1{OrderId: orderId, CancelReason: "changed my mind", CanceledAt: time.Now()}})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{orderId}, repository.released)
+}
@@ -0,0 +1,57 @@
+package delivery_slot_module
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/eventstroredb"
+	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/configurations/mappings"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/configurations/mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/data/repositories"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/projections"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/workers"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+)
+
+type deliverySlotsModuleConfigurator struct {
+	*infrastructure.InfrastructureConfiguration
+	echoServer customEcho.EchoHttpServer
+}
+
+func NewDeliverySlotsModuleConfigurator(infrastructure *infrastructure.InfrastructureConfiguration, echoServer customEcho.EchoHttpServer) contracts.DeliverySlotsModuleConfigurator {
+	return &deliverySlotsModuleConfigurator{InfrastructureConfiguration: infrastructure, echoServer: echoServer}
+}
+
+func (c *deliverySlotsModuleConfigurator) ConfigureDeliverySlotsModule(ctx context.Context) error {
+	deliverySchedule, err := models.NewDeliverySchedule(c.Cfg.DeliverySlots)
+	if err != nil {
+		return err
+	}
+
+	deliverySlotRepository := repositories.NewPostgresDeliverySlotRepository(c.Log, c.Cfg, c.Gorm.DB)
+
+	// the places of the canceled orders are released by the order subscription of the event store worker
+	c.Projections = append(c.Projections, projections.NewDeliverySlotReleaseProjection(deliverySlotRepository, c.Log))
+
+	// the reservations whose compensation failed are fixed by the stored orders in the background
+	eventStore := eventstroredb.NewEventStoreDbEventStore(c.Log, c.Esdb, c.EsdbSerializer)
+	orderAggregateStore := eventstroredb.NewEventStoreAggregateStore[*aggregate.Order](c.Log, eventStore, c.EsdbSerializer)
+	reconciler := workers.NewDeliverySlotReconciler(c.Log, deliverySchedule, deliverySlotRepository, orderAggregateStore)
+	c.Workers = append(c.Workers, workers.NewDeliverySlotReconcileWorker(reconciler, c.Log))
+
+	err = mappings.ConfigureMappings()
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.ConfigDeliverySlotsMediator(deliverySchedule, deliverySlotRepository, c.InfrastructureConfiguration)
+	if err != nil {
+		return err
+	}
+
+	c.configEndpoints(ctx)
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package delivery_slot_module
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/delivery"
+	gettingAvailableDeliverySlotsV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/features/getting_available_delivery_slots/endpoints/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+)
+
+func (c *deliverySlotsModuleConfigurator) configEndpoints(ctx context.Context) {
+	configV1Endpoints(c.echoServer, c.InfrastructureConfiguration, ctx)
+}
+
+func configV1Endpoints(echoServer customEcho.EchoHttpServer, infra *infrastructure.InfrastructureConfiguration, ctx context.Context) {
+	echoServer.ConfigGroup("/api/v1", func(v1 *echo.Group) {
+		deliverySlotsGroup := v1.Group("/delivery-slots")
+
+		deliverySlotEndpointBase := delivery.NewDeliverySlotEndpointBase(infra, deliverySlotsGroup)
+
+		// GetAvailableDeliverySlots
+		getAvailableDeliverySlotsEndpoint := gettingAvailableDeliverySlotsV1.NewGetAvailableDeliverySlotsEndpoint(deliverySlotEndpointBase)
+		getAvailableDeliverySlotsEndpoint.MapRoute()
+	})
+}
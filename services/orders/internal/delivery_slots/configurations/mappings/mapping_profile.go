@@ -0,0 +1,19 @@
+package mappings
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/models"
+)
+
+func ConfigureMappings() error {
+	return mapper.CreateCustomMap[*models.DeliverySlot, *dtos.DeliverySlotDto](func(slot *models.DeliverySlot) *dtos.DeliverySlotDto {
+		return &dtos.DeliverySlotDto{
+			Zone:      slot.Zone,
+			StartsAt:  slot.StartsAt,
+			EndsAt:    slot.EndsAt,
+			Capacity:  slot.Capacity,
+			Available: slot.Available(),
+		}
+	})
+}
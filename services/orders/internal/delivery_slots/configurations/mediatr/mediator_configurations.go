@@ -0,0 +1,14 @@
+package mediatr
+
+import (
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/contracts"
+	gettingAvailableDeliverySlotsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/features/getting_available_delivery_slots/dtos"
+	gettingAvailableDeliverySlotsV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/features/getting_available_delivery_slots/queries/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+)
+
+func ConfigDeliverySlotsMediator(deliverySchedule *models.DeliverySchedule, deliverySlotRepository contracts.DeliverySlotRepository, infra *infrastructure.InfrastructureConfiguration) error {
+	return mediatr.RegisterRequestHandler[*gettingAvailableDeliverySlotsV1.GetAvailableDeliverySlots, *gettingAvailableDeliverySlotsDtos.GetAvailableDeliverySlotsResponseDto](gettingAvailableDeliverySlotsV1.NewGetAvailableDeliverySlotsHandler(infra.Log, infra.Cfg, deliverySchedule, deliverySlotRepository))
+}
@@ -0,0 +1,26 @@
+package contracts
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/models"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type DeliverySlotRepository interface {
+	// Reserve reserves a place of the slot for the order, it returns a DeliverySlotFullError when the slot has no free place.
+	// Reserving the reserved slot of the order again doesn't take another place and returns false
+	Reserve(ctx context.Context, orderId uuid.UUID, slot *models.DeliverySlot) (bool, error)
+	// Reschedule moves the reservation of the order to the slot, the place in the previous slot is released when the slot is reserved
+	Reschedule(ctx context.Context, orderId uuid.UUID, slot *models.DeliverySlot) error
+	// Release releases the place of the order in its slot, it returns false when the order has no reservation
+	Release(ctx context.Context, orderId uuid.UUID) (bool, error)
+	GetReservation(ctx context.Context, orderId uuid.UUID) (*models.DeliverySlotReservation, error)
+	// GetSlots returns the reserved slots of the zone which start between from and to, the to time is excluded
+	GetSlots(ctx context.Context, zone string, from time.Time, to time.Time) ([]*models.DeliverySlot, error)
+	// MarkForReconciliation records the order for the reconcile worker, marking a marked order again only updates its reason
+	MarkForReconciliation(ctx context.Context, orderId uuid.UUID, reason string) error
+	// GetReconciliations returns the oldest marked orders up to the limit
+	GetReconciliations(ctx context.Context, limit int) ([]*models.DeliverySlotReconciliation, error)
+	RemoveReconciliation(ctx context.Context, orderId uuid.UUID) error
+}
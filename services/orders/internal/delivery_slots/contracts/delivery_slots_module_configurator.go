@@ -0,0 +1,7 @@
+package contracts
+
+import "context"
+
+type DeliverySlotsModuleConfigurator interface {
+	ConfigureDeliverySlotsModule(ctx context.Context) error
+}
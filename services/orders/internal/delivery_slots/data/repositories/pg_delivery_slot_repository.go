@@ -0,0 +1,276 @@
+package repositories
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/models"
+	domainExceptions "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/exceptions/domain"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type postgresDeliverySlotRepository struct {
+	log  logger.Logger
+	cfg  *config.Config
+	gorm *gorm.DB
+}
+
+func NewPostgresDeliverySlotRepository(log logger.Logger, cfg *config.Config, gorm *gorm.DB) contracts.DeliverySlotRepository {
+	return &postgresDeliverySlotRepository{log: log, cfg: cfg, gorm: gorm}
+}
+
+func (p *postgresDeliverySlotRepository) Reserve(ctx context.Context, orderId uuid.UUID, slot *models.DeliverySlot) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresDeliverySlotRepository.Reserve")
+	span.LogFields(log.String("OrderId", orderId.String()))
+	span.LogFields(log.Object("DeliverySlot", slot))
+	defer span.Finish()
+
+	created := false
+	err := p.gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		reservation, err := lockReservation(tx, orderId)
+		if err != nil {
+			return err
+		}
+		if reservation != nil {
+			if slot.SameSlot(reservation.Zone, reservation.SlotStartsAt) {
+				return nil
+			}
+
+			return customErrors.NewConflictError(fmt.Sprintf("order %s has a reservation in another delivery slot", orderId))
+		}
+
+		if err := takePlace(tx, slot); err != nil {
+			return err
+		}
+
+		// the order id key rolls back a concurrent reservation of the same order
+		reservation = &models.DeliverySlotReservation{OrderId: orderId, Zone: slot.Zone, SlotStartsAt: slot.StartsAt, ReservedAt: time.Now()}
+		if err := tx.Create(reservation).Error; err != nil {
+			return errors.WrapIf(err, "[postgresDeliverySlotRepository_Reserve.Create] error in inserting the delivery slot reservation")
+		}
+		created = true
+
+		return nil
+	})
+	if err != nil {
+		return false, tracing.TraceWithErr(span, errors.WithMessage(err, fmt.Sprintf("[postgresDeliverySlotRepository_Reserve.Transaction] error in reserving the delivery slot of order %s", orderId)))
+	}
+	if created {
+		p.log.Infow(fmt.Sprintf("[postgresDeliverySlotRepository.Reserve] delivery slot of zone %s at %s reserved for order %s", slot.Zone, slot.StartsAt, orderId), logger.Fields{"OrderId": orderId, "Zone": slot.Zone, "StartsAt": slot.StartsAt})
+	}
+
+	return created, nil
+}
+
+func (p *postgresDeliverySlotRepository) Reschedule(ctx context.Context, orderId uuid.UUID, slot *models.DeliverySlot) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresDeliverySlotRepository.Reschedule")
+	span.LogFields(log.String("OrderId", orderId.String()))
+	span.LogFields(log.Object("DeliverySlot", slot))
+	defer span.Finish()
+
+	err := p.gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		reservation, err := lockReservation(tx, orderId)
+		if err != nil {
+			return err
+		}
+		if reservation == nil {
+			return customErrors.NewNotFoundError(fmt.Sprintf("order %s has no delivery slot reservation", orderId))
+		}
+		if slot.SameSlot(reservation.Zone, reservation.SlotStartsAt) {
+			return nil
+		}
+
+		if err := takePlace(tx, slot); err != nil {
+			return err
+		}
+		if err := releasePlace(tx, reservation); err != nil {
+			return err
+		}
+
+		reservation.Zone = slot.Zone
+		reservation.SlotStartsAt = slot.StartsAt
+		reservation.ReservedAt = time.Now()
+		if err := tx.Save(reservation).Error; err != nil {
+			return errors.WrapIf(err, "[postgresDeliverySlotRepository_Reschedule.Save] error in updating the delivery slot reservation")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WithMessage(err, fmt.Sprintf("[postgresDeliverySlotRepository_Reschedule.Transaction] error in rescheduling the delivery slot of order %s", orderId)))
+	}
+	p.log.Infow(fmt.Sprintf("[postgresDeliverySlotRepository.Reschedule] delivery slot of order %s rescheduled to zone %s at %s", orderId, slot.Zone, slot.StartsAt), logger.Fields{"OrderId": orderId, "Zone": slot.Zone, "StartsAt": slot.StartsAt})
+
+	return nil
+}
+
+func (p *postgresDeliverySlotRepository) Release(ctx context.Context, orderId uuid.UUID) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresDeliverySlotRepository.Release")
+	span.LogFields(log.String("OrderId", orderId.String()))
+	defer span.Finish()
+
+	released := false
+	err := p.gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		reservation, err := lockReservation(tx, orderId)
+		if err != nil || reservation == nil {
+			return err
+		}
+
+		if err := tx.Delete(reservation).Error; err != nil {
+			return errors.WrapIf(err, "[postgresDeliverySlotRepository_Release.Delete] error in deleting the delivery slot reservation")
+		}
+		if err := releasePlace(tx, reservation); err != nil {
+			return err
+		}
+		released = true
+
+		return nil
+	})
+	if err != nil {
+		return false, tracing.TraceWithErr(span, errors.WithMessage(err, fmt.Sprintf("[postgresDeliverySlotRepository_Release.Transaction] error in releasing the delivery slot of order %s", orderId)))
+	}
+	if released {
+		p.log.Infow(fmt.Sprintf("[postgresDeliverySlotRepository.Release] delivery slot of order %s released", orderId), logger.Fields{"OrderId": orderId})
+	}
+
+	return released, nil
+}
+
+func (p *postgresDeliverySlotRepository) GetReservation(ctx context.Context, orderId uuid.UUID) (*models.DeliverySlotReservation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresDeliverySlotRepository.GetReservation")
+	span.LogFields(log.String("OrderId", orderId.String()))
+	defer span.Finish()
+
+	var reservation models.DeliverySlotReservation
+	if err := p.gorm.WithContext(ctx).First(&reservation, "order_id = ?", orderId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[postgresDeliverySlotRepository_GetReservation.First] error in loading the delivery slot reservation of order %s", orderId)))
+	}
+	span.LogFields(log.Object("DeliverySlotReservation", reservation))
+
+	return &reservation, nil
+}
+
+func (p *postgresDeliverySlotRepository) GetSlots(ctx context.Context, zone string, from time.Time, to time.Time) ([]*models.DeliverySlot, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresDeliverySlotRepository.GetSlots")
+	span.LogFields(log.String("Zone", zone))
+	span.LogFields(log.String("From", from.String()))
+	span.LogFields(log.String("To", to.String()))
+	defer span.Finish()
+
+	var slots []*models.DeliverySlot
+	err := p.gorm.WithContext(ctx).
+		Where("zone = ? AND starts_at >= ? AND starts_at < ?", zone, from, to).
+		Order("starts_at").
+		Find(&slots).Error
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[postgresDeliverySlotRepository_GetSlots.Find] error in loading the delivery slots of zone %s", zone)))
+	}
+
+	return slots, nil
+}
+
+func (p *postgresDeliverySlotRepository) MarkForReconciliation(ctx context.Context, orderId uuid.UUID, reason string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresDeliverySlotRepository.MarkForReconciliation")
+	span.LogFields(log.String("OrderId", orderId.String()))
+	span.LogFields(log.String("Reason", reason))
+	defer span.Finish()
+
+	err := p.gorm.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "order_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reason"}),
+	}).Create(&models.DeliverySlotReconciliation{OrderId: orderId, Reason: reason, RecordedAt: time.Now()}).Error
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[postgresDeliverySlotRepository_MarkForReconciliation.Create] error in marking the delivery slot of order %s for reconciliation", orderId)))
+	}
+	p.log.Infow(fmt.Sprintf("[postgresDeliverySlotRepository.MarkForReconciliation] delivery slot of order %s marked for reconciliation: %s", orderId, reason), logger.Fields{"OrderId": orderId})
+
+	return nil
+}
+
+func (p *postgresDeliverySlotRepository) GetReconciliations(ctx context.Context, limit int) ([]*models.DeliverySlotReconciliation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresDeliverySlotRepository.GetReconciliations")
+	defer span.Finish()
+
+	var reconciliations []*models.DeliverySlotReconciliation
+	if err := p.gorm.WithContext(ctx).Order("recorded_at").Limit(limit).Find(&reconciliations).Error; err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[postgresDeliverySlotRepository_GetReconciliations.Find] error in loading the delivery slot reconciliations"))
+	}
+
+	return reconciliations, nil
+}
+
+func (p *postgresDeliverySlotRepository) RemoveReconciliation(ctx context.Context, orderId uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresDeliverySlotRepository.RemoveReconciliation")
+	span.LogFields(log.String("OrderId", orderId.String()))
+	defer span.Finish()
+
+	if err := p.gorm.WithContext(ctx).Delete(&models.DeliverySlotReconciliation{}, "order_id = ?", orderId).Error; err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[postgresDeliverySlotRepository_RemoveReconciliation.Delete] error in removing the delivery slot reconciliation of order %s", orderId)))
+	}
+
+	return nil
+}
+
+// lockReservation loads the reservation of the order with a row lock, so the changes of the same order are serialized
+func lockReservation(tx *gorm.DB, orderId uuid.UUID) (*models.DeliverySlotReservation, error) {
+	reservation := &models.DeliverySlotReservation{}
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(reservation, "order_id = ?", orderId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.WrapIf(err, "[postgresDeliverySlotRepository_lockReservation.First] error in loading the delivery slot reservation")
+	}
+
+	return reservation, nil
+}
+
+// takePlace creates the slot with the configured capacity on its first reservation and increments its reserved places.
+// The conditional increment is atomic, so the concurrent reservations can't take more places than the capacity
+func takePlace(tx *gorm.DB, slot *models.DeliverySlot) error {
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "zone"}, {Name: "starts_at"}},
+		DoUpdates: clause.AssignmentColumns([]string{"ends_at", "capacity"}),
+	}).Create(&models.DeliverySlot{Zone: slot.Zone, StartsAt: slot.StartsAt, EndsAt: slot.EndsAt, Capacity: slot.Capacity}).Error
+	if err != nil {
+		return errors.WrapIf(err, "[postgresDeliverySlotRepository_takePlace.Create] error in upserting the delivery slot")
+	}
+
+	result := tx.Model(&models.DeliverySlot{}).
+		Where("zone = ? AND starts_at = ? AND reserved < capacity", slot.Zone, slot.StartsAt).
+		Update("reserved", gorm.Expr("reserved + 1"))
+	if result.Error != nil {
+		return errors.WrapIf(result.Error, "[postgresDeliverySlotRepository_takePlace.Update] error in reserving a place of the delivery slot")
+	}
+	if result.RowsAffected == 0 {
+		return domainExceptions.NewDeliverySlotFullError(fmt.Sprintf("delivery slot of zone %s at %s is full", slot.Zone, slot.StartsAt.Format(time.RFC3339)))
+	}
+
+	return nil
+}
+
+func releasePlace(tx *gorm.DB, reservation *models.DeliverySlotReservation) error {
+	err := tx.Model(&models.DeliverySlot{}).
+		Where("zone = ? AND starts_at = ? AND reserved > 0", reservation.Zone, reservation.SlotStartsAt).
+		Update("reserved", gorm.Expr("reserved - 1")).Error
+	if err != nil {
+		return errors.WrapIf(err, "[postgresDeliverySlotRepository_releasePlace.Update] error in releasing a place of the delivery slot")
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package delivery
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+)
+
+type DeliverySlotEndpointBase struct {
+	*infrastructure.InfrastructureConfiguration
+	DeliverySlotsGroup *echo.Group
+}
+
+func NewDeliverySlotEndpointBase(infra *infrastructure.InfrastructureConfiguration, deliverySlotsGroup *echo.Group) *DeliverySlotEndpointBase {
+	return &DeliverySlotEndpointBase{DeliverySlotsGroup: deliverySlotsGroup, InfrastructureConfiguration: infra}
+}
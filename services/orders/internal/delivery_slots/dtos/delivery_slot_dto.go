@@ -0,0 +1,11 @@
+package dtos
+
+import "time"
+
+type DeliverySlotDto struct {
+	Zone      string    `json:"zone"`
+	StartsAt  time.Time `json:"startsAt"`
+	EndsAt    time.Time `json:"endsAt"`
+	Capacity  int       `json:"capacity"`
+	Available int       `json:"available"`
+}
@@ -0,0 +1,30 @@
+package dtos
+
+import (
+	"github.com/labstack/echo/v4"
+	"time"
+)
+
+// DeliverySlotsDateLayout is the layout of the from and to days of the available delivery slots requests
+const DeliverySlotsDateLayout = "2006-01-02"
+
+type GetAvailableDeliverySlotsRequestDto struct {
+	Zone string    `query:"zone" json:"zone"`
+	From time.Time `query:"from" json:"from"`
+	To   time.Time `query:"to" json:"to"`
+}
+
+// GetAvailableDeliverySlotsRequestFromCtx binds the zone and the days from the query string, the days are in the DeliverySlotsDateLayout
+func GetAvailableDeliverySlotsRequestFromCtx(c echo.Context) (*GetAvailableDeliverySlotsRequestDto, error) {
+	request := &GetAvailableDeliverySlotsRequestDto{}
+	err := echo.QueryParamsBinder(c).
+		String("zone", &request.Zone).
+		Time("from", &request.From, DeliverySlotsDateLayout).
+		Time("to", &request.To, DeliverySlotsDateLayout).
+		BindError()
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
@@ -0,0 +1,10 @@
+package dtos
+
+import deliverySlotsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/dtos"
+
+type GetAvailableDeliverySlotsResponseDto struct {
+	Zone  string                               `json:"zone"`
+	From  string                               `json:"from"`
+	To    string                               `json:"to"`
+	Slots []*deliverySlotsDtos.DeliverySlotDto `json:"slots"`
+}
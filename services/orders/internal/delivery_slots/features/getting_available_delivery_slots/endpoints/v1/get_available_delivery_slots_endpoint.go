@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/delivery"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/features/getting_available_delivery_slots/dtos"
+	gettingAvailableDeliverySlotsV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/features/getting_available_delivery_slots/queries/v1"
+	"net/http"
+)
+
+type getAvailableDeliverySlotsEndpoint struct {
+	*delivery.DeliverySlotEndpointBase
+}
+
+func NewGetAvailableDeliverySlotsEndpoint(endpointBase *delivery.DeliverySlotEndpointBase) *getAvailableDeliverySlotsEndpoint {
+	return &getAvailableDeliverySlotsEndpoint{endpointBase}
+}
+
+func (ep *getAvailableDeliverySlotsEndpoint) MapRoute() {
+	ep.DeliverySlotsGroup.GET("", ep.handler())
+}
+
+// GetAvailableDeliverySlots
+// @Tags DeliverySlots
+// @Summary Get available delivery slots
+// @Description Get the delivery slots of a zone with a free place between two days (yyyy-mm-dd, utc), the slots can be booked before they start within the booking days
+// @Accept json
+// @Produce json
+// @Param GetAvailableDeliverySlotsRequestDto query dtos.GetAvailableDeliverySlotsRequestDto true "GetAvailableDeliverySlotsRequestDto"
+// @Success 200 {object} dtos.GetAvailableDeliverySlotsResponseDto
+// @Router /api/v1/delivery-slots [get]
+func (ep *getAvailableDeliverySlotsEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.GetAvailableDeliverySlotsHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "getAvailableDeliverySlotsEndpoint.handler")
+		defer span.Finish()
+
+		request, err := dtos.GetAvailableDeliverySlotsRequestFromCtx(c)
+		if err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[getAvailableDeliverySlotsEndpoint_handler.GetAvailableDeliverySlotsRequestFromCtx] error in getting data from query string")
+			ep.Log.Errorf(fmt.Sprintf("[getAvailableDeliverySlotsEndpoint_handler.GetAvailableDeliverySlotsRequestFromCtx] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		query := gettingAvailableDeliverySlotsV1.NewGetAvailableDeliverySlots(request.Zone, request.From, request.To)
+		if err := ep.Validator.StructCtx(ctx, query); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[getAvailableDeliverySlotsEndpoint_handler.StructCtx] query validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[getAvailableDeliverySlotsEndpoint_handler.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		queryResult, err := mediatr.Send[*gettingAvailableDeliverySlotsV1.GetAvailableDeliverySlots, *dtos.GetAvailableDeliverySlotsResponseDto](ctx, query)
+		if err != nil {
+			err = errors.WithMessage(err, "[getAvailableDeliverySlotsEndpoint_handler.Send] error in sending GetAvailableDeliverySlots")
+			ep.Log.Error(fmt.Sprintf("[getAvailableDeliverySlotsEndpoint_handler.Send] err: {%v}", tracing.TraceWithErr(span, err)))
+			return err
+		}
+
+		return c.JSON(http.StatusOK, queryResult)
+	}
+}
@@ -0,0 +1,22 @@
+package v1
+
+import "time"
+
+// GetAvailableDeliverySlots gets the bookable slots of the zone with a free place between the from and to days, both days included
+type GetAvailableDeliverySlots struct {
+	Zone string    `validate:"required"`
+	From time.Time `validate:"required"`
+	To   time.Time `validate:"required,gtefield=From"`
+}
+
+// NewGetAvailableDeliverySlots lists the slots from today when the from day isn't set, and the slots of the from day when the to day isn't set
+func NewGetAvailableDeliverySlots(zone string, from time.Time, to time.Time) *GetAvailableDeliverySlots {
+	if from.IsZero() {
+		from = time.Now().UTC().Truncate(24 * time.Hour)
+	}
+	if to.IsZero() {
+		to = from
+	}
+
+	return &GetAvailableDeliverySlots{Zone: zone, From: from, To: to}
+}
@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"context"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/contracts"
+	deliverySlotsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/features/getting_available_delivery_slots/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"time"
+)
+
+type GetAvailableDeliverySlotsHandler struct {
+	log                    logger.Logger
+	cfg                    *config.Config
+	deliverySchedule       *models.DeliverySchedule
+	deliverySlotRepository contracts.DeliverySlotRepository
+}
+
+func NewGetAvailableDeliverySlotsHandler(log logger.Logger, cfg *config.Config, deliverySchedule *models.DeliverySchedule, deliverySlotRepository contracts.DeliverySlotRepository) *GetAvailableDeliverySlotsHandler {
+	return &GetAvailableDeliverySlotsHandler{log: log, cfg: cfg, deliverySchedule: deliverySchedule, deliverySlotRepository: deliverySlotRepository}
+}
+
+func (q *GetAvailableDeliverySlotsHandler) Handle(ctx context.Context, query *GetAvailableDeliverySlots) (*dtos.GetAvailableDeliverySlotsResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetAvailableDeliverySlotsHandler.Handle")
+	span.LogFields(log.Object("Query", query))
+	defer span.Finish()
+
+	from := query.From.UTC().Truncate(24 * time.Hour)
+	to := query.To.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+
+	slots, err := q.deliverySchedule.Slots(query.Zone, from, to)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, err)
+	}
+
+	// only the reserved slots are stored, the other slots have all of their capacity
+	reservedSlots, err := q.deliverySlotRepository.GetSlots(ctx, query.Zone, from, to)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetAvailableDeliverySlotsHandler_Handle.GetSlots] error in getting the reserved delivery slots in the repository"))
+	}
+	reserved := make(map[time.Time]int, len(reservedSlots))
+	for _, slot := range reservedSlots {
+		reserved[slot.StartsAt.UTC()] = slot.Reserved
+	}
+
+	now := time.Now()
+	availableSlots := make([]*models.DeliverySlot, 0, len(slots))
+	for _, slot := range slots {
+		slot.Reserved = reserved[slot.StartsAt]
+		if q.deliverySchedule.IsBookable(slot, now) && slot.Available() > 0 {
+			availableSlots = append(availableSlots, slot)
+		}
+	}
+
+	slotsDto, err := mapper.Map[[]*deliverySlotsDtos.DeliverySlotDto](availableSlots)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetAvailableDeliverySlotsHandler_Handle.Map] error in the mapping delivery slots"))
+	}
+
+	q.log.Infow("[GetAvailableDeliverySlotsHandler.Handle] available delivery slots fetched", logger.Fields{"Zone": query.Zone, "From": query.From, "To": query.To})
+
+	return &dtos.GetAvailableDeliverySlotsResponseDto{
+		Zone:  query.Zone,
+		From:  query.From.Format(dtos.DeliverySlotsDateLayout),
+		To:    query.To.Format(dtos.DeliverySlotsDateLayout),
+		Slots: slotsDto,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/configurations/mappings"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// reservedSlotRepository returns the stored reserved slots
+type reservedSlotRepository struct {
+	contracts.DeliverySlotRepository
+	slots []*models.DeliverySlot
+}
+
+func (r *reservedSlotRepository) GetSlots(ctx context.Context, zone string, from time.Time, to time.Time) ([]*models.DeliverySlot, error) {
+	return r.slots, nil
+}
+
+func Test_Get_Available_Delivery_Slots_Query_Handler(t *testing.T) {
+	require.NoError(t, mappings.ConfigureMappings())
+
+	schedule, err := models.NewDeliverySchedule(&config.DeliverySlots{
+		BookingDays: 7,
+		Zones: []*config.DeliveryZone{
+			{
+				Zone: "central",
+				Windows: []*config.DeliveryWindow{
+					{Start: "08:00", End: "12:00", Capacity: 10},
+					{Start: "12:00", End: "16:00", Capacity: 2},
+					{Start: "16:00", End: "20:00", Capacity: 5},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tomorrow := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	repository := &reservedSlotRepository{slots: []*models.DeliverySlot{
+		{Zone: "central", StartsAt: tomorrow.Add(8 * time.Hour), Capacity: 10, Reserved: 4},
+		{Zone: "central", StartsAt: tomorrow.Add(12 * time.Hour), Capacity: 2, Reserved: 2},
+	}}
+	handler := NewGetAvailableDeliverySlotsHandler(defaultLogger.Logger, &config.Config{}, schedule, repository)
+
+	result, err := handler.Handle(context.Background(), NewGetAvailableDeliverySlots("central", tomorrow, time.Time{}))
+	require.NoError(t, err)
+
+	// the full slot of the noon isn't available
+	require.Len(t, result.Slots, 2)
+	assert.Equal(t, tomorrow.Add(8*time.Hour), result.Slots[0].StartsAt)
+	assert.Equal(t, 6, result.Slots[0].Available)
+	assert.Equal(t, tomorrow.Add(16*time.Hour), result.Slots[1].StartsAt)
+	assert.Equal(t, 5, result.Slots[1].Available)
+}
@@ -0,0 +1,162 @@
+package models
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	domainExceptions "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/exceptions/domain"
+	"sort"
+	"time"
+)
+
+// DeliveryWindowLayout is the layout of the start and end of the configured delivery windows
+const DeliveryWindowLayout = "15:04"
+
+const day = 24 * time.Hour
+
+type deliveryWindow struct {
+	start    time.Duration
+	end      time.Duration
+	capacity int
+}
+
+// DeliverySchedule is the daily delivery windows of the zones, a slot is a window of a zone on an utc day
+type DeliverySchedule struct {
+	bookingDays int
+	zones       map[string][]*deliveryWindow
+}
+
+// NewDeliverySchedule validates the configured windows, the windows of a zone can't overlap
+func NewDeliverySchedule(cfg *config.DeliverySlots) (*DeliverySchedule, error) {
+	if cfg == nil {
+		return nil, errors.New("delivery slots are not configured")
+	}
+	if cfg.BookingDays <= 0 {
+		return nil, errors.Errorf("booking days of the delivery slots should be positive, it is %d", cfg.BookingDays)
+	}
+
+	zones := make(map[string][]*deliveryWindow)
+	for _, zone := range cfg.Zones {
+		if zone.Zone == "" {
+			return nil, errors.New("delivery zone should have a name")
+		}
+		if _, ok := zones[zone.Zone]; ok {
+			return nil, errors.Errorf("delivery zone %s is configured more than once", zone.Zone)
+		}
+		if len(zone.Windows) == 0 {
+			return nil, errors.Errorf("delivery zone %s has no delivery window", zone.Zone)
+		}
+
+		windows := make([]*deliveryWindow, 0, len(zone.Windows))
+		for _, window := range zone.Windows {
+			deliveryWindow, err := parseDeliveryWindow(window)
+			if err != nil {
+				return nil, errors.WrapIf(err, fmt.Sprintf("invalid delivery window of zone %s", zone.Zone))
+			}
+			windows = append(windows, deliveryWindow)
+		}
+
+		sort.Slice(windows, func(i, j int) bool {
+			return windows[i].start < windows[j].start
+		})
+		for i := 1; i < len(windows); i++ {
+			if windows[i].start < windows[i-1].end {
+				return nil, errors.Errorf("delivery windows of zone %s overlap", zone.Zone)
+			}
+		}
+
+		zones[zone.Zone] = windows
+	}
+
+	return &DeliverySchedule{bookingDays: cfg.BookingDays, zones: zones}, nil
+}
+
+func parseDeliveryWindow(window *config.DeliveryWindow) (*deliveryWindow, error) {
+	start, err := time.Parse(DeliveryWindowLayout, window.Start)
+	if err != nil {
+		return nil, errors.WrapIf(err, "the start should be in the hh:mm format")
+	}
+	end, err := time.Parse(DeliveryWindowLayout, window.End)
+	if err != nil {
+		return nil, errors.WrapIf(err, "the end should be in the hh:mm format")
+	}
+	if !end.After(start) {
+		return nil, errors.Errorf("the window %s-%s should end after its start on the same day", window.Start, window.End)
+	}
+	if window.Capacity <= 0 {
+		return nil, errors.Errorf("the capacity of the window %s-%s should be positive", window.Start, window.End)
+	}
+
+	return &deliveryWindow{start: sinceMidnight(start), end: sinceMidnight(end), capacity: window.Capacity}, nil
+}
+
+func sinceMidnight(clock time.Time) time.Duration {
+	return time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute
+}
+
+// BookingDays is the number of the days from today whose slots can be booked
+func (s *DeliverySchedule) BookingDays() int {
+	return s.bookingDays
+}
+
+// Slot is the slot of the zone whose window contains the delivery time
+func (s *DeliverySchedule) Slot(zone string, deliveryTime time.Time) (*DeliverySlot, error) {
+	windows, ok := s.zones[zone]
+	if !ok {
+		return nil, domainExceptions.NewDeliverySlotUnavailableError(fmt.Sprintf("delivery zone %s is not found", zone))
+	}
+
+	deliveryTime = deliveryTime.UTC()
+	deliveryDay := deliveryTime.Truncate(day)
+	offset := deliveryTime.Sub(deliveryDay)
+	for _, window := range windows {
+		if offset >= window.start && offset < window.end {
+			return window.slot(zone, deliveryDay), nil
+		}
+	}
+
+	return nil, domainExceptions.NewDeliverySlotUnavailableError(fmt.Sprintf("delivery time %s is not in a delivery window of zone %s", deliveryTime.Format(time.RFC3339), zone))
+}
+
+// BookableSlot is the slot of the delivery time which can be booked at now, a slot can be booked before its start within the booking days
+func (s *DeliverySchedule) BookableSlot(zone string, deliveryTime time.Time, now time.Time) (*DeliverySlot, error) {
+	slot, err := s.Slot(zone, deliveryTime)
+	if err != nil {
+		return nil, err
+	}
+	if !s.IsBookable(slot, now) {
+		return nil, domainExceptions.NewDeliverySlotUnavailableError(fmt.Sprintf("delivery slot of zone %s at %s can't be booked, the slots of the next %d days can be booked before they start", zone, slot.StartsAt.Format(time.RFC3339), s.bookingDays))
+	}
+
+	return slot, nil
+}
+
+func (s *DeliverySchedule) IsBookable(slot *DeliverySlot, now time.Time) bool {
+	lastBookingDay := now.UTC().Truncate(day).AddDate(0, 0, s.bookingDays)
+
+	return slot.StartsAt.After(now) && slot.StartsAt.Before(lastBookingDay)
+}
+
+// Slots are the slots of the zone which start between the from and to times, the to time is excluded
+func (s *DeliverySchedule) Slots(zone string, from time.Time, to time.Time) ([]*DeliverySlot, error) {
+	windows, ok := s.zones[zone]
+	if !ok {
+		return nil, domainExceptions.NewDeliverySlotUnavailableError(fmt.Sprintf("delivery zone %s is not found", zone))
+	}
+
+	var slots []*DeliverySlot
+	for deliveryDay := from.UTC().Truncate(day); deliveryDay.Before(to); deliveryDay = deliveryDay.AddDate(0, 0, 1) {
+		for _, window := range windows {
+			slot := window.slot(zone, deliveryDay)
+			if !slot.StartsAt.Before(from) && slot.StartsAt.Before(to) {
+				slots = append(slots, slot)
+			}
+		}
+	}
+
+	return slots, nil
+}
+
+func (w *deliveryWindow) slot(zone string, deliveryDay time.Time) *DeliverySlot {
+	return &DeliverySlot{Zone: zone, StartsAt: deliveryDay.Add(w.start), EndsAt: deliveryDay.Add(w.end), Capacity: w.capacity}
+}
@@ -0,0 +1,102 @@
+package models
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	domainExceptions "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/exceptions/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func newDeliverySlotsConfig() *config.DeliverySlots {
+	return &config.DeliverySlots{
+		BookingDays: 7,
+		Zones: []*config.DeliveryZone{
+			{
+				Zone: "central",
+				Windows: []*config.DeliveryWindow{
+					{Start: "12:00", End: "16:00", Capacity: 20},
+					{Start: "08:00", End: "12:00", Capacity: 10},
+				},
+			},
+		},
+	}
+}
+
+func Test_New_Delivery_Schedule_Validates_Windows(t *testing.T) {
+	cfg := newDeliverySlotsConfig()
+	cfg.Zones[0].Windows = append(cfg.Zones[0].Windows, &config.DeliveryWindow{Start: "15:00", End: "18:00", Capacity: 5})
+	_, err := NewDeliverySchedule(cfg)
+	assert.Error(t, err)
+
+	cfg = newDeliverySlotsConfig()
+	cfg.Zones[0].Windows[0].End = "11:00"
+	_, err = NewDeliverySchedule(cfg)
+	assert.Error(t, err)
+
+	cfg = newDeliverySlotsConfig()
+	cfg.Zones[0].Windows[0].Capacity = 0
+	_, err = NewDeliverySchedule(cfg)
+	assert.Error(t, err)
+
+	cfg = newDeliverySlotsConfig()
+	cfg.Zones = append(cfg.Zones, cfg.Zones[0])
+	_, err = NewDeliverySchedule(cfg)
+	assert.Error(t, err)
+
+	_, err = NewDeliverySchedule(nil)
+	assert.Error(t, err)
+}
+
+func Test_Delivery_Schedule_Slot(t *testing.T) {
+	schedule, err := NewDeliverySchedule(newDeliverySlotsConfig())
+	require.NoError(t, err)
+
+	slot, err := schedule.Slot("central", time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC), slot.StartsAt)
+	assert.Equal(t, time.Date(2024, 3, 10, 16, 0, 0, 0, time.UTC), slot.EndsAt)
+	assert.Equal(t, 20, slot.Capacity)
+
+	// the delivery times are compared in utc
+	slot, err = schedule.Slot("central", time.Date(2024, 3, 10, 11, 30, 0, 0, time.FixedZone("CET", 3600)))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC), slot.StartsAt)
+
+	_, err = schedule.Slot("central", time.Date(2024, 3, 10, 16, 0, 0, 0, time.UTC))
+	assert.True(t, domainExceptions.IsDeliverySlotUnavailableError(err))
+
+	_, err = schedule.Slot("suburbs", time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC))
+	assert.True(t, domainExceptions.IsDeliverySlotUnavailableError(err))
+}
+
+func Test_Delivery_Schedule_Bookable_Slot(t *testing.T) {
+	schedule, err := NewDeliverySchedule(newDeliverySlotsConfig())
+	require.NoError(t, err)
+	now := time.Date(2024, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	slot, err := schedule.BookableSlot("central", time.Date(2024, 3, 16, 13, 0, 0, 0, time.UTC), now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 16, 12, 0, 0, 0, time.UTC), slot.StartsAt)
+
+	// the slot of now is already started
+	_, err = schedule.BookableSlot("central", time.Date(2024, 3, 10, 10, 0, 0, 0, time.UTC), now)
+	assert.True(t, domainExceptions.IsDeliverySlotUnavailableError(err))
+
+	// the seventh day from today is after the booking days
+	_, err = schedule.BookableSlot("central", time.Date(2024, 3, 17, 8, 0, 0, 0, time.UTC), now)
+	assert.True(t, domainExceptions.IsDeliverySlotUnavailableError(err))
+}
+
+func Test_Delivery_Schedule_Slots(t *testing.T) {
+	schedule, err := NewDeliverySchedule(newDeliverySlotsConfig())
+	require.NoError(t, err)
+
+	slots, err := schedule.Slots("central", time.Date(2024, 3, 10, 10, 0, 0, 0, time.UTC), time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, slots, 3)
+	assert.Equal(t, time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC), slots[0].StartsAt)
+	assert.Equal(t, time.Date(2024, 3, 11, 8, 0, 0, 0, time.UTC), slots[1].StartsAt)
+	assert.Equal(t, time.Date(2024, 3, 11, 12, 0, 0, 0, time.UTC), slots[2].StartsAt)
+}
@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// DeliverySlot is a delivery window of a zone on a day, the capacity is the number of the orders which can be delivered in the slot
+type DeliverySlot struct {
+	Zone     string    `json:"zone" gorm:"primaryKey"`
+	StartsAt time.Time `json:"startsAt" gorm:"primaryKey"`
+	EndsAt   time.Time `json:"endsAt"`
+	Capacity int       `json:"capacity"`
+	// Reserved is the number of the submitted orders which are delivered in the slot
+	Reserved int `json:"reserved"`
+}
+
+func (DeliverySlot) TableName() string {
+	return "delivery_slots"
+}
+
+// Available is the number of the free places of the slot, it is zero when the capacity is lowered below the reserved places
+func (s *DeliverySlot) Available() int {
+	if s.Reserved >= s.Capacity {
+		return 0
+	}
+
+	return s.Capacity - s.Reserved
+}
+
+// SameSlot reports whether the slot is the slot of the zone which starts at the time
+func (s *DeliverySlot) SameSlot(zone string, startsAt time.Time) bool {
+	return s.Zone == zone && s.StartsAt.Equal(startsAt)
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// DeliverySlotReconciliation marks an order whose reservation may not match the stored order, because a compensation of its reservation
+// failed. The reconcile worker moves or releases the reservation of the order by its stored state and removes the mark
+type DeliverySlotReconciliation struct {
+	OrderId    uuid.UUID `json:"orderId" gorm:"primaryKey"`
+	Reason     string    `json:"reason"`
+	RecordedAt time.Time `json:"recordedAt" gorm:"index"`
+}
+
+func (DeliverySlotReconciliation) TableName() string {
+	return "delivery_slot_reconciliations"
+}
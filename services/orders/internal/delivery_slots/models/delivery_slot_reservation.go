@@ -0,0 +1,18 @@
+package models
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// DeliverySlotReservation is the place of an order in a delivery slot, an order has one reservation
+type DeliverySlotReservation struct {
+	OrderId      uuid.UUID `json:"orderId" gorm:"primaryKey"`
+	Zone         string    `json:"zone" gorm:"index:idx_delivery_slot_reservations_slot"`
+	SlotStartsAt time.Time `json:"slotStartsAt" gorm:"index:idx_delivery_slot_reservations_slot"`
+	ReservedAt   time.Time `json:"reservedAt"`
+}
+
+func (DeliverySlotReservation) TableName() string {
+	return "delivery_slot_reservations"
+}
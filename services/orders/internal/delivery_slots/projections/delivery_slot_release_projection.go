@@ -0,0 +1,48 @@
+package projections
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/contracts"
+	cancelingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/domain/v1"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// deliverySlotReleaseProjection releases the delivery slot place of a canceled order. The subscription retries an event until its projections
+// succeed and releasing is idempotent, so the place is released even if the first try fails
+type deliverySlotReleaseProjection struct {
+	deliverySlotRepository contracts.DeliverySlotRepository
+	logger                 logger.Logger
+}
+
+func NewDeliverySlotReleaseProjection(deliverySlotRepository contracts.DeliverySlotRepository, logger logger.Logger) projection.IProjection {
+	return &deliverySlotReleaseProjection{deliverySlotRepository: deliverySlotRepository, logger: logger}
+}
+
+func (d deliverySlotReleaseProjection) ProcessEvent(ctx context.Context, streamEvent *models.StreamEvent) error {
+	evt, ok := streamEvent.Event.(*cancelingOrderEvents.OrderCanceledV1)
+	if !ok {
+		return nil
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "deliverySlotReleaseProjection.ProcessEvent")
+	span.LogFields(log.String("OrderId", evt.OrderId.String()))
+	defer span.Finish()
+
+	// an order which is canceled before its submit has no reservation
+	released, err := d.deliverySlotRepository.Release(ctx, evt.OrderId)
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WithMessage(err, "[deliverySlotReleaseProjection_ProcessEvent.Release] error in releasing the delivery slot of the canceled order"))
+	}
+	if released {
+		d.logger.Infow(fmt.Sprintf("[deliverySlotReleaseProjection.ProcessEvent] delivery slot of canceled order %s released", evt.OrderId), logger.Fields{"OrderId": evt.OrderId})
+	}
+
+	return nil
+}
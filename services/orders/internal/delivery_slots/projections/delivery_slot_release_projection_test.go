@@ -0,0 +1,40 @@
+package projections
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/contracts"
+	cancelingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/domain/v1"
+	submittingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/events/domain/v1"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// releasingDeliverySlotRepository records the released reservations
+type releasingDeliverySlotRepository struct {
+	contracts.DeliverySlotRepository
+	released []uuid.UUID
+}
+
+func (r *releasingDeliverySlotRepository) Release(ctx context.Context, orderId uuid.UUID) (bool, error) {
+	r.released = append(r.released, orderId)
+	return true, nil
+}
+
+func Test_Delivery_Slot_Of_Canceled_Order_Is_Released(t *testing.T) {
+	repository := &releasingDeliverySlotRepository{}
+	projection := NewDeliverySlotReleaseProjection(repository, defaultLogger.Logger)
+	orderId := uuid.NewV4()
+
+	err := projection.ProcessEvent(context.Background(), &models.StreamEvent{Event: &submittingOrderEvents.OrderSubmittedV1{OrderId: orderId, SubmittedAt: time.Now()}})
+	require.NoError(t, err)
+	assert.Empty(t, repository.released)
+
+	err = projection.ProcessEvent(context.Background(), &models.StreamEvent{Event: &cancelingOrderEvents.OrderCanceledV1{OrderId: orderId, CancelReason: "changed my mind", CanceledAt: time.Now()}})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{orderId}, repository.released)
+}
@@ -0,0 +1,121 @@
+package workers
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/store"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+const (
+	reconcileInterval  = time.Minute
+	reconcileBatchSize = 100
+)
+
+// DeliverySlotReconciler fixes the reservations of the orders which are marked for reconciliation by the stored order, the reservation of a
+// submitted order is moved to the slot of its delivery time and the reservation of a canceled or not submitted order is released.
+// A mark is removed only after its reservation is fixed, so a failed reconciliation is tried again on the next run
+type DeliverySlotReconciler struct {
+	log                    logger.Logger
+	deliverySchedule       *models.DeliverySchedule
+	deliverySlotRepository contracts.DeliverySlotRepository
+	aggregateStore         store.AggregateStore[*aggregate.Order]
+}
+
+func NewDeliverySlotReconciler(log logger.Logger, deliverySchedule *models.DeliverySchedule, deliverySlotRepository contracts.DeliverySlotRepository, aggregateStore store.AggregateStore[*aggregate.Order]) *DeliverySlotReconciler {
+	return &DeliverySlotReconciler{log: log, deliverySchedule: deliverySchedule, deliverySlotRepository: deliverySlotRepository, aggregateStore: aggregateStore}
+}
+
+// ReconcileNext reconciles the next batch of the marked orders, it returns the number of the reconciled orders
+func (r *DeliverySlotReconciler) ReconcileNext(ctx context.Context) (int, error) {
+	reconciliations, err := r.deliverySlotRepository.GetReconciliations(ctx, reconcileBatchSize)
+	if err != nil {
+		return 0, errors.WrapIf(err, "[DeliverySlotReconciler_ReconcileNext.GetReconciliations] error in loading the delivery slot reconciliations")
+	}
+
+	reconciled := 0
+	var errs error
+	for _, reconciliation := range reconciliations {
+		if err := r.reconcile(ctx, reconciliation.OrderId); err != nil {
+			errs = errors.Append(errs, err)
+			continue
+		}
+		if err := r.deliverySlotRepository.RemoveReconciliation(ctx, reconciliation.OrderId); err != nil {
+			errs = errors.Append(errs, errors.WrapIf(err, "[DeliverySlotReconciler_ReconcileNext.RemoveReconciliation] error in removing the delivery slot reconciliation"))
+			continue
+		}
+		reconciled++
+	}
+
+	return reconciled, errs
+}
+
+func (r *DeliverySlotReconciler) reconcile(ctx context.Context, orderId uuid.UUID) error {
+	order, err := r.aggregateStore.Load(ctx, orderId)
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("[DeliverySlotReconciler_reconcile.Load] error in loading order %s", orderId))
+	}
+
+	if order.Canceled() || !order.Submitted() {
+		if _, err := r.deliverySlotRepository.Release(ctx, orderId); err != nil {
+			return errors.WithMessage(err, "[DeliverySlotReconciler_reconcile.Release] error in releasing the delivery slot of order")
+		}
+		r.log.Infow(fmt.Sprintf("[DeliverySlotReconciler.reconcile] delivery slot of order %s reconciled by releasing it", orderId), logger.Fields{"OrderId": orderId})
+
+		return nil
+	}
+
+	reservation, err := r.deliverySlotRepository.GetReservation(ctx, orderId)
+	if err != nil {
+		return errors.WithMessage(err, "[DeliverySlotReconciler_reconcile.GetReservation] error in getting the delivery slot reservation of order")
+	}
+	if reservation == nil {
+		r.log.Warnf("[DeliverySlotReconciler.reconcile] submitted order %s has no delivery slot reservation", orderId)
+		return nil
+	}
+
+	// the slot of the stored delivery time may be full already, the mark is kept and the move is tried again on the next run
+	slot, err := r.deliverySchedule.Slot(reservation.Zone, order.DeliveredTime())
+	if err != nil {
+		return errors.WithMessage(err, "[DeliverySlotReconciler_reconcile.Slot] error in finding the delivery slot of order")
+	}
+	if err := r.deliverySlotRepository.Reschedule(ctx, orderId, slot); err != nil {
+		return errors.WithMessage(err, "[DeliverySlotReconciler_reconcile.Reschedule] error in moving the delivery slot of order")
+	}
+	r.log.Infow(fmt.Sprintf("[DeliverySlotReconciler.reconcile] delivery slot of order %s reconciled to zone %s at %s", orderId, slot.Zone, slot.StartsAt), logger.Fields{"OrderId": orderId, "Zone": slot.Zone, "StartsAt": slot.StartsAt})
+
+	return nil
+}
+
+// NewDeliverySlotReconcileWorker reconciles the marked orders until the worker stops
+func NewDeliverySlotReconcileWorker(reconciler *DeliverySlotReconciler, logger logger.Logger) web.Worker {
+	stop := make(chan struct{})
+
+	return web.NewBackgroundWorker(func(ctx context.Context) error {
+		ticker := time.NewTicker(reconcileInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if _, err := reconciler.ReconcileNext(ctx); err != nil && ctx.Err() == nil {
+					logger.Errorf("[DeliverySlotReconcileWorker.ReconcileNext] error in reconciling the delivery slots: {%v}", err)
+				}
+			case <-stop:
+				return nil
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}, func(ctx context.Context) error {
+		close(stop)
+		return nil
+	})
+}
@@ -0,0 +1,171 @@
+package workers
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/store"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/mappings"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func Test_Delivery_Slot_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, mappings.ConfigureMappings())
+
+	deliveryTime := time.Date(2022, 10, 1, 13, 0, 0, 0, time.UTC)
+	storedSlotStartsAt := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	movedSlotStartsAt := time.Date(2022, 10, 2, 8, 0, 0, 0, time.UTC)
+
+	t.Run("reservation of submitted order is moved back to the slot of the stored order", func(t *testing.T) {
+		reconciler, repository, orders := newTestReconciler(t)
+		order := orders.add(newTestOrder(t, deliveryTime, true))
+		repository.reservations[order.Id()] = &models.DeliverySlotReservation{OrderId: order.Id(), Zone: "central", SlotStartsAt: movedSlotStartsAt}
+		require.NoError(t, repository.MarkForReconciliation(ctx, order.Id(), "rollback failed"))
+
+		reconciled, err := reconciler.ReconcileNext(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, reconciled)
+		assert.Equal(t, storedSlotStartsAt, repository.reservations[order.Id()].SlotStartsAt)
+		assert.Empty(t, repository.reconciliations)
+	})
+
+	t.Run("reservation of not submitted order is released", func(t *testing.T) {
+		reconciler, repository, orders := newTestReconciler(t)
+		order := orders.add(newTestOrder(t, deliveryTime, false))
+		repository.reservations[order.Id()] = &models.DeliverySlotReservation{OrderId: order.Id(), Zone: "central", SlotStartsAt: storedSlotStartsAt}
+		require.NoError(t, repository.MarkForReconciliation(ctx, order.Id(), "release failed"))
+
+		reconciled, err := reconciler.ReconcileNext(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, reconciled)
+		assert.NotContains(t, repository.reservations, order.Id())
+		assert.Empty(t, repository.reconciliations)
+	})
+
+	t.Run("failed reconciliation is kept for the next run", func(t *testing.T) {
+		reconciler, repository, orders := newTestReconciler(t)
+		order := orders.add(newTestOrder(t, deliveryTime, true))
+		repository.reservations[order.Id()] = &models.DeliverySlotReservation{OrderId: order.Id(), Zone: "central", SlotStartsAt: movedSlotStartsAt}
+		require.NoError(t, repository.MarkForReconciliation(ctx, order.Id(), "rollback failed"))
+		repository.rescheduleErr = errors.New("delivery slot is full")
+
+		reconciled, err := reconciler.ReconcileNext(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, 0, reconciled)
+		assert.Contains(t, repository.reconciliations, order.Id())
+
+		repository.rescheduleErr = nil
+		reconciled, err = reconciler.ReconcileNext(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, reconciled)
+		assert.Equal(t, storedSlotStartsAt, repository.reservations[order.Id()].SlotStartsAt)
+	})
+}
+
+func newTestReconciler(t *testing.T) (*DeliverySlotReconciler, *fakeDeliverySlotRepository, *fakeOrderAggregateStore) {
+	deliverySchedule, err := models.NewDeliverySchedule(&config.DeliverySlots{
+		BookingDays: 7,
+		Zones: []*config.DeliveryZone{
+			{
+				Zone: "central",
+				Windows: []*config.DeliveryWindow{
+					{Start: "08:00", End: "12:00", Capacity: 10},
+					{Start: "12:00", End: "16:00", Capacity: 10},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	repository := &fakeDeliverySlotRepository{reservations: map[uuid.UUID]*models.DeliverySlotReservation{}, reconciliations: map[uuid.UUID]*models.DeliverySlotReconciliation{}}
+	orders := &fakeOrderAggregateStore{orders: map[uuid.UUID]*aggregate.Order{}}
+
+	return NewDeliverySlotReconciler(defaultLogger.Logger, deliverySchedule, repository, orders), repository, orders
+}
+
+func newTestOrder(t *testing.T, deliveryTime time.Time, submitted bool) *aggregate.Order {
+	shopItems := []*value_objects.ShopItem{value_objects.CreateNewShopItem(uuid.NewV4(), "book", "a book", 2, domain.MustParseMoney("10", "USD"))}
+	order, err := aggregate.NewOrder(uuid.NewV4(), shopItems, "test@example.com", "test address", deliveryTime, time.Now())
+	require.NoError(t, err)
+	if submitted {
+		require.NoError(t, order.Submit(time.Now()))
+	}
+
+	return order
+}
+
+type fakeOrderAggregateStore struct {
+	store.AggregateStore[*aggregate.Order]
+	orders map[uuid.UUID]*aggregate.Order
+}
+
+func (f *fakeOrderAggregateStore) add(order *aggregate.Order) *aggregate.Order {
+	f.orders[order.Id()] = order
+	return order
+}
+
+func (f *fakeOrderAggregateStore) Load(ctx context.Context, aggregateId uuid.UUID) (*aggregate.Order, error) {
+	order, ok := f.orders[aggregateId]
+	if !ok {
+		return nil, errors.New("order is not found")
+	}
+
+	return order, nil
+}
+
+type fakeDeliverySlotRepository struct {
+	contracts.DeliverySlotRepository
+	reservations    map[uuid.UUID]*models.DeliverySlotReservation
+	reconciliations map[uuid.UUID]*models.DeliverySlotReconciliation
+	rescheduleErr   error
+}
+
+func (f *fakeDeliverySlotRepository) Reschedule(ctx context.Context, orderId uuid.UUID, slot *models.DeliverySlot) error {
+	if f.rescheduleErr != nil {
+		return f.rescheduleErr
+	}
+	f.reservations[orderId].SlotStartsAt = slot.StartsAt
+
+	return nil
+}
+
+func (f *fakeDeliverySlotRepository) Release(ctx context.Context, orderId uuid.UUID) (bool, error) {
+	_, ok := f.reservations[orderId]
+	delete(f.reservations, orderId)
+
+	return ok, nil
+}
+
+func (f *fakeDeliverySlotRepository) GetReservation(ctx context.Context, orderId uuid.UUID) (*models.DeliverySlotReservation, error) {
+	return f.reservations[orderId], nil
+}
+
+func (f *fakeDeliverySlotRepository) MarkForReconciliation(ctx context.Context, orderId uuid.UUID, reason string) error {
+	f.reconciliations[orderId] = &models.DeliverySlotReconciliation{OrderId: orderId, Reason: reason, RecordedAt: time.Now()}
+	return nil
+}
+
+func (f *fakeDeliverySlotRepository) GetReconciliations(ctx context.Context, limit int) ([]*models.DeliverySlotReconciliation, error) {
+	var reconciliations []*models.DeliverySlotReconciliation
+	for _, reconciliation := range f.reconciliations {
+		reconciliations = append(reconciliations, reconciliation)
+	}
+
+	return reconciliations, nil
+}
+
+func (f *fakeDeliverySlotRepository) RemoveReconciliation(ctx context.Context, orderId uuid.UUID) error {
+	delete(f.reconciliations, orderId)
+	return nil
+}
@@ -0,0 +1,55 @@
+package catalogs
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/clients"
+	catalogsService "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/proto/catalogs_service_clients"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcCatalogClient struct {
+	productsClient catalogsService.ProductsServiceClient
+}
+
+func NewGrpcCatalogClient(conn grpc.ClientConnInterface) clients.CatalogClient {
+	return &grpcCatalogClient{productsClient: catalogsService.NewProductsServiceClient(conn)}
+}
+
+func (g *grpcCatalogClient) GetProductById(ctx context.Context, productId uuid.UUID) (*dtos.CatalogProductDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "grpcCatalogClient.GetProductById")
+	span.LogFields(log.String("ProductId", productId.String()))
+	defer span.Finish()
+
+	res, err := g.productsClient.GetProductById(ctx, &catalogsService.GetProductByIdReq{ProductId: productId.String()})
+	if status.Code(err) == codes.NotFound {
+		return nil, tracing.TraceWithErr(span, customErrors.NewNotFoundErrorWrap(err, fmt.Sprintf("[grpcCatalogClient_GetProductById] product with id %s not found in the catalog", productId)))
+	}
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[grpcCatalogClient_GetProductById] error in getting product with id %s from the catalogs read service", productId)))
+	}
+
+	product := res.GetProduct()
+
+	price, err := domain.ParseMoney(product.GetPrice().GetAmount(), product.GetPrice().GetCurrency())
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[grpcCatalogClient_GetProductById.ParseMoney] error in parsing the price of product with id %s", productId)))
+	}
+
+	return &dtos.CatalogProductDto{
+		ProductId:   productId,
+		Name:        product.GetName(),
+		Description: product.GetDescription(),
+		Price:       price,
+	}, nil
+}
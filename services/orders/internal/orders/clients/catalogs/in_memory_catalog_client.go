@@ -0,0 +1,46 @@
+package catalogs
+
+import (
+	"context"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	uuid "github.com/satori/go.uuid"
+	"sync"
+)
+
+// InMemoryCatalogClient is a local stand-in for the catalogs read service, it serves the products which are added to it
+type InMemoryCatalogClient struct {
+	products map[uuid.UUID]*dtos.CatalogProductDto
+	mu       sync.RWMutex
+}
+
+func NewInMemoryCatalogClient(products ...*dtos.CatalogProductDto) *InMemoryCatalogClient {
+	c := &InMemoryCatalogClient{products: make(map[uuid.UUID]*dtos.CatalogProductDto)}
+	for _, product := range products {
+		c.AddProduct(product)
+	}
+
+	return c
+}
+
+// AddProduct adds the product to the catalog or replaces the existing product with the same id
+func (c *InMemoryCatalogClient) AddProduct(product *dtos.CatalogProductDto) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.products[product.ProductId] = product
+}
+
+func (c *InMemoryCatalogClient) GetProductById(ctx context.Context, productId uuid.UUID) (*dtos.CatalogProductDto, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	product, ok := c.products[productId]
+	if !ok {
+		return nil, customErrors.NewNotFoundError(fmt.Sprintf("[InMemoryCatalogClient_GetProductById] product with id %s not found in the catalog", productId))
+	}
+	copied := *product
+
+	return &copied, nil
+}
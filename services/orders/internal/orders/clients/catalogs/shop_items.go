@@ -0,0 +1,30 @@
+package catalogs
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/clients"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
+)
+
+// PriceShopItems looks up the product of each item in the catalog and snapshots its current name, description and price,
+// only the product id and the quantity of the requested items are trusted
+func PriceShopItems(ctx context.Context, catalogClient clients.CatalogClient, items []*dtos.ShopItemDto) ([]*value_objects.ShopItem, error) {
+	shopItems := make([]*value_objects.ShopItem, 0, len(items))
+	for _, item := range items {
+		product, err := catalogClient.GetProductById(ctx, item.ProductId)
+		if customErrors.IsNotFoundError(err) {
+			return nil, customErrors.NewBadRequestErrorWrap(err, fmt.Sprintf("[PriceShopItems.GetProductById] product with id %s is not in the catalog", item.ProductId))
+		}
+		if err != nil {
+			return nil, errors.WrapIf(err, fmt.Sprintf("[PriceShopItems.GetProductById] error in getting product with id %s from the catalog", item.ProductId))
+		}
+
+		shopItems = append(shopItems, value_objects.CreateNewShopItem(product.ProductId, product.Name, product.Description, item.Quantity, product.Price))
+	}
+
+	return shopItems, nil
+}
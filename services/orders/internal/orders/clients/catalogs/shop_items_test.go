@@ -0,0 +1,46 @@
+package catalogs
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_Price_Shop_Items(t *testing.T) {
+	book := &dtos.CatalogProductDto{ProductId: uuid.NewV4(), Name: "book", Description: "a book", Price: domain.MustParseMoney("12.50", "USD")}
+	catalogClient := NewInMemoryCatalogClient(book)
+
+	t.Run("items are priced by the catalog", func(t *testing.T) {
+		items := []*dtos.ShopItemDto{{ProductId: book.ProductId, Title: "free book", Description: "a cheap book", Quantity: 2, Price: domain.MustParseMoney("0.01", "USD")}}
+
+		shopItems, err := PriceShopItems(context.Background(), catalogClient, items)
+		require.NoError(t, err)
+		require.Len(t, shopItems, 1)
+
+		assert.Equal(t, book.ProductId, shopItems[0].ProductId())
+		assert.Equal(t, "book", shopItems[0].Title())
+		assert.Equal(t, "a book", shopItems[0].Description())
+		assert.Equal(t, uint64(2), shopItems[0].Quantity())
+		assert.Equal(t, domain.MustParseMoney("12.50", "USD"), shopItems[0].Price())
+	})
+
+	t.Run("unknown product is a bad request", func(t *testing.T) {
+		items := []*dtos.ShopItemDto{{ProductId: book.ProductId, Quantity: 1}, {ProductId: uuid.NewV4(), Quantity: 1}}
+
+		_, err := PriceShopItems(context.Background(), catalogClient, items)
+		assert.True(t, customErrors.IsBadRequestError(err))
+	})
+
+	t.Run("catalog price changes are taken at order time", func(t *testing.T) {
+		catalogClient.AddProduct(&dtos.CatalogProductDto{ProductId: book.ProductId, Name: "book", Description: "a book", Price: domain.MustParseMoney("15", "USD")})
+
+		shopItems, err := PriceShopItems(context.Background(), catalogClient, []*dtos.ShopItemDto{{ProductId: book.ProductId, Quantity: 1}})
+		require.NoError(t, err)
+		assert.Equal(t, domain.MustParseMoney("15", "USD"), shopItems[0].Price())
+	})
+}
@@ -1,10 +1,68 @@
 package consumers
 
-import "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+import (
+	rabbitmqConsumer "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/consumer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/consumer/options"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/consts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	cancelingOrderIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/integration/external/v1"
+	payingOrderIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/integration/external/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+)
 
 func ConfigConsumers(infra *infrastructure.InfrastructureConfiguration) error {
-	//add custom message type mappings
-	//utils.RegisterCustomMessageTypesToRegistrty(map[string]types.IMessage{"orderCreatedV1": &OrderCreatedV1{}})
+	consumerBase := delivery.NewOrderConsumersBase(infra)
+
+	stockConsumer, err := rabbitmqConsumer.NewRabbitMQMultiTypeConsumer(
+		infra.RabbitMQConnection,
+		consts.StockQueue,
+		func(builder *options.RabbitMQMultiTypeConsumerOptionsBuilder) {
+			// queue arguments should be the same as the declared topology, otherwise the broker rejects the declaration
+			builder.WithQueueArgs(infra.Cfg.RabbitMQ.Topology.QueueArguments(consts.StockQueue))
+			builder.WithMetrics(infra.Metrics.RabbitMQ)
+			builder.WithMessageRegistry(infra.MessageRegistry)
+		},
+		infra.EventSerializer,
+		infra.Log)
+	if err != nil {
+		return err
+	}
+
+	err = rabbitmqConsumer.AddHandler[*cancelingOrderIntegration.StockReservationFailedV1](stockConsumer, cancelingOrderIntegration.NewStockReservationFailedConsumer(consumerBase), nil)
+	if err != nil {
+		return err
+	}
+
+	err = rabbitmqConsumer.AddHandler[*cancelingOrderIntegration.StockReservationExpiredV1](stockConsumer, cancelingOrderIntegration.NewStockReservationExpiredConsumer(consumerBase), nil)
+	if err != nil {
+		return err
+	}
+	infra.Consumers = append(infra.Consumers, stockConsumer)
+
+	paymentsConsumer, err := rabbitmqConsumer.NewRabbitMQMultiTypeConsumer(
+		infra.RabbitMQConnection,
+		consts.PaymentsQueue,
+		func(builder *options.RabbitMQMultiTypeConsumerOptionsBuilder) {
+			builder.WithQueueArgs(infra.Cfg.RabbitMQ.Topology.QueueArguments(consts.PaymentsQueue))
+			builder.WithMetrics(infra.Metrics.RabbitMQ)
+			builder.WithMessageRegistry(infra.MessageRegistry)
+		},
+		infra.EventSerializer,
+		infra.Log)
+	if err != nil {
+		return err
+	}
+
+	err = rabbitmqConsumer.AddHandler[*payingOrderIntegration.PaymentSucceededV1](paymentsConsumer, payingOrderIntegration.NewPaymentSucceededConsumer(consumerBase), nil)
+	if err != nil {
+		return err
+	}
+
+	err = rabbitmqConsumer.AddHandler[*cancelingOrderIntegration.PaymentFailedV1](paymentsConsumer, cancelingOrderIntegration.NewPaymentFailedConsumer(consumerBase), nil)
+	if err != nil {
+		return err
+	}
+	infra.Consumers = append(infra.Consumers, paymentsConsumer)
 
 	return nil
 }
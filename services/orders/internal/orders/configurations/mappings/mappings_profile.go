@@ -1,13 +1,17 @@
 package mappings
 
 import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
 	grpcOrderService "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/proto/service_clients"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	gettingOrderHistoryDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_order_history/dtos"
+	requestingReturnDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/dtos"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/read_models"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
+	uuid "github.com/satori/go.uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -58,13 +62,19 @@ func ConfigureMappings() error {
 		if err != nil {
 			return nil
 		}
+		returns, err := mapper.Map[[]*grpcOrderService.OrderReturn](orderReadDto.Returns)
+		if err != nil {
+			return nil
+		}
 
 		return &grpcOrderService.OrderReadModel{
 			Id:              orderReadDto.Id,
 			OrderId:         orderReadDto.OrderId,
 			PaymentId:       orderReadDto.PaymentId,
 			DeliveredTime:   timestamppb.New(orderReadDto.DeliveredTime),
-			TotalPrice:      orderReadDto.TotalPrice,
+			CouponCode:      orderReadDto.CouponCode,
+			Discount:        moneyToGrpc(orderReadDto.Discount),
+			TotalPrice:      moneyToGrpc(orderReadDto.TotalPrice),
 			DeliveryAddress: orderReadDto.DeliveryAddress,
 			AccountEmail:    orderReadDto.AccountEmail,
 			Canceled:        orderReadDto.Canceled,
@@ -73,6 +83,8 @@ func ConfigureMappings() error {
 			Submitted:       orderReadDto.Submitted,
 			CancelReason:    orderReadDto.CancelReason,
 			ShopItems:       items,
+			Returns:         returns,
+			RefundedAmount:  moneyToGrpc(orderReadDto.RefundedAmount),
 			CreatedAt:       timestamppb.New(orderReadDto.CreatedAt),
 			UpdatedAt:       timestamppb.New(orderReadDto.UpdatedAt),
 		}
@@ -82,7 +94,15 @@ func ConfigureMappings() error {
 	}
 
 	// dtos.ShopItemReadDto -> grpcOrderService.ShopItemReadModel
-	err = mapper.CreateMap[*dtos.ShopItemReadDto, *grpcOrderService.ShopItemReadModel]()
+	err = mapper.CreateCustomMap[*dtos.ShopItemReadDto, *grpcOrderService.ShopItemReadModel](func(src *dtos.ShopItemReadDto) *grpcOrderService.ShopItemReadModel {
+		return &grpcOrderService.ShopItemReadModel{
+			ProductId:   src.ProductId,
+			Title:       src.Title,
+			Description: src.Description,
+			Quantity:    src.Quantity,
+			Price:       moneyToGrpc(src.Price),
+		}
+	})
 	if err != nil {
 		return err
 	}
@@ -95,14 +115,43 @@ func ConfigureMappings() error {
 
 	// ShopItemDto -> ShopItem
 	err = mapper.CreateCustomMap[*dtos.ShopItemDto, *value_objects.ShopItem](func(src *dtos.ShopItemDto) *value_objects.ShopItem {
-		return value_objects.CreateNewShopItem(src.Title, src.Description, src.Quantity, src.Price)
+		return value_objects.CreateNewShopItem(src.ProductId, src.Title, src.Description, src.Quantity, src.Price)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Coupon -> CouponDto
+	err = mapper.CreateMap[*value_objects.Coupon, *dtos.CouponDto]()
+	if err != nil {
+		return err
+	}
+
+	// CouponDto -> Coupon
+	err = mapper.CreateCustomMap[*dtos.CouponDto, *value_objects.Coupon](func(src *dtos.CouponDto) *value_objects.Coupon {
+		coupon, err := value_objects.NewCoupon(src.Code, src.DiscountType, src.PercentOff, src.AmountOff, src.MinimumBasket)
+		if err != nil {
+			return nil
+		}
+
+		return coupon
+	})
+	if err != nil {
+		return err
+	}
+
+	// dtos.CouponDto -> read_models.CouponReadModel
+	err = mapper.CreateCustomMap[*dtos.CouponDto, *read_models.CouponReadModel](func(src *dtos.CouponDto) *read_models.CouponReadModel {
+		return read_models.NewCouponReadModel(src.Code, src.DiscountType, src.PercentOff, src.AmountOff, src.MinimumBasket)
 	})
 	if err != nil {
 		return err
 	}
 
 	// dtos.ShopItemDto -> read_models.ShopItemReadModel
-	err = mapper.CreateMap[*dtos.ShopItemDto, *read_models.ShopItemReadModel]()
+	err = mapper.CreateCustomMap[*dtos.ShopItemDto, *read_models.ShopItemReadModel](func(src *dtos.ShopItemDto) *read_models.ShopItemReadModel {
+		return read_models.NewShopItemReadModel(src.ProductId.String(), src.Title, src.Description, src.Quantity, src.Price)
+	})
 	if err != nil {
 		return err
 	}
@@ -116,10 +165,11 @@ func ConfigureMappings() error {
 	// value_objects.ShopItem -> grpcOrderService.ShopItem
 	err = mapper.CreateCustomMap[*value_objects.ShopItem, *grpcOrderService.ShopItem](func(src *value_objects.ShopItem) *grpcOrderService.ShopItem {
 		return &grpcOrderService.ShopItem{
+			ProductId:   src.ProductId().String(),
 			Title:       src.Title(),
 			Description: src.Description(),
 			Quantity:    src.Quantity(),
-			Price:       src.Price(),
+			Price:       moneyToGrpc(src.Price()),
 		}
 	})
 	if err != nil {
@@ -128,14 +178,23 @@ func ConfigureMappings() error {
 
 	// grpcOrderService.ShopItem -> value_objects.ShopItem
 	err = mapper.CreateCustomMap[*grpcOrderService.ShopItem, *value_objects.ShopItem](func(src *grpcOrderService.ShopItem) *value_objects.ShopItem {
-		return value_objects.CreateNewShopItem(src.Title, src.Description, src.Quantity, src.Price)
+		return value_objects.CreateNewShopItem(uuid.FromStringOrNil(src.ProductId), src.Title, src.Description, src.Quantity, moneyFromGrpc(src.Price))
 	})
 	if err != nil {
 		return err
 	}
 
 	// grpcOrderService.ShopItem -> dtos.ShopItemDto
-	err = mapper.CreateMap[*grpcOrderService.ShopItem, *dtos.ShopItemDto]()
+	// an invalid product id is mapped to an empty id, which isn't in the catalog, and the price is replaced by the catalog price
+	err = mapper.CreateCustomMap[*grpcOrderService.ShopItem, *dtos.ShopItemDto](func(src *grpcOrderService.ShopItem) *dtos.ShopItemDto {
+		return &dtos.ShopItemDto{
+			ProductId:   uuid.FromStringOrNil(src.ProductId),
+			Title:       src.Title,
+			Description: src.Description,
+			Quantity:    src.Quantity,
+			Price:       moneyFromGrpc(src.Price),
+		}
+	})
 	if err != nil {
 		return err
 	}
@@ -146,6 +205,10 @@ func ConfigureMappings() error {
 		if err != nil {
 			return nil
 		}
+		returns, err := mapper.Map[[]*grpcOrderService.OrderReturn](order.Returns())
+		if err != nil {
+			return nil
+		}
 
 		return &grpcOrderService.Order{
 			OrderId:         order.Id().String(),
@@ -157,10 +220,14 @@ func ConfigureMappings() error {
 			Paid:            order.Paid(),
 			CancelReason:    order.CancelReason(),
 			Submitted:       order.Submitted(),
-			TotalPrice:      order.TotalPrice(),
+			CouponCode:      order.CouponCode(),
+			Discount:        moneyToGrpc(order.Discount()),
+			TotalPrice:      moneyToGrpc(order.TotalPrice()),
 			CreatedAt:       timestamppb.New(order.CreatedAt()),
 			UpdatedAt:       timestamppb.New(order.UpdatedAt()),
 			ShopItems:       items,
+			Returns:         returns,
+			RefundedAmount:  moneyToGrpc(order.RefundedAmount()),
 			PaymentId:       order.PaymentId().String(),
 		}
 	})
@@ -168,6 +235,106 @@ func ConfigureMappings() error {
 		return err
 	}
 
+	// ReturnItem -> ReturnItemDto
+	err = mapper.CreateMap[*value_objects.ReturnItem, *dtos.ReturnItemDto]()
+	if err != nil {
+		return err
+	}
+
+	// ReturnItemDto -> ReturnItem
+	err = mapper.CreateCustomMap[*dtos.ReturnItemDto, *value_objects.ReturnItem](func(src *dtos.ReturnItemDto) *value_objects.ReturnItem {
+		return value_objects.NewReturnItem(src.ProductId, src.Quantity)
+	})
+	if err != nil {
+		return err
+	}
+
+	// dtos.ReturnItemDto -> read_models.ReturnItemReadModel
+	err = mapper.CreateCustomMap[*dtos.ReturnItemDto, *read_models.ReturnItemReadModel](func(src *dtos.ReturnItemDto) *read_models.ReturnItemReadModel {
+		return read_models.NewReturnItemReadModel(src.ProductId.String(), src.Quantity)
+	})
+	if err != nil {
+		return err
+	}
+
+	// read_models.ReturnItemReadModel -> dtos.ReturnItemReadDto
+	err = mapper.CreateMap[*read_models.ReturnItemReadModel, *dtos.ReturnItemReadDto]()
+	if err != nil {
+		return err
+	}
+
+	// read_models.OrderReturnReadModel -> dtos.OrderReturnReadDto
+	err = mapper.CreateMap[*read_models.OrderReturnReadModel, *dtos.OrderReturnReadDto]()
+	if err != nil {
+		return err
+	}
+
+	// grpcOrderService.ReturnItem -> dtos.ReturnItemDto
+	err = mapper.CreateCustomMap[*grpcOrderService.ReturnItem, *dtos.ReturnItemDto](func(src *grpcOrderService.ReturnItem) *dtos.ReturnItemDto {
+		return &dtos.ReturnItemDto{ProductId: uuid.FromStringOrNil(src.ProductId), Quantity: src.Quantity}
+	})
+	if err != nil {
+		return err
+	}
+
+	// dtos.OrderReturnReadDto -> grpcOrderService.OrderReturn
+	err = mapper.CreateCustomMap[*dtos.OrderReturnReadDto, *grpcOrderService.OrderReturn](func(src *dtos.OrderReturnReadDto) *grpcOrderService.OrderReturn {
+		items := make([]*grpcOrderService.ReturnItem, 0, len(src.Items))
+		for _, item := range src.Items {
+			items = append(items, &grpcOrderService.ReturnItem{ProductId: item.ProductId, Quantity: item.Quantity})
+		}
+
+		return &grpcOrderService.OrderReturn{
+			ReturnId:     src.ReturnId,
+			Items:        items,
+			Reason:       src.Reason,
+			RefundAmount: moneyToGrpc(src.RefundAmount),
+			Status:       src.Status,
+			RefundId:     src.RefundId,
+			RequestedAt:  timestamppb.New(src.RequestedAt),
+			ApprovedAt:   timestamppb.New(src.ApprovedAt),
+			RefundedAt:   timestamppb.New(src.RefundedAt),
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	// value_objects.OrderReturn -> grpcOrderService.OrderReturn
+	err = mapper.CreateCustomMap[*value_objects.OrderReturn, *grpcOrderService.OrderReturn](func(src *value_objects.OrderReturn) *grpcOrderService.OrderReturn {
+		items := make([]*grpcOrderService.ReturnItem, 0, len(src.Items()))
+		for _, item := range src.Items() {
+			items = append(items, &grpcOrderService.ReturnItem{ProductId: item.ProductId().String(), Quantity: item.Quantity()})
+		}
+
+		return &grpcOrderService.OrderReturn{
+			ReturnId:     src.ReturnId().String(),
+			Items:        items,
+			Reason:       src.Reason(),
+			RefundAmount: moneyToGrpc(src.RefundAmount()),
+			Status:       src.Status(),
+			RefundId:     src.RefundId().String(),
+			RequestedAt:  timestamppb.New(src.RequestedAt()),
+			ApprovedAt:   timestamppb.New(src.ApprovedAt()),
+			RefundedAt:   timestamppb.New(src.RefundedAt()),
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	// requestingReturnDtos.RequestReturnResponseDto -> grpcOrderService.RequestReturnRes
+	err = mapper.CreateCustomMap[*requestingReturnDtos.RequestReturnResponseDto, *grpcOrderService.RequestReturnRes](func(src *requestingReturnDtos.RequestReturnResponseDto) *grpcOrderService.RequestReturnRes {
+		return &grpcOrderService.RequestReturnRes{
+			OrderId:      src.OrderId.String(),
+			ReturnId:     src.ReturnId.String(),
+			RefundAmount: moneyToGrpc(src.RefundAmount),
+		}
+	})
+	if err != nil {
+		return err
+	}
+
 	err = mapper.CreateCustomMap[*utils.ListResult[*dtos.OrderReadDto], *grpcOrderService.GetOrdersRes](func(orders *utils.ListResult[*dtos.OrderReadDto]) *grpcOrderService.GetOrdersRes {
 		o, err := mapper.Map[[]*grpcOrderService.OrderReadModel](orders.Items)
 		if err != nil {
@@ -187,5 +354,65 @@ func ConfigureMappings() error {
 		return err
 	}
 
+	err = mapper.CreateCustomMap[*utils.ListResult[*dtos.OrderReadDto], *grpcOrderService.GetCustomerOrdersRes](func(orders *utils.ListResult[*dtos.OrderReadDto]) *grpcOrderService.GetCustomerOrdersRes {
+		o, err := mapper.Map[[]*grpcOrderService.OrderReadModel](orders.Items)
+		if err != nil {
+			return nil
+		}
+		return &grpcOrderService.GetCustomerOrdersRes{
+			Pagination: &grpcOrderService.Pagination{
+				Size:       int32(orders.Size),
+				Page:       int32(orders.Page),
+				TotalItems: orders.TotalItems,
+				TotalPages: int32(orders.TotalPage),
+			},
+			Orders: o,
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	err = mapper.CreateCustomMap[*utils.ListResult[*gettingOrderHistoryDtos.OrderHistoryEventDto], *grpcOrderService.GetOrderHistoryRes](func(history *utils.ListResult[*gettingOrderHistoryDtos.OrderHistoryEventDto]) *grpcOrderService.GetOrderHistoryRes {
+		events := make([]*grpcOrderService.OrderHistoryEvent, 0, len(history.Items))
+		for _, event := range history.Items {
+			events = append(events, &grpcOrderService.OrderHistoryEvent{
+				EventId:       event.EventId,
+				EventType:     event.EventType,
+				Version:       event.Version,
+				OccurredOn:    timestamppb.New(event.OccurredOn),
+				CorrelationId: event.CorrelationId,
+				UserId:        event.UserId,
+				Changes:       event.Changes,
+			})
+		}
+		return &grpcOrderService.GetOrderHistoryRes{
+			Pagination: &grpcOrderService.Pagination{
+				Size:       int32(history.Size),
+				Page:       int32(history.Page),
+				TotalItems: history.TotalItems,
+				TotalPages: int32(history.TotalPage),
+			},
+			Events: events,
+		}
+	})
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
+
+func moneyToGrpc(money domain.Money) *grpcOrderService.Money {
+	return &grpcOrderService.Money{Amount: money.Amount.String(), Currency: money.Currency.String()}
+}
+
+// moneyFromGrpc maps an invalid money to the zero money, the prices of the requested items are replaced by the catalog prices
+func moneyFromGrpc(money *grpcOrderService.Money) domain.Money {
+	parsed, err := domain.ParseMoney(money.GetAmount(), money.GetCurrency())
+	if err != nil {
+		return domain.Money{}
+	}
+
+	return parsed
+}
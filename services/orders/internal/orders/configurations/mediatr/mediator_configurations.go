@@ -1,27 +1,127 @@
 package mediatr
 
 import (
+	"emperror.dev/errors"
 	"github.com/mehdihadeli/go-mediatr"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/eventstroredb"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	couponRepositories "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/data/repositories"
+	deliverySlotRepositories "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/data/repositories"
+	deliverySlotModels "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/clients"
+	orderRepositories "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/repositories"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/data/repositories"
+	applyingCouponV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/commands/v1"
+	applyingCouponDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/dtos"
+	approvingReturnV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/approving_return/commands/v1"
+	approvingReturnDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/approving_return/dtos"
+	cancelingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/commands/v1"
+	cancelingOrderDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/dtos"
+	completingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/completing_order/commands/v1"
+	completingOrderDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/completing_order/dtos"
 	creatingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/commands/v1"
 	creatingOrderDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/dtos"
+	gettingCustomerOrdersDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_customer_orders/dtos"
+	gettingCustomerOrdersV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_customer_orders/queries/v1"
 	gettingOrderByIdDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_order_by_id/dtos"
 	gettingOrderByIdV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_order_by_id/queries/v1"
+	gettingOrderHistoryDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_order_history/dtos"
+	gettingOrderHistoryV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_order_history/queries/v1"
 	gettingOrdersDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_orders/dtos"
 	gettingOrdersV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_orders/queryies/v1"
+	issuingRefundV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/commands/v1"
+	issuingRefundDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/dtos"
+	payingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/commands/v1"
+	payingOrderDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/dtos"
+	requestingReturnV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/commands/v1"
+	requestingReturnDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/dtos"
+	reschedulingDeliveryV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/rescheduling_delivery/commands/v1"
+	reschedulingDeliveryDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/rescheduling_delivery/dtos"
+	searchingOrdersDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/searching_orders/dtos"
+	searchingOrdersV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/searching_orders/queries/v1"
+	submittingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/commands/v1"
+	submittingOrderDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/dtos"
+	updatingShoppingCartV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/commands/v1"
+	updatingShoppingCartDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/dtos"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
 )
 
-func ConfigOrdersMediator(infra *infrastructure.InfrastructureConfiguration) error {
+func ConfigOrdersMediator(infra *infrastructure.InfrastructureConfiguration, catalogClient clients.CatalogClient) error {
 	eventStore := eventstroredb.NewEventStoreDbEventStore(infra.Log, infra.Esdb, infra.EsdbSerializer)
 	orderAggregateStore := eventstroredb.NewEventStoreAggregateStore[*aggregate.Order](infra.Log, eventStore, infra.EsdbSerializer)
 
+	couponRepository := couponRepositories.NewPostgresCouponRepository(infra.Log, infra.Cfg, infra.Gorm.DB)
+
+	deliverySchedule, err := deliverySlotModels.NewDeliverySchedule(infra.Cfg.DeliverySlots)
+	if err != nil {
+		return err
+	}
+	deliverySlotRepository := deliverySlotRepositories.NewPostgresDeliverySlotRepository(infra.Log, infra.Cfg, infra.Gorm.DB)
+
 	mongoOrderReadRepository := repositories.NewMongoOrderReadRepository(infra.Log, infra.Cfg, infra.MongoClient)
 
+	// listing and searching orders are served from the configured read store
+	var queryOrderReadRepository orderRepositories.OrderReadRepository = mongoOrderReadRepository
+	if infra.Cfg.ReadStore == config.ElasticReadStore {
+		if infra.ElasticClient == nil {
+			return errors.New("elastic read store needs the elastic configuration")
+		}
+		queryOrderReadRepository = repositories.NewElasticOrderReadRepository(infra.Log, infra.Cfg, infra.ElasticClient)
+	}
+
 	//https://stackoverflow.com/questions/72034479/how-to-implement-generic-interfaces
-	err := mediatr.RegisterRequestHandler[*creatingOrderV1.CreateOrder, *creatingOrderDtos.CreateOrderResponseDto](creatingOrderV1.NewCreateOrderHandler(infra.Log, infra.Cfg, orderAggregateStore))
+	err = mediatr.RegisterRequestHandler[*creatingOrderV1.CreateOrder, *creatingOrderDtos.CreateOrderResponseDto](creatingOrderV1.NewCreateOrderHandler(infra.Log, infra.Cfg, orderAggregateStore, catalogClient))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*updatingShoppingCartV1.UpdateShoppingCart, *updatingShoppingCartDtos.UpdateShoppingCartResponseDto](updatingShoppingCartV1.NewUpdateShoppingCartHandler(infra.Log, infra.Cfg, orderAggregateStore, catalogClient))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*applyingCouponV1.ApplyCoupon, *applyingCouponDtos.ApplyCouponResponseDto](applyingCouponV1.NewApplyCouponHandler(infra.Log, infra.Cfg, orderAggregateStore, couponRepository))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*submittingOrderV1.SubmitOrder, *submittingOrderDtos.SubmitOrderResponseDto](submittingOrderV1.NewSubmitOrderHandler(infra.Log, infra.Cfg, orderAggregateStore, deliverySchedule, deliverySlotRepository))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*payingOrderV1.PayOrder, *payingOrderDtos.PayOrderResponseDto](payingOrderV1.NewPayOrderHandler(infra.Log, infra.Cfg, orderAggregateStore))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*cancelingOrderV1.CancelOrder, *cancelingOrderDtos.CancelOrderResponseDto](cancelingOrderV1.NewCancelOrderHandler(infra.Log, infra.Cfg, orderAggregateStore))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*reschedulingDeliveryV1.RescheduleDelivery, *reschedulingDeliveryDtos.RescheduleDeliveryResponseDto](reschedulingDeliveryV1.NewRescheduleDeliveryHandler(infra.Log, infra.Cfg, orderAggregateStore, deliverySchedule, deliverySlotRepository))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*completingOrderV1.CompleteOrder, *completingOrderDtos.CompleteOrderResponseDto](completingOrderV1.NewCompleteOrderHandler(infra.Log, infra.Cfg, orderAggregateStore))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*requestingReturnV1.RequestReturn, *requestingReturnDtos.RequestReturnResponseDto](requestingReturnV1.NewRequestReturnHandler(infra.Log, infra.Cfg, orderAggregateStore))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*approvingReturnV1.ApproveReturn, *approvingReturnDtos.ApproveReturnResponseDto](approvingReturnV1.NewApproveReturnHandler(infra.Log, infra.Cfg, orderAggregateStore))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*issuingRefundV1.IssueRefund, *issuingRefundDtos.IssueRefundResponseDto](issuingRefundV1.NewIssueRefundHandler(infra.Log, infra.Cfg, orderAggregateStore))
 	if err != nil {
 		return err
 	}
@@ -31,7 +131,22 @@ func ConfigOrdersMediator(infra *infrastructure.InfrastructureConfiguration) err
 		return err
 	}
 
-	err = mediatr.RegisterRequestHandler[*gettingOrdersV1.GetOrders, *gettingOrdersDtos.GetOrdersResponseDto](gettingOrdersV1.NewGetOrdersHandler(infra.Log, infra.Cfg, mongoOrderReadRepository))
+	err = mediatr.RegisterRequestHandler[*gettingOrderHistoryV1.GetOrderHistory, *gettingOrderHistoryDtos.GetOrderHistoryResponseDto](gettingOrderHistoryV1.NewGetOrderHistoryHandler(infra.Log, infra.Cfg, eventStore))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*gettingOrdersV1.GetOrders, *gettingOrdersDtos.GetOrdersResponseDto](gettingOrdersV1.NewGetOrdersHandler(infra.Log, infra.Cfg, queryOrderReadRepository))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*gettingCustomerOrdersV1.GetCustomerOrders, *gettingCustomerOrdersDtos.GetCustomerOrdersResponseDto](gettingCustomerOrdersV1.NewGetCustomerOrdersHandler(infra.Log, infra.Cfg, queryOrderReadRepository))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*searchingOrdersV1.SearchOrders, *searchingOrdersDtos.SearchOrdersResponseDto](searchingOrdersV1.NewSearchOrdersHandler(infra.Log, infra.Cfg, queryOrderReadRepository))
 	if err != nil {
 		return err
 	}
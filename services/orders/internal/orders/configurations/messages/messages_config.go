@@ -0,0 +1,101 @@
+package messages
+
+import (
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	couponAppliedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/events/integration/v1"
+	returnApprovedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/approving_return/events/integration/v1"
+	cancelingOrderExternal "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/integration/external/v1"
+	canceledIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/integration/v1"
+	completedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/completing_order/events/integration/v1"
+	createdIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/events/integration/v1"
+	refundIssuedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/events/integration/v1"
+	payingOrderExternal "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/integration/external/v1"
+	paidIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/integration/v1"
+	returnRequestedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/events/integration/v1"
+	deliveryRescheduledIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/rescheduling_delivery/events/integration/v1"
+	submittedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/events/integration/v1"
+	shoppingCartUpdatedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/events/integration/v1"
+)
+
+// ConfigMessageContracts registers the wire names of the order integration events
+func ConfigMessageContracts(registry *messageRegistry.MessageRegistry) error {
+	// the go type names are kept as aliases for the messages which are published before the contracts
+	err := messageRegistry.Register[*createdIntegration.OrderCreatedV1](registry, "orders.order_created", 1, "OrderCreatedV1")
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*shoppingCartUpdatedIntegration.ShoppingCartUpdatedV1](registry, "orders.shopping_cart_updated", 1, "ShoppingCartUpdatedV1")
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*submittedIntegration.OrderSubmittedV1](registry, "orders.order_submitted", 1, "OrderSubmittedV1")
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*paidIntegration.OrderPaidV1](registry, "orders.order_paid", 1, "OrderPaidV1")
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*canceledIntegration.OrderCanceledV1](registry, "orders.order_canceled", 1, "OrderCanceledV1")
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*completedIntegration.OrderCompletedV1](registry, "orders.order_completed", 1, "OrderCompletedV1")
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*couponAppliedIntegration.CouponAppliedV1](registry, "orders.coupon_applied", 1)
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*paidIntegration.PaymentRejectedV1](registry, "orders.payment_rejected", 1)
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*returnRequestedIntegration.ReturnRequestedV1](registry, "orders.return_requested", 1)
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*returnApprovedIntegration.ReturnApprovedV1](registry, "orders.return_approved", 1)
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*refundIssuedIntegration.RefundIssuedV1](registry, "orders.refund_issued", 1)
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*deliveryRescheduledIntegration.DeliveryRescheduledV1](registry, "orders.delivery_rescheduled", 1)
+	if err != nil {
+		return err
+	}
+
+	// the consumed stock events have the wire names of the catalogs write service contracts
+	err = messageRegistry.Register[*cancelingOrderExternal.StockReservationFailedV1](registry, "catalogs.stock_reservation_failed", 1)
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*cancelingOrderExternal.StockReservationExpiredV1](registry, "catalogs.stock_reservation_expired", 1)
+	if err != nil {
+		return err
+	}
+
+	// the consumed payment events have the wire names of the payments service contracts
+	err = messageRegistry.Register[*payingOrderExternal.PaymentSucceededV1](registry, "payments.payment_succeeded", 1)
+	if err != nil {
+		return err
+	}
+
+	return messageRegistry.Register[*cancelingOrderExternal.PaymentFailedV1](registry, "payments.payment_failed", 1)
+}
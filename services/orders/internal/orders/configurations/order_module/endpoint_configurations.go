@@ -5,9 +5,22 @@ import (
 	"github.com/labstack/echo/v4"
 	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	applyingCouponV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/endpoints/v1"
+	approvingReturnV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/approving_return/endpoints/v1"
+	cancelingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/endpoints/v1"
+	completingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/completing_order/endpoints/v1"
 	creatingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/endpoints/v1"
+	gettingCustomerOrdersV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_customer_orders/endpoints/v1"
 	gettingOrderByIdV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_order_by_id/endpoints/v1"
+	gettingOrderHistoryV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_order_history/endpoints/v1"
 	gettingOrdersV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_orders/endpoints/v1"
+	issuingRefundV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/endpoints/v1"
+	payingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/endpoints/v1"
+	requestingReturnV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/endpoints/v1"
+	reschedulingDeliveryV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/rescheduling_delivery/endpoints/v1"
+	searchingOrdersV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/searching_orders/endpoints/v1"
+	submittingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/endpoints/v1"
+	updatingShoppingCartV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/endpoints/v1"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
 )
 
@@ -25,12 +38,64 @@ func configV1Endpoints(echoServer customEcho.EchoHttpServer, infra *infrastructu
 		createProductEndpoint := creatingOrderV1.NewCreteOrderEndpoint(orderEndpointBase)
 		createProductEndpoint.MapRoute()
 
+		// UpdateShoppingCart
+		updateShoppingCartEndpoint := updatingShoppingCartV1.NewUpdateShoppingCartEndpoint(orderEndpointBase)
+		updateShoppingCartEndpoint.MapRoute()
+
+		// ApplyCoupon
+		applyCouponEndpoint := applyingCouponV1.NewApplyCouponEndpoint(orderEndpointBase)
+		applyCouponEndpoint.MapRoute()
+
+		// SubmitOrder
+		submitOrderEndpoint := submittingOrderV1.NewSubmitOrderEndpoint(orderEndpointBase)
+		submitOrderEndpoint.MapRoute()
+
+		// PayOrder
+		payOrderEndpoint := payingOrderV1.NewPayOrderEndpoint(orderEndpointBase)
+		payOrderEndpoint.MapRoute()
+
+		// CancelOrder
+		cancelOrderEndpoint := cancelingOrderV1.NewCancelOrderEndpoint(orderEndpointBase)
+		cancelOrderEndpoint.MapRoute()
+
+		// RescheduleDelivery
+		rescheduleDeliveryEndpoint := reschedulingDeliveryV1.NewRescheduleDeliveryEndpoint(orderEndpointBase)
+		rescheduleDeliveryEndpoint.MapRoute()
+
+		// CompleteOrder
+		completeOrderEndpoint := completingOrderV1.NewCompleteOrderEndpoint(orderEndpointBase)
+		completeOrderEndpoint.MapRoute()
+
+		// RequestReturn
+		requestReturnEndpoint := requestingReturnV1.NewRequestReturnEndpoint(orderEndpointBase)
+		requestReturnEndpoint.MapRoute()
+
+		// ApproveReturn
+		approveReturnEndpoint := approvingReturnV1.NewApproveReturnEndpoint(orderEndpointBase)
+		approveReturnEndpoint.MapRoute()
+
+		// IssueRefund
+		issueRefundEndpoint := issuingRefundV1.NewIssueRefundEndpoint(orderEndpointBase)
+		issueRefundEndpoint.MapRoute()
+
+		// GetCustomerOrders, `/me` is a static route so it is matched before `/:id`
+		getCustomerOrdersEndpoint := gettingCustomerOrdersV1.NewGetCustomerOrdersEndpoint(orderEndpointBase)
+		getCustomerOrdersEndpoint.MapRoute()
+
 		// GetOrderByID
 		getOrderByIdEndpoint := gettingOrderByIdV1.NewGetOrderByIdEndpoint(orderEndpointBase)
 		getOrderByIdEndpoint.MapRoute()
 
+		// GetOrderHistory
+		getOrderHistoryEndpoint := gettingOrderHistoryV1.NewGetOrderHistoryEndpoint(orderEndpointBase)
+		getOrderHistoryEndpoint.MapRoute()
+
 		// GetOrders
 		getOrders := gettingOrdersV1.NewGetOrdersEndpoint(orderEndpointBase)
 		getOrders.MapRoute()
+
+		// SearchOrders
+		searchOrders := searchingOrdersV1.NewSearchOrdersEndpoint(orderEndpointBase)
+		searchOrders.MapRoute()
 	})
 }
@@ -7,6 +7,6 @@ import (
 )
 
 func (c *ordersModuleConfigurator) configGrpc(ctx context.Context) {
-	orderGrpcService := grpc.NewOrderGrpcService(c.InfrastructureConfiguration)
+	orderGrpcService := grpc.NewOrderGrpcService(c.InfrastructureConfiguration, c.orderWatcher)
 	orders_service.RegisterOrdersServiceServer(c.grpcServer.GetCurrentGrpcServer(), orderGrpcService)
 }
@@ -2,24 +2,46 @@ package order_module
 
 import (
 	"context"
+	"emperror.dev/errors"
 	grpcServer "github.com/mehdihadeli/store-golang-microservice-sample/pkg/grpc"
 	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/topology"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/clients/catalogs"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/consumers"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/mappings"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/messages"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/projections"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/upcasters"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts"
+	couponAppliedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/events/integration/v1"
+	returnApprovedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/approving_return/events/integration/v1"
+	canceledIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/integration/v1"
+	completedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/completing_order/events/integration/v1"
+	createdIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/events/integration/v1"
+	refundIssuedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/events/integration/v1"
+	paidIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/integration/v1"
+	returnRequestedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/events/integration/v1"
+	deliveryRescheduledIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/rescheduling_delivery/events/integration/v1"
+	submittedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/events/integration/v1"
+	shoppingCartUpdatedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/events/integration/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/watchers"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
 )
 
 type ordersModuleConfigurator struct {
 	*infrastructure.InfrastructureConfiguration
-	echoServer customEcho.EchoHttpServer
-	grpcServer grpcServer.GrpcServer
+	echoServer   customEcho.EchoHttpServer
+	grpcServer   grpcServer.GrpcServer
+	orderWatcher contracts.OrderWatcher
 }
 
 func NewOrdersModuleConfigurator(infrastructure *infrastructure.InfrastructureConfiguration, echoServer customEcho.EchoHttpServer, grpcServer grpcServer.GrpcServer) contracts.OrdersModuleConfigurator {
-	return &ordersModuleConfigurator{InfrastructureConfiguration: infrastructure, echoServer: echoServer, grpcServer: grpcServer}
+	// the watchers of the grpc streams are notified by the projections of this instance
+	orderWatcher := watchers.NewInMemoryOrderWatcher()
+
+	return &ordersModuleConfigurator{InfrastructureConfiguration: infrastructure, echoServer: echoServer, grpcServer: grpcServer, orderWatcher: orderWatcher}
 }
 
 func (c *ordersModuleConfigurator) ConfigureOrdersModule(ctx context.Context) error {
@@ -28,7 +50,20 @@ func (c *ordersModuleConfigurator) ConfigureOrdersModule(ctx context.Context) er
 		return err
 	}
 
-	err = mediatr.ConfigOrdersMediator(c.InfrastructureConfiguration)
+	// conflicting message contracts fail the startup
+	err = messages.ConfigMessageContracts(c.MessageRegistry)
+	if err != nil {
+		return err
+	}
+
+	// the float prices of the stored order events are read as money
+	upcasters.ConfigEventUpcasters(c.EventUpcasters)
+
+	// the order items are priced by the products of the catalogs read service
+	if c.CatalogsGrpcClient == nil {
+		return errors.New("orders module needs the catalogsReadServiceClient configuration")
+	}
+	err = mediatr.ConfigOrdersMediator(c.InfrastructureConfiguration, catalogs.NewGrpcCatalogClient(c.CatalogsGrpcClient))
 	if err != nil {
 		return err
 	}
@@ -38,13 +73,36 @@ func (c *ordersModuleConfigurator) ConfigureOrdersModule(ctx context.Context) er
 		return err
 	}
 
+	// exchanges of the produced integration events should be declared in the topology
+	producedMessages := []types.IMessage{
+		&createdIntegration.OrderCreatedV1{},
+		&shoppingCartUpdatedIntegration.ShoppingCartUpdatedV1{},
+		&submittedIntegration.OrderSubmittedV1{},
+		&paidIntegration.OrderPaidV1{},
+		&paidIntegration.PaymentRejectedV1{},
+		&canceledIntegration.OrderCanceledV1{},
+		&completedIntegration.OrderCompletedV1{},
+		&couponAppliedIntegration.CouponAppliedV1{},
+		&returnRequestedIntegration.ReturnRequestedV1{},
+		&returnApprovedIntegration.ReturnApprovedV1{},
+		&refundIssuedIntegration.RefundIssuedV1{},
+		&deliveryRescheduledIntegration.DeliveryRescheduledV1{},
+	}
+	err = topology.ConfigureTopology(c.RabbitMQConnection, c.Cfg.RabbitMQ.Topology, c.Consumers, producedMessages)
+	if err != nil {
+		return err
+	}
+
 	if c.Cfg.DeliveryType == "grpc" {
 		c.configGrpc(ctx)
 	} else {
 		c.configEndpoints(ctx)
 	}
 
-	projections.ConfigOrderProjections(c.InfrastructureConfiguration)
+	err = projections.ConfigOrderProjections(ctx, c.InfrastructureConfiguration, c.orderWatcher)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
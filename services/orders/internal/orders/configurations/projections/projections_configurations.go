@@ -1,20 +1,36 @@
 package projections
 
 import (
-	"fmt"
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts"
 	orderRepositories "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/data/repositories"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/projections"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
 )
 
-func ConfigOrderProjections(infra *infrastructure.InfrastructureConfiguration) {
+func ConfigOrderProjections(ctx context.Context, infra *infrastructure.InfrastructureConfiguration, orderWatcher contracts.OrderWatcher) error {
 	mongoOrderReadRepository := orderRepositories.NewMongoOrderReadRepository(infra.Log, infra.Cfg, infra.MongoClient)
-	elasticOrderReadRepository := orderRepositories.NewElasticOrderReadRepository(infra.Log, infra.Cfg, infra.ElasticClient)
 
 	mongoOrderProjection := projections.NewMongoOrderProjection(mongoOrderReadRepository, infra.Producer, infra.Log)
 	infra.Projections = append(infra.Projections, mongoOrderProjection)
 
-	elasticOrderProjection := projections.NewElasticOrderProjection(elasticOrderReadRepository)
-	fmt.Println(elasticOrderProjection)
-	//c.Projections = append(c.Projections, elasticOrderProjection)
+	// the watchers are notified with the mongo read model, after it is projected
+	orderWatchProjection := projections.NewOrderWatchProjection(mongoOrderReadRepository, orderWatcher, infra.Log)
+	infra.Projections = append(infra.Projections, orderWatchProjection)
+
+	// elastic read model is projected only when elastic is configured
+	if infra.ElasticClient == nil {
+		return nil
+	}
+
+	err := orderRepositories.ConfigElasticOrderIndexTemplate(ctx, infra.ElasticClient, infra.Cfg)
+	if err != nil {
+		return err
+	}
+
+	elasticOrderReadRepository := orderRepositories.NewElasticOrderReadRepository(infra.Log, infra.Cfg, infra.ElasticClient)
+	elasticOrderProjection := projections.NewElasticOrderProjection(elasticOrderReadRepository, infra.Log)
+	infra.Projections = append(infra.Projections, elasticOrderProjection)
+
+	return nil
 }
@@ -0,0 +1,53 @@
+package upcasters
+
+import (
+	"emperror.dev/errors"
+	"encoding/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/upcasting"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	createdOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/events/domain/v1"
+	updatedShoppingCartEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/events/domain/v1"
+)
+
+// LegacyPriceCurrency is the currency of the float prices of the order events which are stored before the money prices
+const LegacyPriceCurrency = domain.USD
+
+// ConfigEventUpcasters registers the upcasters of the stored order events
+func ConfigEventUpcasters(registry *upcasting.EventUpcasterRegistry) {
+	registry.Register(
+		upcasting.NewJsonEventUpcaster(typeMapper.GetTypeName(&createdOrderEvents.OrderCreatedV1{}), upcastShopItemPrices),
+		upcasting.NewJsonEventUpcaster(typeMapper.GetTypeName(&updatedShoppingCartEvents.ShoppingCartUpdatedV1{}), upcastShopItemPrices),
+	)
+}
+
+// upcastShopItemPrices converts the float prices of the shop items to the money prices in the legacy currency
+func upcastShopItemPrices(event map[string]interface{}) error {
+	shopItems, ok := event["shopItems"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, shopItem := range shopItems {
+		item, ok := shopItem.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		price, ok := item["price"].(json.Number)
+		if !ok {
+			continue
+		}
+
+		amount, err := domain.ParseDecimal(price.String())
+		if err != nil {
+			return errors.WrapIf(err, "[upcastShopItemPrices] error in parsing the shop item price")
+		}
+		money, err := domain.NewMoney(amount, LegacyPriceCurrency)
+		if err != nil {
+			return err
+		}
+		item["price"] = money
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package upcasters
+
+import (
+	"encoding/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/upcasting"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	createdOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/events/domain/v1"
+	updatedShoppingCartEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/events/domain/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_Upcast_Legacy_Order_Created_Prices(t *testing.T) {
+	registry := upcasting.NewEventUpcasterRegistry()
+	ConfigEventUpcasters(registry)
+
+	legacy := []byte(`{"order_id":"8e5b7d47-2bd3-4b6f-9f3b-58c7f0e1c3a1","shopItems":[{"title":"book","quantity":2,"price":12.5},{"title":"pen","quantity":1,"price":0.1}],"accountEmail":"test@test.com","deliveryAddress":"street"}`)
+
+	data, err := registry.Upcast(legacy, typeMapper.GetTypeName(&createdOrderEvents.OrderCreatedV1{}), serializer.JsonContentType)
+	require.NoError(t, err)
+
+	var event createdOrderEvents.OrderCreatedV1
+	require.NoError(t, json.Unmarshal(data, &event))
+	assert.Equal(t, "12.50 USD", event.ShopItems[0].Price.String())
+	assert.Equal(t, "0.10 USD", event.ShopItems[1].Price.String())
+	assert.Equal(t, "test@test.com", event.AccountEmail)
+
+	// the events with the money prices aren't changed
+	upcasted, err := registry.Upcast(data, typeMapper.GetTypeName(&createdOrderEvents.OrderCreatedV1{}), serializer.JsonContentType)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(upcasted))
+}
+
+func Test_Upcast_Legacy_Shopping_Cart_Updated_Prices(t *testing.T) {
+	registry := upcasting.NewEventUpcasterRegistry()
+	ConfigEventUpcasters(registry)
+
+	legacy := []byte(`{"orderId":"8e5b7d47-2bd3-4b6f-9f3b-58c7f0e1c3a1","shopItems":[{"title":"book","quantity":2,"price":19.999}]}`)
+
+	data, err := registry.Upcast(legacy, typeMapper.GetTypeName(&updatedShoppingCartEvents.ShoppingCartUpdatedV1{}), serializer.JsonContentType)
+	require.NoError(t, err)
+
+	var event updatedShoppingCartEvents.ShoppingCartUpdatedV1
+	require.NoError(t, json.Unmarshal(data, &event))
+	assert.Equal(t, "20.00 USD", event.ShopItems[0].Price.String())
+}
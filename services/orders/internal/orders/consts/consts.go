@@ -0,0 +1,8 @@
+package consts
+
+const (
+	// StockQueue receives the catalogs stock events which cancel the orders
+	StockQueue = "orders_service_stock"
+	// PaymentsQueue receives the payments service events which pay or cancel the orders
+	PaymentsQueue = "orders_service_payments"
+)
@@ -0,0 +1,12 @@
+package clients
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	uuid "github.com/satori/go.uuid"
+)
+
+// CatalogClient reads the current state of the catalog products, an unknown product is returned as a not found error
+type CatalogClient interface {
+	GetProductById(ctx context.Context, productId uuid.UUID) (*dtos.CatalogProductDto, error)
+}
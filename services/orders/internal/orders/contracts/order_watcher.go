@@ -0,0 +1,22 @@
+package contracts
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/read_models"
+	uuid "github.com/satori/go.uuid"
+)
+
+// OrderChange is a state change of an order, the read model is the state of the order after the event with the stream version
+type OrderChange struct {
+	Version   int64
+	EventType string
+	Order     *read_models.OrderReadModel
+}
+
+// OrderWatcher delivers the changes of the orders which are projected by the subscription to the watchers of the orders
+type OrderWatcher interface {
+	// Watch returns the channel of the changes of the order and a func which stops the watching. The channel is closed when the watching
+	// is stopped or when the watcher doesn't receive the changes fast enough, then the watcher should resume from the last received version
+	Watch(orderId uuid.UUID) (<-chan *OrderChange, func())
+	HasWatchers(orderId uuid.UUID) bool
+	Notify(orderId uuid.UUID, change *OrderChange)
+}
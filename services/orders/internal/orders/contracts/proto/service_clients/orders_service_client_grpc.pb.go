@@ -24,9 +24,22 @@ const _ = grpc.SupportPackageIsVersion7
 type OrdersServiceClient interface {
 	CreateOrder(ctx context.Context, in *CreateOrderReq, opts ...grpc.CallOption) (*CreateOrderRes, error)
 	SubmitOrder(ctx context.Context, in *SubmitOrderReq, opts ...grpc.CallOption) (*SubmitOrderRes, error)
+	PayOrder(ctx context.Context, in *PayOrderReq, opts ...grpc.CallOption) (*PayOrderRes, error)
+	CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...grpc.CallOption) (*CancelOrderRes, error)
+	CompleteOrder(ctx context.Context, in *CompleteOrderReq, opts ...grpc.CallOption) (*CompleteOrderRes, error)
 	UpdateShoppingCart(ctx context.Context, in *UpdateShoppingCartReq, opts ...grpc.CallOption) (*UpdateShoppingCartRes, error)
+	ApplyCoupon(ctx context.Context, in *ApplyCouponReq, opts ...grpc.CallOption) (*ApplyCouponRes, error)
 	GetOrderByID(ctx context.Context, in *GetOrderByIDReq, opts ...grpc.CallOption) (*GetOrderByIDRes, error)
 	GetOrders(ctx context.Context, in *GetOrdersReq, opts ...grpc.CallOption) (*GetOrdersRes, error)
+	GetOrderHistory(ctx context.Context, in *GetOrderHistoryReq, opts ...grpc.CallOption) (*GetOrderHistoryRes, error)
+	RequestReturn(ctx context.Context, in *RequestReturnReq, opts ...grpc.CallOption) (*RequestReturnRes, error)
+	ApproveReturn(ctx context.Context, in *ApproveReturnReq, opts ...grpc.CallOption) (*ApproveReturnRes, error)
+	IssueRefund(ctx context.Context, in *IssueRefundReq, opts ...grpc.CallOption) (*IssueRefundRes, error)
+	GetCustomerOrders(ctx context.Context, in *GetCustomerOrdersReq, opts ...grpc.CallOption) (*GetCustomerOrdersRes, error)
+	GetSalesReport(ctx context.Context, in *GetSalesReportReq, opts ...grpc.CallOption) (*GetSalesReportRes, error)
+	ExportSalesReport(ctx context.Context, in *ExportSalesReportReq, opts ...grpc.CallOption) (*ExportSalesReportRes, error)
+	WatchOrder(ctx context.Context, in *WatchOrderReq, opts ...grpc.CallOption) (OrdersService_WatchOrderClient, error)
+	RescheduleDelivery(ctx context.Context, in *RescheduleDeliveryReq, opts ...grpc.CallOption) (*RescheduleDeliveryRes, error)
 }
 
 type ordersServiceClient struct {
@@ -55,6 +68,33 @@ func (c *ordersServiceClient) SubmitOrder(ctx context.Context, in *SubmitOrderRe
 	return out, nil
 }
 
+func (c *ordersServiceClient) PayOrder(ctx context.Context, in *PayOrderReq, opts ...grpc.CallOption) (*PayOrderRes, error) {
+	out := new(PayOrderRes)
+	err := c.cc.Invoke(ctx, "/orders_service.OrdersService/PayOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...grpc.CallOption) (*CancelOrderRes, error) {
+	out := new(CancelOrderRes)
+	err := c.cc.Invoke(ctx, "/orders_service.OrdersService/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CompleteOrder(ctx context.Context, in *CompleteOrderReq, opts ...grpc.CallOption) (*CompleteOrderRes, error) {
+	out := new(CompleteOrderRes)
+	err := c.cc.Invoke(ctx, "/orders_service.OrdersService/CompleteOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ordersServiceClient) UpdateShoppingCart(ctx context.Context, in *UpdateShoppingCartReq, opts ...grpc.CallOption) (*UpdateShoppingCartRes, error) {
 	out := new(UpdateShoppingCartRes)
 	err := c.cc.Invoke(ctx, "/orders_service.OrdersService/UpdateShoppingCart", in, out, opts...)
@@ -64,6 +104,15 @@ func (c *ordersServiceClient) UpdateShoppingCart(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *ordersServiceClient) ApplyCoupon(ctx context.Context, in *ApplyCouponReq, opts ...grpc.CallOption) (*ApplyCouponRes, error) {
+	out := new(ApplyCouponRes)
+	err := c.cc.Invoke(ctx, "/orders_service.OrdersService/ApplyCoupon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ordersServiceClient) GetOrderByID(ctx context.Context, in *GetOrderByIDReq, opts ...grpc.CallOption) (*GetOrderByIDRes, error) {
 	out := new(GetOrderByIDRes)
 	err := c.cc.Invoke(ctx, "/orders_service.OrdersService/GetOrderByID", in, out, opts...)
@@ -82,15 +131,132 @@ func (c *ordersServiceClient) GetOrders(ctx context.Context, in *GetOrdersReq, o
 	return out, nil
 }
 
+func (c *ordersServiceClient) GetOrderHistory(ctx context.Context, in *GetOrderHistoryReq, opts ...grpc.CallOption) (*GetOrderHistoryRes, error) {
+	out := new(GetOrderHistoryRes)
+	err := c.cc.Invoke(ctx, "/orders_service.OrdersService/GetOrderHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) RequestReturn(ctx context.Context, in *RequestReturnReq, opts ...grpc.CallOption) (*RequestReturnRes, error) {
+	out := new(RequestReturnRes)
+	err := c.cc.Invoke(ctx, "/orders_service.OrdersService/RequestReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ApproveReturn(ctx context.Context, in *ApproveReturnReq, opts ...grpc.CallOption) (*ApproveReturnRes, error) {
+	out := new(ApproveReturnRes)
+	err := c.cc.Invoke(ctx, "/orders_service.OrdersService/ApproveReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) IssueRefund(ctx context.Context, in *IssueRefundReq, opts ...grpc.CallOption) (*IssueRefundRes, error) {
+	out := new(IssueRefundRes)
+	err := c.cc.Invoke(ctx, "/orders_service.OrdersService/IssueRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetCustomerOrders(ctx context.Context, in *GetCustomerOrdersReq, opts ...grpc.CallOption) (*GetCustomerOrdersRes, error) {
+	out := new(GetCustomerOrdersRes)
+	err := c.cc.Invoke(ctx, "/orders_service.OrdersService/GetCustomerOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetSalesReport(ctx context.Context, in *GetSalesReportReq, opts ...grpc.CallOption) (*GetSalesReportRes, error) {
+	out := new(GetSalesReportRes)
+	err := c.cc.Invoke(ctx, "/orders_service.OrdersService/GetSalesReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ExportSalesReport(ctx context.Context, in *ExportSalesReportReq, opts ...grpc.CallOption) (*ExportSalesReportRes, error) {
+	out := new(ExportSalesReportRes)
+	err := c.cc.Invoke(ctx, "/orders_service.OrdersService/ExportSalesReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) WatchOrder(ctx context.Context, in *WatchOrderReq, opts ...grpc.CallOption) (OrdersService_WatchOrderClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OrdersService_ServiceDesc.Streams[0], "/orders_service.OrdersService/WatchOrder", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ordersServiceWatchOrderClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OrdersService_WatchOrderClient interface {
+	Recv() (*WatchOrderRes, error)
+	grpc.ClientStream
+}
+
+type ordersServiceWatchOrderClient struct {
+	grpc.ClientStream
+}
+
+func (x *ordersServiceWatchOrderClient) Recv() (*WatchOrderRes, error) {
+	m := new(WatchOrderRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ordersServiceClient) RescheduleDelivery(ctx context.Context, in *RescheduleDeliveryReq, opts ...grpc.CallOption) (*RescheduleDeliveryRes, error) {
+	out := new(RescheduleDeliveryRes)
+	err := c.cc.Invoke(ctx, "/orders_service.OrdersService/RescheduleDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrdersServiceServer is the server API for OrdersService service.
 // All implementations should embed UnimplementedOrdersServiceServer
 // for forward compatibility
 type OrdersServiceServer interface {
 	CreateOrder(context.Context, *CreateOrderReq) (*CreateOrderRes, error)
 	SubmitOrder(context.Context, *SubmitOrderReq) (*SubmitOrderRes, error)
+	PayOrder(context.Context, *PayOrderReq) (*PayOrderRes, error)
+	CancelOrder(context.Context, *CancelOrderReq) (*CancelOrderRes, error)
+	CompleteOrder(context.Context, *CompleteOrderReq) (*CompleteOrderRes, error)
 	UpdateShoppingCart(context.Context, *UpdateShoppingCartReq) (*UpdateShoppingCartRes, error)
+	ApplyCoupon(context.Context, *ApplyCouponReq) (*ApplyCouponRes, error)
 	GetOrderByID(context.Context, *GetOrderByIDReq) (*GetOrderByIDRes, error)
 	GetOrders(context.Context, *GetOrdersReq) (*GetOrdersRes, error)
+	GetOrderHistory(context.Context, *GetOrderHistoryReq) (*GetOrderHistoryRes, error)
+	RequestReturn(context.Context, *RequestReturnReq) (*RequestReturnRes, error)
+	ApproveReturn(context.Context, *ApproveReturnReq) (*ApproveReturnRes, error)
+	IssueRefund(context.Context, *IssueRefundReq) (*IssueRefundRes, error)
+	GetCustomerOrders(context.Context, *GetCustomerOrdersReq) (*GetCustomerOrdersRes, error)
+	GetSalesReport(context.Context, *GetSalesReportReq) (*GetSalesReportRes, error)
+	ExportSalesReport(context.Context, *ExportSalesReportReq) (*ExportSalesReportRes, error)
+	WatchOrder(*WatchOrderReq, OrdersService_WatchOrderServer) error
+	RescheduleDelivery(context.Context, *RescheduleDeliveryReq) (*RescheduleDeliveryRes, error)
 }
 
 // UnimplementedOrdersServiceServer should be embedded to have forward compatible implementations.
@@ -103,15 +269,54 @@ func (UnimplementedOrdersServiceServer) CreateOrder(context.Context, *CreateOrde
 func (UnimplementedOrdersServiceServer) SubmitOrder(context.Context, *SubmitOrderReq) (*SubmitOrderRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
 }
+func (UnimplementedOrdersServiceServer) PayOrder(context.Context, *PayOrderReq) (*PayOrderRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) CancelOrder(context.Context, *CancelOrderReq) (*CancelOrderRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) CompleteOrder(context.Context, *CompleteOrderReq) (*CompleteOrderRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteOrder not implemented")
+}
 func (UnimplementedOrdersServiceServer) UpdateShoppingCart(context.Context, *UpdateShoppingCartReq) (*UpdateShoppingCartRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateShoppingCart not implemented")
 }
+func (UnimplementedOrdersServiceServer) ApplyCoupon(context.Context, *ApplyCouponReq) (*ApplyCouponRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyCoupon not implemented")
+}
 func (UnimplementedOrdersServiceServer) GetOrderByID(context.Context, *GetOrderByIDReq) (*GetOrderByIDRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByID not implemented")
 }
 func (UnimplementedOrdersServiceServer) GetOrders(context.Context, *GetOrdersReq) (*GetOrdersRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
 }
+func (UnimplementedOrdersServiceServer) GetOrderHistory(context.Context, *GetOrderHistoryReq) (*GetOrderHistoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
+}
+func (UnimplementedOrdersServiceServer) RequestReturn(context.Context, *RequestReturnReq) (*RequestReturnRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReturn not implemented")
+}
+func (UnimplementedOrdersServiceServer) ApproveReturn(context.Context, *ApproveReturnReq) (*ApproveReturnRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveReturn not implemented")
+}
+func (UnimplementedOrdersServiceServer) IssueRefund(context.Context, *IssueRefundReq) (*IssueRefundRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueRefund not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetCustomerOrders(context.Context, *GetCustomerOrdersReq) (*GetCustomerOrdersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerOrders not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetSalesReport(context.Context, *GetSalesReportReq) (*GetSalesReportRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSalesReport not implemented")
+}
+func (UnimplementedOrdersServiceServer) ExportSalesReport(context.Context, *ExportSalesReportReq) (*ExportSalesReportRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSalesReport not implemented")
+}
+func (UnimplementedOrdersServiceServer) WatchOrder(*WatchOrderReq, OrdersService_WatchOrderServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) RescheduleDelivery(context.Context, *RescheduleDeliveryReq) (*RescheduleDeliveryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleDelivery not implemented")
+}
 
 // UnsafeOrdersServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to OrdersServiceServer will
@@ -160,6 +365,60 @@ func _OrdersService_SubmitOrder_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersService_PayOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).PayOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orders_service.OrdersService/PayOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).PayOrder(ctx, req.(*PayOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orders_service.OrdersService/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CancelOrder(ctx, req.(*CancelOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CompleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CompleteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orders_service.OrdersService/CompleteOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CompleteOrder(ctx, req.(*CompleteOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrdersService_UpdateShoppingCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateShoppingCartReq)
 	if err := dec(in); err != nil {
@@ -178,6 +437,24 @@ func _OrdersService_UpdateShoppingCart_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersService_ApplyCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyCouponReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ApplyCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orders_service.OrdersService/ApplyCoupon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ApplyCoupon(ctx, req.(*ApplyCouponReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrdersService_GetOrderByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetOrderByIDReq)
 	if err := dec(in); err != nil {
@@ -214,6 +491,171 @@ func _OrdersService_GetOrders_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersService_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orders_service.OrdersService/GetOrderHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetOrderHistory(ctx, req.(*GetOrderHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_RequestReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestReturnReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).RequestReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orders_service.OrdersService/RequestReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).RequestReturn(ctx, req.(*RequestReturnReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ApproveReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveReturnReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ApproveReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orders_service.OrdersService/ApproveReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ApproveReturn(ctx, req.(*ApproveReturnReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_IssueRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueRefundReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).IssueRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orders_service.OrdersService/IssueRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).IssueRefund(ctx, req.(*IssueRefundReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetCustomerOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerOrdersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetCustomerOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orders_service.OrdersService/GetCustomerOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetCustomerOrders(ctx, req.(*GetCustomerOrdersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetSalesReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSalesReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetSalesReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orders_service.OrdersService/GetSalesReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetSalesReport(ctx, req.(*GetSalesReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ExportSalesReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSalesReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ExportSalesReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orders_service.OrdersService/ExportSalesReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ExportSalesReport(ctx, req.(*ExportSalesReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_WatchOrder_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrderReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrdersServiceServer).WatchOrder(m, &ordersServiceWatchOrderServer{stream})
+}
+
+type OrdersService_WatchOrderServer interface {
+	Send(*WatchOrderRes) error
+	grpc.ServerStream
+}
+
+type ordersServiceWatchOrderServer struct {
+	grpc.ServerStream
+}
+
+func (x *ordersServiceWatchOrderServer) Send(m *WatchOrderRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OrdersService_RescheduleDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleDeliveryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).RescheduleDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orders_service.OrdersService/RescheduleDelivery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).RescheduleDelivery(ctx, req.(*RescheduleDeliveryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrdersService_ServiceDesc is the grpc.ServiceDesc for OrdersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,10 +671,26 @@ var OrdersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitOrder",
 			Handler:    _OrdersService_SubmitOrder_Handler,
 		},
+		{
+			MethodName: "PayOrder",
+			Handler:    _OrdersService_PayOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _OrdersService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "CompleteOrder",
+			Handler:    _OrdersService_CompleteOrder_Handler,
+		},
 		{
 			MethodName: "UpdateShoppingCart",
 			Handler:    _OrdersService_UpdateShoppingCart_Handler,
 		},
+		{
+			MethodName: "ApplyCoupon",
+			Handler:    _OrdersService_ApplyCoupon_Handler,
+		},
 		{
 			MethodName: "GetOrderByID",
 			Handler:    _OrdersService_GetOrderByID_Handler,
@@ -241,7 +699,45 @@ var OrdersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrders",
 			Handler:    _OrdersService_GetOrders_Handler,
 		},
+		{
+			MethodName: "GetOrderHistory",
+			Handler:    _OrdersService_GetOrderHistory_Handler,
+		},
+		{
+			MethodName: "RequestReturn",
+			Handler:    _OrdersService_RequestReturn_Handler,
+		},
+		{
+			MethodName: "ApproveReturn",
+			Handler:    _OrdersService_ApproveReturn_Handler,
+		},
+		{
+			MethodName: "IssueRefund",
+			Handler:    _OrdersService_IssueRefund_Handler,
+		},
+		{
+			MethodName: "GetCustomerOrders",
+			Handler:    _OrdersService_GetCustomerOrders_Handler,
+		},
+		{
+			MethodName: "GetSalesReport",
+			Handler:    _OrdersService_GetSalesReport_Handler,
+		},
+		{
+			MethodName: "ExportSalesReport",
+			Handler:    _OrdersService_ExportSalesReport_Handler,
+		},
+		{
+			MethodName: "RescheduleDelivery",
+			Handler:    _OrdersService_RescheduleDelivery_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrder",
+			Handler:       _OrdersService_WatchOrder_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api_docs/orders/protobuf/orders/service_clients/orders_service_client.proto",
 }
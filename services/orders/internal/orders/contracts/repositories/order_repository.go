@@ -10,6 +10,8 @@ import (
 type OrderReadRepository interface {
 	GetAllOrders(ctx context.Context, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error)
 	SearchOrders(ctx context.Context, searchText string, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error)
+	// GetCustomerOrders returns the orders of the account, an empty status returns the orders in all the statuses
+	GetCustomerOrders(ctx context.Context, accountEmail string, status string, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error)
 	GetOrderById(ctx context.Context, uuid uuid.UUID) (*read_models.OrderReadModel, error)
 	GetOrderByOrderId(ctx context.Context, orderId uuid.UUID) (*read_models.OrderReadModel, error)
 	CreateOrder(ctx context.Context, order *read_models.OrderReadModel) (*read_models.OrderReadModel, error)
@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/elasticsearch"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	v7 "github.com/olivere/elastic/v7"
+)
+
+// ConfigElasticOrderIndexTemplate puts the index template of the OrderReadModel documents, the texts which are searched get a keyword sub field for exact matches and sorting.
+// The fields which the list queries filter by are keywords with the same name as the mongo fields, so accountEmail is searched by its text sub field
+func ConfigElasticOrderIndexTemplate(ctx context.Context, elasticClient *v7.Client, cfg *config.Config) error {
+	searchableText := map[string]interface{}{
+		"type":   "text",
+		"fields": map[string]interface{}{"keyword": map[string]interface{}{"type": "keyword", "ignore_above": 256}},
+	}
+	// the decimal amounts are json strings, elastic coerces them to numbers for the range queries and sorting
+	money := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"amount":   map[string]interface{}{"type": "double"},
+			"currency": map[string]interface{}{"type": "keyword"},
+		},
+	}
+
+	template := map[string]interface{}{
+		"index_patterns": []string{cfg.ElasticIndexes.Orders},
+		"template": map[string]interface{}{
+			"mappings": map[string]interface{}{
+				"dynamic": false,
+				"properties": map[string]interface{}{
+					"id":      map[string]interface{}{"type": "keyword"},
+					"orderId": map[string]interface{}{"type": "keyword"},
+					"accountEmail": map[string]interface{}{
+						"type":   "keyword",
+						"fields": map[string]interface{}{"text": map[string]interface{}{"type": "text"}},
+					},
+					"deliveryAddress": searchableText,
+					"cancelReason":    map[string]interface{}{"type": "text"},
+					"discount":        money,
+					"totalPrice":      money,
+					"refundedAmount":  money,
+					"deliveredTime":   map[string]interface{}{"type": "date"},
+					"paid":            map[string]interface{}{"type": "boolean"},
+					"submitted":       map[string]interface{}{"type": "boolean"},
+					"completed":       map[string]interface{}{"type": "boolean"},
+					"canceled":        map[string]interface{}{"type": "boolean"},
+					"status":          map[string]interface{}{"type": "keyword"},
+					"paymentId":       map[string]interface{}{"type": "keyword"},
+					"createdAt":       map[string]interface{}{"type": "date"},
+					"updatedAt":       map[string]interface{}{"type": "date"},
+					"version":         map[string]interface{}{"type": "long"},
+					"coupon": map[string]interface{}{
+						"properties": map[string]interface{}{
+							"code":         map[string]interface{}{"type": "keyword"},
+							"discountType": map[string]interface{}{"type": "keyword"},
+						},
+					},
+					"shopItems": map[string]interface{}{
+						"properties": map[string]interface{}{
+							"productId":   map[string]interface{}{"type": "keyword"},
+							"title":       searchableText,
+							"description": map[string]interface{}{"type": "text"},
+							"quantity":    map[string]interface{}{"type": "long"},
+							"price":       money,
+						},
+					},
+					"returns": map[string]interface{}{
+						"properties": map[string]interface{}{
+							"returnId":     map[string]interface{}{"type": "keyword"},
+							"status":       map[string]interface{}{"type": "keyword"},
+							"refundAmount": money,
+							"requestedAt":  map[string]interface{}{"type": "date"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return elasticsearch.PutIndexTemplate(ctx, elasticClient, fmt.Sprintf("%s_template", cfg.ElasticIndexes.Orders), template)
+}
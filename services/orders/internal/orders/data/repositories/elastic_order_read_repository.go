@@ -2,15 +2,25 @@ package repositories
 
 import (
 	"context"
+	"emperror.dev/errors"
+	"encoding/json"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/elasticsearch"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/repositories"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/read_models"
 	v7 "github.com/olivere/elastic/v7"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
 	uuid "github.com/satori/go.uuid"
 )
 
+// orders are indexed with their orderId as the document id and the stream version as the external document version,
+// so a replayed or an out of order event can't overwrite a newer state of the order
 type elasticOrderReadRepository struct {
 	log           logger.Logger
 	cfg           *config.Config
@@ -22,36 +32,167 @@ func NewElasticOrderReadRepository(log logger.Logger, cfg *config.Config, elasti
 }
 
 func (e elasticOrderReadRepository) GetAllOrders(ctx context.Context, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
-	//TODO implement me
-	panic("implement me")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "elasticOrderReadRepository.GetAllOrders")
+	defer span.Finish()
+
+	result, err := elasticsearch.Paginate[*read_models.OrderReadModel](ctx, listQuery, e.elasticClient, e.cfg.ElasticIndexes.Orders, nil, v7.NewFieldSort("createdAt").Desc())
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[elasticOrderReadRepository_GetAllOrders.Paginate] error in the paginate"))
+	}
+
+	e.log.Infow("[elasticOrderReadRepository.GetAllOrders] orders loaded", logger.Fields{"OrdersResult": result})
+	span.LogFields(log.Object("OrdersResult", result))
+
+	return result, nil
 }
 
 func (e elasticOrderReadRepository) SearchOrders(ctx context.Context, searchText string, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
-	//TODO implement me
-	panic("implement me")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "elasticOrderReadRepository.SearchOrders")
+	span.LogFields(log.String("SearchText", searchText))
+	defer span.Finish()
+
+	query := v7.NewMultiMatchQuery(searchText, "accountEmail.text", "deliveryAddress", "shopItems.title").Fuzziness("AUTO")
+
+	result, err := elasticsearch.Paginate[*read_models.OrderReadModel](ctx, listQuery, e.elasticClient, e.cfg.ElasticIndexes.Orders, query)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[elasticOrderReadRepository_SearchOrders.Paginate] error in the paginate"))
+	}
+
+	e.log.Infow(fmt.Sprintf("[elasticOrderReadRepository.SearchOrders] orders loaded for search term '%s'", searchText), logger.Fields{"OrdersResult": result})
+	span.LogFields(log.Object("OrdersResult", result))
+
+	return result, nil
 }
 
-func (e elasticOrderReadRepository) GetOrderById(ctx context.Context, uuid uuid.UUID) (*read_models.OrderReadModel, error) {
-	//TODO implement me
-	panic("implement me")
+func (e elasticOrderReadRepository) GetCustomerOrders(ctx context.Context, accountEmail string, status string, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "elasticOrderReadRepository.GetCustomerOrders")
+	span.LogFields(log.String("AccountEmail", accountEmail))
+	span.LogFields(log.String("Status", status))
+	defer span.Finish()
+
+	query := v7.NewBoolQuery().Filter(v7.NewTermQuery("accountEmail", accountEmail))
+	if status != "" {
+		query = query.Filter(v7.NewTermQuery("status", status))
+	}
+
+	result, err := elasticsearch.Paginate[*read_models.OrderReadModel](ctx, listQuery, e.elasticClient, e.cfg.ElasticIndexes.Orders, query, v7.NewFieldSort("createdAt").Desc())
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[elasticOrderReadRepository_GetCustomerOrders.Paginate] error in the paginate"))
+	}
+
+	e.log.Infow(fmt.Sprintf("[elasticOrderReadRepository.GetCustomerOrders] orders of account '%s' loaded", accountEmail), logger.Fields{"OrdersResult": result, "AccountEmail": accountEmail})
+	span.LogFields(log.Object("OrdersResult", result))
+
+	return result, nil
+}
+
+func (e elasticOrderReadRepository) GetOrderById(ctx context.Context, id uuid.UUID) (*read_models.OrderReadModel, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "elasticOrderReadRepository.GetOrderById")
+	span.LogFields(log.String("Id", id.String()))
+	defer span.Finish()
+
+	result, err := e.elasticClient.Search(e.cfg.ElasticIndexes.Orders).Query(v7.NewTermQuery("id", id.String())).Size(1).Do(ctx)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[elasticOrderReadRepository_GetOrderById.Search] can't find the order with id %s into the elastic.", id)))
+	}
+
+	if len(result.Hits.Hits) == 0 {
+		return nil, nil
+	}
+
+	var order read_models.OrderReadModel
+	if err := json.Unmarshal(result.Hits.Hits[0].Source, &order); err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[elasticOrderReadRepository_GetOrderById.Unmarshal] error in unmarshalling order"))
+	}
+
+	span.LogFields(log.Object("Order", order))
+	e.log.Infow(fmt.Sprintf("[elasticOrderReadRepository.GetOrderById] order with id %s laoded", id.String()), logger.Fields{"Order": order, "Id": id})
+
+	return &order, nil
 }
 
-func (e elasticOrderReadRepository) GetOrderByOrderId(ctx context.Context, uuid uuid.UUID) (*read_models.OrderReadModel, error) {
-	//TODO implement me
-	panic("implement me")
+func (e elasticOrderReadRepository) GetOrderByOrderId(ctx context.Context, orderId uuid.UUID) (*read_models.OrderReadModel, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "elasticOrderReadRepository.GetOrderByOrderId")
+	span.LogFields(log.String("OrderId", orderId.String()))
+	defer span.Finish()
+
+	result, err := e.elasticClient.Get().Index(e.cfg.ElasticIndexes.Orders).Id(orderId.String()).Do(ctx)
+	if err != nil {
+		if v7.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[elasticOrderReadRepository_GetOrderByOrderId.Get] can't find the order with orderId %s into the elastic.", orderId)))
+	}
+
+	var order read_models.OrderReadModel
+	if err := json.Unmarshal(result.Source, &order); err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[elasticOrderReadRepository_GetOrderByOrderId.Unmarshal] error in unmarshalling order"))
+	}
+
+	span.LogFields(log.Object("Order", order))
+	e.log.Infow(fmt.Sprintf("[elasticOrderReadRepository.GetOrderByOrderId] order with orderId %s laoded", orderId.String()), logger.Fields{"Order": order, "orderId": orderId})
+
+	return &order, nil
 }
 
 func (e elasticOrderReadRepository) CreateOrder(ctx context.Context, order *read_models.OrderReadModel) (*read_models.OrderReadModel, error) {
-	//TODO implement me
-	panic("implement me")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "elasticOrderReadRepository.CreateOrder")
+	defer span.Finish()
+
+	if err := e.index(ctx, order); err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[elasticOrderReadRepository_CreateOrder.Index] error in the inserting order into the elastic."))
+	}
+
+	span.LogFields(log.Object("Order", order))
+	e.log.Infow(fmt.Sprintf("[elasticOrderReadRepository.CreateOrder] order with id '%s' created", order.OrderId), logger.Fields{"Order": order, "Id": order.OrderId})
+
+	return order, nil
 }
 
 func (e elasticOrderReadRepository) UpdateOrder(ctx context.Context, order *read_models.OrderReadModel) (*read_models.OrderReadModel, error) {
-	//TODO implement me
-	panic("implement me")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "elasticOrderReadRepository.UpdateOrder")
+	defer span.Finish()
+
+	if err := e.index(ctx, order); err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, fmt.Sprintf("[elasticOrderReadRepository_UpdateOrder.Index] error in updating order with id %s into the elastic.", order.OrderId)))
+	}
+
+	span.LogFields(log.Object("Order", order))
+	e.log.Infow(fmt.Sprintf("[elasticOrderReadRepository.UpdateOrder] order with id '%s' updated", order.OrderId), logger.Fields{"Order": order, "Id": order.OrderId})
+
+	return order, nil
+}
+
+func (e elasticOrderReadRepository) DeleteOrderByID(ctx context.Context, id uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "elasticOrderReadRepository.DeleteOrderByID")
+	span.LogFields(log.String("Id", id.String()))
+	defer span.Finish()
+
+	_, err := e.elasticClient.DeleteByQuery(e.cfg.ElasticIndexes.Orders).Query(v7.NewTermQuery("id", id.String())).Do(ctx)
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[elasticOrderReadRepository_DeleteOrderByID.DeleteByQuery] error in deleting order with id %s from the elastic.", id)))
+	}
+
+	e.log.Infow(fmt.Sprintf("[elasticOrderReadRepository.DeleteOrderByID] order with id %s deleted", id), logger.Fields{"Id": id})
+
+	return nil
 }
 
-func (e elasticOrderReadRepository) DeleteOrderByID(ctx context.Context, uuid uuid.UUID) error {
-	//TODO implement me
-	panic("implement me")
+// index writes the order with its version as the external version, elastic rejects the write with a conflict when the stored version is the same or newer
+func (e elasticOrderReadRepository) index(ctx context.Context, order *read_models.OrderReadModel) error {
+	_, err := e.elasticClient.Index().
+		Index(e.cfg.ElasticIndexes.Orders).
+		Id(order.OrderId).
+		BodyJson(order).
+		Version(order.Version).
+		VersionType("external").
+		Do(ctx)
+	if err != nil {
+		if v7.IsConflict(err) {
+			return customErrors.NewConflictErrorWrap(err, fmt.Sprintf("order with orderId %s has a newer version than %d", order.OrderId, order.Version))
+		}
+		return errors.WrapIf(err, "Index")
+	}
+
+	return nil
 }
@@ -18,6 +18,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"regexp"
 )
 
 type mongoOrderReadRepository struct {
@@ -54,10 +55,12 @@ func (m mongoOrderReadRepository) SearchOrders(ctx context.Context, searchText s
 
 	collection := m.mongoClient.Database(m.cfg.Mongo.Db).Collection(m.cfg.MongoCollections.Orders)
 
+	pattern := regexp.QuoteMeta(searchText)
 	filter := bson.D{
 		{Key: "$or", Value: bson.A{
-			bson.D{{Key: "name", Value: primitive.Regex{Pattern: searchText, Options: "gi"}}},
-			bson.D{{Key: "description", Value: primitive.Regex{Pattern: searchText, Options: "gi"}}},
+			bson.D{{Key: "accountEmail", Value: primitive.Regex{Pattern: pattern, Options: "i"}}},
+			bson.D{{Key: "deliveryAddress", Value: primitive.Regex{Pattern: pattern, Options: "i"}}},
+			bson.D{{Key: "shopItems.title", Value: primitive.Regex{Pattern: pattern, Options: "i"}}},
 		}},
 	}
 
@@ -72,6 +75,30 @@ func (m mongoOrderReadRepository) SearchOrders(ctx context.Context, searchText s
 	return result, nil
 }
 
+func (m mongoOrderReadRepository) GetCustomerOrders(ctx context.Context, accountEmail string, status string, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongoOrderReadRepository.GetCustomerOrders")
+	span.LogFields(log.String("AccountEmail", accountEmail))
+	span.LogFields(log.String("Status", status))
+	defer span.Finish()
+
+	collection := m.mongoClient.Database(m.cfg.Mongo.Db).Collection(m.cfg.MongoCollections.Orders)
+
+	filter := bson.D{{Key: "accountEmail", Value: accountEmail}}
+	if status != "" {
+		filter = append(filter, bson.E{Key: "status", Value: status})
+	}
+
+	result, err := mongodb.Paginate[*read_models.OrderReadModel](ctx, listQuery, collection, filter)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[mongoOrderReadRepository_GetCustomerOrders.Paginate] error in the paginate"))
+	}
+
+	m.log.Infow(fmt.Sprintf("[mongoOrderReadRepository.GetCustomerOrders] orders of account '%s' loaded", accountEmail), logger.Fields{"OrdersResult": result, "AccountEmail": accountEmail})
+	span.LogFields(log.Object("OrdersResult", result))
+
+	return result, nil
+}
+
 func (m mongoOrderReadRepository) GetOrderById(ctx context.Context, id uuid.UUID) (*read_models.OrderReadModel, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "mongoOrderReadRepository.GetOrderById")
 	span.LogFields(log.String("Id", id.String()))
@@ -143,7 +170,7 @@ func (m mongoOrderReadRepository) UpdateOrder(ctx context.Context, order *read_m
 	ops.SetUpsert(true)
 
 	var updated read_models.OrderReadModel
-	if err := collection.FindOneAndUpdate(ctx, bson.M{"_id": order.OrderId}, bson.M{"$set": order}, ops).Decode(&updated); err != nil {
+	if err := collection.FindOneAndUpdate(ctx, bson.M{"_id": order.Id}, bson.M{"$set": order}, ops).Decode(&updated); err != nil {
 		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[mongoOrderReadRepository_UpdateOrder.FindOneAndUpdate] error in updating order with id %s into the database.", order.OrderId)))
 	}
 
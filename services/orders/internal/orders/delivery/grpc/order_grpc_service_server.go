@@ -5,31 +5,72 @@ import (
 	"emperror.dev/errors"
 	"fmt"
 	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/grpc/grpcErrors"
 	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts"
 	grpcOrderService "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/proto/service_clients"
+	orderRepositories "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/data/repositories"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	applyingCouponCommandV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/commands/v1"
+	applyingCouponDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/dtos"
+	approvingReturnCommandV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/approving_return/commands/v1"
+	approvingReturnDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/approving_return/dtos"
+	cancelingOrderCommandV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/commands/v1"
+	cancelingOrderDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/dtos"
+	completingOrderCommandV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/completing_order/commands/v1"
+	completingOrderDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/completing_order/dtos"
 	creatingOrderCommandV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/commands/v1"
 	orderDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/dtos"
+	gettingCustomerOrdersDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_customer_orders/dtos"
+	gettingCustomerOrdersQueryV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_customer_orders/queries/v1"
 	gettingOrderByIdDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_order_by_id/dtos"
 	gettingOrderByIdQueryV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_order_by_id/queries/v1"
+	gettingOrderHistoryDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_order_history/dtos"
+	gettingOrderHistoryQueryV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_order_history/queries/v1"
 	gettingOrdersDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_orders/dtos"
 	gettingOrdersQueryV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_orders/queryies/v1"
+	issuingRefundCommandV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/commands/v1"
+	issuingRefundDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/dtos"
+	payingOrderCommandV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/commands/v1"
+	payingOrderDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/dtos"
+	requestingReturnCommandV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/commands/v1"
+	requestingReturnDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/dtos"
+	reschedulingDeliveryCommandV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/rescheduling_delivery/commands/v1"
+	reschedulingDeliveryDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/rescheduling_delivery/dtos"
+	searchingOrdersDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/searching_orders/dtos"
+	searchingOrdersQueryV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/searching_orders/queries/v1"
+	submittingOrderCommandV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/commands/v1"
+	submittingOrderDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/dtos"
+	updatingShoppingCartCommandV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/commands/v1"
+	updatingShoppingCartDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/dtos"
+	watchingOrderQueryV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/watching_order/queries/v1"
+	reportsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/dtos"
+	exportingSalesReportDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/exporting_sales_report/dtos"
+	exportingSalesReportQueryV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/exporting_sales_report/queries/v1"
+	gettingSalesReportDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/getting_sales_report/dtos"
+	gettingSalesReportQueryV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/getting_sales_report/queries/v1"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
 	"github.com/opentracing/opentracing-go/log"
 	uuid "github.com/satori/go.uuid"
+	"time"
 )
 
 type OrderGrpcServiceServer struct {
 	*infrastructure.InfrastructureConfiguration
+	watchOrderHandler *watchingOrderQueryV1.WatchOrderHandler
 }
 
-func NewOrderGrpcService(infra *infrastructure.InfrastructureConfiguration) *OrderGrpcServiceServer {
-	return &OrderGrpcServiceServer{InfrastructureConfiguration: infra}
+func NewOrderGrpcService(infra *infrastructure.InfrastructureConfiguration, orderWatcher contracts.OrderWatcher) *OrderGrpcServiceServer {
+	mongoOrderReadRepository := orderRepositories.NewMongoOrderReadRepository(infra.Log, infra.Cfg, infra.MongoClient)
+	watchOrderHandler := watchingOrderQueryV1.NewWatchOrderHandler(infra.Log, infra.Cfg, mongoOrderReadRepository, orderWatcher)
+
+	return &OrderGrpcServiceServer{InfrastructureConfiguration: infra, watchOrderHandler: watchOrderHandler}
 }
 
 func (o OrderGrpcServiceServer) CreateOrder(ctx context.Context, req *grpcOrderService.CreateOrderReq) (*grpcOrderService.CreateOrderRes, error) {
@@ -102,13 +143,252 @@ func (o OrderGrpcServiceServer) GetOrderByID(ctx context.Context, req *grpcOrder
 }
 
 func (o OrderGrpcServiceServer) SubmitOrder(ctx context.Context, req *grpcOrderService.SubmitOrderReq) (*grpcOrderService.SubmitOrderRes, error) {
-	//TODO implement me
-	panic("implement me")
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "OrderGrpcServiceServer.SubmitOrder")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.SubmitOrderGrpcRequests.Inc()
+	defer span.Finish()
+
+	orderIdUUID, err := uuid.FromString(req.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_SubmitOrder.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_SubmitOrder.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	command := submittingOrderCommandV1.NewSubmitOrder(orderIdUUID, req.DeliveryZone)
+	if err := o.Validator.StructCtx(ctx, command); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_SubmitOrder.StructCtx] command validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_SubmitOrder.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	result, err := mediatr.Send[*submittingOrderCommandV1.SubmitOrder, *submittingOrderDtos.SubmitOrderResponseDto](ctx, command)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_SubmitOrder.Send] error in sending SubmitOrder")
+		o.Log.Errorw(fmt.Sprintf("[OrderGrpcServiceServer_SubmitOrder.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+		return nil, grpcErrors.ErrGrpcResponse(err)
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return &grpcOrderService.SubmitOrderRes{OrderId: result.OrderId.String()}, nil
+}
+
+func (o OrderGrpcServiceServer) PayOrder(ctx context.Context, req *grpcOrderService.PayOrderReq) (*grpcOrderService.PayOrderRes, error) {
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "OrderGrpcServiceServer.PayOrder")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.PayOrderGrpcRequests.Inc()
+	defer span.Finish()
+
+	orderIdUUID, err := uuid.FromString(req.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_PayOrder.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_PayOrder.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	paymentIdUUID, err := uuid.FromString(req.PaymentId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_PayOrder.uuid.FromString] error in converting paymentId uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_PayOrder.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	amount, err := domain.ParseMoney(req.GetAmount().GetAmount(), req.GetAmount().GetCurrency())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_PayOrder.ParseMoney] error in parsing the payment amount")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_PayOrder.ParseMoney] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	command := payingOrderCommandV1.NewPayOrder(orderIdUUID, paymentIdUUID, amount)
+	if err := o.Validator.StructCtx(ctx, command); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_PayOrder.StructCtx] command validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_PayOrder.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	result, err := mediatr.Send[*payingOrderCommandV1.PayOrder, *payingOrderDtos.PayOrderResponseDto](ctx, command)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_PayOrder.Send] error in sending PayOrder")
+		o.Log.Errorw(fmt.Sprintf("[OrderGrpcServiceServer_PayOrder.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+		return nil, grpcErrors.ErrGrpcResponse(err)
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return &grpcOrderService.PayOrderRes{OrderId: result.OrderId.String()}, nil
+}
+
+func (o OrderGrpcServiceServer) CancelOrder(ctx context.Context, req *grpcOrderService.CancelOrderReq) (*grpcOrderService.CancelOrderRes, error) {
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "OrderGrpcServiceServer.CancelOrder")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.CancelOrderGrpcRequests.Inc()
+	defer span.Finish()
+
+	orderIdUUID, err := uuid.FromString(req.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_CancelOrder.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_CancelOrder.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	command := cancelingOrderCommandV1.NewCancelOrder(orderIdUUID, req.CancelReason)
+	if err := o.Validator.StructCtx(ctx, command); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_CancelOrder.StructCtx] command validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_CancelOrder.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	result, err := mediatr.Send[*cancelingOrderCommandV1.CancelOrder, *cancelingOrderDtos.CancelOrderResponseDto](ctx, command)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_CancelOrder.Send] error in sending CancelOrder")
+		o.Log.Errorw(fmt.Sprintf("[OrderGrpcServiceServer_CancelOrder.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+		return nil, grpcErrors.ErrGrpcResponse(err)
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return &grpcOrderService.CancelOrderRes{OrderId: result.OrderId.String()}, nil
+}
+
+func (o OrderGrpcServiceServer) RescheduleDelivery(ctx context.Context, req *grpcOrderService.RescheduleDeliveryReq) (*grpcOrderService.RescheduleDeliveryRes, error) {
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "OrderGrpcServiceServer.RescheduleDelivery")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.RescheduleDeliveryGrpcRequests.Inc()
+	defer span.Finish()
+
+	orderIdUUID, err := uuid.FromString(req.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_RescheduleDelivery.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_RescheduleDelivery.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	// a missing delivery time stays zero, so it fails the validation instead of being the unix epoch
+	var deliveryTime time.Time
+	if req.DeliveryTime != nil {
+		deliveryTime = req.DeliveryTime.AsTime()
+	}
+
+	command := reschedulingDeliveryCommandV1.NewRescheduleDelivery(orderIdUUID, deliveryTime)
+	if err := o.Validator.StructCtx(ctx, command); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_RescheduleDelivery.StructCtx] command validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_RescheduleDelivery.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	result, err := mediatr.Send[*reschedulingDeliveryCommandV1.RescheduleDelivery, *reschedulingDeliveryDtos.RescheduleDeliveryResponseDto](ctx, command)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_RescheduleDelivery.Send] error in sending RescheduleDelivery")
+		o.Log.Errorw(fmt.Sprintf("[OrderGrpcServiceServer_RescheduleDelivery.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+		return nil, grpcErrors.ErrGrpcResponse(err)
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return &grpcOrderService.RescheduleDeliveryRes{OrderId: result.OrderId.String()}, nil
+}
+
+func (o OrderGrpcServiceServer) CompleteOrder(ctx context.Context, req *grpcOrderService.CompleteOrderReq) (*grpcOrderService.CompleteOrderRes, error) {
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "OrderGrpcServiceServer.CompleteOrder")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.CompleteOrderGrpcRequests.Inc()
+	defer span.Finish()
+
+	orderIdUUID, err := uuid.FromString(req.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_CompleteOrder.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_CompleteOrder.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	command := completingOrderCommandV1.NewCompleteOrder(orderIdUUID)
+	if err := o.Validator.StructCtx(ctx, command); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_CompleteOrder.StructCtx] command validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_CompleteOrder.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	result, err := mediatr.Send[*completingOrderCommandV1.CompleteOrder, *completingOrderDtos.CompleteOrderResponseDto](ctx, command)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_CompleteOrder.Send] error in sending CompleteOrder")
+		o.Log.Errorw(fmt.Sprintf("[OrderGrpcServiceServer_CompleteOrder.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+		return nil, grpcErrors.ErrGrpcResponse(err)
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return &grpcOrderService.CompleteOrderRes{OrderId: result.OrderId.String()}, nil
 }
 
 func (o OrderGrpcServiceServer) UpdateShoppingCart(ctx context.Context, req *grpcOrderService.UpdateShoppingCartReq) (*grpcOrderService.UpdateShoppingCartRes, error) {
-	//TODO implement me
-	panic("implement me")
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "OrderGrpcServiceServer.UpdateShoppingCart")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.UpdateOrderGrpcRequests.Inc()
+	defer span.Finish()
+
+	orderIdUUID, err := uuid.FromString(req.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_UpdateShoppingCart.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_UpdateShoppingCart.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	shopItemsDtos, err := mapper.Map[[]*dtos.ShopItemDto](req.GetShopItems())
+	if err != nil {
+		return nil, err
+	}
+
+	command := updatingShoppingCartCommandV1.NewUpdateShoppingCart(orderIdUUID, shopItemsDtos)
+	if err := o.Validator.StructCtx(ctx, command); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_UpdateShoppingCart.StructCtx] command validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_UpdateShoppingCart.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	_, err = mediatr.Send[*updatingShoppingCartCommandV1.UpdateShoppingCart, *updatingShoppingCartDtos.UpdateShoppingCartResponseDto](ctx, command)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_UpdateShoppingCart.Send] error in sending UpdateShoppingCart")
+		o.Log.Errorw(fmt.Sprintf("[OrderGrpcServiceServer_UpdateShoppingCart.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+		return nil, grpcErrors.ErrGrpcResponse(err)
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return &grpcOrderService.UpdateShoppingCartRes{}, nil
+}
+
+func (o OrderGrpcServiceServer) ApplyCoupon(ctx context.Context, req *grpcOrderService.ApplyCouponReq) (*grpcOrderService.ApplyCouponRes, error) {
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "OrderGrpcServiceServer.ApplyCoupon")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.ApplyCouponGrpcRequests.Inc()
+	defer span.Finish()
+
+	orderIdUUID, err := uuid.FromString(req.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_ApplyCoupon.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_ApplyCoupon.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	command := applyingCouponCommandV1.NewApplyCoupon(orderIdUUID, req.CouponCode)
+	if err := o.Validator.StructCtx(ctx, command); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_ApplyCoupon.StructCtx] command validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_ApplyCoupon.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	result, err := mediatr.Send[*applyingCouponCommandV1.ApplyCoupon, *applyingCouponDtos.ApplyCouponResponseDto](ctx, command)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_ApplyCoupon.Send] error in sending ApplyCoupon")
+		o.Log.Errorw(fmt.Sprintf("[OrderGrpcServiceServer_ApplyCoupon.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+		return nil, grpcErrors.ErrGrpcResponse(err)
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return &grpcOrderService.ApplyCouponRes{OrderId: result.OrderId.String()}, nil
 }
 
 func (o OrderGrpcServiceServer) GetOrders(ctx context.Context, req *grpcOrderService.GetOrdersReq) (*grpcOrderService.GetOrdersRes, error) {
@@ -117,19 +397,69 @@ func (o OrderGrpcServiceServer) GetOrders(ctx context.Context, req *grpcOrderSer
 	o.Metrics.GetOrdersGrpcRequests.Inc()
 	defer span.Finish()
 
-	query := gettingOrdersQueryV1.NewGetOrders(&utils.ListQuery{Page: int(req.Page), Size: int(req.Size)})
+	listQuery := &utils.ListQuery{Page: int(req.Page), Size: int(req.Size)}
 
-	queryResult, err := mediatr.Send[*gettingOrdersQueryV1.GetOrders, *gettingOrdersDtos.GetOrdersResponseDto](ctx, query)
+	var orders *utils.ListResult[*dtos.OrderReadDto]
+	if req.SearchText != "" {
+		o.Metrics.SearchOrderGrpcRequests.Inc()
+		queryResult, err := mediatr.Send[*searchingOrdersQueryV1.SearchOrders, *searchingOrdersDtos.SearchOrdersResponseDto](ctx, searchingOrdersQueryV1.NewSearchOrders(req.SearchText, listQuery))
+		if err != nil {
+			err = errors.WithMessage(err, "[OrderGrpcServiceServer_GetOrders.Send] error in sending SearchOrders")
+			o.Log.Error(fmt.Sprintf("[OrderGrpcServiceServer_GetOrders.Send] err: {%v}", tracing.TraceWithErr(span, err)))
+			return nil, grpcErrors.ErrGrpcResponse(err)
+		}
+		orders = queryResult.Orders
+	} else {
+		queryResult, err := mediatr.Send[*gettingOrdersQueryV1.GetOrders, *gettingOrdersDtos.GetOrdersResponseDto](ctx, gettingOrdersQueryV1.NewGetOrders(listQuery))
+		if err != nil {
+			err = errors.WithMessage(err, "[OrderGrpcServiceServer_GetOrders.Send] error in sending GetOrders")
+			o.Log.Error(fmt.Sprintf("[OrderGrpcServiceServer_GetOrders.Send] err: {%v}", tracing.TraceWithErr(span, err)))
+			return nil, grpcErrors.ErrGrpcResponse(err)
+		}
+		orders = queryResult.Orders
+	}
+
+	ordersResponse, err := mapper.Map[*grpcOrderService.GetOrdersRes](orders)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_GetOrders.Map] error in mapping orders")
+		return nil, grpcErrors.ErrGrpcResponse(tracing.TraceWithErr(span, err))
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return ordersResponse, nil
+}
+
+func (o OrderGrpcServiceServer) GetCustomerOrders(ctx context.Context, req *grpcOrderService.GetCustomerOrdersReq) (*grpcOrderService.GetCustomerOrdersRes, error) {
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "OrderGrpcServiceServer.GetCustomerOrders")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.GetCustomerOrdersGrpcRequests.Inc()
+	defer span.Finish()
+
+	accountEmail := core.GetUserEmail(ctx)
+	if accountEmail == "" {
+		unauthorizedErr := customErrors.NewUnAuthorizedError("[OrderGrpcServiceServer_GetCustomerOrders.GetUserEmail] the request is not sent by an authenticated user")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_GetCustomerOrders.GetUserEmail] err: %v", tracing.TraceWithErr(span, unauthorizedErr)))
+		return nil, grpcErrors.ErrGrpcResponse(unauthorizedErr)
+	}
+
+	query := gettingCustomerOrdersQueryV1.NewGetCustomerOrders(accountEmail, req.Status, &utils.ListQuery{Page: int(req.Page), Size: int(req.Size), OrderBy: req.OrderBy})
+	if err := o.Validator.StructCtx(ctx, query); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_GetCustomerOrders.StructCtx] query validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_GetCustomerOrders.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
 
+	queryResult, err := mediatr.Send[*gettingCustomerOrdersQueryV1.GetCustomerOrders, *gettingCustomerOrdersDtos.GetCustomerOrdersResponseDto](ctx, query)
 	if err != nil {
-		err = errors.WithMessage(err, "[OrderGrpcServiceServer_GetOrders.Send] error in sending GetOrders")
-		o.Log.Error(fmt.Sprintf("[OrderGrpcServiceServer_GetOrders.Send] err: {%v}", tracing.TraceWithErr(span, err)))
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_GetCustomerOrders.Send] error in sending GetCustomerOrders")
+		o.Log.Errorw(fmt.Sprintf("[OrderGrpcServiceServer_GetCustomerOrders.Send] account: {%s}, err: %v", query.AccountEmail, tracing.TraceWithErr(span, err)), logger.Fields{"AccountEmail": query.AccountEmail})
 		return nil, grpcErrors.ErrGrpcResponse(err)
 	}
 
-	ordersResponse, err := mapper.Map[*grpcOrderService.GetOrdersRes](queryResult.Orders)
+	ordersResponse, err := mapper.Map[*grpcOrderService.GetCustomerOrdersRes](queryResult.Orders)
 	if err != nil {
-		err = errors.WithMessage(err, "[OrderGrpcServiceServer_GetOrders.Map] error in mapping orders")
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_GetCustomerOrders.Map] error in mapping customer orders")
 		return nil, grpcErrors.ErrGrpcResponse(tracing.TraceWithErr(span, err))
 	}
 
@@ -137,3 +467,290 @@ func (o OrderGrpcServiceServer) GetOrders(ctx context.Context, req *grpcOrderSer
 
 	return ordersResponse, nil
 }
+
+func (o OrderGrpcServiceServer) GetOrderHistory(ctx context.Context, req *grpcOrderService.GetOrderHistoryReq) (*grpcOrderService.GetOrderHistoryRes, error) {
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "OrderGrpcServiceServer.GetOrderHistory")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.GetOrderHistoryGrpcRequests.Inc()
+	defer span.Finish()
+
+	orderIdUUID, err := uuid.FromString(req.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_GetOrderHistory.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_GetOrderHistory.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	query := gettingOrderHistoryQueryV1.NewGetOrderHistory(orderIdUUID, &utils.ListQuery{Page: int(req.Page), Size: int(req.Size)})
+	if err := o.Validator.StructCtx(ctx, query); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_GetOrderHistory.StructCtx] query validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_GetOrderHistory.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	queryResult, err := mediatr.Send[*gettingOrderHistoryQueryV1.GetOrderHistory, *gettingOrderHistoryDtos.GetOrderHistoryResponseDto](ctx, query)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_GetOrderHistory.Send] error in sending GetOrderHistory")
+		o.Log.Errorw(fmt.Sprintf("[OrderGrpcServiceServer_GetOrderHistory.Send] id: {%s}, err: %v", query.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"OrderId": query.OrderId})
+		return nil, grpcErrors.ErrGrpcResponse(err)
+	}
+
+	historyResponse, err := mapper.Map[*grpcOrderService.GetOrderHistoryRes](queryResult.History)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_GetOrderHistory.Map] error in mapping order history")
+		return nil, grpcErrors.ErrGrpcResponse(tracing.TraceWithErr(span, err))
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return historyResponse, nil
+}
+
+func (o OrderGrpcServiceServer) RequestReturn(ctx context.Context, req *grpcOrderService.RequestReturnReq) (*grpcOrderService.RequestReturnRes, error) {
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "OrderGrpcServiceServer.RequestReturn")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.RequestReturnGrpcRequests.Inc()
+	defer span.Finish()
+
+	orderIdUUID, err := uuid.FromString(req.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_RequestReturn.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_RequestReturn.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	itemsDtos, err := mapper.Map[[]*dtos.ReturnItemDto](req.GetItems())
+	if err != nil {
+		return nil, err
+	}
+
+	command := requestingReturnCommandV1.NewRequestReturn(orderIdUUID, itemsDtos, req.Reason)
+	if err := o.Validator.StructCtx(ctx, command); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_RequestReturn.StructCtx] command validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_RequestReturn.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	result, err := mediatr.Send[*requestingReturnCommandV1.RequestReturn, *requestingReturnDtos.RequestReturnResponseDto](ctx, command)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_RequestReturn.Send] error in sending RequestReturn")
+		o.Log.Errorw(fmt.Sprintf("[OrderGrpcServiceServer_RequestReturn.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+		return nil, grpcErrors.ErrGrpcResponse(err)
+	}
+
+	response, err := mapper.Map[*grpcOrderService.RequestReturnRes](result)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_RequestReturn.Map] error in mapping RequestReturnResponseDto")
+		return nil, grpcErrors.ErrGrpcResponse(tracing.TraceWithErr(span, err))
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return response, nil
+}
+
+func (o OrderGrpcServiceServer) ApproveReturn(ctx context.Context, req *grpcOrderService.ApproveReturnReq) (*grpcOrderService.ApproveReturnRes, error) {
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "OrderGrpcServiceServer.ApproveReturn")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.ApproveReturnGrpcRequests.Inc()
+	defer span.Finish()
+
+	orderIdUUID, err := uuid.FromString(req.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_ApproveReturn.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_ApproveReturn.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	returnIdUUID, err := uuid.FromString(req.ReturnId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_ApproveReturn.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_ApproveReturn.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	command := approvingReturnCommandV1.NewApproveReturn(orderIdUUID, returnIdUUID)
+	if err := o.Validator.StructCtx(ctx, command); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_ApproveReturn.StructCtx] command validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_ApproveReturn.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	result, err := mediatr.Send[*approvingReturnCommandV1.ApproveReturn, *approvingReturnDtos.ApproveReturnResponseDto](ctx, command)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_ApproveReturn.Send] error in sending ApproveReturn")
+		o.Log.Errorw(fmt.Sprintf("[OrderGrpcServiceServer_ApproveReturn.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+		return nil, grpcErrors.ErrGrpcResponse(err)
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return &grpcOrderService.ApproveReturnRes{OrderId: result.OrderId.String(), ReturnId: result.ReturnId.String()}, nil
+}
+
+func (o OrderGrpcServiceServer) IssueRefund(ctx context.Context, req *grpcOrderService.IssueRefundReq) (*grpcOrderService.IssueRefundRes, error) {
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "OrderGrpcServiceServer.IssueRefund")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.IssueRefundGrpcRequests.Inc()
+	defer span.Finish()
+
+	orderIdUUID, err := uuid.FromString(req.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_IssueRefund.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_IssueRefund.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	returnIdUUID, err := uuid.FromString(req.ReturnId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_IssueRefund.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_IssueRefund.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	refundIdUUID, err := uuid.FromString(req.RefundId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_IssueRefund.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_IssueRefund.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	command := issuingRefundCommandV1.NewIssueRefund(orderIdUUID, returnIdUUID, refundIdUUID)
+	if err := o.Validator.StructCtx(ctx, command); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_IssueRefund.StructCtx] command validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_IssueRefund.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	result, err := mediatr.Send[*issuingRefundCommandV1.IssueRefund, *issuingRefundDtos.IssueRefundResponseDto](ctx, command)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_IssueRefund.Send] error in sending IssueRefund")
+		o.Log.Errorw(fmt.Sprintf("[OrderGrpcServiceServer_IssueRefund.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+		return nil, grpcErrors.ErrGrpcResponse(err)
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return &grpcOrderService.IssueRefundRes{OrderId: result.OrderId.String(), ReturnId: result.ReturnId.String()}, nil
+}
+
+func (o OrderGrpcServiceServer) GetSalesReport(ctx context.Context, req *grpcOrderService.GetSalesReportReq) (*grpcOrderService.GetSalesReportRes, error) {
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "OrderGrpcServiceServer.GetSalesReport")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.GetSalesReportGrpcRequests.Inc()
+	defer span.Finish()
+
+	from, to, err := reportsDtos.ParseSalesReportDays(req.From, req.To)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_GetSalesReport.ParseSalesReportDays] error in parsing the report days")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_GetSalesReport.ParseSalesReportDays] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	query := gettingSalesReportQueryV1.NewGetSalesReport(from, to, req.Granularity, int(req.Top))
+	if err := o.Validator.StructCtx(ctx, query); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_GetSalesReport.StructCtx] query validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_GetSalesReport.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	queryResult, err := mediatr.Send[*gettingSalesReportQueryV1.GetSalesReport, *gettingSalesReportDtos.GetSalesReportResponseDto](ctx, query)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_GetSalesReport.Send] error in sending GetSalesReport")
+		o.Log.Error(fmt.Sprintf("[OrderGrpcServiceServer_GetSalesReport.Send] err: %v", tracing.TraceWithErr(span, err)))
+		return nil, grpcErrors.ErrGrpcResponse(err)
+	}
+
+	periods, err := mapper.Map[[]*grpcOrderService.SalesPeriod](queryResult.Periods)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_GetSalesReport.Map] error in mapping sales periods")
+		return nil, grpcErrors.ErrGrpcResponse(tracing.TraceWithErr(span, err))
+	}
+
+	topProducts, err := mapper.Map[[]*grpcOrderService.ProductSales](queryResult.TopProducts)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_GetSalesReport.Map] error in mapping top products")
+		return nil, grpcErrors.ErrGrpcResponse(tracing.TraceWithErr(span, err))
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return &grpcOrderService.GetSalesReportRes{Periods: periods, TopProducts: topProducts}, nil
+}
+
+func (o OrderGrpcServiceServer) ExportSalesReport(ctx context.Context, req *grpcOrderService.ExportSalesReportReq) (*grpcOrderService.ExportSalesReportRes, error) {
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "OrderGrpcServiceServer.ExportSalesReport")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.ExportSalesReportGrpcRequests.Inc()
+	defer span.Finish()
+
+	from, to, err := reportsDtos.ParseSalesReportDays(req.From, req.To)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_ExportSalesReport.ParseSalesReportDays] error in parsing the report days")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_ExportSalesReport.ParseSalesReportDays] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return nil, grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	query := exportingSalesReportQueryV1.NewExportSalesReport(from, to, req.Granularity)
+	if err := o.Validator.StructCtx(ctx, query); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_ExportSalesReport.StructCtx] query validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_ExportSalesReport.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return nil, grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	queryResult, err := mediatr.Send[*exportingSalesReportQueryV1.ExportSalesReport, *exportingSalesReportDtos.ExportSalesReportResponseDto](ctx, query)
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_ExportSalesReport.Send] error in sending ExportSalesReport")
+		o.Log.Error(fmt.Sprintf("[OrderGrpcServiceServer_ExportSalesReport.Send] err: %v", tracing.TraceWithErr(span, err)))
+		return nil, grpcErrors.ErrGrpcResponse(err)
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return &grpcOrderService.ExportSalesReportRes{FileName: queryResult.FileName, ContentType: queryResult.ContentType, Content: queryResult.Content}, nil
+}
+
+// WatchOrder streams the current order and its changes until the client closes the stream, the stream is also ended when the client lags
+// behind the changes or the connection is recycled, then the client should watch again from the version after its last received change
+func (o OrderGrpcServiceServer) WatchOrder(req *grpcOrderService.WatchOrderReq, stream grpcOrderService.OrdersService_WatchOrderServer) error {
+	ctx, span := tracing.StartGrpcServerTracerSpan(stream.Context(), "OrderGrpcServiceServer.WatchOrder")
+	span.LogFields(log.Object("Request", req))
+	o.Metrics.WatchOrderGrpcRequests.Inc()
+	defer span.Finish()
+
+	orderIdUUID, err := uuid.FromString(req.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[OrderGrpcServiceServer_WatchOrder.uuid.FromString] error in converting uuid")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_WatchOrder.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		return grpcErrors.ErrGrpcResponse(badRequestErr)
+	}
+
+	query := watchingOrderQueryV1.NewWatchOrder(orderIdUUID, req.FromVersion)
+	if err := o.Validator.StructCtx(ctx, query); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[OrderGrpcServiceServer_WatchOrder.StructCtx] query validation failed")
+		o.Log.Errorf(fmt.Sprintf("[OrderGrpcServiceServer_WatchOrder.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+		return grpcErrors.ErrGrpcResponse(validationErr)
+	}
+
+	err = o.watchOrderHandler.Handle(ctx, query, func(change *contracts.OrderChange) error {
+		orderReadDto, err := mapper.Map[*dtos.OrderReadDto](change.Order)
+		if err != nil {
+			return errors.WithMessage(err, "[OrderGrpcServiceServer_WatchOrder.Map] error in mapping OrderReadDto")
+		}
+		order, err := mapper.Map[*grpcOrderService.OrderReadModel](orderReadDto)
+		if err != nil {
+			return errors.WithMessage(err, "[OrderGrpcServiceServer_WatchOrder.Map] error in mapping order")
+		}
+
+		return stream.Send(&grpcOrderService.WatchOrderRes{Version: change.Version, EventType: change.EventType, Order: order})
+	})
+	if err != nil {
+		err = errors.WithMessage(err, "[OrderGrpcServiceServer_WatchOrder.Handle] error in watching order")
+		o.Log.Errorw(fmt.Sprintf("[OrderGrpcServiceServer_WatchOrder.Handle] orderId: {%s}, err: %v", query.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"OrderId": query.OrderId})
+		return grpcErrors.ErrGrpcResponse(err)
+	}
+
+	o.Metrics.SuccessGrpcRequests.Inc()
+
+	return nil
+}
@@ -2,10 +2,14 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
 	ordersService "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/proto/service_clients"
+	ordersDto "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/test_fixtures/e2e"
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"testing"
@@ -25,7 +29,7 @@ func TestRunner(t *testing.T) {
 	//https://pkg.go.dev/testing@master#hdr-Subtests_and_Sub_benchmarks
 	t.Run("GRPC", func(t *testing.T) {
 		// Before running the tests
-		orderGrpcService := NewOrderGrpcService(fixture.InfrastructureConfiguration)
+		orderGrpcService := NewOrderGrpcService(fixture.InfrastructureConfiguration, fixture.OrderWatcher)
 		ordersService.RegisterOrdersServiceServer(fixture.GrpcServer.GetCurrentGrpcServer(), orderGrpcService)
 
 		orderGrpcServiceTests := OrderGrpcServiceTests{
@@ -46,16 +50,17 @@ func TestRunner(t *testing.T) {
 }
 
 func (p *OrderGrpcServiceTests) Test_Create_Order() {
+	product := &ordersDto.CatalogProductDto{ProductId: uuid.NewV4(), Name: gofakeit.Name(), Description: gofakeit.AdjectiveDescriptive(), Price: domain.MustParseMoney(fmt.Sprintf("%.2f", gofakeit.Price(100, 10000)), "USD")}
+	p.CatalogClient.AddProduct(product)
+
 	req := &ordersService.CreateOrderReq{
 		AccountEmail:    gofakeit.Email(),
 		DeliveryAddress: gofakeit.Address().Address,
 		DeliveryTime:    timestamppb.New(time.Now()),
 		ShopItems: []*ordersService.ShopItem{
 			{
-				Quantity:    uint64(gofakeit.Number(1, 10)),
-				Description: gofakeit.AdjectiveDescriptive(),
-				Price:       gofakeit.Price(100, 10000),
-				Title:       gofakeit.Name(),
+				ProductId: product.ProductId.String(),
+				Quantity:  uint64(gofakeit.Number(1, 10)),
 			},
 		},
 	}
@@ -0,0 +1,21 @@
+package delivery
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+)
+
+type OrderConsumersBase struct {
+	*infrastructure.InfrastructureConfiguration
+}
+
+func NewOrderConsumersBase(infra *infrastructure.InfrastructureConfiguration) *OrderConsumersBase {
+	return &OrderConsumersBase{InfrastructureConfiguration: infra}
+}
+
+func (pm *OrderConsumersBase) CommitMessage() {
+	pm.Metrics.SuccessKafkaMessages.Inc()
+}
+
+func (pm *OrderConsumersBase) CommitErrMessage() {
+	pm.Metrics.ErrorKafkaMessages.Inc()
+}
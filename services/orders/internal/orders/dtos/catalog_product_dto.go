@@ -0,0 +1,13 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CatalogProductDto struct {
+	ProductId   uuid.UUID    `json:"productId"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Price       domain.Money `json:"price"`
+}
@@ -0,0 +1,12 @@
+package dtos
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+
+// CouponDto is the discount rule of the coupon at the time it was applied, later changes of the coupon catalog don't change the applied discounts
+type CouponDto struct {
+	Code          string         `json:"code"`
+	DiscountType  string         `json:"discountType"`
+	PercentOff    domain.Decimal `json:"percentOff"`
+	AmountOff     domain.Money   `json:"amountOff"`
+	MinimumBasket domain.Money   `json:"minimumBasket"`
+}
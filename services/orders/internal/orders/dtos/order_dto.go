@@ -1,6 +1,7 @@
 package dtos
 
 import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	uuid "github.com/satori/go.uuid"
 	"time"
 )
@@ -11,7 +12,7 @@ type OrderDto struct {
 	AccountEmail    string         `json:"accountEmail"`
 	DeliveryAddress string         `json:"deliveryAddress"`
 	CancelReason    string         `json:"cancelReason"`
-	TotalPrice      float64        `json:"totalPrice"`
+	TotalPrice      domain.Money   `json:"totalPrice"`
 	DeliveredTime   time.Time      `json:"deliveredTime"`
 	Paid            bool           `json:"paid"`
 	Submitted       bool           `json:"submitted"`
@@ -1,21 +1,29 @@
 package dtos
 
-import "time"
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"time"
+)
 
 type OrderReadDto struct {
-	Id              string             `json:"id"`
-	OrderId         string             `json:"orderId"`
-	ShopItems       []*ShopItemReadDto `json:"shopItems"`
-	AccountEmail    string             `json:"accountEmail"`
-	DeliveryAddress string             `json:"deliveryAddress"`
-	CancelReason    string             `json:"cancelReason"`
-	TotalPrice      float64            `json:"totalPrice"`
-	DeliveredTime   time.Time          `json:"deliveredTime"`
-	Paid            bool               `json:"paid"`
-	Submitted       bool               `json:"submitted"`
-	Completed       bool               `json:"completed"`
-	Canceled        bool               `json:"canceled"`
-	PaymentId       string             `json:"paymentId"`
-	CreatedAt       time.Time          `json:"createdAt"`
-	UpdatedAt       time.Time          `json:"updatedAt"`
+	Id              string                `json:"id"`
+	OrderId         string                `json:"orderId"`
+	ShopItems       []*ShopItemReadDto    `json:"shopItems"`
+	AccountEmail    string                `json:"accountEmail"`
+	DeliveryAddress string                `json:"deliveryAddress"`
+	CancelReason    string                `json:"cancelReason"`
+	CouponCode      string                `json:"couponCode,omitempty"`
+	Discount        domain.Money          `json:"discount"`
+	TotalPrice      domain.Money          `json:"totalPrice"`
+	Returns         []*OrderReturnReadDto `json:"returns,omitempty"`
+	RefundedAmount  domain.Money          `json:"refundedAmount"`
+	DeliveredTime   time.Time             `json:"deliveredTime"`
+	Paid            bool                  `json:"paid"`
+	Submitted       bool                  `json:"submitted"`
+	Completed       bool                  `json:"completed"`
+	Canceled        bool                  `json:"canceled"`
+	Status          string                `json:"status"`
+	PaymentId       string                `json:"paymentId"`
+	CreatedAt       time.Time             `json:"createdAt"`
+	UpdatedAt       time.Time             `json:"updatedAt"`
 }
@@ -0,0 +1,23 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"time"
+)
+
+type OrderReturnReadDto struct {
+	ReturnId     string               `json:"returnId"`
+	Items        []*ReturnItemReadDto `json:"items"`
+	Reason       string               `json:"reason"`
+	RefundAmount domain.Money         `json:"refundAmount"`
+	Status       string               `json:"status"`
+	RefundId     string               `json:"refundId,omitempty"`
+	RequestedAt  time.Time            `json:"requestedAt"`
+	ApprovedAt   time.Time            `json:"approvedAt,omitempty"`
+	RefundedAt   time.Time            `json:"refundedAt,omitempty"`
+}
+
+type ReturnItemReadDto struct {
+	ProductId string `json:"productId"`
+	Quantity  uint64 `json:"quantity"`
+}
@@ -0,0 +1,8 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+type ReturnItemDto struct {
+	ProductId uuid.UUID `json:"productId" validate:"required"`
+	Quantity  uint64    `json:"quantity" validate:"required,max=10000"`
+}
@@ -1,8 +1,15 @@
 package dtos
 
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+// ShopItemDto is priced by the catalog, the title, description and price of a requested item are replaced by the catalog product
 type ShopItemDto struct {
-	Title       string  `json:"title"`
-	Description string  `json:"description"`
-	Quantity    uint64  `json:"quantity"`
-	Price       float64 `json:"price"`
+	ProductId   uuid.UUID    `json:"productId" validate:"required"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Quantity    uint64       `json:"quantity" validate:"required,max=10000"`
+	Price       domain.Money `json:"price"`
 }
@@ -1,8 +1,11 @@
 package dtos
 
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+
 type ShopItemReadDto struct {
-	Title       string  `json:"title"`
-	Description string  `json:"description"`
-	Quantity    uint64  `json:"quantity"`
-	Price       float64 `json:"price"`
+	ProductId   string       `json:"productId"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Quantity    uint64       `json:"quantity"`
+	Price       domain.Money `json:"price"`
 }
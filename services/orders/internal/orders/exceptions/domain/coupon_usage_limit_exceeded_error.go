@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// couponUsageLimitExceededError is returned when a customer has already used a coupon as many times as its usage limit
+type couponUsageLimitExceededError struct {
+	customErrors.BadRequestError
+}
+
+type CouponUsageLimitExceededError interface {
+	customErrors.BadRequestError
+	IsCouponUsageLimitExceededError() bool
+}
+
+func NewCouponUsageLimitExceededError(message string) error {
+	bad := customErrors.NewBadRequestError(message)
+	customErr := customErrors.GetCustomError(bad).(customErrors.BadRequestError)
+	br := &couponUsageLimitExceededError{
+		BadRequestError: customErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+func (err *couponUsageLimitExceededError) IsCouponUsageLimitExceededError() bool {
+	return true
+}
+
+func IsCouponUsageLimitExceededError(err error) bool {
+	var ce CouponUsageLimitExceededError
+	if errors.As(err, &ce) {
+		return ce.IsCouponUsageLimitExceededError()
+	}
+
+	return false
+}
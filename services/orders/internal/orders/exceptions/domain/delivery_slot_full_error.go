@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// deliverySlotFullError is returned when the capacity of a delivery slot is reserved by the other orders
+type deliverySlotFullError struct {
+	customErrors.ConflictError
+}
+
+type DeliverySlotFullError interface {
+	customErrors.ConflictError
+	IsDeliverySlotFullError() bool
+}
+
+func NewDeliverySlotFullError(message string) error {
+	conflict := customErrors.NewConflictError(message)
+	customErr := customErrors.GetCustomError(conflict).(customErrors.ConflictError)
+	br := &deliverySlotFullError{
+		ConflictError: customErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+func (err *deliverySlotFullError) IsDeliverySlotFullError() bool {
+	return true
+}
+
+func IsDeliverySlotFullError(err error) bool {
+	var de DeliverySlotFullError
+	if errors.As(err, &de) {
+		return de.IsDeliverySlotFullError()
+	}
+
+	return false
+}
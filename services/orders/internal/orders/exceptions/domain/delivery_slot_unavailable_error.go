@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// deliverySlotUnavailableError is returned when the delivery time of an order is not in a bookable delivery window of its zone
+type deliverySlotUnavailableError struct {
+	customErrors.BadRequestError
+}
+
+type DeliverySlotUnavailableError interface {
+	customErrors.BadRequestError
+	IsDeliverySlotUnavailableError() bool
+}
+
+func NewDeliverySlotUnavailableError(message string) error {
+	bad := customErrors.NewBadRequestError(message)
+	customErr := customErrors.GetCustomError(bad).(customErrors.BadRequestError)
+	br := &deliverySlotUnavailableError{
+		BadRequestError: customErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+func (err *deliverySlotUnavailableError) IsDeliverySlotUnavailableError() bool {
+	return true
+}
+
+func IsDeliverySlotUnavailableError(err error) bool {
+	var de DeliverySlotUnavailableError
+	if errors.As(err, &de) {
+		return de.IsDeliverySlotUnavailableError()
+	}
+
+	return false
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// invalidCouponError is returned for an unknown coupon code or the invalid discount rules of a coupon
+type invalidCouponError struct {
+	customErrors.BadRequestError
+}
+
+type InvalidCouponError interface {
+	customErrors.BadRequestError
+	IsInvalidCouponError() bool
+}
+
+func NewInvalidCouponError(message string) error {
+	bad := customErrors.NewBadRequestError(message)
+	customErr := customErrors.GetCustomError(bad).(customErrors.BadRequestError)
+	br := &invalidCouponError{
+		BadRequestError: customErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+func (err *invalidCouponError) IsInvalidCouponError() bool {
+	return true
+}
+
+func IsInvalidCouponError(err error) bool {
+	var ic InvalidCouponError
+	if errors.As(err, &ic) {
+		return ic.IsInvalidCouponError()
+	}
+
+	return false
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// invalidOrderStateError is returned when a transition is not allowed in the current state of the order, like paying an unsubmitted order
+type invalidOrderStateError struct {
+	customErrors.ConflictError
+}
+
+type InvalidOrderStateError interface {
+	customErrors.ConflictError
+	IsInvalidOrderStateError() bool
+}
+
+func NewInvalidOrderStateError(message string) error {
+	conflict := customErrors.NewConflictError(message)
+	customErr := customErrors.GetCustomError(conflict).(customErrors.ConflictError)
+	br := &invalidOrderStateError{
+		ConflictError: customErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+func (err *invalidOrderStateError) IsInvalidOrderStateError() bool {
+	return true
+}
+
+func IsInvalidOrderStateError(err error) bool {
+	var ie InvalidOrderStateError
+	if errors.As(err, &ie) {
+		return ie.IsInvalidOrderStateError()
+	}
+
+	return false
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// invalidReturnError is returned when the returned items of an order are empty, unknown or more than the ordered quantities
+type invalidReturnError struct {
+	customErrors.BadRequestError
+}
+
+type InvalidReturnError interface {
+	customErrors.BadRequestError
+	IsInvalidReturnError() bool
+}
+
+func NewInvalidReturnError(message string) error {
+	bad := customErrors.NewBadRequestError(message)
+	customErr := customErrors.GetCustomError(bad).(customErrors.BadRequestError)
+	br := &invalidReturnError{
+		BadRequestError: customErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+func (err *invalidReturnError) IsInvalidReturnError() bool {
+	return true
+}
+
+func IsInvalidReturnError(err error) bool {
+	var ir InvalidReturnError
+	if errors.As(err, &ir) {
+		return ir.IsInvalidReturnError()
+	}
+
+	return false
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// minimumBasketNotReachedError is returned when the total price of an order is below the minimum basket of a coupon
+type minimumBasketNotReachedError struct {
+	customErrors.BadRequestError
+}
+
+type MinimumBasketNotReachedError interface {
+	customErrors.BadRequestError
+	IsMinimumBasketNotReachedError() bool
+}
+
+func NewMinimumBasketNotReachedError(message string) error {
+	bad := customErrors.NewBadRequestError(message)
+	customErr := customErrors.GetCustomError(bad).(customErrors.BadRequestError)
+	br := &minimumBasketNotReachedError{
+		BadRequestError: customErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+func (err *minimumBasketNotReachedError) IsMinimumBasketNotReachedError() bool {
+	return true
+}
+
+func IsMinimumBasketNotReachedError(err error) bool {
+	var me MinimumBasketNotReachedError
+	if errors.As(err, &me) {
+		return me.IsMinimumBasketNotReachedError()
+	}
+
+	return false
+}
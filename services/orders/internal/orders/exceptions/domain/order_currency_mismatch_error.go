@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// orderCurrencyMismatchError is returned when the items of an order are priced in different currencies
+type orderCurrencyMismatchError struct {
+	customErrors.BadRequestError
+}
+
+type OrderCurrencyMismatchError interface {
+	customErrors.BadRequestError
+	IsOrderCurrencyMismatchError() bool
+}
+
+func NewOrderCurrencyMismatchError(message string) error {
+	bad := customErrors.NewBadRequestError(message)
+	customErr := customErrors.GetCustomError(bad).(customErrors.BadRequestError)
+	br := &orderCurrencyMismatchError{
+		BadRequestError: customErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+func (err *orderCurrencyMismatchError) IsOrderCurrencyMismatchError() bool {
+	return true
+}
+
+func IsOrderCurrencyMismatchError(err error) bool {
+	var oe OrderCurrencyMismatchError
+	if errors.As(err, &oe) {
+		return oe.IsOrderCurrencyMismatchError()
+	}
+
+	return false
+}
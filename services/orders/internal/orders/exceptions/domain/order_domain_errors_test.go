@@ -3,6 +3,8 @@ package domain
 import (
 	"fmt"
 	httpErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -30,3 +32,73 @@ func Test_InvalidEmail_Address_Error(t *testing.T) {
 	assert.True(t, IsInvalidEmailAddressError(err))
 	fmt.Println(httpErrors.ErrorsWithStack(err))
 }
+
+func Test_Invalid_Order_State_Error(t *testing.T) {
+	err := NewInvalidOrderStateError("order is not submitted")
+	assert.True(t, IsInvalidOrderStateError(err))
+	assert.True(t, customErrors.IsConflictError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
+
+func Test_Order_Currency_Mismatch_Error(t *testing.T) {
+	err := NewOrderCurrencyMismatchError("order items are priced in USD and EUR")
+	assert.True(t, IsOrderCurrencyMismatchError(err))
+	assert.True(t, customErrors.IsBadRequestError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
+
+func Test_Payment_Amount_Mismatch_Error(t *testing.T) {
+	err := NewPaymentAmountMismatchError("payment amount 10.00 USD is not the total price 25.00 USD")
+	assert.True(t, IsPaymentAmountMismatchError(err))
+	assert.True(t, customErrors.IsBadRequestError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
+
+func Test_Invalid_Coupon_Error(t *testing.T) {
+	err := NewInvalidCouponError("coupon SUMMER10 doesn't exist")
+	assert.True(t, IsInvalidCouponError(err))
+	assert.True(t, customErrors.IsBadRequestError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
+
+func Test_Coupon_Usage_Limit_Exceeded_Error(t *testing.T) {
+	err := NewCouponUsageLimitExceededError("coupon SUMMER10 is already used 1 times")
+	assert.True(t, IsCouponUsageLimitExceededError(err))
+	assert.True(t, customErrors.IsBadRequestError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
+
+func Test_Minimum_Basket_Not_Reached_Error(t *testing.T) {
+	err := NewMinimumBasketNotReachedError("minimum basket of coupon SUMMER10 is 50.00 USD")
+	assert.True(t, IsMinimumBasketNotReachedError(err))
+	assert.True(t, customErrors.IsBadRequestError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
+
+func Test_Invalid_Return_Error(t *testing.T) {
+	err := NewInvalidReturnError("returned quantity of the item is more than its ordered quantity")
+	assert.True(t, IsInvalidReturnError(err))
+	assert.True(t, customErrors.IsBadRequestError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
+
+func Test_Order_Return_Not_Found_Error(t *testing.T) {
+	err := NewOrderReturnNotFoundError(uuid.NewV4(), uuid.NewV4())
+	assert.True(t, IsOrderReturnNotFoundError(err))
+	assert.True(t, customErrors.IsNotFoundError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
+
+func Test_Delivery_Slot_Unavailable_Error(t *testing.T) {
+	err := NewDeliverySlotUnavailableError("delivery time is not in a delivery window of zone central")
+	assert.True(t, IsDeliverySlotUnavailableError(err))
+	assert.True(t, customErrors.IsBadRequestError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
+
+func Test_Delivery_Slot_Full_Error(t *testing.T) {
+	err := NewDeliverySlotFullError("delivery slot is full")
+	assert.True(t, IsDeliverySlotFullError(err))
+	assert.True(t, customErrors.IsConflictError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+type orderReturnNotFoundError struct {
+	customErrors.NotFoundError
+}
+
+type OrderReturnNotFoundError interface {
+	customErrors.NotFoundError
+	IsOrderReturnNotFoundError() bool
+}
+
+func NewOrderReturnNotFoundError(orderId uuid.UUID, returnId uuid.UUID) error {
+	notFound := customErrors.NewNotFoundError(fmt.Sprintf("return with id %s of order %s not found", returnId, orderId))
+	customErr := customErrors.GetCustomError(notFound).(customErrors.NotFoundError)
+	br := &orderReturnNotFoundError{
+		NotFoundError: customErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+func (err *orderReturnNotFoundError) IsOrderReturnNotFoundError() bool {
+	return true
+}
+
+func IsOrderReturnNotFoundError(err error) bool {
+	var rn OrderReturnNotFoundError
+	if errors.As(err, &rn) {
+		return rn.IsOrderReturnNotFoundError()
+	}
+
+	return false
+}
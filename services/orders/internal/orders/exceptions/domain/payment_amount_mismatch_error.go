@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// paymentAmountMismatchError is returned when the captured amount of a payment is not the total price of the order
+type paymentAmountMismatchError struct {
+	customErrors.BadRequestError
+}
+
+type PaymentAmountMismatchError interface {
+	customErrors.BadRequestError
+	IsPaymentAmountMismatchError() bool
+}
+
+func NewPaymentAmountMismatchError(message string) error {
+	bad := customErrors.NewBadRequestError(message)
+	customErr := customErrors.GetCustomError(bad).(customErrors.BadRequestError)
+	br := &paymentAmountMismatchError{
+		BadRequestError: customErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+func (err *paymentAmountMismatchError) IsPaymentAmountMismatchError() bool {
+	return true
+}
+
+func IsPaymentAmountMismatchError(err error) bool {
+	var pe PaymentAmountMismatchError
+	if errors.As(err, &pe) {
+		return pe.IsPaymentAmountMismatchError()
+	}
+
+	return false
+}
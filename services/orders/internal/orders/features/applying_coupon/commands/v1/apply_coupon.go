@@ -0,0 +1,16 @@
+package v1
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type ApplyCoupon struct {
+	OrderId    uuid.UUID `validate:"required"`
+	CouponCode string    `validate:"required"`
+	AppliedAt  time.Time `validate:"required"`
+}
+
+func NewApplyCoupon(orderId uuid.UUID, couponCode string) *ApplyCoupon {
+	return &ApplyCoupon{OrderId: orderId, CouponCode: couponCode, AppliedAt: time.Now()}
+}
@@ -0,0 +1,107 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/store"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	couponContracts "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/contracts"
+	couponModels "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/models"
+	domainExceptions "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/exceptions/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ApplyCouponHandler struct {
+	log              logger.Logger
+	cfg              *config.Config
+	aggregateStore   store.AggregateStore[*aggregate.Order]
+	couponRepository couponContracts.CouponRepository
+}
+
+func NewApplyCouponHandler(log logger.Logger, cfg *config.Config, aggregateStore store.AggregateStore[*aggregate.Order], couponRepository couponContracts.CouponRepository) *ApplyCouponHandler {
+	return &ApplyCouponHandler{log: log, cfg: cfg, aggregateStore: aggregateStore, couponRepository: couponRepository}
+}
+
+func (c *ApplyCouponHandler) Handle(ctx context.Context, command *ApplyCoupon) (*dtos.ApplyCouponResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ApplyCouponHandler.Handle")
+	span.LogFields(log.String("OrderId", command.OrderId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	coupon, err := c.couponRepository.GetCouponByCode(ctx, command.CouponCode)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, fmt.Sprintf("[ApplyCouponHandler_Handle.GetCouponByCode] error in fetching coupon with code %s", command.CouponCode)))
+	}
+	if coupon == nil {
+		return nil, tracing.TraceWithErr(span, domainExceptions.NewInvalidCouponError(fmt.Sprintf("[ApplyCouponHandler_Handle.GetCouponByCode] coupon with code %s not found", command.CouponCode)))
+	}
+
+	order, err := c.aggregateStore.Load(ctx, command.OrderId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[ApplyCouponHandler_Handle.Load] error in loading order aggregate"))
+	}
+
+	discountRule, err := coupon.DiscountRule()
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[ApplyCouponHandler_Handle.DiscountRule] error in reading the coupon discount rule"))
+	}
+
+	err = order.ApplyCoupon(discountRule, command.AppliedAt)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[ApplyCouponHandler_Handle.ApplyCoupon] error in applying coupon to order"))
+	}
+
+	// the redemption is recorded before the event is stored, so the usage limit is checked atomically with the other orders of the customer
+	redemption := &couponModels.CouponRedemption{
+		CouponRedemptionId: uuid.NewV4(),
+		CouponId:           coupon.CouponId,
+		AccountEmail:       order.AccountEmail(),
+		OrderId:            order.Id(),
+		RedeemedAt:         command.AppliedAt,
+	}
+	created, err := c.couponRepository.Redeem(ctx, coupon, redemption)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[ApplyCouponHandler_Handle.Redeem] error in redeeming the coupon"))
+	}
+
+	_, err = c.aggregateStore.Store(order, core.MetadataFromContext(ctx), ctx)
+	if err != nil {
+		if created {
+			c.releaseRedemption(ctx, order.Id(), coupon.Code)
+		}
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[ApplyCouponHandler_Handle.Store] error in storing order aggregate"))
+	}
+
+	response := &dtos.ApplyCouponResponseDto{OrderId: order.Id()}
+	span.LogFields(log.Object("ApplyCouponResponseDto", response))
+
+	c.log.Infow(fmt.Sprintf("[ApplyCouponHandler.Handle] coupon %s applied to order with id: {%s}", coupon.Code, command.OrderId), logger.Fields{"OrderId": command.OrderId, "CouponCode": coupon.Code})
+
+	return response, nil
+}
+
+// releaseRedemption compensates the redemption of a coupon which is not applied to the order. a concurrent command of the order may have applied
+// the coupon with the redemption of this command, so the redemption is kept when the stored order has the coupon
+func (c *ApplyCouponHandler) releaseRedemption(ctx context.Context, orderId uuid.UUID, couponCode string) {
+	order, err := c.aggregateStore.Load(ctx, orderId)
+	if err != nil {
+		c.log.Errorw(fmt.Sprintf("[ApplyCouponHandler.releaseRedemption] error in loading order %s, the coupon redemption is kept: %v", orderId, err), logger.Fields{"OrderId": orderId})
+		return
+	}
+	if order.CouponCode() == couponCode {
+		return
+	}
+
+	if _, err := c.couponRepository.ReleaseRedemption(ctx, orderId); err != nil {
+		c.log.Errorw(fmt.Sprintf("[ApplyCouponHandler.releaseRedemption] error in releasing the coupon redemption of order %s: %v", orderId, err), logger.Fields{"OrderId": orderId})
+	}
+}
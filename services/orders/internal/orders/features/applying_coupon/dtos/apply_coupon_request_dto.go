@@ -0,0 +1,9 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// ApplyCouponRequestDto validation will handle in command level
+type ApplyCouponRequestDto struct {
+	OrderId    uuid.UUID `param:"id" json:"-"`
+	CouponCode string    `json:"couponCode"`
+}
@@ -0,0 +1,7 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+type ApplyCouponResponseDto struct {
+	OrderId uuid.UUID `json:"orderId"`
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	applyingCouponV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/dtos"
+	"net/http"
+)
+
+type applyCouponEndpoint struct {
+	*delivery.OrderEndpointBase
+}
+
+func NewApplyCouponEndpoint(endpointBase *delivery.OrderEndpointBase) *applyCouponEndpoint {
+	return &applyCouponEndpoint{endpointBase}
+}
+
+func (ep *applyCouponEndpoint) MapRoute() {
+	ep.OrdersGroup.POST("/:id/coupon", ep.handler())
+}
+
+// Apply Coupon
+// @Tags Orders
+// @Summary Apply coupon
+// @Description Apply a coupon to an open order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param ApplyCouponRequestDto body dtos.ApplyCouponRequestDto true "Coupon data"
+// @Success 200 {object} dtos.ApplyCouponResponseDto
+// @Router /api/v1/orders/{id}/coupon [post]
+func (ep *applyCouponEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.ApplyCouponHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "applyCouponEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.ApplyCouponRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[applyCouponEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[applyCouponEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := applyingCouponV1.NewApplyCoupon(request.OrderId, request.CouponCode)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[applyCouponEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[applyCouponEndpoint_handler.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*applyingCouponV1.ApplyCoupon, *dtos.ApplyCouponResponseDto](ctx, command)
+
+		if err != nil {
+			err = errors.WithMessage(err, "[applyCouponEndpoint_handler.Send] error in sending ApplyCoupon")
+			ep.Log.Errorw(fmt.Sprintf("[applyCouponEndpoint_handler.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
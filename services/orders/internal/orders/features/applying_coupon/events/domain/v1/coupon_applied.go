@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type CouponAppliedV1 struct {
+	*domain.DomainEvent
+	OrderId   uuid.UUID       `json:"orderId" bson:"orderId,omitempty"`
+	Coupon    *dtos.CouponDto `json:"coupon" bson:"coupon,omitempty"`
+	AppliedAt time.Time       `json:"appliedAt" bson:"appliedAt,omitempty"`
+}
+
+func NewCouponAppliedV1(orderId uuid.UUID, coupon *dtos.CouponDto, appliedAt time.Time) (*CouponAppliedV1, error) {
+	if orderId == uuid.Nil {
+		return nil, customErrors.NewDomainError("orderId is invalid")
+	}
+
+	if coupon == nil || coupon.Code == "" {
+		return nil, customErrors.NewDomainError("coupon is required")
+	}
+
+	if appliedAt.IsZero() {
+		return nil, customErrors.NewDomainError("appliedAt can't be zero")
+	}
+
+	eventData := &CouponAppliedV1{OrderId: orderId, Coupon: coupon, AppliedAt: appliedAt}
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
+
+	return eventData, nil
+}
@@ -0,0 +1,16 @@
+package v1
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type ApproveReturn struct {
+	OrderId    uuid.UUID `validate:"required"`
+	ReturnId   uuid.UUID `validate:"required"`
+	ApprovedAt time.Time `validate:"required"`
+}
+
+func NewApproveReturn(orderId uuid.UUID, returnId uuid.UUID) *ApproveReturn {
+	return &ApproveReturn{OrderId: orderId, ReturnId: returnId, ApprovedAt: time.Now()}
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/store"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/approving_return/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type ApproveReturnHandler struct {
+	log            logger.Logger
+	cfg            *config.Config
+	aggregateStore store.AggregateStore[*aggregate.Order]
+}
+
+func NewApproveReturnHandler(log logger.Logger, cfg *config.Config, aggregateStore store.AggregateStore[*aggregate.Order]) *ApproveReturnHandler {
+	return &ApproveReturnHandler{log: log, cfg: cfg, aggregateStore: aggregateStore}
+}
+
+func (c *ApproveReturnHandler) Handle(ctx context.Context, command *ApproveReturn) (*dtos.ApproveReturnResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ApproveReturnHandler.Handle")
+	span.LogFields(log.String("OrderId", command.OrderId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	order, err := c.aggregateStore.Load(ctx, command.OrderId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[ApproveReturnHandler_Handle.Load] error in loading order aggregate"))
+	}
+
+	err = order.ApproveReturn(command.ReturnId, command.ApprovedAt)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[ApproveReturnHandler_Handle.ApproveReturn] error in approving return"))
+	}
+
+	_, err = c.aggregateStore.Store(order, core.MetadataFromContext(ctx), ctx)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[ApproveReturnHandler_Handle.Store] error in storing order aggregate"))
+	}
+
+	response := &dtos.ApproveReturnResponseDto{OrderId: order.Id(), ReturnId: command.ReturnId}
+	span.LogFields(log.Object("ApproveReturnResponseDto", response))
+
+	c.log.Infow(fmt.Sprintf("[ApproveReturnHandler.Handle] return with id: {%s} of order with id: {%s} approved", command.ReturnId, command.OrderId), logger.Fields{"OrderId": command.OrderId, "ReturnId": command.ReturnId})
+
+	return response, nil
+}
@@ -0,0 +1,9 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// ApproveReturnRequestDto validation will handle in command level
+type ApproveReturnRequestDto struct {
+	OrderId  uuid.UUID `param:"id" json:"-"`
+	ReturnId uuid.UUID `param:"returnId" json:"-"`
+}
@@ -0,0 +1,8 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+type ApproveReturnResponseDto struct {
+	OrderId  uuid.UUID `json:"orderId"`
+	ReturnId uuid.UUID `json:"returnId"`
+}
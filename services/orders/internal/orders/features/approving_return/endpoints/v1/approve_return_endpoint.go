@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	approvingReturnV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/approving_return/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/approving_return/dtos"
+	"net/http"
+)
+
+type approveReturnEndpoint struct {
+	*delivery.OrderEndpointBase
+}
+
+func NewApproveReturnEndpoint(endpointBase *delivery.OrderEndpointBase) *approveReturnEndpoint {
+	return &approveReturnEndpoint{endpointBase}
+}
+
+func (ep *approveReturnEndpoint) MapRoute() {
+	ep.OrdersGroup.POST("/:id/returns/:returnId/approve", ep.handler())
+}
+
+// Approve Return
+// @Tags Orders
+// @Summary Approve return
+// @Description Approve a requested return of an order when the returned items are received
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param returnId path string true "Return ID"
+// @Success 200 {object} dtos.ApproveReturnResponseDto
+// @Router /api/v1/orders/{id}/returns/{returnId}/approve [post]
+func (ep *approveReturnEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.ApproveReturnHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "approveReturnEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.ApproveReturnRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[approveReturnEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[approveReturnEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := approvingReturnV1.NewApproveReturn(request.OrderId, request.ReturnId)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[approveReturnEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[approveReturnEndpoint_handler.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*approvingReturnV1.ApproveReturn, *dtos.ApproveReturnResponseDto](ctx, command)
+
+		if err != nil {
+			err = errors.WithMessage(err, "[approveReturnEndpoint_handler.Send] error in sending ApproveReturn")
+			ep.Log.Errorw(fmt.Sprintf("[approveReturnEndpoint_handler.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type ReturnApprovedV1 struct {
+	*domain.DomainEvent
+	OrderId    uuid.UUID `json:"orderId" bson:"orderId,omitempty"`
+	ReturnId   uuid.UUID `json:"returnId" bson:"returnId,omitempty"`
+	ApprovedAt time.Time `json:"approvedAt" bson:"approvedAt,omitempty"`
+}
+
+func NewReturnApprovedV1(orderId uuid.UUID, returnId uuid.UUID, approvedAt time.Time) (*ReturnApprovedV1, error) {
+	if orderId == uuid.Nil {
+		return nil, customErrors.NewDomainError("orderId is invalid")
+	}
+
+	if returnId == uuid.Nil {
+		return nil, customErrors.NewDomainError("returnId is invalid")
+	}
+
+	if approvedAt.IsZero() {
+		return nil, customErrors.NewDomainError("approvedAt can't be zero")
+	}
+
+	eventData := &ReturnApprovedV1{OrderId: orderId, ReturnId: returnId, ApprovedAt: approvedAt}
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
+
+	return eventData, nil
+}
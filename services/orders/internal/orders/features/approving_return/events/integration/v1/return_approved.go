@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	uuid "github.com/satori/go.uuid"
+)
+
+// ReturnApprovedV1 is published when the returned items of an order are received, the returned items are the quantities which can be restocked
+type ReturnApprovedV1 struct {
+	*types.Message
+	*dtos.OrderReadDto
+	ReturnId      string                    `json:"returnId"`
+	ReturnedItems []*dtos.ReturnItemReadDto `json:"returnedItems"`
+}
+
+func NewReturnApprovedV1(orderReadDto *dtos.OrderReadDto, returnId string, returnedItems []*dtos.ReturnItemReadDto) *ReturnApprovedV1 {
+	return &ReturnApprovedV1{OrderReadDto: orderReadDto, ReturnId: returnId, ReturnedItems: returnedItems, Message: types.NewMessage(uuid.NewV4().String())}
+}
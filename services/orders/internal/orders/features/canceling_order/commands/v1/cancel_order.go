@@ -0,0 +1,23 @@
+package v1
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type CancelOrder struct {
+	OrderId      uuid.UUID `validate:"required"`
+	CancelReason string    `validate:"required"`
+	CanceledAt   time.Time `validate:"required"`
+	// OnlyUnpaid keeps a paid order, the cancellations which are started by the system like an expired stock reservation shouldn't cancel a
+	// paid order
+	OnlyUnpaid bool
+}
+
+func NewCancelOrder(orderId uuid.UUID, cancelReason string) *CancelOrder {
+	return &CancelOrder{OrderId: orderId, CancelReason: cancelReason, CanceledAt: time.Now()}
+}
+
+func NewCancelUnpaidOrder(orderId uuid.UUID, cancelReason string) *CancelOrder {
+	return &CancelOrder{OrderId: orderId, CancelReason: cancelReason, CanceledAt: time.Now(), OnlyUnpaid: true}
+}
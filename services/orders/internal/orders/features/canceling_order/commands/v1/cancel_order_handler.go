@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/store"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// CancelOrderHandler cancels the order, the delivery slot of the canceled order is released by the delivery slots projection
+type CancelOrderHandler struct {
+	log            logger.Logger
+	cfg            *config.Config
+	aggregateStore store.AggregateStore[*aggregate.Order]
+}
+
+func NewCancelOrderHandler(log logger.Logger, cfg *config.Config, aggregateStore store.AggregateStore[*aggregate.Order]) *CancelOrderHandler {
+	return &CancelOrderHandler{log: log, cfg: cfg, aggregateStore: aggregateStore}
+}
+
+func (c *CancelOrderHandler) Handle(ctx context.Context, command *CancelOrder) (*dtos.CancelOrderResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CancelOrderHandler.Handle")
+	span.LogFields(log.String("OrderId", command.OrderId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	order, err := c.aggregateStore.Load(ctx, command.OrderId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[CancelOrderHandler_Handle.Load] error in loading order aggregate"))
+	}
+
+	if command.OnlyUnpaid {
+		err = order.CancelUnpaid(command.CancelReason, command.CanceledAt)
+	} else {
+		err = order.Cancel(command.CancelReason, command.CanceledAt)
+	}
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[CancelOrderHandler_Handle.Cancel] error in canceling order"))
+	}
+
+	_, err = c.aggregateStore.Store(order, core.MetadataFromContext(ctx), ctx)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[CancelOrderHandler_Handle.Store] error in storing order aggregate"))
+	}
+
+	response := &dtos.CancelOrderResponseDto{OrderId: order.Id()}
+	span.LogFields(log.Object("CancelOrderResponseDto", response))
+
+	c.log.Infow(fmt.Sprintf("[CancelOrderHandler.Handle] order with id: {%s} canceled", command.OrderId), logger.Fields{"OrderId": command.OrderId})
+
+	return response, nil
+}
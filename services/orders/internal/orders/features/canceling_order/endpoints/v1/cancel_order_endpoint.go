@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	cancelingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/dtos"
+	"net/http"
+)
+
+type cancelOrderEndpoint struct {
+	*delivery.OrderEndpointBase
+}
+
+func NewCancelOrderEndpoint(endpointBase *delivery.OrderEndpointBase) *cancelOrderEndpoint {
+	return &cancelOrderEndpoint{endpointBase}
+}
+
+func (ep *cancelOrderEndpoint) MapRoute() {
+	ep.OrdersGroup.POST("/:id/cancel", ep.handler())
+}
+
+// Cancel Order
+// @Tags Orders
+// @Summary Cancel order
+// @Description Cancel an existing order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param CancelOrderRequestDto body dtos.CancelOrderRequestDto true "Cancel reason"
+// @Success 200 {object} dtos.CancelOrderResponseDto
+// @Router /api/v1/orders/{id}/cancel [post]
+func (ep *cancelOrderEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.CancelOrderHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "cancelOrderEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.CancelOrderRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[cancelOrderEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[cancelOrderEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := cancelingOrderV1.NewCancelOrder(request.OrderId, request.CancelReason)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[cancelOrderEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[cancelOrderEndpoint_handler.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*cancelingOrderV1.CancelOrder, *dtos.CancelOrderResponseDto](ctx, command)
+
+		if err != nil {
+			err = errors.WithMessage(err, "[cancelOrderEndpoint_handler.Send] error in sending CancelOrder")
+			ep.Log.Errorw(fmt.Sprintf("[cancelOrderEndpoint_handler.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
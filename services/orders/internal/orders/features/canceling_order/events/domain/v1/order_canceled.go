@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type OrderCanceledV1 struct {
+	*domain.DomainEvent
+	OrderId      uuid.UUID `json:"orderId" bson:"orderId,omitempty"`
+	CancelReason string    `json:"cancelReason" bson:"cancelReason,omitempty"`
+	CanceledAt   time.Time `json:"canceledAt" bson:"canceledAt,omitempty"`
+}
+
+func NewOrderCanceledV1(orderId uuid.UUID, cancelReason string, canceledAt time.Time) (*OrderCanceledV1, error) {
+	if orderId == uuid.Nil {
+		return nil, customErrors.NewDomainError("orderId is invalid")
+	}
+
+	if cancelReason == "" {
+		return nil, customErrors.NewDomainError("cancelReason is required")
+	}
+
+	if canceledAt.IsZero() {
+		return nil, customErrors.NewDomainError("canceledAt can't be zero")
+	}
+
+	eventData := &OrderCanceledV1{OrderId: orderId, CancelReason: cancelReason, CanceledAt: canceledAt}
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
+
+	return eventData, nil
+}
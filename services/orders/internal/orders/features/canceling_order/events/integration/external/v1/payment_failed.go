@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+)
+
+// PaymentFailedV1 is published by the payments service when the payment of an order can't be captured
+type PaymentFailedV1 struct {
+	*types.Message
+	PaymentId string `json:"paymentId"`
+	OrderId   string `json:"orderId"`
+	Reason    string `json:"reason"`
+}
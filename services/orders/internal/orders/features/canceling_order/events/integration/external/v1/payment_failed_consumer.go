@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	domainExceptions "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/exceptions/domain"
+	cancelingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/dtos"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+type paymentFailedConsumer struct {
+	*delivery.OrderConsumersBase
+}
+
+func NewPaymentFailedConsumer(orderConsumersBase *delivery.OrderConsumersBase) *paymentFailedConsumer {
+	return &paymentFailedConsumer{orderConsumersBase}
+}
+
+func (c *paymentFailedConsumer) Handle(ctx context.Context, consumeContext types.IMessageConsumeContext[*PaymentFailedV1]) error {
+	if consumeContext.Message() == nil {
+		return nil
+	}
+	span, ctx := opentracing.StartSpanFromContext(ctx, "paymentFailedConsumer.Handle")
+	span.LogFields(log.Object("Message", consumeContext.Created()))
+	defer span.Finish()
+
+	message := consumeContext.Message()
+
+	orderId, err := uuid.FromString(message.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[paymentFailedConsumer_Handle.uuid.FromString] error in the converting order uuid")
+		c.Log.Errorf(fmt.Sprintf("[paymentFailedConsumer_Handle.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		c.CommitErrMessage()
+
+		return badRequestErr
+	}
+
+	// a failed payment of an order which is already paid with another payment doesn't cancel it
+	command := cancelingOrderV1.NewCancelUnpaidOrder(orderId, fmt.Sprintf("payment failed: %s", message.Reason))
+	_, err = mediatr.Send[*cancelingOrderV1.CancelOrder, *dtos.CancelOrderResponseDto](ctx, command)
+	if domainExceptions.IsInvalidOrderStateError(err) {
+		// a paid, completed or already canceled order keeps its state
+		c.Log.Infow(fmt.Sprintf("[paymentFailedConsumer_Handle.Send] order '%s' is not canceled, %v", orderId, err), logger.Fields{"OrderId": orderId})
+		c.CommitMessage()
+
+		return nil
+	}
+	if err != nil {
+		err = errors.WithMessage(err, "[paymentFailedConsumer_Handle.Send] error in sending CancelOrder")
+		c.Log.Errorw(fmt.Sprintf("[paymentFailedConsumer_Handle.Send] id: {%s}, err: {%v}", orderId, tracing.TraceWithErr(span, err)), logger.Fields{"OrderId": orderId})
+		c.CommitErrMessage()
+
+		return err
+	}
+	c.CommitMessage()
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/inmemory"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	cancelingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/dtos"
+	stockIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/integration/external/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/test_fixtures/integration"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Stock_Reservation_Consumers(t *testing.T) {
+	test.SkipCI(t)
+	fixture := integration.NewIntegrationTestFixture()
+	defer fixture.Cleanup()
+
+	err := mediatr.RegisterRequestHandler[*cancelingOrderV1.CancelOrder, *dtos.CancelOrderResponseDto](cancelingOrderV1.NewCancelOrderHandler(fixture.Log, fixture.Cfg, fixture.OrderAggregateStore))
+	require.NoError(t, err)
+
+	// the catalogs events are delivered by the in-process transport, so the consumers are tested without the broker
+	ctx := context.Background()
+	transport := inmemory.NewInMemoryTransport()
+	producer := inmemory.NewInMemoryProducer(transport, fixture.EventSerializer, fixture.Log)
+	consumerBase := delivery.NewOrderConsumersBase(fixture.InfrastructureConfiguration)
+
+	failedConsumer := inmemory.NewInMemoryConsumer[*stockIntegration.StockReservationFailedV1](transport, stockIntegration.NewStockReservationFailedConsumer(consumerBase), fixture.EventSerializer, fixture.Log)
+	require.NoError(t, failedConsumer.Consume(ctx))
+	expiredConsumer := inmemory.NewInMemoryConsumer[*stockIntegration.StockReservationExpiredV1](transport, stockIntegration.NewStockReservationExpiredConsumer(consumerBase), fixture.EventSerializer, fixture.Log)
+	require.NoError(t, expiredConsumer.Consume(ctx))
+
+	newSubmittedOrder := func(paid bool) *aggregate.Order {
+		shopItems := []*value_objects.ShopItem{value_objects.CreateNewShopItem(uuid.NewV4(), gofakeit.Name(), gofakeit.AdjectiveDescriptive(), 2, domain.MustParseMoney("10", "USD"))}
+		order, err := aggregate.NewOrder(uuid.NewV4(), shopItems, gofakeit.Email(), gofakeit.Address().Address, time.Now(), time.Now())
+		require.NoError(t, err)
+		require.NoError(t, order.Submit(time.Now()))
+		if paid {
+			require.NoError(t, order.Pay(uuid.NewV4(), order.TotalPrice(), time.Now()))
+		}
+		_, err = fixture.OrderAggregateStore.Store(order, core.MetadataFromContext(ctx), ctx)
+		require.NoError(t, err)
+
+		return order
+	}
+
+	t.Run("failed reservation cancels the order", func(t *testing.T) {
+		order := newSubmittedOrder(false)
+
+		err := producer.Publish(ctx, &stockIntegration.StockReservationFailedV1{Message: types.NewMessage(uuid.NewV4().String()), OrderId: order.Id().String(), Reason: "out of stock"}, nil)
+		require.NoError(t, err)
+
+		canceledOrder, err := fixture.OrderAggregateStore.Load(ctx, order.Id())
+		require.NoError(t, err)
+		assert.True(t, canceledOrder.Canceled())
+		assert.Equal(t, "stock reservation failed: out of stock", canceledOrder.CancelReason())
+	})
+
+	t.Run("expired reservation cancels the unpaid order", func(t *testing.T) {
+		order := newSubmittedOrder(false)
+
+		err := producer.Publish(ctx, &stockIntegration.StockReservationExpiredV1{Message: types.NewMessage(uuid.NewV4().String()), OrderId: order.Id().String()}, nil)
+		require.NoError(t, err)
+
+		canceledOrder, err := fixture.OrderAggregateStore.Load(ctx, order.Id())
+		require.NoError(t, err)
+		assert.True(t, canceledOrder.Canceled())
+	})
+
+	t.Run("expired reservation keeps the paid order", func(t *testing.T) {
+		order := newSubmittedOrder(true)
+
+		err := producer.Publish(ctx, &stockIntegration.StockReservationExpiredV1{Message: types.NewMessage(uuid.NewV4().String()), OrderId: order.Id().String()}, nil)
+		require.NoError(t, err)
+
+		paidOrder, err := fixture.OrderAggregateStore.Load(ctx, order.Id())
+		require.NoError(t, err)
+		assert.False(t, paidOrder.Canceled())
+		assert.True(t, paidOrder.Paid())
+	})
+}
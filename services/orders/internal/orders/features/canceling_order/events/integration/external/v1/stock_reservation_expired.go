@@ -0,0 +1,11 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+)
+
+// StockReservationExpiredV1 is published by the catalogs write service when a submitted order isn't paid before its stock reservation expires
+type StockReservationExpiredV1 struct {
+	*types.Message
+	OrderId string `json:"orderId"`
+}
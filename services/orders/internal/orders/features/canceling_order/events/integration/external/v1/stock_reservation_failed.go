@@ -0,0 +1,12 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+)
+
+// StockReservationFailedV1 is published by the catalogs write service when the stock of a submitted order can't be reserved
+type StockReservationFailedV1 struct {
+	*types.Message
+	OrderId string `json:"orderId"`
+	Reason  string `json:"reason"`
+}
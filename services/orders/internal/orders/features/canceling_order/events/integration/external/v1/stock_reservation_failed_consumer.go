@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	domainExceptions "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/exceptions/domain"
+	cancelingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/dtos"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+type stockReservationFailedConsumer struct {
+	*delivery.OrderConsumersBase
+}
+
+func NewStockReservationFailedConsumer(orderConsumersBase *delivery.OrderConsumersBase) *stockReservationFailedConsumer {
+	return &stockReservationFailedConsumer{orderConsumersBase}
+}
+
+func (c *stockReservationFailedConsumer) Handle(ctx context.Context, consumeContext types.IMessageConsumeContext[*StockReservationFailedV1]) error {
+	if consumeContext.Message() == nil {
+		return nil
+	}
+	span, ctx := opentracing.StartSpanFromContext(ctx, "stockReservationFailedConsumer.Handle")
+	span.LogFields(log.Object("Message", consumeContext.Created()))
+	defer span.Finish()
+
+	message := consumeContext.Message()
+
+	orderId, err := uuid.FromString(message.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[stockReservationFailedConsumer_Handle.uuid.FromString] error in the converting order uuid")
+		c.Log.Errorf(fmt.Sprintf("[stockReservationFailedConsumer_Handle.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		c.CommitErrMessage()
+
+		return badRequestErr
+	}
+
+	command := cancelingOrderV1.NewCancelOrder(orderId, fmt.Sprintf("stock reservation failed: %s", message.Reason))
+	_, err = mediatr.Send[*cancelingOrderV1.CancelOrder, *dtos.CancelOrderResponseDto](ctx, command)
+	if domainExceptions.IsInvalidOrderStateError(err) {
+		// an order which is already canceled or completed keeps its state
+		c.Log.Infow(fmt.Sprintf("[stockReservationFailedConsumer_Handle.Send] order '%s' is not canceled, %v", orderId, err), logger.Fields{"OrderId": orderId})
+		c.CommitMessage()
+
+		return nil
+	}
+	if err != nil {
+		err = errors.WithMessage(err, "[stockReservationFailedConsumer_Handle.Send] error in sending CancelOrder")
+		c.Log.Errorw(fmt.Sprintf("[stockReservationFailedConsumer_Handle.Send] id: {%s}, err: {%v}", orderId, tracing.TraceWithErr(span, err)), logger.Fields{"OrderId": orderId})
+		c.CommitErrMessage()
+
+		return err
+	}
+	c.CommitMessage()
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package v1
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type CompleteOrder struct {
+	OrderId     uuid.UUID `validate:"required"`
+	CompletedAt time.Time `validate:"required"`
+}
+
+func NewCompleteOrder(orderId uuid.UUID) *CompleteOrder {
+	return &CompleteOrder{OrderId: orderId, CompletedAt: time.Now()}
+}
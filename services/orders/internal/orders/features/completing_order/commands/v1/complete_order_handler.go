@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/store"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/completing_order/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type CompleteOrderHandler struct {
+	log            logger.Logger
+	cfg            *config.Config
+	aggregateStore store.AggregateStore[*aggregate.Order]
+}
+
+func NewCompleteOrderHandler(log logger.Logger, cfg *config.Config, aggregateStore store.AggregateStore[*aggregate.Order]) *CompleteOrderHandler {
+	return &CompleteOrderHandler{log: log, cfg: cfg, aggregateStore: aggregateStore}
+}
+
+func (c *CompleteOrderHandler) Handle(ctx context.Context, command *CompleteOrder) (*dtos.CompleteOrderResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CompleteOrderHandler.Handle")
+	span.LogFields(log.String("OrderId", command.OrderId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	order, err := c.aggregateStore.Load(ctx, command.OrderId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[CompleteOrderHandler_Handle.Load] error in loading order aggregate"))
+	}
+
+	err = order.Complete(command.CompletedAt)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[CompleteOrderHandler_Handle.Complete] error in completing order"))
+	}
+
+	_, err = c.aggregateStore.Store(order, core.MetadataFromContext(ctx), ctx)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[CompleteOrderHandler_Handle.Store] error in storing order aggregate"))
+	}
+
+	response := &dtos.CompleteOrderResponseDto{OrderId: order.Id()}
+	span.LogFields(log.Object("CompleteOrderResponseDto", response))
+
+	c.log.Infow(fmt.Sprintf("[CompleteOrderHandler.Handle] order with id: {%s} completed", command.OrderId), logger.Fields{"OrderId": command.OrderId})
+
+	return response, nil
+}
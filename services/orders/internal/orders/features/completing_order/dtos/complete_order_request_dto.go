@@ -0,0 +1,8 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// CompleteOrderRequestDto validation will handle in command level
+type CompleteOrderRequestDto struct {
+	OrderId uuid.UUID `param:"id" json:"-"`
+}
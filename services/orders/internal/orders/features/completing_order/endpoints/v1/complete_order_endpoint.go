@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	completingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/completing_order/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/completing_order/dtos"
+	"net/http"
+)
+
+type completeOrderEndpoint struct {
+	*delivery.OrderEndpointBase
+}
+
+func NewCompleteOrderEndpoint(endpointBase *delivery.OrderEndpointBase) *completeOrderEndpoint {
+	return &completeOrderEndpoint{endpointBase}
+}
+
+func (ep *completeOrderEndpoint) MapRoute() {
+	ep.OrdersGroup.POST("/:id/complete", ep.handler())
+}
+
+// Complete Order
+// @Tags Orders
+// @Summary Complete order
+// @Description Complete an existing order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} dtos.CompleteOrderResponseDto
+// @Router /api/v1/orders/{id}/complete [post]
+func (ep *completeOrderEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.CompleteOrderHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "completeOrderEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.CompleteOrderRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[completeOrderEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[completeOrderEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := completingOrderV1.NewCompleteOrder(request.OrderId)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[completeOrderEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[completeOrderEndpoint_handler.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*completingOrderV1.CompleteOrder, *dtos.CompleteOrderResponseDto](ctx, command)
+
+		if err != nil {
+			err = errors.WithMessage(err, "[completeOrderEndpoint_handler.Send] error in sending CompleteOrder")
+			ep.Log.Errorw(fmt.Sprintf("[completeOrderEndpoint_handler.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type OrderCompletedV1 struct {
+	*domain.DomainEvent
+	OrderId     uuid.UUID `json:"orderId" bson:"orderId,omitempty"`
+	CompletedAt time.Time `json:"completedAt" bson:"completedAt,omitempty"`
+}
+
+func NewOrderCompletedV1(orderId uuid.UUID, completedAt time.Time) (*OrderCompletedV1, error) {
+	if orderId == uuid.Nil {
+		return nil, customErrors.NewDomainError("orderId is invalid")
+	}
+
+	if completedAt.IsZero() {
+		return nil, customErrors.NewDomainError("completedAt can't be zero")
+	}
+
+	eventData := &OrderCompletedV1{OrderId: orderId, CompletedAt: completedAt}
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
+
+	return eventData, nil
+}
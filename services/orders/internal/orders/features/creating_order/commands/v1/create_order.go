@@ -11,7 +11,7 @@ import (
 
 type CreateOrder struct {
 	OrderId         uuid.UUID           `validate:"required"`
-	ShopItems       []*dtos.ShopItemDto `validate:"required"`
+	ShopItems       []*dtos.ShopItemDto `validate:"required,dive"`
 	AccountEmail    string              `validate:"required,email"`
 	DeliveryAddress string              `validate:"required"`
 	DeliveryTime    time.Time           `validate:"required"`
@@ -2,16 +2,18 @@ package v1
 
 import (
 	"context"
+	"emperror.dev/errors"
 	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/store"
 	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
-	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/clients/catalogs"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/clients"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/dtos"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
-	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/log"
 )
@@ -20,10 +22,11 @@ type CreateOrderHandler struct {
 	log            logger.Logger
 	cfg            *config.Config
 	aggregateStore store.AggregateStore[*aggregate.Order]
+	catalogClient  clients.CatalogClient
 }
 
-func NewCreateOrderHandler(log logger.Logger, cfg *config.Config, aggregateStore store.AggregateStore[*aggregate.Order]) *CreateOrderHandler {
-	return &CreateOrderHandler{log: log, cfg: cfg, aggregateStore: aggregateStore}
+func NewCreateOrderHandler(log logger.Logger, cfg *config.Config, aggregateStore store.AggregateStore[*aggregate.Order], catalogClient clients.CatalogClient) *CreateOrderHandler {
+	return &CreateOrderHandler{log: log, cfg: cfg, aggregateStore: aggregateStore, catalogClient: catalogClient}
 }
 
 func (c *CreateOrderHandler) Handle(ctx context.Context, command *CreateOrder) (*dtos.CreateOrderResponseDto, error) {
@@ -32,9 +35,10 @@ func (c *CreateOrderHandler) Handle(ctx context.Context, command *CreateOrder) (
 	span.LogFields(log.Object("Command", command))
 	defer span.Finish()
 
-	shopItems, err := mapper.Map[[]*value_objects.ShopItem](command.ShopItems)
+	// an unknown product keeps its bad request status
+	shopItems, err := catalogs.PriceShopItems(ctx, c.catalogClient, command.ShopItems)
 	if err != nil {
-		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[CreateOrderHandler_Handle.Map] error in the mapping shopItems"))
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[CreateOrderHandler_Handle.PriceShopItems] error in pricing shopItems with the catalog"))
 	}
 
 	order, err := aggregate.NewOrder(command.OrderId, shopItems, command.AccountEmail, command.DeliveryAddress, command.DeliveryTime, command.CreatedAt)
@@ -43,7 +47,7 @@ func (c *CreateOrderHandler) Handle(ctx context.Context, command *CreateOrder) (
 		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[CreateOrderHandler_Handle.NewOrder] error in creating new order"))
 	}
 
-	_, err = c.aggregateStore.Store(order, nil, ctx)
+	_, err = c.aggregateStore.Store(order, core.MetadataFromContext(ctx), ctx)
 	if err != nil {
 		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[CreateOrderHandler_Handle.Store] error in storing order aggregate"))
 	}
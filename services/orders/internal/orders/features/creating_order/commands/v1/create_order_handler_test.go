@@ -2,13 +2,17 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/mehdihadeli/go-mediatr"
 	customTypes "github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/custom_types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/clients/catalogs"
 	ordersDto "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/dtos"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/test_fixtures/integration"
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"testing"
 	"time"
@@ -19,7 +23,15 @@ func Test_Create_Order_Command_Handler(t *testing.T) {
 	fixture := integration.NewIntegrationTestFixture()
 	defer fixture.Cleanup()
 
-	err := mediatr.RegisterRequestHandler[*CreateOrder, *dtos.CreateOrderResponseDto](NewCreateOrderHandler(fixture.Log, fixture.Cfg, fixture.OrderAggregateStore))
+	product := &ordersDto.CatalogProductDto{
+		ProductId:   uuid.NewV4(),
+		Name:        gofakeit.Name(),
+		Description: gofakeit.AdjectiveDescriptive(),
+		Price:       domain.MustParseMoney(fmt.Sprintf("%.2f", gofakeit.Price(100, 10000)), "USD"),
+	}
+	catalogClient := catalogs.NewInMemoryCatalogClient(product)
+
+	err := mediatr.RegisterRequestHandler[*CreateOrder, *dtos.CreateOrderResponseDto](NewCreateOrderHandler(fixture.Log, fixture.Cfg, fixture.OrderAggregateStore, catalogClient))
 	if err != nil {
 		return
 	}
@@ -32,10 +44,8 @@ func Test_Create_Order_Command_Handler(t *testing.T) {
 		DeliveryTime:    customTypes.CustomTime(time.Now()),
 		ShopItems: []*ordersDto.ShopItemDto{
 			{
-				Quantity:    uint64(gofakeit.Number(1, 10)),
-				Description: gofakeit.AdjectiveDescriptive(),
-				Price:       gofakeit.Price(100, 10000),
-				Title:       gofakeit.Name(),
+				ProductId: product.ProductId,
+				Quantity:  uint64(gofakeit.Number(1, 10)),
 			},
 		},
 	}
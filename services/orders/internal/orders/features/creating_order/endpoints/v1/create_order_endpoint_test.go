@@ -2,14 +2,17 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/gavv/httpexpect/v2"
 	customTypes "github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/custom_types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
 	ordersDto "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/dtos"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/test_fixtures/e2e"
+	uuid "github.com/satori/go.uuid"
 	"net/http"
 	"testing"
 	"time"
@@ -31,6 +34,9 @@ func Test_Create_Order_E2E(t *testing.T) {
 	fixture.Run()
 	defer fixture.Cleanup()
 
+	product := &ordersDto.CatalogProductDto{ProductId: uuid.NewV4(), Name: gofakeit.Name(), Description: gofakeit.AdjectiveDescriptive(), Price: domain.MustParseMoney(fmt.Sprintf("%.2f", gofakeit.Price(100, 10000)), "USD")}
+	fixture.CatalogClient.AddProduct(product)
+
 	// create httpexpect instance
 	expect := httpexpect.New(t, fixture.HttpServer.URL)
 
@@ -40,10 +46,8 @@ func Test_Create_Order_E2E(t *testing.T) {
 		DeliveryTime:    customTypes.CustomTime(time.Now()),
 		ShopItems: []*ordersDto.ShopItemDto{
 			{
-				Quantity:    uint64(gofakeit.Number(1, 10)),
-				Description: gofakeit.AdjectiveDescriptive(),
-				Price:       gofakeit.Price(100, 10000),
-				Title:       gofakeit.Name(),
+				ProductId: product.ProductId,
+				Quantity:  uint64(gofakeit.Number(1, 10)),
 			},
 		},
 	}
@@ -0,0 +1,8 @@
+package dtos
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+
+type GetCustomerOrdersRequestDto struct {
+	Status           string `query:"status" json:"status,omitempty"`
+	*utils.ListQuery `json:"listQuery"`
+}
@@ -0,0 +1,10 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	ordersDto "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+)
+
+type GetCustomerOrdersResponseDto struct {
+	Orders *utils.ListResult[*ordersDto.OrderReadDto]
+}
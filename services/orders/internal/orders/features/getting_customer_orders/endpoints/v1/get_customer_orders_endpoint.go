@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_customer_orders/dtos"
+	v1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_customer_orders/queries/v1"
+	"net/http"
+)
+
+type getCustomerOrdersEndpoint struct {
+	*delivery.OrderEndpointBase
+}
+
+func NewGetCustomerOrdersEndpoint(orderEndpointBase *delivery.OrderEndpointBase) *getCustomerOrdersEndpoint {
+	return &getCustomerOrdersEndpoint{orderEndpointBase}
+}
+
+func (ep *getCustomerOrdersEndpoint) MapRoute() {
+	ep.OrdersGroup.GET("/me", ep.handler())
+}
+
+// Get Customer Orders
+// @Tags Orders
+// @Summary Get my orders
+// @Description Get the orders of the authenticated user, newest first by default
+// @Accept json
+// @Produce json
+// @Param getCustomerOrdersRequestDto query dtos.GetCustomerOrdersRequestDto false "GetCustomerOrdersRequestDto"
+// @Success 200 {object} dtos.GetCustomerOrdersResponseDto
+// @Router /api/v1/orders/me [get]
+func (ep *getCustomerOrdersEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.GetCustomerOrdersHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "getCustomerOrdersEndpoint.handler")
+		defer span.Finish()
+
+		accountEmail := core.GetUserEmail(ctx)
+		if accountEmail == "" {
+			unauthorizedErr := customErrors.NewUnAuthorizedError("[getCustomerOrdersEndpoint_handler.GetUserEmail] the request is not sent by an authenticated user")
+			ep.Log.Errorf(fmt.Sprintf("[getCustomerOrdersEndpoint_handler.GetUserEmail] err: %v", tracing.TraceWithErr(span, unauthorizedErr)))
+			return unauthorizedErr
+		}
+
+		listQuery, err := utils.GetListQueryFromCtx(c)
+		if err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[getCustomerOrdersEndpoint_handler.GetListQueryFromCtx] error in getting data from query string")
+			ep.Log.Errorf(fmt.Sprintf("[getCustomerOrdersEndpoint_handler.GetListQueryFromCtx] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		request := &dtos.GetCustomerOrdersRequestDto{ListQuery: listQuery}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[getCustomerOrdersEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[getCustomerOrdersEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		query := v1.NewGetCustomerOrders(accountEmail, request.Status, request.ListQuery)
+		if err := ep.Validator.StructCtx(ctx, query); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[getCustomerOrdersEndpoint_handler.StructCtx] query validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[getCustomerOrdersEndpoint_handler.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		queryResult, err := mediatr.Send[*v1.GetCustomerOrders, *dtos.GetCustomerOrdersResponseDto](ctx, query)
+		if err != nil {
+			err = errors.WithMessage(err, "[getCustomerOrdersEndpoint_handler.Send] error in sending GetCustomerOrders")
+			ep.Log.Errorw(fmt.Sprintf("[getCustomerOrdersEndpoint_handler.Send] account: {%s}, err: %v", query.AccountEmail, tracing.TraceWithErr(span, err)), logger.Fields{"AccountEmail": query.AccountEmail})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, queryResult)
+	}
+}
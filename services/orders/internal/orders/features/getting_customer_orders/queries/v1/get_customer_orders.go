@@ -0,0 +1,14 @@
+package v1
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+
+// GetCustomerOrders gets the orders of the account, the account email is taken from the authenticated user of the request
+type GetCustomerOrders struct {
+	AccountEmail string `validate:"required,email"`
+	Status       string `validate:"omitempty,oneof=created submitted paid completed canceled"`
+	*utils.ListQuery
+}
+
+func NewGetCustomerOrders(accountEmail string, status string, query *utils.ListQuery) *GetCustomerOrders {
+	return &GetCustomerOrders{AccountEmail: accountEmail, Status: status, ListQuery: query}
+}
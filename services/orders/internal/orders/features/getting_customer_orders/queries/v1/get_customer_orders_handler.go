@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/repositories"
+	ordersDto "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_customer_orders/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/read_models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// defaultCustomerOrdersOrderBy lists the newest orders of the customer first
+const defaultCustomerOrdersOrderBy = "createdAt desc"
+
+type GetCustomerOrdersHandler struct {
+	log                 logger.Logger
+	cfg                 *config.Config
+	orderReadRepository repositories.OrderReadRepository
+}
+
+func NewGetCustomerOrdersHandler(log logger.Logger, cfg *config.Config, orderReadRepository repositories.OrderReadRepository) *GetCustomerOrdersHandler {
+	return &GetCustomerOrdersHandler{log: log, cfg: cfg, orderReadRepository: orderReadRepository}
+}
+
+func (c *GetCustomerOrdersHandler) Handle(ctx context.Context, query *GetCustomerOrders) (*dtos.GetCustomerOrdersResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetCustomerOrdersHandler.Handle")
+	span.LogFields(log.String("AccountEmail", query.AccountEmail))
+	span.LogFields(log.Object("Query", query))
+	defer span.Finish()
+
+	if query.ListQuery.GetOrderBy() == "" {
+		query.ListQuery.SetOrderBy(defaultCustomerOrdersOrderBy)
+	}
+
+	if err := query.ListQuery.Validate(read_models.CustomerOrderQueryFields); err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[GetCustomerOrdersHandler_Handle.Validate] error in validating the list query"))
+	}
+
+	orders, err := c.orderReadRepository.GetCustomerOrders(ctx, query.AccountEmail, query.Status, query.ListQuery)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetCustomerOrdersHandler_Handle.GetCustomerOrders] error in getting the customer orders in the repository"))
+	}
+
+	listResultDto, err := utils.ListResultToListResultDto[*ordersDto.OrderReadDto](orders)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetCustomerOrdersHandler_Handle.ListResultToListResultDto] error in the mapping ListResultToListResultDto"))
+	}
+
+	c.log.Infow("[GetCustomerOrdersHandler.Handle] customer orders fetched", logger.Fields{"AccountEmail": query.AccountEmail})
+
+	return &dtos.GetCustomerOrdersResponseDto{Orders: listResultDto}, nil
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/mappings"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/repositories"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/read_models"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// customerOrderReadRepository records the customer query which is sent to the repository
+type customerOrderReadRepository struct {
+	repositories.OrderReadRepository
+	accountEmail string
+	status       string
+	listQuery    *utils.ListQuery
+}
+
+func (r *customerOrderReadRepository) GetCustomerOrders(ctx context.Context, accountEmail string, status string, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
+	r.accountEmail = accountEmail
+	r.status = status
+	r.listQuery = listQuery
+
+	orders := []*read_models.OrderReadModel{{Id: uuid.NewV4().String(), OrderId: uuid.NewV4().String(), AccountEmail: accountEmail, Status: read_models.OrderPaidStatus}}
+	return utils.NewListResult[*read_models.OrderReadModel](orders, listQuery.GetSize(), listQuery.GetPage(), int64(len(orders))), nil
+}
+
+func Test_Get_Customer_Orders_Query_Handler(t *testing.T) {
+	require.NoError(t, mappings.ConfigureMappings())
+
+	repository := &customerOrderReadRepository{}
+	handler := NewGetCustomerOrdersHandler(defaultLogger.Logger, &config.Config{}, repository)
+
+	result, err := handler.Handle(context.Background(), NewGetCustomerOrders("customer@example.com", read_models.OrderPaidStatus, utils.NewListQuery(10, 1)))
+	require.NoError(t, err)
+
+	assert.Equal(t, "customer@example.com", repository.accountEmail)
+	assert.Equal(t, read_models.OrderPaidStatus, repository.status)
+	assert.Equal(t, defaultCustomerOrdersOrderBy, repository.listQuery.GetOrderBy())
+	assert.Len(t, result.Orders.Items, 1)
+	assert.Equal(t, "customer@example.com", result.Orders.Items[0].AccountEmail)
+}
+
+func Test_Get_Customer_Orders_Query_Handler_Rejects_Account_Filter(t *testing.T) {
+	repository := &customerOrderReadRepository{}
+	handler := NewGetCustomerOrdersHandler(defaultLogger.Logger, &config.Config{}, repository)
+
+	query := NewGetCustomerOrders("customer@example.com", "", utils.NewListQuery(10, 1))
+	query.Filters = []*utils.FilterModel{{Field: "accountEmail", Value: "other@example.com", Comparison: utils.EqualComparison}}
+
+	_, err := handler.Handle(context.Background(), query)
+
+	assert.Error(t, err)
+	assert.Nil(t, repository.listQuery)
+}
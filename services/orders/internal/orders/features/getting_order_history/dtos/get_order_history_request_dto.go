@@ -0,0 +1,11 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	uuid "github.com/satori/go.uuid"
+)
+
+type GetOrderHistoryRequestDto struct {
+	OrderId          uuid.UUID `param:"id" json:"-"`
+	*utils.ListQuery `json:"listQuery"`
+}
@@ -0,0 +1,7 @@
+package dtos
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+
+type GetOrderHistoryResponseDto struct {
+	History *utils.ListResult[*OrderHistoryEventDto] `json:"history"`
+}
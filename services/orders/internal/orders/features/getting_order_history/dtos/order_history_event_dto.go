@@ -0,0 +1,14 @@
+package dtos
+
+import "time"
+
+// OrderHistoryEventDto is an event of the order stream with the changes it made to the order
+type OrderHistoryEventDto struct {
+	EventId       string    `json:"eventId"`
+	EventType     string    `json:"eventType"`
+	Version       int64     `json:"version"`
+	OccurredOn    time.Time `json:"occurredOn"`
+	CorrelationId string    `json:"correlationId,omitempty"`
+	UserId        string    `json:"userId,omitempty"`
+	Changes       []string  `json:"changes"`
+}
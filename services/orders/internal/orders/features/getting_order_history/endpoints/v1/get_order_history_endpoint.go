@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_order_history/dtos"
+	v1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_order_history/queries/v1"
+	"net/http"
+)
+
+type getOrderHistoryEndpoint struct {
+	*delivery.OrderEndpointBase
+}
+
+func NewGetOrderHistoryEndpoint(orderEndpointBase *delivery.OrderEndpointBase) *getOrderHistoryEndpoint {
+	return &getOrderHistoryEndpoint{orderEndpointBase}
+}
+
+func (ep *getOrderHistoryEndpoint) MapRoute() {
+	ep.OrdersGroup.GET("/:id/history", ep.handler())
+}
+
+// Get Order History
+// @Tags Orders
+// @Summary Get order history
+// @Description Get the events of an order with the changes they made, oldest first
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param getOrderHistoryRequestDto query dtos.GetOrderHistoryRequestDto false "GetOrderHistoryRequestDto"
+// @Success 200 {object} dtos.GetOrderHistoryResponseDto
+// @Router /api/v1/orders/{id}/history [get]
+func (ep *getOrderHistoryEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.GetOrderHistoryHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "getOrderHistoryEndpoint.handler")
+		defer span.Finish()
+
+		listQuery, err := utils.GetListQueryFromCtx(c)
+		if err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[getOrderHistoryEndpoint_handler.GetListQueryFromCtx] error in getting data from query string")
+			ep.Log.Errorf(fmt.Sprintf("[getOrderHistoryEndpoint_handler.GetListQueryFromCtx] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		request := &dtos.GetOrderHistoryRequestDto{ListQuery: listQuery}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[getOrderHistoryEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[getOrderHistoryEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		query := v1.NewGetOrderHistory(request.OrderId, request.ListQuery)
+		if err := ep.Validator.StructCtx(ctx, query); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[getOrderHistoryEndpoint_handler.StructCtx] query validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[getOrderHistoryEndpoint_handler.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		queryResult, err := mediatr.Send[*v1.GetOrderHistory, *dtos.GetOrderHistoryResponseDto](ctx, query)
+		if err != nil {
+			err = errors.WithMessage(err, "[getOrderHistoryEndpoint_handler.Send] error in sending GetOrderHistory")
+			ep.Log.Errorw(fmt.Sprintf("[getOrderHistoryEndpoint_handler.Send] id: {%s}, err: %v", query.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"OrderId": query.OrderId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, queryResult)
+	}
+}
@@ -0,0 +1,15 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	uuid "github.com/satori/go.uuid"
+)
+
+type GetOrderHistory struct {
+	OrderId uuid.UUID `validate:"required"`
+	*utils.ListQuery
+}
+
+func NewGetOrderHistory(orderId uuid.UUID, query *utils.ListQuery) *GetOrderHistory {
+	return &GetOrderHistory{OrderId: orderId, ListQuery: query}
+}
@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/store"
+	streamName "github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/models/stream_name"
+	readPosition "github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/models/stream_position/read_position"
+	esErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/eventstroredb/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_order_history/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type GetOrderHistoryHandler struct {
+	log        logger.Logger
+	cfg        *config.Config
+	eventStore store.EventStore
+}
+
+func NewGetOrderHistoryHandler(log logger.Logger, cfg *config.Config, eventStore store.EventStore) *GetOrderHistoryHandler {
+	return &GetOrderHistoryHandler{log: log, cfg: cfg, eventStore: eventStore}
+}
+
+func (q *GetOrderHistoryHandler) Handle(ctx context.Context, query *GetOrderHistory) (*dtos.GetOrderHistoryResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetOrderHistoryHandler.Handle")
+	span.LogFields(log.String("OrderId", query.OrderId.String()))
+	span.LogFields(log.Object("Query", query))
+	defer span.Finish()
+
+	stream := streamName.ForID[*aggregate.Order](query.OrderId)
+
+	// the stream versions start from zero, so the version of the last event gives the number of the events
+	lastEvents, err := q.eventStore.ReadEventsBackwardsFromEnd(stream, 1, ctx)
+	if esErrors.IsStreamNotFoundError(err) {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, fmt.Sprintf("[GetOrderHistoryHandler_Handle.ReadEventsBackwardsFromEnd] order with id %s not found", query.OrderId)))
+	}
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetOrderHistoryHandler_Handle.ReadEventsBackwardsFromEnd] error in reading the last event of the order stream"))
+	}
+	if len(lastEvents) == 0 {
+		return nil, tracing.TraceWithErr(span, customErrors.NewNotFoundError(fmt.Sprintf("[GetOrderHistoryHandler_Handle] order with id %s not found", query.OrderId)))
+	}
+	totalEvents := lastEvents[0].Version + 1
+
+	history := make([]*dtos.OrderHistoryEventDto, 0, query.GetLimit())
+	offset := int64(query.GetOffset())
+
+	if offset < totalEvents {
+		// the changes of an event depend on the state before it, so the stream is replayed from the start up to the end of the page
+		streamEvents, err := q.eventStore.ReadEvents(stream, readPosition.Start, uint64(offset)+uint64(query.GetLimit()), ctx)
+		if err != nil {
+			return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetOrderHistoryHandler_Handle.ReadEvents] error in reading the order stream"))
+		}
+
+		order := &aggregate.Order{}
+		order.NewEmptyAggregate()
+		before := map[string]string{}
+
+		for _, streamEvent := range streamEvents {
+			if err := order.When(streamEvent.Event); err != nil {
+				return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, fmt.Sprintf("[GetOrderHistoryHandler_Handle.When] error in applying the event with version %d", streamEvent.Version)))
+			}
+			after := orderState(order)
+
+			if streamEvent.Version >= offset {
+				history = append(history, &dtos.OrderHistoryEventDto{
+					EventId:       streamEvent.EventID.String(),
+					EventType:     streamEvent.Event.GetEventType(),
+					Version:       streamEvent.Version,
+					OccurredOn:    streamEvent.Event.GetOccurredOn(),
+					CorrelationId: metadataValue(streamEvent.Metadata, core.CorrelationIdMetadataKey),
+					UserId:        metadataValue(streamEvent.Metadata, core.UserIdMetadataKey),
+					Changes:       orderChanges(before, after),
+				})
+			}
+
+			before = after
+		}
+	}
+
+	q.log.Infow(fmt.Sprintf("[GetOrderHistoryHandler.Handle] history of order with id: {%s} fetched", query.OrderId.String()), logger.Fields{"OrderId": query.OrderId})
+
+	return &dtos.GetOrderHistoryResponseDto{History: utils.NewListResult[*dtos.OrderHistoryEventDto](history, query.GetSize(), query.GetPage(), totalEvents)}, nil
+}
+
+func metadataValue(metadata core.Metadata, key string) string {
+	value, err := metadata.GetKey(key)
+	if err != nil {
+		return ""
+	}
+
+	return fmt.Sprint(value)
+}
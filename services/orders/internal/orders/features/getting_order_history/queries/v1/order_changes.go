@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/read_models"
+	uuid "github.com/satori/go.uuid"
+	"strings"
+	"time"
+)
+
+// orderStateFields are the fields of the order state in the order of the changes
+var orderStateFields = []string{"status", "accountEmail", "deliveryAddress", "deliveredTime", "shopItems", "coupon", "discount", "totalPrice", "paymentId", "cancelReason", "returns", "refundedAmount"}
+
+// orderState is the readable state of the order aggregate, keyed by orderStateFields
+func orderState(order *aggregate.Order) map[string]string {
+	var items []string
+	for _, item := range order.ShopItems() {
+		items = append(items, fmt.Sprintf("%s x%d (%s)", item.Title(), item.Quantity(), item.Price()))
+	}
+	var returns []string
+	for _, orderReturn := range order.Returns() {
+		returns = append(returns, fmt.Sprintf("%s %s (%s)", orderReturn.ReturnId(), orderReturn.Status(), orderReturn.RefundAmount()))
+	}
+
+	state := map[string]string{
+		"status":          orderStatus(order),
+		"accountEmail":    order.AccountEmail(),
+		"deliveryAddress": order.DeliveryAddress(),
+		"shopItems":       strings.Join(items, ", "),
+		"totalPrice":      order.TotalPrice().String(),
+		"cancelReason":    order.CancelReason(),
+		"returns":         strings.Join(returns, ", "),
+	}
+	if !order.DeliveredTime().IsZero() {
+		state["deliveredTime"] = order.DeliveredTime().Format(time.RFC3339)
+	}
+	if order.Coupon() != nil {
+		state["coupon"] = order.CouponCode()
+		state["discount"] = order.Discount().String()
+	}
+	if order.PaymentId() != uuid.Nil {
+		state["paymentId"] = order.PaymentId().String()
+	}
+	if order.RefundedAmount().IsPositive() {
+		state["refundedAmount"] = order.RefundedAmount().String()
+	}
+
+	return state
+}
+
+func orderStatus(order *aggregate.Order) string {
+	switch {
+	case order.Canceled():
+		return read_models.OrderCanceledStatus
+	case order.Completed():
+		return read_models.OrderCompletedStatus
+	case order.Paid():
+		return read_models.OrderPaidStatus
+	case order.Submitted():
+		return read_models.OrderSubmittedStatus
+	default:
+		return read_models.OrderCreatedStatus
+	}
+}
+
+// orderChanges describes the changes between two states of the order, e.g. `status: "submitted" -> "paid"`
+func orderChanges(before map[string]string, after map[string]string) []string {
+	changes := make([]string, 0)
+	for _, field := range orderStateFields {
+		if before[field] == after[field] {
+			continue
+		}
+
+		switch {
+		case before[field] == "":
+			changes = append(changes, fmt.Sprintf("%s: set to %q", field, after[field]))
+		case after[field] == "":
+			changes = append(changes, fmt.Sprintf("%s: %q removed", field, before[field]))
+		default:
+			changes = append(changes, fmt.Sprintf("%s: %q -> %q", field, before[field], after[field]))
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/mappings"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func Test_Order_Changes(t *testing.T) {
+	require.NoError(t, mappings.ConfigureMappings())
+
+	shopItems := []*value_objects.ShopItem{value_objects.CreateNewShopItem(uuid.NewV4(), "book", "a book", 2, domain.MustParseMoney("10", "USD"))}
+	order, err := aggregate.NewOrder(uuid.NewV4(), shopItems, "test@example.com", "test address", time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC), time.Now())
+	require.NoError(t, err)
+	require.NoError(t, order.UpdateShoppingCard([]*value_objects.ShopItem{value_objects.CreateNewShopItem(uuid.NewV4(), "pen", "a pen", 1, domain.MustParseMoney("1.5", "USD"))}, time.Now()))
+	require.NoError(t, order.Submit(time.Now()))
+	require.NoError(t, order.Cancel("changed my mind", time.Now()))
+
+	// replays the events like the history of the order stream
+	replayed := &aggregate.Order{}
+	replayed.NewEmptyAggregate()
+	before := map[string]string{}
+
+	var changes [][]string
+	for _, event := range order.UncommittedEvents() {
+		require.NoError(t, replayed.When(event))
+		after := orderState(replayed)
+		changes = append(changes, orderChanges(before, after))
+		before = after
+	}
+
+	assert.Equal(t, [][]string{
+		{
+			`status: set to "created"`,
+			`accountEmail: set to "test@example.com"`,
+			`deliveryAddress: set to "test address"`,
+			`deliveredTime: set to "2022-10-01T12:00:00Z"`,
+			`shopItems: set to "book x2 (10.00 USD)"`,
+			`totalPrice: set to "20.00 USD"`,
+		},
+		{
+			`shopItems: "book x2 (10.00 USD)" -> "pen x1 (1.50 USD)"`,
+			`totalPrice: "20.00 USD" -> "1.50 USD"`,
+		},
+		{`status: "created" -> "submitted"`},
+		{`status: "submitted" -> "canceled"`, `cancelReason: set to "changed my mind"`},
+	}, changes)
+}
@@ -45,7 +45,7 @@ func (ep *getOrdersEndpoint) handler() echo.HandlerFunc {
 		if err != nil {
 			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[getOrdersEndpoint_handler.GetListQueryFromCtx] error in getting data from query string")
 			ep.Log.Errorf(fmt.Sprintf("[getOrdersEndpoint_handler.GetListQueryFromCtx] err: %v", tracing.TraceWithErr(span, badRequestErr)))
-			return err
+			return badRequestErr
 		}
 
 		request := &dtos.GetOrdersRequestDto{ListQuery: listQuery}
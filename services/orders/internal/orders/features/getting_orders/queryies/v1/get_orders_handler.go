@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"emperror.dev/errors"
 	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
@@ -10,18 +11,19 @@ import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/repositories"
 	ordersDto "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/getting_orders/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/read_models"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/log"
 )
 
 type GetOrdersHandler struct {
-	log                      logger.Logger
-	cfg                      *config.Config
-	mongoOrderReadRepository repositories.OrderReadRepository
+	log                 logger.Logger
+	cfg                 *config.Config
+	orderReadRepository repositories.OrderReadRepository
 }
 
-func NewGetOrdersHandler(log logger.Logger, cfg *config.Config, mongoOrderReadRepository repositories.OrderReadRepository) *GetOrdersHandler {
-	return &GetOrdersHandler{log: log, cfg: cfg, mongoOrderReadRepository: mongoOrderReadRepository}
+func NewGetOrdersHandler(log logger.Logger, cfg *config.Config, orderReadRepository repositories.OrderReadRepository) *GetOrdersHandler {
+	return &GetOrdersHandler{log: log, cfg: cfg, orderReadRepository: orderReadRepository}
 }
 
 func (c *GetOrdersHandler) Handle(ctx context.Context, query *GetOrders) (*dtos.GetOrdersResponseDto, error) {
@@ -29,7 +31,12 @@ func (c *GetOrdersHandler) Handle(ctx context.Context, query *GetOrders) (*dtos.
 	span.LogFields(log.Object("Query", query))
 	defer span.Finish()
 
-	products, err := c.mongoOrderReadRepository.GetAllOrders(ctx, query.ListQuery)
+	// the validation errors keep their status, so an invalid filter or order by is returned as a bad request
+	if err := query.ListQuery.Validate(read_models.OrderQueryFields); err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[GetOrdersHandler_Handle.Validate] error in validating the list query"))
+	}
+
+	products, err := c.orderReadRepository.GetAllOrders(ctx, query.ListQuery)
 	if err != nil {
 		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetOrdersHandler_Handle.GetAllOrders] error in getting orders in the repository"))
 	}
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// IssueRefund records the refund of an approved return, RefundId is the id of the refund in the payments service
+type IssueRefund struct {
+	OrderId    uuid.UUID `validate:"required"`
+	ReturnId   uuid.UUID `validate:"required"`
+	RefundId   uuid.UUID `validate:"required"`
+	RefundedAt time.Time `validate:"required"`
+}
+
+func NewIssueRefund(orderId uuid.UUID, returnId uuid.UUID, refundId uuid.UUID) *IssueRefund {
+	return &IssueRefund{OrderId: orderId, ReturnId: returnId, RefundId: refundId, RefundedAt: time.Now()}
+}
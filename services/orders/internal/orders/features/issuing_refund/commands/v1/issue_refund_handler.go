@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/store"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type IssueRefundHandler struct {
+	log            logger.Logger
+	cfg            *config.Config
+	aggregateStore store.AggregateStore[*aggregate.Order]
+}
+
+func NewIssueRefundHandler(log logger.Logger, cfg *config.Config, aggregateStore store.AggregateStore[*aggregate.Order]) *IssueRefundHandler {
+	return &IssueRefundHandler{log: log, cfg: cfg, aggregateStore: aggregateStore}
+}
+
+func (c *IssueRefundHandler) Handle(ctx context.Context, command *IssueRefund) (*dtos.IssueRefundResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "IssueRefundHandler.Handle")
+	span.LogFields(log.String("OrderId", command.OrderId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	order, err := c.aggregateStore.Load(ctx, command.OrderId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[IssueRefundHandler_Handle.Load] error in loading order aggregate"))
+	}
+
+	err = order.IssueRefund(command.ReturnId, command.RefundId, command.RefundedAt)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[IssueRefundHandler_Handle.IssueRefund] error in issuing refund"))
+	}
+
+	_, err = c.aggregateStore.Store(order, core.MetadataFromContext(ctx), ctx)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[IssueRefundHandler_Handle.Store] error in storing order aggregate"))
+	}
+
+	response := &dtos.IssueRefundResponseDto{OrderId: order.Id(), ReturnId: command.ReturnId}
+	span.LogFields(log.Object("IssueRefundResponseDto", response))
+
+	c.log.Infow(fmt.Sprintf("[IssueRefundHandler.Handle] refund of return with id: {%s} of order with id: {%s} issued", command.ReturnId, command.OrderId), logger.Fields{"OrderId": command.OrderId, "ReturnId": command.ReturnId})
+
+	return response, nil
+}
@@ -0,0 +1,10 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// IssueRefundRequestDto validation will handle in command level
+type IssueRefundRequestDto struct {
+	OrderId  uuid.UUID `param:"id" json:"-"`
+	ReturnId uuid.UUID `param:"returnId" json:"-"`
+	RefundId uuid.UUID `json:"refundId"`
+}
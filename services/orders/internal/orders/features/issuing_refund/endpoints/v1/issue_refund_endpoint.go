@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	issuingRefundV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/dtos"
+	"net/http"
+)
+
+type issueRefundEndpoint struct {
+	*delivery.OrderEndpointBase
+}
+
+func NewIssueRefundEndpoint(endpointBase *delivery.OrderEndpointBase) *issueRefundEndpoint {
+	return &issueRefundEndpoint{endpointBase}
+}
+
+func (ep *issueRefundEndpoint) MapRoute() {
+	ep.OrdersGroup.POST("/:id/returns/:returnId/refund", ep.handler())
+}
+
+// Issue Refund
+// @Tags Orders
+// @Summary Issue refund
+// @Description Record the refund of an approved return of an order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param returnId path string true "Return ID"
+// @Param IssueRefundRequestDto body dtos.IssueRefundRequestDto true "Refund data"
+// @Success 200 {object} dtos.IssueRefundResponseDto
+// @Router /api/v1/orders/{id}/returns/{returnId}/refund [post]
+func (ep *issueRefundEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.IssueRefundHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "issueRefundEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.IssueRefundRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[issueRefundEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[issueRefundEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := issuingRefundV1.NewIssueRefund(request.OrderId, request.ReturnId, request.RefundId)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[issueRefundEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[issueRefundEndpoint_handler.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*issuingRefundV1.IssueRefund, *dtos.IssueRefundResponseDto](ctx, command)
+
+		if err != nil {
+			err = errors.WithMessage(err, "[issueRefundEndpoint_handler.Send] error in sending IssueRefund")
+			ep.Log.Errorw(fmt.Sprintf("[issueRefundEndpoint_handler.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type RefundIssuedV1 struct {
+	*domain.DomainEvent
+	OrderId    uuid.UUID `json:"orderId" bson:"orderId,omitempty"`
+	ReturnId   uuid.UUID `json:"returnId" bson:"returnId,omitempty"`
+	RefundId   uuid.UUID `json:"refundId" bson:"refundId,omitempty"`
+	RefundedAt time.Time `json:"refundedAt" bson:"refundedAt,omitempty"`
+}
+
+func NewRefundIssuedV1(orderId uuid.UUID, returnId uuid.UUID, refundId uuid.UUID, refundedAt time.Time) (*RefundIssuedV1, error) {
+	if orderId == uuid.Nil {
+		return nil, customErrors.NewDomainError("orderId is invalid")
+	}
+
+	if returnId == uuid.Nil {
+		return nil, customErrors.NewDomainError("returnId is invalid")
+	}
+
+	if refundId == uuid.Nil {
+		return nil, customErrors.NewDomainError("refundId is invalid")
+	}
+
+	if refundedAt.IsZero() {
+		return nil, customErrors.NewDomainError("refundedAt can't be zero")
+	}
+
+	eventData := &RefundIssuedV1{OrderId: orderId, ReturnId: returnId, RefundId: refundId, RefundedAt: refundedAt}
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
+
+	return eventData, nil
+}
@@ -0,0 +1,16 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	uuid "github.com/satori/go.uuid"
+)
+
+type RefundIssuedV1 struct {
+	*types.Message
+	*dtos.OrderReadDto
+}
+
+func NewRefundIssuedV1(orderReadDto *dtos.OrderReadDto) *RefundIssuedV1 {
+	return &RefundIssuedV1{OrderReadDto: orderReadDto, Message: types.NewMessage(uuid.NewV4().String())}
+}
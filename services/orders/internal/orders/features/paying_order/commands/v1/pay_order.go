@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type PayOrder struct {
+	OrderId   uuid.UUID `validate:"required"`
+	PaymentId uuid.UUID `validate:"required"`
+	// Amount is the captured amount of the payment, it should be the total price of the order
+	Amount domain.Money
+	PaidAt time.Time `validate:"required"`
+}
+
+func NewPayOrder(orderId uuid.UUID, paymentId uuid.UUID, amount domain.Money) *PayOrder {
+	return &PayOrder{OrderId: orderId, PaymentId: paymentId, Amount: amount, PaidAt: time.Now()}
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/store"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type PayOrderHandler struct {
+	log            logger.Logger
+	cfg            *config.Config
+	aggregateStore store.AggregateStore[*aggregate.Order]
+}
+
+func NewPayOrderHandler(log logger.Logger, cfg *config.Config, aggregateStore store.AggregateStore[*aggregate.Order]) *PayOrderHandler {
+	return &PayOrderHandler{log: log, cfg: cfg, aggregateStore: aggregateStore}
+}
+
+func (c *PayOrderHandler) Handle(ctx context.Context, command *PayOrder) (*dtos.PayOrderResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PayOrderHandler.Handle")
+	span.LogFields(log.String("OrderId", command.OrderId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	order, err := c.aggregateStore.Load(ctx, command.OrderId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[PayOrderHandler_Handle.Load] error in loading order aggregate"))
+	}
+
+	// the payments service republishes the event of a retried capture, the order which is already paid by the payment is not changed
+	if order.Paid() && order.PaymentId() == command.PaymentId {
+		c.log.Infow(fmt.Sprintf("[PayOrderHandler.Handle] order with id: {%s} is already paid by payment '%s'", command.OrderId, command.PaymentId), logger.Fields{"OrderId": command.OrderId, "PaymentId": command.PaymentId})
+		return &dtos.PayOrderResponseDto{OrderId: order.Id()}, nil
+	}
+
+	err = order.Pay(command.PaymentId, command.Amount, command.PaidAt)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[PayOrderHandler_Handle.Pay] error in paying order"))
+	}
+
+	_, err = c.aggregateStore.Store(order, core.MetadataFromContext(ctx), ctx)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[PayOrderHandler_Handle.Store] error in storing order aggregate"))
+	}
+
+	response := &dtos.PayOrderResponseDto{OrderId: order.Id()}
+	span.LogFields(log.Object("PayOrderResponseDto", response))
+
+	c.log.Infow(fmt.Sprintf("[PayOrderHandler.Handle] order with id: {%s} paid", command.OrderId), logger.Fields{"OrderId": command.OrderId})
+
+	return response, nil
+}
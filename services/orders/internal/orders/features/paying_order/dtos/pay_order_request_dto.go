@@ -0,0 +1,13 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+// PayOrderRequestDto validation will handle in command level
+type PayOrderRequestDto struct {
+	OrderId   uuid.UUID    `param:"id" json:"-"`
+	PaymentId uuid.UUID    `json:"paymentId"`
+	Amount    domain.Money `json:"amount"`
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	payingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/dtos"
+	"net/http"
+)
+
+type payOrderEndpoint struct {
+	*delivery.OrderEndpointBase
+}
+
+func NewPayOrderEndpoint(endpointBase *delivery.OrderEndpointBase) *payOrderEndpoint {
+	return &payOrderEndpoint{endpointBase}
+}
+
+func (ep *payOrderEndpoint) MapRoute() {
+	ep.OrdersGroup.POST("/:id/pay", ep.handler())
+}
+
+// Pay Order
+// @Tags Orders
+// @Summary Pay order
+// @Description Pay an existing order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param PayOrderRequestDto body dtos.PayOrderRequestDto true "Payment ID and captured amount"
+// @Success 200 {object} dtos.PayOrderResponseDto
+// @Router /api/v1/orders/{id}/pay [post]
+func (ep *payOrderEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.PayOrderHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "payOrderEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.PayOrderRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[payOrderEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[payOrderEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := payingOrderV1.NewPayOrder(request.OrderId, request.PaymentId, request.Amount)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[payOrderEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[payOrderEndpoint_handler.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*payingOrderV1.PayOrder, *dtos.PayOrderResponseDto](ctx, command)
+
+		if err != nil {
+			err = errors.WithMessage(err, "[payOrderEndpoint_handler.Send] error in sending PayOrder")
+			ep.Log.Errorw(fmt.Sprintf("[payOrderEndpoint_handler.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type OrderPaidV1 struct {
+	*domain.DomainEvent
+	OrderId   uuid.UUID `json:"orderId" bson:"orderId,omitempty"`
+	PaymentId uuid.UUID `json:"paymentId" bson:"paymentId,omitempty"`
+	PaidAt    time.Time `json:"paidAt" bson:"paidAt,omitempty"`
+}
+
+func NewOrderPaidV1(orderId uuid.UUID, paymentId uuid.UUID, paidAt time.Time) (*OrderPaidV1, error) {
+	if orderId == uuid.Nil {
+		return nil, customErrors.NewDomainError("orderId is invalid")
+	}
+
+	if paymentId == uuid.Nil {
+		return nil, customErrors.NewDomainError("paymentId is invalid")
+	}
+
+	if paidAt.IsZero() {
+		return nil, customErrors.NewDomainError("paidAt can't be zero")
+	}
+
+	eventData := &OrderPaidV1{OrderId: orderId, PaymentId: paymentId, PaidAt: paidAt}
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
+
+	return eventData, nil
+}
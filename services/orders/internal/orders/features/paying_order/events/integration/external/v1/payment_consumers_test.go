@@ -0,0 +1,132 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/inmemory"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	cancelingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/commands/v1"
+	cancelingOrderDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/dtos"
+	cancelingOrderExternal "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/integration/external/v1"
+	payingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/commands/v1"
+	payingOrderDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/dtos"
+	payingOrderExternal "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/integration/external/v1"
+	paidIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/integration/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/test_fixtures/integration"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Payment_Consumers(t *testing.T) {
+	test.SkipCI(t)
+	fixture := integration.NewIntegrationTestFixture()
+	defer fixture.Cleanup()
+
+	err := mediatr.RegisterRequestHandler[*payingOrderV1.PayOrder, *payingOrderDtos.PayOrderResponseDto](payingOrderV1.NewPayOrderHandler(fixture.Log, fixture.Cfg, fixture.OrderAggregateStore))
+	require.NoError(t, err)
+	err = mediatr.RegisterRequestHandler[*cancelingOrderV1.CancelOrder, *cancelingOrderDtos.CancelOrderResponseDto](cancelingOrderV1.NewCancelOrderHandler(fixture.Log, fixture.Cfg, fixture.OrderAggregateStore))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	transport := inmemory.NewInMemoryTransport()
+	producer := inmemory.NewInMemoryProducer(transport, fixture.EventSerializer, fixture.Log)
+	// the rejected payments are published by the consumer to the in memory transport
+	fixture.Producer = producer
+	consumerBase := delivery.NewOrderConsumersBase(fixture.InfrastructureConfiguration)
+
+	succeededConsumer := inmemory.NewInMemoryConsumer[*payingOrderExternal.PaymentSucceededV1](transport, payingOrderExternal.NewPaymentSucceededConsumer(consumerBase), fixture.EventSerializer, fixture.Log)
+	require.NoError(t, succeededConsumer.Consume(ctx))
+	failedConsumer := inmemory.NewInMemoryConsumer[*cancelingOrderExternal.PaymentFailedV1](transport, cancelingOrderExternal.NewPaymentFailedConsumer(consumerBase), fixture.EventSerializer, fixture.Log)
+	require.NoError(t, failedConsumer.Consume(ctx))
+	paymentRejected := &paymentRejectedHandler{}
+	require.NoError(t, inmemory.NewInMemoryConsumer[*paidIntegration.PaymentRejectedV1](transport, paymentRejected, fixture.EventSerializer, fixture.Log).Consume(ctx))
+
+	newSubmittedOrder := func() *aggregate.Order {
+		shopItems := []*value_objects.ShopItem{value_objects.CreateNewShopItem(uuid.NewV4(), gofakeit.Name(), gofakeit.AdjectiveDescriptive(), 1, domain.MustParseMoney("25", "USD"))}
+		order, err := aggregate.NewOrder(uuid.NewV4(), shopItems, gofakeit.Email(), gofakeit.Address().Address, time.Now(), time.Now())
+		require.NoError(t, err)
+		require.NoError(t, order.Submit(time.Now()))
+		_, err = fixture.OrderAggregateStore.Store(order, core.MetadataFromContext(ctx), ctx)
+		require.NoError(t, err)
+
+		return order
+	}
+
+	t.Run("succeeded payment pays the order", func(t *testing.T) {
+		order := newSubmittedOrder()
+		paymentId := uuid.NewV4()
+
+		event := &payingOrderExternal.PaymentSucceededV1{Message: types.NewMessage(uuid.NewV4().String()), PaymentId: paymentId.String(), OrderId: order.Id().String(), Amount: order.TotalPrice()}
+		require.NoError(t, producer.Publish(ctx, event, nil))
+		// a retried capture republishes the event, the duplicate is acknowledged without changing the order
+		require.NoError(t, producer.Publish(ctx, event, nil))
+
+		paidOrder, err := fixture.OrderAggregateStore.Load(ctx, order.Id())
+		require.NoError(t, err)
+		assert.True(t, paidOrder.Paid())
+		assert.Equal(t, paymentId, paidOrder.PaymentId())
+	})
+
+	t.Run("payment of another amount is rejected", func(t *testing.T) {
+		order := newSubmittedOrder()
+		paymentId := uuid.NewV4()
+
+		err := producer.Publish(ctx, &payingOrderExternal.PaymentSucceededV1{Message: types.NewMessage(uuid.NewV4().String()), PaymentId: paymentId.String(), OrderId: order.Id().String(), Amount: domain.MustParseMoney("1", "USD")}, nil)
+		require.NoError(t, err)
+
+		unpaidOrder, err := fixture.OrderAggregateStore.Load(ctx, order.Id())
+		require.NoError(t, err)
+		assert.False(t, unpaidOrder.Paid())
+
+		require.NotEmpty(t, paymentRejected.messages)
+		rejected := paymentRejected.messages[len(paymentRejected.messages)-1]
+		assert.Equal(t, paymentId, rejected.PaymentId)
+		assert.Equal(t, domain.MustParseMoney("1", "USD"), rejected.Amount)
+	})
+
+	t.Run("payment of a canceled order is rejected", func(t *testing.T) {
+		order := newSubmittedOrder()
+		require.NoError(t, order.Cancel("changed my mind", time.Now()))
+		_, err := fixture.OrderAggregateStore.Store(order, core.MetadataFromContext(ctx), ctx)
+		require.NoError(t, err)
+		paymentId := uuid.NewV4()
+
+		err = producer.Publish(ctx, &payingOrderExternal.PaymentSucceededV1{Message: types.NewMessage(uuid.NewV4().String()), PaymentId: paymentId.String(), OrderId: order.Id().String(), Amount: order.TotalPrice()}, nil)
+		require.NoError(t, err)
+
+		require.NotEmpty(t, paymentRejected.messages)
+		assert.Equal(t, paymentId, paymentRejected.messages[len(paymentRejected.messages)-1].PaymentId)
+	})
+
+	t.Run("failed payment cancels the order", func(t *testing.T) {
+		order := newSubmittedOrder()
+
+		err := producer.Publish(ctx, &cancelingOrderExternal.PaymentFailedV1{Message: types.NewMessage(uuid.NewV4().String()), PaymentId: uuid.NewV4().String(), OrderId: order.Id().String(), Reason: "card declined"}, nil)
+		require.NoError(t, err)
+
+		canceledOrder, err := fixture.OrderAggregateStore.Load(ctx, order.Id())
+		require.NoError(t, err)
+		assert.True(t, canceledOrder.Canceled())
+		assert.Equal(t, "payment failed: card declined", canceledOrder.CancelReason())
+	})
+}
+
+type paymentRejectedHandler struct {
+	messages []*paidIntegration.PaymentRejectedV1
+}
+
+func (h *paymentRejectedHandler) Handle(ctx context.Context, consumeContext types.IMessageConsumeContext[*paidIntegration.PaymentRejectedV1]) error {
+	h.messages = append(h.messages, consumeContext.Message())
+	return nil
+}
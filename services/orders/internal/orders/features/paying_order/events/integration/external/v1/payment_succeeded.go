@@ -0,0 +1,14 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+)
+
+// PaymentSucceededV1 is published by the payments service when the payment of an order is captured, Amount is the captured amount
+type PaymentSucceededV1 struct {
+	*types.Message
+	PaymentId string       `json:"paymentId"`
+	OrderId   string       `json:"orderId"`
+	Amount    domain.Money `json:"amount"`
+}
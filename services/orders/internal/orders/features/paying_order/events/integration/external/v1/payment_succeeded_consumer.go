@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	domainExceptions "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/exceptions/domain"
+	payingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/dtos"
+	paidIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/integration/v1"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+type paymentSucceededConsumer struct {
+	*delivery.OrderConsumersBase
+}
+
+func NewPaymentSucceededConsumer(orderConsumersBase *delivery.OrderConsumersBase) *paymentSucceededConsumer {
+	return &paymentSucceededConsumer{orderConsumersBase}
+}
+
+func (c *paymentSucceededConsumer) Handle(ctx context.Context, consumeContext types.IMessageConsumeContext[*PaymentSucceededV1]) error {
+	if consumeContext.Message() == nil {
+		return nil
+	}
+	span, ctx := opentracing.StartSpanFromContext(ctx, "paymentSucceededConsumer.Handle")
+	span.LogFields(log.Object("Message", consumeContext.Created()))
+	defer span.Finish()
+
+	message := consumeContext.Message()
+
+	orderId, err := uuid.FromString(message.OrderId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[paymentSucceededConsumer_Handle.uuid.FromString] error in the converting order uuid")
+		c.Log.Errorf(fmt.Sprintf("[paymentSucceededConsumer_Handle.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		c.CommitErrMessage()
+
+		return badRequestErr
+	}
+
+	paymentId, err := uuid.FromString(message.PaymentId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[paymentSucceededConsumer_Handle.uuid.FromString] error in the converting payment uuid")
+		c.Log.Errorf(fmt.Sprintf("[paymentSucceededConsumer_Handle.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		c.CommitErrMessage()
+
+		return badRequestErr
+	}
+
+	command := payingOrderV1.NewPayOrder(orderId, paymentId, message.Amount)
+	_, err = mediatr.Send[*payingOrderV1.PayOrder, *dtos.PayOrderResponseDto](ctx, command)
+	if domainExceptions.IsInvalidOrderStateError(err) || domainExceptions.IsPaymentAmountMismatchError(err) {
+		// the amount is already captured, so the payment which can't pay the order is refunded by the payments service instead of being dropped
+		paymentRejected := paidIntegration.NewPaymentRejectedV1(paymentId, orderId, message.Amount, err.Error())
+		if err := c.Producer.Publish(ctx, paymentRejected, nil); err != nil {
+			err = customErrors.NewApplicationErrorWrap(err, "[paymentSucceededConsumer_Handle.PublishMessage] error in publishing PaymentRejected integration event")
+			c.Log.Errorw(fmt.Sprintf("[paymentSucceededConsumer_Handle.PublishMessage] id: {%s}, err: {%v}", orderId, tracing.TraceWithErr(span, err)), logger.Fields{"OrderId": orderId, "PaymentId": paymentId})
+			c.CommitErrMessage()
+
+			return err
+		}
+		c.Log.Infow(fmt.Sprintf("[paymentSucceededConsumer_Handle.Send] payment '%s' of order '%s' is rejected, %v", paymentId, orderId, err), logger.Fields{"OrderId": orderId, "PaymentId": paymentId, "MessageId": paymentRejected.MessageId})
+		c.CommitMessage()
+
+		return nil
+	}
+	if err != nil {
+		err = errors.WithMessage(err, "[paymentSucceededConsumer_Handle.Send] error in sending PayOrder")
+		c.Log.Errorw(fmt.Sprintf("[paymentSucceededConsumer_Handle.Send] id: {%s}, err: {%v}", orderId, tracing.TraceWithErr(span, err)), logger.Fields{"OrderId": orderId, "PaymentId": paymentId})
+		c.CommitErrMessage()
+
+		return err
+	}
+	c.CommitMessage()
+
+	return nil
+}
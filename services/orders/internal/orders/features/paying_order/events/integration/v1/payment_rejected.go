@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// PaymentRejectedV1 is published when a captured payment can't pay its order, like a payment of a canceled order or a payment which its
+// amount is not the total price of the order. The payments service refunds the payment
+type PaymentRejectedV1 struct {
+	*types.Message
+	PaymentId uuid.UUID    `json:"paymentId"`
+	OrderId   uuid.UUID    `json:"orderId"`
+	Amount    domain.Money `json:"amount"`
+	Reason    string       `json:"reason"`
+}
+
+func NewPaymentRejectedV1(paymentId uuid.UUID, orderId uuid.UUID, amount domain.Money, reason string) *PaymentRejectedV1 {
+	return &PaymentRejectedV1{PaymentId: paymentId, OrderId: orderId, Amount: amount, Reason: reason, Message: types.NewMessage(uuid.NewV4().String())}
+}
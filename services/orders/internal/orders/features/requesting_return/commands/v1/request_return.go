@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type RequestReturn struct {
+	OrderId     uuid.UUID             `validate:"required"`
+	ReturnId    uuid.UUID             `validate:"required"`
+	Items       []*dtos.ReturnItemDto `validate:"required,dive"`
+	Reason      string                `validate:"required"`
+	RequestedAt time.Time             `validate:"required"`
+}
+
+func NewRequestReturn(orderId uuid.UUID, items []*dtos.ReturnItemDto, reason string) *RequestReturn {
+	return &RequestReturn{OrderId: orderId, ReturnId: uuid.NewV4(), Items: items, Reason: reason, RequestedAt: time.Now()}
+}
@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/store"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type RequestReturnHandler struct {
+	log            logger.Logger
+	cfg            *config.Config
+	aggregateStore store.AggregateStore[*aggregate.Order]
+}
+
+func NewRequestReturnHandler(log logger.Logger, cfg *config.Config, aggregateStore store.AggregateStore[*aggregate.Order]) *RequestReturnHandler {
+	return &RequestReturnHandler{log: log, cfg: cfg, aggregateStore: aggregateStore}
+}
+
+func (c *RequestReturnHandler) Handle(ctx context.Context, command *RequestReturn) (*dtos.RequestReturnResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RequestReturnHandler.Handle")
+	span.LogFields(log.String("OrderId", command.OrderId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	items, err := mapper.Map[[]*value_objects.ReturnItem](command.Items)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[RequestReturnHandler_Handle.Map] error in the mapping []ReturnItemDto to []ReturnItem"))
+	}
+
+	order, err := c.aggregateStore.Load(ctx, command.OrderId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[RequestReturnHandler_Handle.Load] error in loading order aggregate"))
+	}
+
+	err = order.RequestReturn(command.ReturnId, items, command.Reason, command.RequestedAt)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[RequestReturnHandler_Handle.RequestReturn] error in requesting return"))
+	}
+
+	_, err = c.aggregateStore.Store(order, core.MetadataFromContext(ctx), ctx)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[RequestReturnHandler_Handle.Store] error in storing order aggregate"))
+	}
+
+	response := &dtos.RequestReturnResponseDto{OrderId: order.Id(), ReturnId: command.ReturnId, RefundAmount: order.FindReturn(command.ReturnId).RefundAmount()}
+	span.LogFields(log.Object("RequestReturnResponseDto", response))
+
+	c.log.Infow(fmt.Sprintf("[RequestReturnHandler.Handle] return with id: {%s} requested for order with id: {%s}", command.ReturnId, command.OrderId), logger.Fields{"OrderId": command.OrderId, "ReturnId": command.ReturnId})
+
+	return response, nil
+}
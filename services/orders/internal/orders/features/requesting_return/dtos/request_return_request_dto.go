@@ -0,0 +1,13 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	uuid "github.com/satori/go.uuid"
+)
+
+// RequestReturnRequestDto validation will handle in command level
+type RequestReturnRequestDto struct {
+	OrderId uuid.UUID             `param:"id" json:"-"`
+	Items   []*dtos.ReturnItemDto `json:"items"`
+	Reason  string                `json:"reason"`
+}
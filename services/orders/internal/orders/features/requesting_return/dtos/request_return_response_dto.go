@@ -0,0 +1,12 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+type RequestReturnResponseDto struct {
+	OrderId      uuid.UUID    `json:"orderId"`
+	ReturnId     uuid.UUID    `json:"returnId"`
+	RefundAmount domain.Money `json:"refundAmount"`
+}
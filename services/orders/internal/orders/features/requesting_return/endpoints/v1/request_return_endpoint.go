@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	requestingReturnV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/dtos"
+	"net/http"
+)
+
+type requestReturnEndpoint struct {
+	*delivery.OrderEndpointBase
+}
+
+func NewRequestReturnEndpoint(endpointBase *delivery.OrderEndpointBase) *requestReturnEndpoint {
+	return &requestReturnEndpoint{endpointBase}
+}
+
+func (ep *requestReturnEndpoint) MapRoute() {
+	ep.OrdersGroup.POST("/:id/returns", ep.handler())
+}
+
+// Request Return
+// @Tags Orders
+// @Summary Request return
+// @Description Request a return of some items of a completed order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param RequestReturnRequestDto body dtos.RequestReturnRequestDto true "Return data"
+// @Success 201 {object} dtos.RequestReturnResponseDto
+// @Router /api/v1/orders/{id}/returns [post]
+func (ep *requestReturnEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.RequestReturnHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "requestReturnEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.RequestReturnRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[requestReturnEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[requestReturnEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := requestingReturnV1.NewRequestReturn(request.OrderId, request.Items, request.Reason)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[requestReturnEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[requestReturnEndpoint_handler.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*requestingReturnV1.RequestReturn, *dtos.RequestReturnResponseDto](ctx, command)
+
+		if err != nil {
+			err = errors.WithMessage(err, "[requestReturnEndpoint_handler.Send] error in sending RequestReturn")
+			ep.Log.Errorw(fmt.Sprintf("[requestReturnEndpoint_handler.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+			return err
+		}
+
+		return c.JSON(http.StatusCreated, result)
+	}
+}
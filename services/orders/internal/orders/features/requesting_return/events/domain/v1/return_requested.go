@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type ReturnRequestedV1 struct {
+	*domain.DomainEvent
+	OrderId      uuid.UUID             `json:"orderId" bson:"orderId,omitempty"`
+	ReturnId     uuid.UUID             `json:"returnId" bson:"returnId,omitempty"`
+	Items        []*dtos.ReturnItemDto `json:"items" bson:"items,omitempty"`
+	Reason       string                `json:"reason" bson:"reason,omitempty"`
+	RefundAmount domain.Money          `json:"refundAmount" bson:"refundAmount"`
+	RequestedAt  time.Time             `json:"requestedAt" bson:"requestedAt,omitempty"`
+}
+
+func NewReturnRequestedV1(orderId uuid.UUID, returnId uuid.UUID, items []*dtos.ReturnItemDto, reason string, refundAmount domain.Money, requestedAt time.Time) (*ReturnRequestedV1, error) {
+	if orderId == uuid.Nil {
+		return nil, customErrors.NewDomainError("orderId is invalid")
+	}
+
+	if returnId == uuid.Nil {
+		return nil, customErrors.NewDomainError("returnId is invalid")
+	}
+
+	if len(items) == 0 {
+		return nil, customErrors.NewDomainError("items are required")
+	}
+
+	if requestedAt.IsZero() {
+		return nil, customErrors.NewDomainError("requestedAt can't be zero")
+	}
+
+	eventData := &ReturnRequestedV1{OrderId: orderId, ReturnId: returnId, Items: items, Reason: reason, RefundAmount: refundAmount, RequestedAt: requestedAt}
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
+
+	return eventData, nil
+}
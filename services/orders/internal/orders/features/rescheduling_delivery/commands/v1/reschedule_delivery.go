@@ -0,0 +1,17 @@
+package v1
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// RescheduleDelivery changes the delivery time of the order, the reservation of a submitted order is moved to the slot of the new time
+type RescheduleDelivery struct {
+	OrderId       uuid.UUID `validate:"required"`
+	DeliveryTime  time.Time `validate:"required"`
+	RescheduledAt time.Time `validate:"required"`
+}
+
+func NewRescheduleDelivery(orderId uuid.UUID, deliveryTime time.Time) *RescheduleDelivery {
+	return &RescheduleDelivery{OrderId: orderId, DeliveryTime: deliveryTime, RescheduledAt: time.Now()}
+}
@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/store"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	deliverySlotContracts "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/contracts"
+	deliverySlotModels "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/models"
+	domainExceptions "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/exceptions/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/rescheduling_delivery/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+type RescheduleDeliveryHandler struct {
+	log                    logger.Logger
+	cfg                    *config.Config
+	aggregateStore         store.AggregateStore[*aggregate.Order]
+	deliverySchedule       *deliverySlotModels.DeliverySchedule
+	deliverySlotRepository deliverySlotContracts.DeliverySlotRepository
+}
+
+func NewRescheduleDeliveryHandler(log logger.Logger, cfg *config.Config, aggregateStore store.AggregateStore[*aggregate.Order], deliverySchedule *deliverySlotModels.DeliverySchedule, deliverySlotRepository deliverySlotContracts.DeliverySlotRepository) *RescheduleDeliveryHandler {
+	return &RescheduleDeliveryHandler{log: log, cfg: cfg, aggregateStore: aggregateStore, deliverySchedule: deliverySchedule, deliverySlotRepository: deliverySlotRepository}
+}
+
+func (c *RescheduleDeliveryHandler) Handle(ctx context.Context, command *RescheduleDelivery) (*dtos.RescheduleDeliveryResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RescheduleDeliveryHandler.Handle")
+	span.LogFields(log.String("OrderId", command.OrderId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	order, err := c.aggregateStore.Load(ctx, command.OrderId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[RescheduleDeliveryHandler_Handle.Load] error in loading order aggregate"))
+	}
+
+	err = order.RescheduleDelivery(command.DeliveryTime, command.RescheduledAt)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[RescheduleDeliveryHandler_Handle.RescheduleDelivery] error in rescheduling the order delivery"))
+	}
+
+	// an order which isn't submitted yet has no reservation, its slot is reserved by the submit
+	var previousSlot *deliverySlotModels.DeliverySlot
+	if order.Submitted() {
+		previousSlot, err = c.moveReservation(ctx, order, command)
+		if err != nil {
+			return nil, tracing.TraceWithErr(span, err)
+		}
+	}
+
+	_, err = c.aggregateStore.Store(order, core.MetadataFromContext(ctx), ctx)
+	if err != nil {
+		err = customErrors.NewApplicationErrorWrap(err, "[RescheduleDeliveryHandler_Handle.Store] error in storing order aggregate")
+		if previousSlot != nil {
+			err = errors.Combine(err, c.rollbackReservation(ctx, order.Id(), previousSlot))
+		}
+
+		return nil, tracing.TraceWithErr(span, err)
+	}
+
+	response := &dtos.RescheduleDeliveryResponseDto{OrderId: order.Id()}
+	span.LogFields(log.Object("RescheduleDeliveryResponseDto", response))
+
+	c.log.Infow(fmt.Sprintf("[RescheduleDeliveryHandler.Handle] delivery of order with id: {%s} rescheduled to %s", command.OrderId, command.DeliveryTime), logger.Fields{"OrderId": command.OrderId, "DeliveryTime": command.DeliveryTime})
+
+	return response, nil
+}
+
+// moveReservation moves the reservation of the order to the slot of the new delivery time in the same zone, it returns the previous slot
+func (c *RescheduleDeliveryHandler) moveReservation(ctx context.Context, order *aggregate.Order, command *RescheduleDelivery) (*deliverySlotModels.DeliverySlot, error) {
+	reservation, err := c.deliverySlotRepository.GetReservation(ctx, order.Id())
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(err, "[RescheduleDeliveryHandler_moveReservation.GetReservation] error in getting the delivery slot reservation of order")
+	}
+	if reservation == nil {
+		return nil, domainExceptions.NewDeliverySlotUnavailableError(fmt.Sprintf("[RescheduleDeliveryHandler_moveReservation.GetReservation] order with id %s has no delivery slot reservation", order.Id()))
+	}
+
+	previousSlot, err := c.deliverySchedule.Slot(reservation.Zone, reservation.SlotStartsAt)
+	if err != nil {
+		return nil, errors.WithMessage(err, "[RescheduleDeliveryHandler_moveReservation.Slot] error in finding the reserved delivery slot of order")
+	}
+
+	slot, err := c.deliverySchedule.BookableSlot(reservation.Zone, command.DeliveryTime, command.RescheduledAt)
+	if err != nil {
+		return nil, errors.WithMessage(err, "[RescheduleDeliveryHandler_moveReservation.BookableSlot] error in finding the delivery slot of order")
+	}
+
+	err = c.deliverySlotRepository.Reschedule(ctx, order.Id(), slot)
+	if err != nil {
+		return nil, errors.WithMessage(err, "[RescheduleDeliveryHandler_moveReservation.Reschedule] error in rescheduling the delivery slot of order")
+	}
+
+	return previousSlot, nil
+}
+
+// rollbackReservation moves the reservation of the order back to its previous slot after the order isn't stored. When the move fails the order
+// is marked for reconciliation, so the reconcile worker moves its reservation to the slot of the stored order
+func (c *RescheduleDeliveryHandler) rollbackReservation(ctx context.Context, orderId uuid.UUID, previousSlot *deliverySlotModels.DeliverySlot) error {
+	rollbackErr := c.deliverySlotRepository.Reschedule(ctx, orderId, previousSlot)
+	if rollbackErr == nil {
+		return nil
+	}
+	rollbackErr = errors.WithMessage(rollbackErr, "[RescheduleDeliveryHandler_rollbackReservation.Reschedule] error in moving back the delivery slot of order")
+
+	// the request context may be the cause of the failures, the mark outlives it
+	markErr := c.deliverySlotRepository.MarkForReconciliation(context.Background(), orderId, fmt.Sprintf("rollback of the rescheduled delivery slot failed: %v", rollbackErr))
+	if markErr != nil {
+		return errors.Combine(rollbackErr, errors.WithMessage(markErr, "[RescheduleDeliveryHandler_rollbackReservation.MarkForReconciliation] error in marking the delivery slot of order for reconciliation"))
+	}
+
+	return rollbackErr
+}
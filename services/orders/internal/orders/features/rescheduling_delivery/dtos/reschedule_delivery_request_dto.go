@@ -0,0 +1,12 @@
+package dtos
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// RescheduleDeliveryRequestDto validation will handle in command level
+type RescheduleDeliveryRequestDto struct {
+	OrderId      uuid.UUID `param:"id" json:"-"`
+	DeliveryTime time.Time `json:"deliveryTime"`
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	reschedulingDeliveryV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/rescheduling_delivery/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/rescheduling_delivery/dtos"
+	"net/http"
+)
+
+type rescheduleDeliveryEndpoint struct {
+	*delivery.OrderEndpointBase
+}
+
+func NewRescheduleDeliveryEndpoint(endpointBase *delivery.OrderEndpointBase) *rescheduleDeliveryEndpoint {
+	return &rescheduleDeliveryEndpoint{endpointBase}
+}
+
+func (ep *rescheduleDeliveryEndpoint) MapRoute() {
+	ep.OrdersGroup.POST("/:id/delivery/reschedule", ep.handler())
+}
+
+// Reschedule Delivery
+// @Tags Orders
+// @Summary Reschedule delivery
+// @Description Change the delivery time of an order, the delivery slot of a submitted order is moved to the slot of the new time in its zone
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param RescheduleDeliveryRequestDto body dtos.RescheduleDeliveryRequestDto true "Delivery data"
+// @Success 200 {object} dtos.RescheduleDeliveryResponseDto
+// @Router /api/v1/orders/{id}/delivery/reschedule [post]
+func (ep *rescheduleDeliveryEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.RescheduleDeliveryHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "rescheduleDeliveryEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.RescheduleDeliveryRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[rescheduleDeliveryEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[rescheduleDeliveryEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := reschedulingDeliveryV1.NewRescheduleDelivery(request.OrderId, request.DeliveryTime)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[rescheduleDeliveryEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[rescheduleDeliveryEndpoint_handler.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*reschedulingDeliveryV1.RescheduleDelivery, *dtos.RescheduleDeliveryResponseDto](ctx, command)
+
+		if err != nil {
+			err = errors.WithMessage(err, "[rescheduleDeliveryEndpoint_handler.Send] error in sending RescheduleDelivery")
+			ep.Log.Errorw(fmt.Sprintf("[rescheduleDeliveryEndpoint_handler.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type DeliveryRescheduledV1 struct {
+	*domain.DomainEvent
+	OrderId       uuid.UUID `json:"orderId" bson:"orderId,omitempty"`
+	DeliveredTime time.Time `json:"deliveredTime" bson:"deliveredTime,omitempty"`
+	RescheduledAt time.Time `json:"rescheduledAt" bson:"rescheduledAt,omitempty"`
+}
+
+func NewDeliveryRescheduledV1(orderId uuid.UUID, deliveredTime time.Time, rescheduledAt time.Time) (*DeliveryRescheduledV1, error) {
+	if orderId == uuid.Nil {
+		return nil, customErrors.NewDomainError("orderId is invalid")
+	}
+
+	if deliveredTime.IsZero() {
+		return nil, customErrors.NewDomainError("deliveredTime can't be zero")
+	}
+
+	if rescheduledAt.IsZero() {
+		return nil, customErrors.NewDomainError("rescheduledAt can't be zero")
+	}
+
+	eventData := &DeliveryRescheduledV1{OrderId: orderId, DeliveredTime: deliveredTime, RescheduledAt: rescheduledAt}
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
+
+	return eventData, nil
+}
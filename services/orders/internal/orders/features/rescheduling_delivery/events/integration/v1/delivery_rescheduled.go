@@ -0,0 +1,16 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	uuid "github.com/satori/go.uuid"
+)
+
+type DeliveryRescheduledV1 struct {
+	*types.Message
+	*dtos.OrderReadDto
+}
+
+func NewDeliveryRescheduledV1(orderReadDto *dtos.OrderReadDto) *DeliveryRescheduledV1 {
+	return &DeliveryRescheduledV1{OrderReadDto: orderReadDto, Message: types.NewMessage(uuid.NewV4().String())}
+}
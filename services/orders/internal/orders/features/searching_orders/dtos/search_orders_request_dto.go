@@ -0,0 +1,8 @@
+package dtos
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+
+type SearchOrdersRequestDto struct {
+	SearchText       string `query:"search" json:"search"`
+	*utils.ListQuery `json:"listQuery"`
+}
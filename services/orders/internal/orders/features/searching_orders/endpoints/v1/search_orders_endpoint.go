@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/searching_orders/dtos"
+	v1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/searching_orders/queries/v1"
+	"net/http"
+)
+
+type searchOrdersEndpoint struct {
+	*delivery.OrderEndpointBase
+}
+
+func NewSearchOrdersEndpoint(orderEndpointBase *delivery.OrderEndpointBase) *searchOrdersEndpoint {
+	return &searchOrdersEndpoint{orderEndpointBase}
+}
+
+func (ep *searchOrdersEndpoint) MapRoute() {
+	ep.OrdersGroup.GET("/search", ep.handler())
+}
+
+// SearchOrders
+// @Tags Orders
+// @Summary Search orders
+// @Description Search orders by account email, delivery address and item titles
+// @Accept json
+// @Produce json
+// @Param searchOrdersRequestDto query dtos.SearchOrdersRequestDto false "SearchOrdersRequestDto"
+// @Success 200 {object} dtos.SearchOrdersResponseDto
+// @Router /api/v1/orders/search [get]
+func (ep *searchOrdersEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.SearchOrderHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "searchOrdersEndpoint.handler")
+		defer span.Finish()
+
+		listQuery, err := utils.GetListQueryFromCtx(c)
+		if err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[searchOrdersEndpoint_handler.GetListQueryFromCtx] error in getting data from query string")
+			ep.Log.Errorf(fmt.Sprintf("[searchOrdersEndpoint_handler.GetListQueryFromCtx] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		request := &dtos.SearchOrdersRequestDto{ListQuery: listQuery}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[searchOrdersEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[searchOrdersEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		query := v1.NewSearchOrders(request.SearchText, request.ListQuery)
+		if err := ep.Validator.StructCtx(ctx, query); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[searchOrdersEndpoint_handler.StructCtx] query validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[searchOrdersEndpoint_handler.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		queryResult, err := mediatr.Send[*v1.SearchOrders, *dtos.SearchOrdersResponseDto](ctx, query)
+		if err != nil {
+			err = errors.WithMessage(err, "[searchOrdersEndpoint_handler.Send] error in sending SearchOrders")
+			ep.Log.Error(fmt.Sprintf("[searchOrdersEndpoint_handler.Send] err: {%v}", tracing.TraceWithErr(span, err)))
+			return err
+		}
+
+		return c.JSON(http.StatusOK, queryResult)
+	}
+}
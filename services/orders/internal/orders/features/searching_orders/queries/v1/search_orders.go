@@ -0,0 +1,12 @@
+package v1
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+
+type SearchOrders struct {
+	SearchText string `validate:"required"`
+	*utils.ListQuery
+}
+
+func NewSearchOrders(searchText string, query *utils.ListQuery) *SearchOrders {
+	return &SearchOrders{SearchText: searchText, ListQuery: query}
+}
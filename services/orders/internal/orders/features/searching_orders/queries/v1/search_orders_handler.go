@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/repositories"
+	ordersDto "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/searching_orders/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/read_models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type SearchOrdersHandler struct {
+	log                 logger.Logger
+	cfg                 *config.Config
+	orderReadRepository repositories.OrderReadRepository
+}
+
+func NewSearchOrdersHandler(log logger.Logger, cfg *config.Config, orderReadRepository repositories.OrderReadRepository) *SearchOrdersHandler {
+	return &SearchOrdersHandler{log: log, cfg: cfg, orderReadRepository: orderReadRepository}
+}
+
+func (c *SearchOrdersHandler) Handle(ctx context.Context, query *SearchOrders) (*dtos.SearchOrdersResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SearchOrdersHandler.Handle")
+	span.LogFields(log.Object("Query", query))
+	defer span.Finish()
+
+	if err := query.ListQuery.Validate(read_models.OrderQueryFields); err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[SearchOrdersHandler_Handle.Validate] error in validating the list query"))
+	}
+
+	orders, err := c.orderReadRepository.SearchOrders(ctx, query.SearchText, query.ListQuery)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[SearchOrdersHandler_Handle.SearchOrders] error in searching orders in the repository"))
+	}
+
+	listResultDto, err := utils.ListResultToListResultDto[*ordersDto.OrderReadDto](orders)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[SearchOrdersHandler_Handle.ListResultToListResultDto] error in the mapping ListResultToListResultDto"))
+	}
+
+	c.log.Info("[SearchOrdersHandler.Handle] orders fetched")
+
+	return &dtos.SearchOrdersResponseDto{Orders: listResultDto}, nil
+}
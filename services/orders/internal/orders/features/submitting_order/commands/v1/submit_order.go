@@ -2,12 +2,16 @@ package v1
 
 import (
 	uuid "github.com/satori/go.uuid"
+	"time"
 )
 
+// SubmitOrder submits the order and reserves a place in the delivery slot of the zone which contains its delivery time
 type SubmitOrder struct {
-	OrderId uuid.UUID `validate:"required"`
+	OrderId      uuid.UUID `validate:"required"`
+	DeliveryZone string    `validate:"required"`
+	SubmittedAt  time.Time `validate:"required"`
 }
 
-func NewSubmitOrder(orderId uuid.UUID) *SubmitOrder {
-	return &SubmitOrder{OrderId: orderId}
+func NewSubmitOrder(orderId uuid.UUID, deliveryZone string) *SubmitOrder {
+	return &SubmitOrder{OrderId: orderId, DeliveryZone: deliveryZone, SubmittedAt: time.Now()}
 }
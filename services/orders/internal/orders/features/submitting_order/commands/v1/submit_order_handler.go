@@ -0,0 +1,107 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/store"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	deliverySlotContracts "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/contracts"
+	deliverySlotModels "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+type SubmitOrderHandler struct {
+	log                    logger.Logger
+	cfg                    *config.Config
+	aggregateStore         store.AggregateStore[*aggregate.Order]
+	deliverySchedule       *deliverySlotModels.DeliverySchedule
+	deliverySlotRepository deliverySlotContracts.DeliverySlotRepository
+}
+
+func NewSubmitOrderHandler(log logger.Logger, cfg *config.Config, aggregateStore store.AggregateStore[*aggregate.Order], deliverySchedule *deliverySlotModels.DeliverySchedule, deliverySlotRepository deliverySlotContracts.DeliverySlotRepository) *SubmitOrderHandler {
+	return &SubmitOrderHandler{log: log, cfg: cfg, aggregateStore: aggregateStore, deliverySchedule: deliverySchedule, deliverySlotRepository: deliverySlotRepository}
+}
+
+func (c *SubmitOrderHandler) Handle(ctx context.Context, command *SubmitOrder) (*dtos.SubmitOrderResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SubmitOrderHandler.Handle")
+	span.LogFields(log.String("OrderId", command.OrderId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	order, err := c.aggregateStore.Load(ctx, command.OrderId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[SubmitOrderHandler_Handle.Load] error in loading order aggregate"))
+	}
+
+	// the domain errors keep their status, so an invalid transition is returned as a conflict
+	err = order.Submit(command.SubmittedAt)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[SubmitOrderHandler_Handle.Submit] error in submitting order"))
+	}
+
+	slot, err := c.deliverySchedule.BookableSlot(command.DeliveryZone, order.DeliveredTime(), command.SubmittedAt)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[SubmitOrderHandler_Handle.BookableSlot] error in finding the delivery slot of order"))
+	}
+
+	// the slot is reserved before the event is stored, so an order is never submitted into a full slot
+	created, err := c.deliverySlotRepository.Reserve(ctx, order.Id(), slot)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[SubmitOrderHandler_Handle.Reserve] error in reserving the delivery slot of order"))
+	}
+
+	_, err = c.aggregateStore.Store(order, core.MetadataFromContext(ctx), ctx)
+	if err != nil {
+		err = customErrors.NewApplicationErrorWrap(err, "[SubmitOrderHandler_Handle.Store] error in storing order aggregate")
+		if created {
+			err = errors.Combine(err, c.releaseSlot(ctx, order.Id()))
+		}
+
+		return nil, tracing.TraceWithErr(span, err)
+	}
+
+	response := &dtos.SubmitOrderResponseDto{OrderId: order.Id()}
+	span.LogFields(log.Object("SubmitOrderResponseDto", response))
+
+	c.log.Infow(fmt.Sprintf("[SubmitOrderHandler.Handle] order with id: {%s} submitted for delivery in zone %s at %s", command.OrderId, slot.Zone, slot.StartsAt), logger.Fields{"OrderId": command.OrderId, "DeliveryZone": slot.Zone})
+
+	return response, nil
+}
+
+// releaseSlot compensates the reservation of a slot which is not followed by the submit of the order. a concurrent submit of the order may have
+// stored the order with the reservation of this command, so the reservation is kept when the stored order is submitted. When the compensation
+// fails the order is marked for reconciliation, so the reconcile worker releases its reservation by the stored order
+func (c *SubmitOrderHandler) releaseSlot(ctx context.Context, orderId uuid.UUID) error {
+	order, err := c.aggregateStore.Load(ctx, orderId)
+	if err != nil {
+		return c.markForReconciliation(orderId, errors.WithMessage(err, "[SubmitOrderHandler_releaseSlot.Load] error in loading order aggregate"))
+	}
+	if order.Submitted() {
+		return nil
+	}
+
+	if _, err := c.deliverySlotRepository.Release(ctx, orderId); err != nil {
+		return c.markForReconciliation(orderId, errors.WithMessage(err, "[SubmitOrderHandler_releaseSlot.Release] error in releasing the delivery slot of order"))
+	}
+
+	return nil
+}
+
+func (c *SubmitOrderHandler) markForReconciliation(orderId uuid.UUID, releaseErr error) error {
+	// the request context may be the cause of the failures, the mark outlives it
+	markErr := c.deliverySlotRepository.MarkForReconciliation(context.Background(), orderId, fmt.Sprintf("release of the delivery slot of the not submitted order failed: %v", releaseErr))
+	if markErr != nil {
+		return errors.Combine(releaseErr, errors.WithMessage(markErr, "[SubmitOrderHandler_markForReconciliation.MarkForReconciliation] error in marking the delivery slot of order for reconciliation"))
+	}
+
+	return releaseErr
+}
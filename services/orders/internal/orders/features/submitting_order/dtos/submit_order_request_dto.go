@@ -0,0 +1,9 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// SubmitOrderRequestDto validation will handle in command level
+type SubmitOrderRequestDto struct {
+	OrderId      uuid.UUID `param:"id" json:"-"`
+	DeliveryZone string    `json:"deliveryZone"`
+}
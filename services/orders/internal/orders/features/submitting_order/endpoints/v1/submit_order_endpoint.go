@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	submittingOrderV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/dtos"
+	"net/http"
+)
+
+type submitOrderEndpoint struct {
+	*delivery.OrderEndpointBase
+}
+
+func NewSubmitOrderEndpoint(endpointBase *delivery.OrderEndpointBase) *submitOrderEndpoint {
+	return &submitOrderEndpoint{endpointBase}
+}
+
+func (ep *submitOrderEndpoint) MapRoute() {
+	ep.OrdersGroup.POST("/:id/submit", ep.handler())
+}
+
+// Submit Order
+// @Tags Orders
+// @Summary Submit order
+// @Description Submit an existing order and reserve its delivery slot in the delivery zone
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param SubmitOrderRequestDto body dtos.SubmitOrderRequestDto true "Order delivery zone"
+// @Success 200 {object} dtos.SubmitOrderResponseDto
+// @Router /api/v1/orders/{id}/submit [post]
+func (ep *submitOrderEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.SubmitOrderHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "submitOrderEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.SubmitOrderRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[submitOrderEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[submitOrderEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := submittingOrderV1.NewSubmitOrder(request.OrderId, request.DeliveryZone)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[submitOrderEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[submitOrderEndpoint_handler.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*submittingOrderV1.SubmitOrder, *dtos.SubmitOrderResponseDto](ctx, command)
+
+		if err != nil {
+			err = errors.WithMessage(err, "[submitOrderEndpoint_handler.Send] error in sending SubmitOrder")
+			ep.Log.Errorw(fmt.Sprintf("[submitOrderEndpoint_handler.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
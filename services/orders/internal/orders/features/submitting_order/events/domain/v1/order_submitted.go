@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type OrderSubmittedV1 struct {
+	*domain.DomainEvent
+	OrderId     uuid.UUID `json:"orderId" bson:"orderId,omitempty"`
+	SubmittedAt time.Time `json:"submittedAt" bson:"submittedAt,omitempty"`
+}
+
+func NewOrderSubmittedV1(orderId uuid.UUID, submittedAt time.Time) (*OrderSubmittedV1, error) {
+	if orderId == uuid.Nil {
+		return nil, customErrors.NewDomainError("orderId is invalid")
+	}
+
+	if submittedAt.IsZero() {
+		return nil, customErrors.NewDomainError("submittedAt can't be zero")
+	}
+
+	eventData := &OrderSubmittedV1{OrderId: orderId, SubmittedAt: submittedAt}
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
+
+	return eventData, nil
+}
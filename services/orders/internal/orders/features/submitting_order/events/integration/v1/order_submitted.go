@@ -0,0 +1,16 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	uuid "github.com/satori/go.uuid"
+)
+
+type OrderSubmittedV1 struct {
+	*types.Message
+	*dtos.OrderReadDto
+}
+
+func NewOrderSubmittedV1(orderReadDto *dtos.OrderReadDto) *OrderSubmittedV1 {
+	return &OrderSubmittedV1{OrderReadDto: orderReadDto, Message: types.NewMessage(uuid.NewV4().String())}
+}
@@ -3,13 +3,15 @@ package v1
 import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
 	uuid "github.com/satori/go.uuid"
+	"time"
 )
 
 type UpdateShoppingCart struct {
 	OrderId   uuid.UUID           `validate:"required"`
-	ShopItems []*dtos.ShopItemDto `validate:"required"`
+	ShopItems []*dtos.ShopItemDto `validate:"required,min=1,dive"`
+	UpdatedAt time.Time           `validate:"required"`
 }
 
 func NewUpdateShoppingCart(orderId uuid.UUID, shopItems []*dtos.ShopItemDto) *UpdateShoppingCart {
-	return &UpdateShoppingCart{OrderId: orderId, ShopItems: shopItems}
+	return &UpdateShoppingCart{OrderId: orderId, ShopItems: shopItems, UpdatedAt: time.Now()}
 }
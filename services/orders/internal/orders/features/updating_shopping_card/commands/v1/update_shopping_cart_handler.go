@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/store"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/clients/catalogs"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/clients"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type UpdateShoppingCartHandler struct {
+	log            logger.Logger
+	cfg            *config.Config
+	aggregateStore store.AggregateStore[*aggregate.Order]
+	catalogClient  clients.CatalogClient
+}
+
+func NewUpdateShoppingCartHandler(log logger.Logger, cfg *config.Config, aggregateStore store.AggregateStore[*aggregate.Order], catalogClient clients.CatalogClient) *UpdateShoppingCartHandler {
+	return &UpdateShoppingCartHandler{log: log, cfg: cfg, aggregateStore: aggregateStore, catalogClient: catalogClient}
+}
+
+func (c *UpdateShoppingCartHandler) Handle(ctx context.Context, command *UpdateShoppingCart) (*dtos.UpdateShoppingCartResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UpdateShoppingCartHandler.Handle")
+	span.LogFields(log.String("OrderId", command.OrderId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	shopItems, err := catalogs.PriceShopItems(ctx, c.catalogClient, command.ShopItems)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[UpdateShoppingCartHandler_Handle.PriceShopItems] error in pricing shopItems with the catalog"))
+	}
+
+	order, err := c.aggregateStore.Load(ctx, command.OrderId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[UpdateShoppingCartHandler_Handle.Load] error in loading order aggregate"))
+	}
+
+	err = order.UpdateShoppingCard(shopItems, command.UpdatedAt)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[UpdateShoppingCartHandler_Handle.UpdateShoppingCard] error in updating order shopping cart"))
+	}
+
+	_, err = c.aggregateStore.Store(order, core.MetadataFromContext(ctx), ctx)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[UpdateShoppingCartHandler_Handle.Store] error in storing order aggregate"))
+	}
+
+	response := &dtos.UpdateShoppingCartResponseDto{OrderId: order.Id()}
+	span.LogFields(log.Object("UpdateShoppingCartResponseDto", response))
+
+	c.log.Infow(fmt.Sprintf("[UpdateShoppingCartHandler.Handle] shopping cart of order with id: {%s} updated", command.OrderId), logger.Fields{"OrderId": command.OrderId})
+
+	return response, nil
+}
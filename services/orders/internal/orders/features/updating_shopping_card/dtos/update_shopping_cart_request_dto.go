@@ -0,0 +1,12 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	uuid "github.com/satori/go.uuid"
+)
+
+// UpdateShoppingCartRequestDto validation will handle in command level
+type UpdateShoppingCartRequestDto struct {
+	OrderId   uuid.UUID           `param:"id" json:"-"`
+	ShopItems []*dtos.ShopItemDto `json:"shopItems"`
+}
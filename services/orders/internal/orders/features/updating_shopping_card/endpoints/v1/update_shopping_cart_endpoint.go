@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/delivery"
+	updatingShoppingCartV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/dtos"
+	"net/http"
+)
+
+type updateShoppingCartEndpoint struct {
+	*delivery.OrderEndpointBase
+}
+
+func NewUpdateShoppingCartEndpoint(endpointBase *delivery.OrderEndpointBase) *updateShoppingCartEndpoint {
+	return &updateShoppingCartEndpoint{endpointBase}
+}
+
+func (ep *updateShoppingCartEndpoint) MapRoute() {
+	ep.OrdersGroup.PUT("/:id/shopping-cart", ep.handler())
+}
+
+// Update Shopping Cart
+// @Tags Orders
+// @Summary Update order shopping cart
+// @Description Replace shop items of an existing order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param UpdateShoppingCartRequestDto body dtos.UpdateShoppingCartRequestDto true "Shop items"
+// @Success 200 {object} dtos.UpdateShoppingCartResponseDto
+// @Router /api/v1/orders/{id}/shopping-cart [put]
+func (ep *updateShoppingCartEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.UpdateOrderHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "updateShoppingCartEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.UpdateShoppingCartRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[updateShoppingCartEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[updateShoppingCartEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := updatingShoppingCartV1.NewUpdateShoppingCart(request.OrderId, request.ShopItems)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[updateShoppingCartEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[updateShoppingCartEndpoint_handler.StructCtx] err: %v", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*updatingShoppingCartV1.UpdateShoppingCart, *dtos.UpdateShoppingCartResponseDto](ctx, command)
+
+		if err != nil {
+			err = errors.WithMessage(err, "[updateShoppingCartEndpoint_handler.Send] error in sending UpdateShoppingCart")
+			ep.Log.Errorw(fmt.Sprintf("[updateShoppingCartEndpoint_handler.Send] id: {%s}, err: %v", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"Id": command.OrderId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	typeMapper "github.com/mehdihadeli/store-golang-microservice-sample/pkg/reflection/type_mappper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	domainExceptions "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/exceptions/domain"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type ShoppingCartUpdatedV1 struct {
+	*domain.DomainEvent
+	OrderId   uuid.UUID           `json:"orderId" bson:"orderId,omitempty"`
+	ShopItems []*dtos.ShopItemDto `json:"shopItems" bson:"shopItems,omitempty"`
+	UpdatedAt time.Time           `json:"updatedAt" bson:"updatedAt,omitempty"`
+}
+
+func NewShoppingCartUpdatedV1(orderId uuid.UUID, shopItems []*dtos.ShopItemDto, updatedAt time.Time) (*ShoppingCartUpdatedV1, error) {
+	if orderId == uuid.Nil {
+		return nil, customErrors.NewDomainError("orderId is invalid")
+	}
+
+	if len(shopItems) == 0 {
+		return nil, domainExceptions.NewOrderShopItemsRequiredError("shopItems is required")
+	}
+
+	if updatedAt.IsZero() {
+		return nil, customErrors.NewDomainError("updatedAt can't be zero")
+	}
+
+	eventData := &ShoppingCartUpdatedV1{OrderId: orderId, ShopItems: shopItems, UpdatedAt: updatedAt}
+	eventData.DomainEvent = domain.NewDomainEvent(typeMapper.GetTypeName(eventData))
+
+	return eventData, nil
+}
@@ -0,0 +1,14 @@
+package v1
+
+import uuid "github.com/satori/go.uuid"
+
+// WatchOrder streams the current state of the order and its following changes, the changes before the FromVersion are not sent,
+// so a client can resume the watching with the version after its last received change
+type WatchOrder struct {
+	OrderId     uuid.UUID `validate:"required"`
+	FromVersion int64     `validate:"gte=0"`
+}
+
+func NewWatchOrder(orderId uuid.UUID, fromVersion int64) *WatchOrder {
+	return &WatchOrder{OrderId: orderId, FromVersion: fromVersion}
+}
@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/repositories"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// WatchOrderHandler is not a mediatr handler, the changes are sent to the stream of the request until the stream is closed
+type WatchOrderHandler struct {
+	log                  logger.Logger
+	cfg                  *config.Config
+	orderMongoRepository repositories.OrderReadRepository
+	orderWatcher         contracts.OrderWatcher
+}
+
+func NewWatchOrderHandler(log logger.Logger, cfg *config.Config, orderMongoRepository repositories.OrderReadRepository, orderWatcher contracts.OrderWatcher) *WatchOrderHandler {
+	return &WatchOrderHandler{log: log, cfg: cfg, orderMongoRepository: orderMongoRepository, orderWatcher: orderWatcher}
+}
+
+// Handle sends the current read model of the order and then its changes, it returns without an error when the context is done or when the
+// watcher is dropped for lagging, then the client resumes from the version after its last received change
+func (w *WatchOrderHandler) Handle(ctx context.Context, query *WatchOrder, send func(change *contracts.OrderChange) error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WatchOrderHandler.Handle")
+	span.LogFields(log.String("OrderId", query.OrderId.String()))
+	span.LogFields(log.Object("Query", query))
+	defer span.Finish()
+
+	// the watching starts before loading the order, so a change which is projected in between is not lost
+	changes, stop := w.orderWatcher.Watch(query.OrderId)
+	defer stop()
+
+	order, err := w.orderMongoRepository.GetOrderByOrderId(ctx, query.OrderId)
+	if err != nil {
+		return tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, fmt.Sprintf("[WatchOrderHandler_Handle.GetOrderByOrderId] error in getting order with orderId %s in the mongo repository", query.OrderId)))
+	}
+	if order == nil {
+		return tracing.TraceWithErr(span, customErrors.NewNotFoundError(fmt.Sprintf("[WatchOrderHandler_Handle.GetOrderByOrderId] order with orderId %s not found", query.OrderId)))
+	}
+
+	lastVersion := query.FromVersion - 1
+	if order.Version > lastVersion {
+		if err := send(&contracts.OrderChange{Version: order.Version, Order: order}); err != nil {
+			return tracing.TraceWithErr(span, errors.WrapIf(err, "[WatchOrderHandler_Handle.send] error in sending the current order"))
+		}
+		lastVersion = order.Version
+	}
+
+	w.log.Infow(fmt.Sprintf("[WatchOrderHandler.Handle] watching order with orderId: {%s} from version %d", query.OrderId, lastVersion+1), logger.Fields{"OrderId": query.OrderId, "FromVersion": query.FromVersion})
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case change, ok := <-changes:
+			if !ok {
+				w.log.Infow(fmt.Sprintf("[WatchOrderHandler.Handle] watcher of order with orderId: {%s} is dropped at version %d", query.OrderId, lastVersion), logger.Fields{"OrderId": query.OrderId, "Version": lastVersion})
+				return nil
+			}
+			// the snapshot or an earlier change already contains this change
+			if change.Version <= lastVersion {
+				continue
+			}
+			if err := send(change); err != nil {
+				return tracing.TraceWithErr(span, errors.WrapIf(err, "[WatchOrderHandler_Handle.send] error in sending the order change"))
+			}
+			lastVersion = change.Version
+		}
+	}
+}
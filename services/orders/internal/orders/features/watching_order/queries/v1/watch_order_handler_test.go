@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/repositories"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/read_models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/watchers"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// watchedOrderReadRepository returns the projected order and notifies a change which is projected right after the order is loaded
+type watchedOrderReadRepository struct {
+	repositories.OrderReadRepository
+	order  *read_models.OrderReadModel
+	loaded func()
+}
+
+func (r *watchedOrderReadRepository) GetOrderByOrderId(ctx context.Context, orderId uuid.UUID) (*read_models.OrderReadModel, error) {
+	if r.loaded != nil {
+		r.loaded()
+	}
+	return r.order, nil
+}
+
+func Test_Watch_Order_Query_Handler_Sends_Order_And_Changes(t *testing.T) {
+	orderWatcher := watchers.NewInMemoryOrderWatcher()
+	orderId := uuid.NewV4()
+	repository := &watchedOrderReadRepository{order: &read_models.OrderReadModel{OrderId: orderId.String(), Version: 1}}
+	repository.loaded = func() {
+		// the change of the loaded order is skipped, the next changes are sent
+		orderWatcher.Notify(orderId, &contracts.OrderChange{Version: 1})
+		orderWatcher.Notify(orderId, &contracts.OrderChange{Version: 2, EventType: "OrderPaidV1"})
+	}
+	handler := NewWatchOrderHandler(defaultLogger.Logger, &config.Config{}, repository, orderWatcher)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var versions []int64
+	err := handler.Handle(ctx, NewWatchOrder(orderId, 0), func(change *contracts.OrderChange) error {
+		versions = append(versions, change.Version)
+		if change.Version == 2 {
+			cancel()
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, versions)
+	assert.False(t, orderWatcher.HasWatchers(orderId))
+}
+
+func Test_Watch_Order_Query_Handler_Resumes_From_Version(t *testing.T) {
+	orderWatcher := watchers.NewInMemoryOrderWatcher()
+	orderId := uuid.NewV4()
+	repository := &watchedOrderReadRepository{order: &read_models.OrderReadModel{OrderId: orderId.String(), Version: 3}}
+	handler := NewWatchOrderHandler(defaultLogger.Logger, &config.Config{}, repository, orderWatcher)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var versions []int64
+	err := handler.Handle(ctx, NewWatchOrder(orderId, 4), func(change *contracts.OrderChange) error {
+		versions = append(versions, change.Version)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+}
+
+func Test_Watch_Order_Query_Handler_Returns_Not_Found(t *testing.T) {
+	handler := NewWatchOrderHandler(defaultLogger.Logger, &config.Config{}, &watchedOrderReadRepository{}, watchers.NewInMemoryOrderWatcher())
+
+	err := handler.Handle(context.Background(), NewWatchOrder(uuid.NewV4(), 0), func(change *contracts.OrderChange) error {
+		return nil
+	})
+
+	assert.Error(t, err)
+}
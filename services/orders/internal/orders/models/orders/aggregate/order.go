@@ -3,6 +3,7 @@ package aggregate
 //https://www.eventstore.com/blog/what-is-event-sourcing
 
 import (
+	"fmt"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/errors"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/models"
@@ -12,8 +13,17 @@ import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/serializer/jsonSerializer"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
 	domainExceptions "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/exceptions/domain"
+	applyingCouponEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/events/domain/v1"
+	approvingReturnEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/approving_return/events/domain/v1"
+	cancelingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/domain/v1"
+	completingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/completing_order/events/domain/v1"
 	creatingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/events/domain/v1"
-	updatingShoppingCardEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/events/v1"
+	issuingRefundEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/events/domain/v1"
+	payingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/domain/v1"
+	requestingReturnEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/events/domain/v1"
+	reschedulingDeliveryEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/rescheduling_delivery/events/domain/v1"
+	submittingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/events/domain/v1"
+	updatingShoppingCardEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/events/domain/v1"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
 	uuid "github.com/satori/go.uuid"
 	"time"
@@ -25,7 +35,9 @@ type Order struct {
 	accountEmail    string
 	deliveryAddress string
 	cancelReason    string
-	totalPrice      float64
+	coupon          *value_objects.Coupon
+	returns         []*value_objects.OrderReturn
+	totalPrice      domain.Money
 	deliveredTime   time.Time
 	paid            bool
 	submitted       bool
@@ -51,6 +63,10 @@ func NewOrder(id uuid.UUID, shopItems []*value_objects.ShopItem, accountEmail, d
 		return nil, domainExceptions.NewOrderShopItemsRequiredError("[Order_NewOrder] order items is required")
 	}
 
+	if _, err := getShopItemsTotalPrice(shopItems); err != nil {
+		return nil, err
+	}
+
 	itemsDto, err := mapper.Map[[]*dtos.ShopItemDto](shopItems)
 	if err != nil {
 		return nil, customErrors.NewDomainErrorWrap(err, "[Order_NewOrder.Map] error in the mapping []ShopItems to []ShopItemsDto")
@@ -69,19 +85,280 @@ func NewOrder(id uuid.UUID, shopItems []*value_objects.ShopItem, accountEmail, d
 	return order, nil
 }
 
-func (o *Order) UpdateShoppingCard(shopItems []*value_objects.ShopItem) error {
+// UpdateShoppingCard replaces the shop items of the order, shopping cart of a submitted or a canceled order can't be changed
+func (o *Order) UpdateShoppingCard(shopItems []*value_objects.ShopItem, updatedAt time.Time) error {
+	if o.canceled {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is canceled and its shopping cart can't be updated", o.Id()))
+	}
+	if o.submitted {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is submitted and its shopping cart can't be updated", o.Id()))
+	}
 
-	event, err := updatingShoppingCardEvents.NewShoppingCartUpdatedV1(shopItems)
+	subtotal, err := getShopItemsTotalPrice(shopItems)
 	if err != nil {
 		return err
 	}
+	// the discount of an applied coupon is recalculated on the new items, which should be priced in the currency of the coupon
+	if o.coupon != nil {
+		if _, err := o.coupon.Discount(subtotal); err != nil {
+			return err
+		}
+	}
 
-	err = o.Apply(event, true)
+	itemsDto, err := mapper.Map[[]*dtos.ShopItemDto](shopItems)
 	if err != nil {
+		return customErrors.NewDomainErrorWrap(err, "[Order_UpdateShoppingCard.Map] error in the mapping []ShopItems to []ShopItemsDto")
+	}
+
+	event, err := updatingShoppingCardEvents.NewShoppingCartUpdatedV1(o.Id(), itemsDto, updatedAt)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(err, "[Order_UpdateShoppingCard.NewShoppingCartUpdatedV1] error in creating shopping cart updated event")
+	}
+
+	return o.Apply(event, true)
+}
+
+// ApplyCoupon applies a coupon to an order which is not submitted or canceled yet, an order can have only one coupon.
+// The usage limit of the coupon is checked by the caller, because the aggregate doesn't know the other orders of the customer
+func (o *Order) ApplyCoupon(coupon *value_objects.Coupon, appliedAt time.Time) error {
+	if o.canceled {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is canceled and a coupon can't be applied", o.Id()))
+	}
+	if o.submitted {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is submitted and a coupon can't be applied", o.Id()))
+	}
+	if o.coupon != nil {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s has already the coupon %s", o.Id(), o.coupon.Code()))
+	}
+
+	if err := coupon.CheckMinimumBasket(o.SubtotalPrice()); err != nil {
 		return err
 	}
 
-	return nil
+	couponDto, err := mapper.Map[*dtos.CouponDto](coupon)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(err, "[Order_ApplyCoupon.Map] error in the mapping Coupon to CouponDto")
+	}
+
+	event, err := applyingCouponEvents.NewCouponAppliedV1(o.Id(), couponDto, appliedAt)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(err, "[Order_ApplyCoupon.NewCouponAppliedV1] error in creating coupon applied event")
+	}
+
+	return o.Apply(event, true)
+}
+
+// Submit submits a created order, a canceled or an already submitted order can't be submitted
+func (o *Order) Submit(submittedAt time.Time) error {
+	if o.canceled {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is canceled and can't be submitted", o.Id()))
+	}
+	if o.submitted {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is already submitted", o.Id()))
+	}
+
+	event, err := submittingOrderEvents.NewOrderSubmittedV1(o.Id(), submittedAt)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(err, "[Order_Submit.NewOrderSubmittedV1] error in creating order submitted event")
+	}
+
+	return o.Apply(event, true)
+}
+
+// Pay pays a submitted order by the captured amount of a payment, an unsubmitted, a canceled or an already paid order can't be paid and the
+// amount should be the total price of the order
+func (o *Order) Pay(paymentId uuid.UUID, amount domain.Money, paidAt time.Time) error {
+	if o.canceled {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is canceled and can't be paid", o.Id()))
+	}
+	if !o.submitted {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is not submitted and can't be paid", o.Id()))
+	}
+	if o.paid {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is already paid", o.Id()))
+	}
+	amountCmp, err := amount.Compare(o.TotalPrice())
+	if err != nil || amountCmp != 0 {
+		return domainExceptions.NewPaymentAmountMismatchError(fmt.Sprintf("payment amount %s of order with id %s is not the total price %s", amount, o.Id(), o.TotalPrice()))
+	}
+
+	event, err := payingOrderEvents.NewOrderPaidV1(o.Id(), paymentId, paidAt)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(err, "[Order_Pay.NewOrderPaidV1] error in creating order paid event")
+	}
+
+	return o.Apply(event, true)
+}
+
+// Cancel cancels an order which is not completed yet, a completed or an already canceled order can't be canceled
+func (o *Order) Cancel(cancelReason string, canceledAt time.Time) error {
+	if o.completed {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is completed and can't be canceled", o.Id()))
+	}
+	if o.canceled {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is already canceled", o.Id()))
+	}
+
+	event, err := cancelingOrderEvents.NewOrderCanceledV1(o.Id(), cancelReason, canceledAt)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(err, "[Order_Cancel.NewOrderCanceledV1] error in creating order canceled event")
+	}
+
+	return o.Apply(event, true)
+}
+
+// CancelUnpaid cancels an order which is not paid yet, it is used by the cancellations which shouldn't cancel a paid order like an expired
+// stock reservation
+func (o *Order) CancelUnpaid(cancelReason string, canceledAt time.Time) error {
+	if o.paid {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is paid and can't be canceled", o.Id()))
+	}
+
+	return o.Cancel(cancelReason, canceledAt)
+}
+
+// Complete completes a paid order, an unpaid, a canceled or an already completed order can't be completed
+func (o *Order) Complete(completedAt time.Time) error {
+	if o.canceled {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is canceled and can't be completed", o.Id()))
+	}
+	if !o.paid {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is not paid and can't be completed", o.Id()))
+	}
+	if o.completed {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is already completed", o.Id()))
+	}
+
+	event, err := completingOrderEvents.NewOrderCompletedV1(o.Id(), completedAt)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(err, "[Order_Complete.NewOrderCompletedV1] error in creating order completed event")
+	}
+
+	return o.Apply(event, true)
+}
+
+// RescheduleDelivery changes the delivery time of an order which is not completed or canceled yet
+func (o *Order) RescheduleDelivery(deliveredTime time.Time, rescheduledAt time.Time) error {
+	if o.canceled {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is canceled and its delivery can't be rescheduled", o.Id()))
+	}
+	if o.completed {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is completed and its delivery can't be rescheduled", o.Id()))
+	}
+
+	event, err := reschedulingDeliveryEvents.NewDeliveryRescheduledV1(o.Id(), deliveredTime, rescheduledAt)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(err, "[Order_RescheduleDelivery.NewDeliveryRescheduledV1] error in creating delivery rescheduled event")
+	}
+
+	return o.Apply(event, true)
+}
+
+// RequestReturn requests a return of some items of a completed order, an item can be returned by several returns up to its ordered quantity.
+// The refund amount is the price of the returned items minus their share of the coupon discount
+func (o *Order) RequestReturn(returnId uuid.UUID, items []*value_objects.ReturnItem, reason string, requestedAt time.Time) error {
+	if o.canceled {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is canceled and its items can't be returned", o.Id()))
+	}
+	if !o.completed {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("order with id %s is not completed and its items can't be returned", o.Id()))
+	}
+	if o.FindReturn(returnId) != nil {
+		return domainExceptions.NewInvalidReturnError(fmt.Sprintf("[Order_RequestReturn] return with id %s of order %s already exists", returnId, o.Id()))
+	}
+	if len(items) == 0 {
+		return domainExceptions.NewInvalidReturnError(fmt.Sprintf("[Order_RequestReturn] return of order %s has no items", o.Id()))
+	}
+
+	returnedBefore := o.ReturnedSubtotal()
+	returnedAfter := returnedBefore
+	requestedQuantities := make(map[uuid.UUID]uint64)
+	for _, item := range items {
+		shopItem := o.findShopItem(item.ProductId())
+		if shopItem == nil {
+			return domainExceptions.NewInvalidReturnError(fmt.Sprintf("[Order_RequestReturn] product %s is not an item of order %s", item.ProductId(), o.Id()))
+		}
+		if item.Quantity() == 0 {
+			return domainExceptions.NewInvalidReturnError(fmt.Sprintf("[Order_RequestReturn] returned quantity of product %s should be positive", item.ProductId()))
+		}
+
+		requestedQuantities[item.ProductId()] += item.Quantity()
+		if o.ReturnedQuantity(item.ProductId())+requestedQuantities[item.ProductId()] > o.orderedQuantity(item.ProductId()) {
+			return domainExceptions.NewInvalidReturnError(fmt.Sprintf("[Order_RequestReturn] returned quantity of product %s is more than its ordered quantity %d", item.ProductId(), o.orderedQuantity(item.ProductId())))
+		}
+
+		price, err := shopItem.Price().Multiply(item.Quantity())
+		if err != nil {
+			return customErrors.NewDomainErrorWrap(err, "[Order_RequestReturn.Multiply] error in calculating the price of the returned items")
+		}
+		sum, err := returnedAfter.Add(price)
+		if err != nil {
+			return customErrors.NewDomainErrorWrap(err, "[Order_RequestReturn.Add] error in adding the price of the returned items")
+		}
+		returnedAfter = sum
+	}
+
+	// the refund is the difference of the refundable amounts before and after the return, so the refunds of all items add up to the total price
+	refundBefore, err := o.refundableAmount(returnedBefore)
+	if err != nil {
+		return err
+	}
+	refundAfter, err := o.refundableAmount(returnedAfter)
+	if err != nil {
+		return err
+	}
+	refundAmount, err := refundAfter.Subtract(refundBefore)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(err, "[Order_RequestReturn.Subtract] error in calculating the refund amount")
+	}
+
+	itemsDto, err := mapper.Map[[]*dtos.ReturnItemDto](items)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(err, "[Order_RequestReturn.Map] error in the mapping []ReturnItem to []ReturnItemDto")
+	}
+
+	event, err := requestingReturnEvents.NewReturnRequestedV1(o.Id(), returnId, itemsDto, reason, refundAmount, requestedAt)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(err, "[Order_RequestReturn.NewReturnRequestedV1] error in creating return requested event")
+	}
+
+	return o.Apply(event, true)
+}
+
+// ApproveReturn approves a requested return when its items are received
+func (o *Order) ApproveReturn(returnId uuid.UUID, approvedAt time.Time) error {
+	orderReturn := o.FindReturn(returnId)
+	if orderReturn == nil {
+		return domainExceptions.NewOrderReturnNotFoundError(o.Id(), returnId)
+	}
+	if orderReturn.Status() != value_objects.ReturnRequestedStatus {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("return with id %s of order %s is %s and can't be approved", returnId, o.Id(), orderReturn.Status()))
+	}
+
+	event, err := approvingReturnEvents.NewReturnApprovedV1(o.Id(), returnId, approvedAt)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(err, "[Order_ApproveReturn.NewReturnApprovedV1] error in creating return approved event")
+	}
+
+	return o.Apply(event, true)
+}
+
+// IssueRefund records the refund of an approved return, the refund id is the id of the refund in the payments service
+func (o *Order) IssueRefund(returnId uuid.UUID, refundId uuid.UUID, refundedAt time.Time) error {
+	orderReturn := o.FindReturn(returnId)
+	if orderReturn == nil {
+		return domainExceptions.NewOrderReturnNotFoundError(o.Id(), returnId)
+	}
+	if orderReturn.Status() != value_objects.ReturnApprovedStatus {
+		return domainExceptions.NewInvalidOrderStateError(fmt.Sprintf("return with id %s of order %s is %s and can't be refunded", returnId, o.Id(), orderReturn.Status()))
+	}
+
+	event, err := issuingRefundEvents.NewRefundIssuedV1(o.Id(), returnId, refundId, refundedAt)
+	if err != nil {
+		return customErrors.NewDomainErrorWrap(err, "[Order_IssueRefund.NewRefundIssuedV1] error in creating refund issued event")
+	}
+
+	return o.Apply(event, true)
 }
 
 func (o *Order) When(event domain.IDomainEvent) error {
@@ -90,6 +367,36 @@ func (o *Order) When(event domain.IDomainEvent) error {
 	case *creatingOrderEvents.OrderCreatedV1:
 		return o.onOrderCreated(evt)
 
+	case *updatingShoppingCardEvents.ShoppingCartUpdatedV1:
+		return o.onShoppingCartUpdated(evt)
+
+	case *applyingCouponEvents.CouponAppliedV1:
+		return o.onCouponApplied(evt)
+
+	case *submittingOrderEvents.OrderSubmittedV1:
+		return o.onOrderSubmitted(evt)
+
+	case *payingOrderEvents.OrderPaidV1:
+		return o.onOrderPaid(evt)
+
+	case *cancelingOrderEvents.OrderCanceledV1:
+		return o.onOrderCanceled(evt)
+
+	case *completingOrderEvents.OrderCompletedV1:
+		return o.onOrderCompleted(evt)
+
+	case *reschedulingDeliveryEvents.DeliveryRescheduledV1:
+		return o.onDeliveryRescheduled(evt)
+
+	case *requestingReturnEvents.ReturnRequestedV1:
+		return o.onReturnRequested(evt)
+
+	case *approvingReturnEvents.ReturnApprovedV1:
+		return o.onReturnApproved(evt)
+
+	case *issuingRefundEvents.RefundIssuedV1:
+		return o.onRefundIssued(evt)
+
 	default:
 		return errors.InvalidEventTypeError
 	}
@@ -111,6 +418,93 @@ func (o *Order) onOrderCreated(evt *creatingOrderEvents.OrderCreatedV1) error {
 	return nil
 }
 
+func (o *Order) onShoppingCartUpdated(evt *updatingShoppingCardEvents.ShoppingCartUpdatedV1) error {
+	items, err := mapper.Map[[]*value_objects.ShopItem](evt.ShopItems)
+	if err != nil {
+		return err
+	}
+
+	o.shopItems = items
+	o.SetUpdatedAt(evt.UpdatedAt)
+
+	return nil
+}
+
+func (o *Order) onCouponApplied(evt *applyingCouponEvents.CouponAppliedV1) error {
+	coupon, err := mapper.Map[*value_objects.Coupon](evt.Coupon)
+	if err != nil {
+		return err
+	}
+
+	o.coupon = coupon
+	o.SetUpdatedAt(evt.AppliedAt)
+
+	return nil
+}
+
+func (o *Order) onOrderSubmitted(evt *submittingOrderEvents.OrderSubmittedV1) error {
+	o.submitted = true
+	o.SetUpdatedAt(evt.SubmittedAt)
+
+	return nil
+}
+
+func (o *Order) onOrderPaid(evt *payingOrderEvents.OrderPaidV1) error {
+	o.paid = true
+	o.paymentId = evt.PaymentId
+	o.SetUpdatedAt(evt.PaidAt)
+
+	return nil
+}
+
+func (o *Order) onOrderCanceled(evt *cancelingOrderEvents.OrderCanceledV1) error {
+	o.canceled = true
+	o.cancelReason = evt.CancelReason
+	o.SetUpdatedAt(evt.CanceledAt)
+
+	return nil
+}
+
+func (o *Order) onOrderCompleted(evt *completingOrderEvents.OrderCompletedV1) error {
+	o.completed = true
+	o.SetUpdatedAt(evt.CompletedAt)
+
+	return nil
+}
+
+func (o *Order) onDeliveryRescheduled(evt *reschedulingDeliveryEvents.DeliveryRescheduledV1) error {
+	o.deliveredTime = evt.DeliveredTime
+	o.SetUpdatedAt(evt.RescheduledAt)
+
+	return nil
+}
+
+func (o *Order) onReturnRequested(evt *requestingReturnEvents.ReturnRequestedV1) error {
+	items, err := mapper.Map[[]*value_objects.ReturnItem](evt.Items)
+	if err != nil {
+		return err
+	}
+
+	o.returns = append(o.returns, value_objects.NewOrderReturn(evt.ReturnId, items, evt.Reason, evt.RefundAmount, evt.RequestedAt))
+	o.SetUpdatedAt(evt.RequestedAt)
+
+	return nil
+}
+
+func (o *Order) onReturnApproved(evt *approvingReturnEvents.ReturnApprovedV1) error {
+	o.replaceReturn(o.FindReturn(evt.ReturnId).Approve(evt.ApprovedAt))
+	o.SetUpdatedAt(evt.ApprovedAt)
+
+	return nil
+}
+
+func (o *Order) onRefundIssued(evt *issuingRefundEvents.RefundIssuedV1) error {
+	o.replaceReturn(o.FindReturn(evt.ReturnId).Refund(evt.RefundId, evt.RefundedAt))
+	o.SetUpdatedAt(evt.RefundedAt)
+
+	return nil
+}
+
 func (o *Order) ShopItems() []*value_objects.ShopItem {
 	return o.shopItems
 }
@@ -135,8 +529,87 @@ func (o *Order) CreatedAt() time.Time {
 	return o.createdAt
 }
 
-func (o *Order) TotalPrice() float64 {
-	return getShopItemsTotalPrice(o.shopItems)
+// SubtotalPrice is the total price of the items before the discount
+func (o *Order) SubtotalPrice() domain.Money {
+	// the items are checked to be priced in one currency before they are applied to the order
+	subtotal, _ := getShopItemsTotalPrice(o.shopItems)
+	return subtotal
+}
+
+// Discount is the discount of the applied coupon on the items, it is zero without a coupon
+func (o *Order) Discount() domain.Money {
+	subtotal := o.SubtotalPrice()
+	if o.coupon == nil {
+		return domain.ZeroMoney(subtotal.Currency)
+	}
+
+	// the items and the coupon are checked to be in one currency before the coupon is applied or the items are updated
+	discount, _ := o.coupon.Discount(subtotal)
+	return discount
+}
+
+// TotalPrice is the total price of the items minus the discount of the applied coupon
+func (o *Order) TotalPrice() domain.Money {
+	totalPrice, _ := o.SubtotalPrice().Subtract(o.Discount())
+	return totalPrice
+}
+
+func (o *Order) Coupon() *value_objects.Coupon {
+	return o.coupon
+}
+
+// CouponCode is the code of the applied coupon, it is empty without a coupon
+func (o *Order) CouponCode() string {
+	if o.coupon == nil {
+		return ""
+	}
+	return o.coupon.Code()
+}
+
+func (o *Order) Returns() []*value_objects.OrderReturn {
+	return o.returns
+}
+
+// ReturnedQuantity is the quantity of the product in all the returns of the order
+func (o *Order) ReturnedQuantity(productId uuid.UUID) uint64 {
+	var quantity uint64
+	for _, orderReturn := range o.returns {
+		for _, item := range orderReturn.Items() {
+			if item.ProductId() == productId {
+				quantity += item.Quantity()
+			}
+		}
+	}
+
+	return quantity
+}
+
+// ReturnedSubtotal is the price of the items in all the returns of the order before the discount
+func (o *Order) ReturnedSubtotal() domain.Money {
+	returned := domain.ZeroMoney(o.SubtotalPrice().Currency)
+	for _, orderReturn := range o.returns {
+		for _, item := range orderReturn.Items() {
+			if shopItem := o.findShopItem(item.ProductId()); shopItem != nil {
+				// the returned items are checked to be the items of the order, so they are priced in the order currency and their price is in range
+				price, _ := shopItem.Price().Multiply(item.Quantity())
+				returned, _ = returned.Add(price)
+			}
+		}
+	}
+
+	return returned
+}
+
+// RefundedAmount is the refund amount of the refunded returns
+func (o *Order) RefundedAmount() domain.Money {
+	refunded := domain.ZeroMoney(o.SubtotalPrice().Currency)
+	for _, orderReturn := range o.returns {
+		if orderReturn.Status() == value_objects.ReturnRefundedStatus {
+			refunded, _ = refunded.Add(orderReturn.RefundAmount())
+		}
+	}
+
+	return refunded
 }
 
 func (o *Order) Paid() bool {
@@ -163,11 +636,86 @@ func (o *Order) String() string {
 	return jsonSerializer.PrettyPrint(o)
 }
 
-func getShopItemsTotalPrice(shopItems []*value_objects.ShopItem) float64 {
-	var totalPrice float64 = 0
+func (o *Order) findShopItem(productId uuid.UUID) *value_objects.ShopItem {
+	for _, item := range o.shopItems {
+		if item.ProductId() == productId {
+			return item
+		}
+	}
+
+	return nil
+}
+
+func (o *Order) orderedQuantity(productId uuid.UUID) uint64 {
+	var quantity uint64
+	for _, item := range o.shopItems {
+		if item.ProductId() == productId {
+			quantity += item.Quantity()
+		}
+	}
+
+	return quantity
+}
+
+// FindReturn is the return of the order with the returnId or nil when the order has no such return
+func (o *Order) FindReturn(returnId uuid.UUID) *value_objects.OrderReturn {
+	for _, orderReturn := range o.returns {
+		if orderReturn.ReturnId() == returnId {
+			return orderReturn
+		}
+	}
+
+	return nil
+}
+
+func (o *Order) replaceReturn(orderReturn *value_objects.OrderReturn) {
+	for i, r := range o.returns {
+		if r.ReturnId() == orderReturn.ReturnId() {
+			o.returns[i] = orderReturn
+		}
+	}
+}
+
+// refundableAmount is the price of the returned items minus their share of the discount, it is the total price when all the items are returned
+func (o *Order) refundableAmount(returnedSubtotal domain.Money) (domain.Money, error) {
+	subtotal := o.SubtotalPrice()
+	if subtotal.IsZero() {
+		return returnedSubtotal, nil
+	}
+
+	discountShare, err := o.Discount().Prorate(returnedSubtotal, subtotal)
+	if err != nil {
+		return domain.Money{}, customErrors.NewDomainErrorWrap(err, "[Order_refundableAmount.Prorate] error in prorating the discount on the returned items")
+	}
+	refundable, err := returnedSubtotal.Subtract(discountShare)
+	if err != nil {
+		return domain.Money{}, customErrors.NewDomainErrorWrap(err, "[Order_refundableAmount.Subtract] error in subtracting the discount share")
+	}
+
+	return refundable, nil
+}
+
+// getShopItemsTotalPrice sums the prices of the items, the items of an order should be priced in one currency
+func getShopItemsTotalPrice(shopItems []*value_objects.ShopItem) (domain.Money, error) {
+	if len(shopItems) == 0 {
+		return domain.Money{}, nil
+	}
+
+	totalPrice := domain.ZeroMoney(shopItems[0].Price().Currency)
 	for _, item := range shopItems {
-		totalPrice += item.Price() * float64(item.Quantity())
+		if item.Price().Currency != totalPrice.Currency {
+			return domain.Money{}, domainExceptions.NewOrderCurrencyMismatchError(fmt.Sprintf("[Order_getShopItemsTotalPrice] order items are priced in %s and %s, an order should be priced in one currency", totalPrice.Currency, item.Price().Currency))
+		}
+		itemPrice, err := item.TotalPrice()
+		if err != nil {
+			return domain.Money{}, customErrors.NewDomainErrorWrap(err, fmt.Sprintf("[Order_getShopItemsTotalPrice.TotalPrice] total price of product %s with quantity %d is out of range", item.ProductId(), item.Quantity()))
+		}
+		sum, err := totalPrice.Add(itemPrice)
+		if err != nil {
+			return domain.Money{}, customErrors.NewDomainErrorWrap(err, "[Order_getShopItemsTotalPrice.Add] total price of the order items is out of range")
+		}
+		totalPrice = sum
 	}
 
-	return totalPrice
+	return totalPrice, nil
 }
@@ -0,0 +1,350 @@
+package aggregate_test
+
+import (
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/mappings"
+	domainExceptions "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/exceptions/domain"
+	creatingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/events/domain/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+var configureMappings sync.Once
+
+func newOrder(t *testing.T) *aggregate.Order {
+	configureMappings.Do(func() {
+		require.NoError(t, mappings.ConfigureMappings())
+	})
+
+	shopItems := []*value_objects.ShopItem{value_objects.CreateNewShopItem(uuid.NewV4(), "book", "a book", 2, domain.MustParseMoney("10", "USD"))}
+	order, err := aggregate.NewOrder(uuid.NewV4(), shopItems, "test@example.com", "test address", time.Now(), time.Now())
+	require.NoError(t, err)
+
+	return order
+}
+
+func Test_Order_Lifecycle(t *testing.T) {
+	order := newOrder(t)
+	paymentId := uuid.NewV4()
+
+	require.NoError(t, order.Submit(time.Now()))
+	require.NoError(t, order.Pay(paymentId, order.TotalPrice(), time.Now()))
+	require.NoError(t, order.Complete(time.Now()))
+
+	assert.True(t, order.Submitted())
+	assert.True(t, order.Paid())
+	assert.True(t, order.Completed())
+	assert.False(t, order.Canceled())
+	assert.Equal(t, paymentId, order.PaymentId())
+	assert.Len(t, order.UncommittedEvents(), 4)
+}
+
+func Test_Order_Created_Price_Snapshot(t *testing.T) {
+	configureMappings.Do(func() {
+		require.NoError(t, mappings.ConfigureMappings())
+	})
+
+	productId := uuid.NewV4()
+	shopItems := []*value_objects.ShopItem{value_objects.CreateNewShopItem(productId, "book", "a book", 2, domain.MustParseMoney("12.50", "USD"))}
+	order, err := aggregate.NewOrder(uuid.NewV4(), shopItems, "test@example.com", "test address", time.Now(), time.Now())
+	require.NoError(t, err)
+
+	require.Len(t, order.UncommittedEvents(), 1)
+	created, ok := order.UncommittedEvents()[0].(*creatingOrderEvents.OrderCreatedV1)
+	require.True(t, ok)
+	require.Len(t, created.ShopItems, 1)
+	assert.Equal(t, productId, created.ShopItems[0].ProductId)
+	assert.Equal(t, "book", created.ShopItems[0].Title)
+	assert.Equal(t, domain.MustParseMoney("12.50", "USD"), created.ShopItems[0].Price)
+
+	assert.Equal(t, productId, order.ShopItems()[0].ProductId())
+	assert.Equal(t, domain.MustParseMoney("25", "USD"), order.TotalPrice())
+}
+
+func Test_Order_Total_Price_Out_Of_Range(t *testing.T) {
+	configureMappings.Do(func() {
+		require.NoError(t, mappings.ConfigureMappings())
+	})
+
+	shopItems := []*value_objects.ShopItem{value_objects.CreateNewShopItem(uuid.NewV4(), "book", "a book", 1<<63, domain.MustParseMoney("1000000", "USD"))}
+	_, err := aggregate.NewOrder(uuid.NewV4(), shopItems, "test@example.com", "test address", time.Now(), time.Now())
+	assert.True(t, customErrors.IsDomainError(err))
+	assert.True(t, errors.Is(err, domain.ErrDecimalOutOfRange))
+}
+
+func Test_Order_Cancel(t *testing.T) {
+	order := newOrder(t)
+
+	require.NoError(t, order.Submit(time.Now()))
+	require.NoError(t, order.Cancel("out of stock", time.Now()))
+
+	assert.True(t, order.Canceled())
+	assert.Equal(t, "out of stock", order.CancelReason())
+}
+
+func Test_Order_Cancel_Unpaid(t *testing.T) {
+	order := newOrder(t)
+
+	require.NoError(t, order.Submit(time.Now()))
+	require.NoError(t, order.CancelUnpaid("stock reservation expired", time.Now()))
+
+	assert.True(t, order.Canceled())
+	assert.Equal(t, "stock reservation expired", order.CancelReason())
+}
+
+func Test_Order_Reschedule_Delivery(t *testing.T) {
+	order := newOrder(t)
+	require.NoError(t, order.Submit(time.Now()))
+
+	deliveredTime := time.Now().Add(48 * time.Hour)
+	require.NoError(t, order.RescheduleDelivery(deliveredTime, time.Now()))
+	assert.Equal(t, deliveredTime, order.DeliveredTime())
+
+	t.Run("reschedule the delivery of a canceled order", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.Cancel("changed my mind", time.Now()))
+		assert.True(t, domainExceptions.IsInvalidOrderStateError(order.RescheduleDelivery(deliveredTime, time.Now())))
+	})
+
+	t.Run("reschedule the delivery of a completed order", func(t *testing.T) {
+		order := completedOrder(t, newOrder(t).ShopItems(), nil)
+		assert.True(t, domainExceptions.IsInvalidOrderStateError(order.RescheduleDelivery(deliveredTime, time.Now())))
+	})
+}
+
+func Test_Order_Invalid_Transitions(t *testing.T) {
+	t.Run("pay an unsubmitted order", func(t *testing.T) {
+		order := newOrder(t)
+		assert.True(t, domainExceptions.IsInvalidOrderStateError(order.Pay(uuid.NewV4(), order.TotalPrice(), time.Now())))
+	})
+
+	t.Run("pay an order by another amount", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.Submit(time.Now()))
+		assert.True(t, domainExceptions.IsPaymentAmountMismatchError(order.Pay(uuid.NewV4(), domain.MustParseMoney("10", "USD"), time.Now())))
+		assert.True(t, domainExceptions.IsPaymentAmountMismatchError(order.Pay(uuid.NewV4(), domain.MustParseMoney("20", "EUR"), time.Now())))
+		assert.False(t, order.Paid())
+	})
+
+	t.Run("complete an unpaid order", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.Submit(time.Now()))
+		assert.True(t, domainExceptions.IsInvalidOrderStateError(order.Complete(time.Now())))
+	})
+
+	t.Run("submit an order twice", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.Submit(time.Now()))
+		assert.True(t, domainExceptions.IsInvalidOrderStateError(order.Submit(time.Now())))
+	})
+
+	t.Run("submit a canceled order", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.Cancel("changed my mind", time.Now()))
+		assert.True(t, domainExceptions.IsInvalidOrderStateError(order.Submit(time.Now())))
+	})
+
+	t.Run("cancel a paid order as unpaid", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.Submit(time.Now()))
+		require.NoError(t, order.Pay(uuid.NewV4(), order.TotalPrice(), time.Now()))
+		assert.True(t, domainExceptions.IsInvalidOrderStateError(order.CancelUnpaid("stock reservation expired", time.Now())))
+		assert.False(t, order.Canceled())
+	})
+
+	t.Run("cancel a completed order", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.Submit(time.Now()))
+		require.NoError(t, order.Pay(uuid.NewV4(), order.TotalPrice(), time.Now()))
+		require.NoError(t, order.Complete(time.Now()))
+		assert.True(t, domainExceptions.IsInvalidOrderStateError(order.Cancel("too late", time.Now())))
+	})
+}
+
+func Test_Order_Update_Shopping_Card(t *testing.T) {
+	order := newOrder(t)
+
+	shopItems := []*value_objects.ShopItem{
+		value_objects.CreateNewShopItem(uuid.NewV4(), "book", "a book", 1, domain.MustParseMoney("10", "USD")),
+		value_objects.CreateNewShopItem(uuid.NewV4(), "pen", "a pen", 3, domain.MustParseMoney("0.1", "USD")),
+	}
+	require.NoError(t, order.UpdateShoppingCard(shopItems, time.Now()))
+
+	assert.Len(t, order.ShopItems(), 2)
+	assert.Equal(t, domain.MustParseMoney("10.30", "USD"), order.TotalPrice())
+
+	t.Run("update shopping cart of a submitted order", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.Submit(time.Now()))
+		assert.True(t, domainExceptions.IsInvalidOrderStateError(order.UpdateShoppingCard(shopItems, time.Now())))
+	})
+
+	t.Run("update shopping cart with no items", func(t *testing.T) {
+		order := newOrder(t)
+		assert.True(t, domainExceptions.IsOrderShopItemsRequiredError(order.UpdateShoppingCard(nil, time.Now())))
+	})
+
+	t.Run("update shopping cart with items in different currencies", func(t *testing.T) {
+		order := newOrder(t)
+		mixedItems := []*value_objects.ShopItem{
+			value_objects.CreateNewShopItem(uuid.NewV4(), "book", "a book", 1, domain.MustParseMoney("10", "USD")),
+			value_objects.CreateNewShopItem(uuid.NewV4(), "pen", "a pen", 1, domain.MustParseMoney("2", "EUR")),
+		}
+		assert.True(t, domainExceptions.IsOrderCurrencyMismatchError(order.UpdateShoppingCard(mixedItems, time.Now())))
+	})
+}
+
+func Test_Order_Apply_Coupon(t *testing.T) {
+	order := newOrder(t)
+
+	coupon, err := value_objects.NewCoupon("SUMMER10", value_objects.PercentageDiscount, domain.NewDecimal(10, 0), domain.Money{}, domain.MustParseMoney("15", "USD"))
+	require.NoError(t, err)
+	require.NoError(t, order.ApplyCoupon(coupon, time.Now()))
+
+	assert.Equal(t, "SUMMER10", order.CouponCode())
+	assert.Equal(t, domain.MustParseMoney("2", "USD"), order.Discount())
+	assert.Equal(t, domain.MustParseMoney("18", "USD"), order.TotalPrice())
+	assert.Len(t, order.UncommittedEvents(), 2)
+
+	t.Run("discount is recalculated on the updated shopping cart", func(t *testing.T) {
+		shopItems := []*value_objects.ShopItem{value_objects.CreateNewShopItem(uuid.NewV4(), "book", "a book", 3, domain.MustParseMoney("10", "USD"))}
+		require.NoError(t, order.UpdateShoppingCard(shopItems, time.Now()))
+		assert.Equal(t, domain.MustParseMoney("27", "USD"), order.TotalPrice())
+
+		shopItems = []*value_objects.ShopItem{value_objects.CreateNewShopItem(uuid.NewV4(), "book", "a book", 1, domain.MustParseMoney("10", "USD"))}
+		require.NoError(t, order.UpdateShoppingCard(shopItems, time.Now()))
+		assert.True(t, order.Discount().IsZero())
+		assert.Equal(t, domain.MustParseMoney("10", "USD"), order.TotalPrice())
+	})
+
+	t.Run("apply a second coupon", func(t *testing.T) {
+		assert.True(t, domainExceptions.IsInvalidOrderStateError(order.ApplyCoupon(coupon, time.Now())))
+	})
+
+	t.Run("apply a coupon to a submitted order", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.Submit(time.Now()))
+		assert.True(t, domainExceptions.IsInvalidOrderStateError(order.ApplyCoupon(coupon, time.Now())))
+	})
+
+	t.Run("apply a coupon below its minimum basket", func(t *testing.T) {
+		order := newOrder(t)
+		bigCoupon, err := value_objects.NewCoupon("BIG", value_objects.FixedAmountDiscount, domain.Decimal{}, domain.MustParseMoney("5", "USD"), domain.MustParseMoney("100", "USD"))
+		require.NoError(t, err)
+		assert.True(t, domainExceptions.IsMinimumBasketNotReachedError(order.ApplyCoupon(bigCoupon, time.Now())))
+	})
+
+	t.Run("apply a coupon in another currency", func(t *testing.T) {
+		order := newOrder(t)
+		euroCoupon, err := value_objects.NewCoupon("EURO", value_objects.FixedAmountDiscount, domain.Decimal{}, domain.MustParseMoney("5", "EUR"), domain.Money{})
+		require.NoError(t, err)
+		assert.True(t, domainExceptions.IsOrderCurrencyMismatchError(order.ApplyCoupon(euroCoupon, time.Now())))
+	})
+}
+
+func completedOrder(t *testing.T, shopItems []*value_objects.ShopItem, coupon *value_objects.Coupon) *aggregate.Order {
+	configureMappings.Do(func() {
+		require.NoError(t, mappings.ConfigureMappings())
+	})
+
+	order, err := aggregate.NewOrder(uuid.NewV4(), shopItems, "test@example.com", "test address", time.Now(), time.Now())
+	require.NoError(t, err)
+	if coupon != nil {
+		require.NoError(t, order.ApplyCoupon(coupon, time.Now()))
+	}
+	require.NoError(t, order.Submit(time.Now()))
+	require.NoError(t, order.Pay(uuid.NewV4(), order.TotalPrice(), time.Now()))
+	require.NoError(t, order.Complete(time.Now()))
+
+	return order
+}
+
+func Test_Order_Request_Return(t *testing.T) {
+	book := value_objects.CreateNewShopItem(uuid.NewV4(), "book", "a book", 2, domain.MustParseMoney("10", "USD"))
+	pen := value_objects.CreateNewShopItem(uuid.NewV4(), "pen", "a pen", 3, domain.MustParseMoney("1.5", "USD"))
+	order := completedOrder(t, []*value_objects.ShopItem{book, pen}, nil)
+
+	returnId := uuid.NewV4()
+	items := []*value_objects.ReturnItem{value_objects.NewReturnItem(book.ProductId(), 1), value_objects.NewReturnItem(pen.ProductId(), 2)}
+	require.NoError(t, order.RequestReturn(returnId, items, "damaged", time.Now()))
+
+	orderReturn := order.FindReturn(returnId)
+	require.NotNil(t, orderReturn)
+	assert.Equal(t, value_objects.ReturnRequestedStatus, orderReturn.Status())
+	assert.Equal(t, domain.MustParseMoney("13", "USD"), orderReturn.RefundAmount())
+	assert.Equal(t, uint64(1), order.ReturnedQuantity(book.ProductId()))
+	assert.Equal(t, uint64(2), order.ReturnedQuantity(pen.ProductId()))
+	assert.True(t, order.Completed())
+
+	t.Run("return more than the ordered quantity", func(t *testing.T) {
+		items := []*value_objects.ReturnItem{value_objects.NewReturnItem(book.ProductId(), 2)}
+		assert.True(t, domainExceptions.IsInvalidReturnError(order.RequestReturn(uuid.NewV4(), items, "damaged", time.Now())))
+	})
+
+	t.Run("return an unknown product", func(t *testing.T) {
+		items := []*value_objects.ReturnItem{value_objects.NewReturnItem(uuid.NewV4(), 1)}
+		assert.True(t, domainExceptions.IsInvalidReturnError(order.RequestReturn(uuid.NewV4(), items, "damaged", time.Now())))
+	})
+
+	t.Run("request a return twice", func(t *testing.T) {
+		items := []*value_objects.ReturnItem{value_objects.NewReturnItem(pen.ProductId(), 1)}
+		assert.True(t, domainExceptions.IsInvalidReturnError(order.RequestReturn(returnId, items, "damaged", time.Now())))
+	})
+
+	t.Run("return items of an uncompleted order", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.Submit(time.Now()))
+		items := []*value_objects.ReturnItem{value_objects.NewReturnItem(order.ShopItems()[0].ProductId(), 1)}
+		assert.True(t, domainExceptions.IsInvalidOrderStateError(order.RequestReturn(uuid.NewV4(), items, "damaged", time.Now())))
+	})
+}
+
+func Test_Order_Return_Refunds_Prorate_Discount(t *testing.T) {
+	book := value_objects.CreateNewShopItem(uuid.NewV4(), "book", "a book", 3, domain.MustParseMoney("10", "USD"))
+	coupon, err := value_objects.NewCoupon("FLAT10", value_objects.FixedAmountDiscount, domain.Decimal{}, domain.MustParseMoney("10", "USD"), domain.Money{})
+	require.NoError(t, err)
+	order := completedOrder(t, []*value_objects.ShopItem{book}, coupon)
+	require.Equal(t, domain.MustParseMoney("20", "USD"), order.TotalPrice())
+
+	// each book is refunded separately, the rounding of the discount shares doesn't leave a remainder
+	expected := []string{"6.67", "6.66", "6.67"}
+	for _, amount := range expected {
+		returnId := uuid.NewV4()
+		require.NoError(t, order.RequestReturn(returnId, []*value_objects.ReturnItem{value_objects.NewReturnItem(book.ProductId(), 1)}, "not needed", time.Now()))
+		require.NoError(t, order.ApproveReturn(returnId, time.Now()))
+		require.NoError(t, order.IssueRefund(returnId, uuid.NewV4(), time.Now()))
+		assert.Equal(t, domain.MustParseMoney(amount, "USD"), order.FindReturn(returnId).RefundAmount())
+	}
+
+	assert.Equal(t, order.TotalPrice(), order.RefundedAmount())
+}
+
+func Test_Order_Return_Transitions(t *testing.T) {
+	book := value_objects.CreateNewShopItem(uuid.NewV4(), "book", "a book", 2, domain.MustParseMoney("10", "USD"))
+	order := completedOrder(t, []*value_objects.ShopItem{book}, nil)
+	returnId := uuid.NewV4()
+	refundId := uuid.NewV4()
+	require.NoError(t, order.RequestReturn(returnId, []*value_objects.ReturnItem{value_objects.NewReturnItem(book.ProductId(), 1)}, "damaged", time.Now()))
+
+	assert.True(t, domainExceptions.IsInvalidOrderStateError(order.IssueRefund(returnId, refundId, time.Now())))
+	assert.True(t, domainExceptions.IsOrderReturnNotFoundError(order.ApproveReturn(uuid.NewV4(), time.Now())))
+
+	require.NoError(t, order.ApproveReturn(returnId, time.Now()))
+	assert.Equal(t, value_objects.ReturnApprovedStatus, order.FindReturn(returnId).Status())
+	assert.True(t, order.RefundedAmount().IsZero())
+	assert.True(t, domainExceptions.IsInvalidOrderStateError(order.ApproveReturn(returnId, time.Now())))
+
+	require.NoError(t, order.IssueRefund(returnId, refundId, time.Now()))
+	assert.Equal(t, value_objects.ReturnRefundedStatus, order.FindReturn(returnId).Status())
+	assert.Equal(t, refundId, order.FindReturn(returnId).RefundId())
+	assert.Equal(t, domain.MustParseMoney("10", "USD"), order.RefundedAmount())
+	assert.True(t, domainExceptions.IsInvalidOrderStateError(order.IssueRefund(returnId, refundId, time.Now())))
+}
@@ -0,0 +1,22 @@
+package read_models
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+
+// CouponReadModel is the discount rule of the coupon applied to the order
+type CouponReadModel struct {
+	Code          string         `json:"code" bson:"code"`
+	DiscountType  string         `json:"discountType" bson:"discountType"`
+	PercentOff    domain.Decimal `json:"percentOff" bson:"percentOff"`
+	AmountOff     domain.Money   `json:"amountOff" bson:"amountOff"`
+	MinimumBasket domain.Money   `json:"minimumBasket" bson:"minimumBasket"`
+}
+
+func NewCouponReadModel(code string, discountType string, percentOff domain.Decimal, amountOff domain.Money, minimumBasket domain.Money) *CouponReadModel {
+	return &CouponReadModel{
+		Code:          code,
+		DiscountType:  discountType,
+		PercentOff:    percentOff,
+		AmountOff:     amountOff,
+		MinimumBasket: minimumBasket,
+	}
+}
@@ -0,0 +1,21 @@
+package read_models
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+
+// OrderQueryFields are the fields of the OrderReadModel which the order list queries can filter and sort by
+var OrderQueryFields = utils.QueryFields{
+	"status":       {Name: "status", Type: utils.StringField},
+	"accountEmail": {Name: "accountEmail", Type: utils.StringField, Sortable: true},
+	"couponCode":   {Name: "coupon.code", Type: utils.StringField},
+	"totalPrice":   {Name: "totalPrice.amount", Type: utils.NumberField, Sortable: true},
+	"createdAt":    {Name: "createdAt", Type: utils.DateField, Sortable: true},
+}
+
+// CustomerOrderQueryFields are the fields which the orders of a customer can be filtered and sorted by, the account email is the customer of the query
+var CustomerOrderQueryFields = utils.QueryFields{
+	"status":     {Name: "status", Type: utils.StringField},
+	"couponCode": {Name: "coupon.code", Type: utils.StringField},
+	"totalPrice": {Name: "totalPrice.amount", Type: utils.NumberField, Sortable: true},
+	"createdAt":  {Name: "createdAt", Type: utils.DateField, Sortable: true},
+	"updatedAt":  {Name: "updatedAt", Type: utils.DateField, Sortable: true},
+}
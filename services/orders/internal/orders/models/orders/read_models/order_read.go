@@ -1,46 +1,157 @@
 package read_models
 
 import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
 	uuid "github.com/satori/go.uuid"
 	"time"
 )
 
+const (
+	OrderCreatedStatus   = "created"
+	OrderSubmittedStatus = "submitted"
+	OrderPaidStatus      = "paid"
+	OrderCanceledStatus  = "canceled"
+	OrderCompletedStatus = "completed"
+)
+
 type OrderReadModel struct {
 	// we generate id ourself because auto generate mongo string id column with type _id is not an uuid
-	Id              string               `json:"id" bson:"_id,omitempty"` //https://www.mongodb.com/docs/drivers/go/current/fundamentals/crud/write-operations/insert/#the-_id-field
-	OrderId         string               `json:"orderId" bson:"orderId,omitempty"`
-	ShopItems       []*ShopItemReadModel `json:"shopItems,omitempty" bson:"shopItems,omitempty"`
-	AccountEmail    string               `json:"accountEmail,omitempty" bson:"accountEmail,omitempty"`
-	DeliveryAddress string               `json:"deliveryAddress,omitempty" bson:"deliveryAddress,omitempty"`
-	CancelReason    string               `json:"cancelReason,omitempty" bson:"cancelReason,omitempty"`
-	TotalPrice      float64              `json:"totalPrice,omitempty" bson:"totalPrice,omitempty"`
-	DeliveredTime   time.Time            `json:"deliveredTime,omitempty" bson:"deliveredTime,omitempty"`
-	Paid            bool                 `json:"paid,omitempty" bson:"paid,omitempty"`
-	Submitted       bool                 `json:"submitted,omitempty" bson:"submitted,omitempty"`
-	Completed       bool                 `json:"completed,omitempty" bson:"completed,omitempty"`
-	Canceled        bool                 `json:"canceled,omitempty" bson:"canceled,omitempty"`
-	PaymentId       string               `json:"paymentId" bson:"paymentId,omitempty"`
-	CreatedAt       time.Time            `json:"createdAt,omitempty" bson:"createdAt,omitempty"`
-	UpdatedAt       time.Time            `json:"updatedAt,omitempty" bson:"updatedAt,omitempty"`
+	Id              string                  `json:"id" bson:"_id,omitempty"` //https://www.mongodb.com/docs/drivers/go/current/fundamentals/crud/write-operations/insert/#the-_id-field
+	OrderId         string                  `json:"orderId" bson:"orderId,omitempty"`
+	ShopItems       []*ShopItemReadModel    `json:"shopItems,omitempty" bson:"shopItems,omitempty"`
+	AccountEmail    string                  `json:"accountEmail,omitempty" bson:"accountEmail,omitempty"`
+	DeliveryAddress string                  `json:"deliveryAddress,omitempty" bson:"deliveryAddress,omitempty"`
+	CancelReason    string                  `json:"cancelReason,omitempty" bson:"cancelReason,omitempty"`
+	Coupon          *CouponReadModel        `json:"coupon,omitempty" bson:"coupon,omitempty"`
+	Discount        domain.Money            `json:"discount" bson:"discount"`
+	TotalPrice      domain.Money            `json:"totalPrice" bson:"totalPrice"`
+	Returns         []*OrderReturnReadModel `json:"returns,omitempty" bson:"returns,omitempty"`
+	// RefundedAmount is the refund amount of the refunded returns
+	RefundedAmount domain.Money `json:"refundedAmount" bson:"refundedAmount"`
+	DeliveredTime  time.Time    `json:"deliveredTime,omitempty" bson:"deliveredTime,omitempty"`
+	Paid           bool         `json:"paid,omitempty" bson:"paid,omitempty"`
+	Submitted      bool         `json:"submitted,omitempty" bson:"submitted,omitempty"`
+	Completed      bool         `json:"completed,omitempty" bson:"completed,omitempty"`
+	Canceled       bool         `json:"canceled,omitempty" bson:"canceled,omitempty"`
+	Status         string       `json:"status,omitempty" bson:"status,omitempty"`
+	PaymentId      string       `json:"paymentId" bson:"paymentId,omitempty"`
+	CreatedAt      time.Time    `json:"createdAt,omitempty" bson:"createdAt,omitempty"`
+	UpdatedAt      time.Time    `json:"updatedAt,omitempty" bson:"updatedAt,omitempty"`
+	// Version is the stream version of the last event projected into the read model
+	Version int64 `json:"version" bson:"version,omitempty"`
 }
 
 func NewOrderReadModel(orderId uuid.UUID, items []*ShopItemReadModel, accountEmail string, deliveryAddress string, deliveryTime time.Time) *OrderReadModel {
-	return &OrderReadModel{
+	order := &OrderReadModel{
 		Id:              uuid.NewV4().String(), // we generate id ourself because auto generate mongo string id column with type _id is not an uuid
 		OrderId:         orderId.String(),
 		ShopItems:       items,
 		AccountEmail:    accountEmail,
 		DeliveryAddress: deliveryAddress,
-		TotalPrice:      getShopItemsTotalPrice(items),
 		DeliveredTime:   deliveryTime,
+		Status:          OrderCreatedStatus,
 		CreatedAt:       time.Now(),
 	}
+	order.recalculateTotalPrice()
+
+	return order
+}
+
+// UpdateShopItems replaces the shop items of the order and recalculates its discount and total price
+func (o *OrderReadModel) UpdateShopItems(items []*ShopItemReadModel) {
+	o.ShopItems = items
+	o.recalculateTotalPrice()
+}
+
+// ApplyCoupon sets the applied coupon of the order and recalculates its discount and total price
+func (o *OrderReadModel) ApplyCoupon(coupon *CouponReadModel) {
+	o.Coupon = coupon
+	o.recalculateTotalPrice()
+}
+
+// AddReturn adds a requested return of the order
+func (o *OrderReadModel) AddReturn(orderReturn *OrderReturnReadModel) {
+	o.Returns = append(o.Returns, orderReturn)
+}
+
+// ApproveReturn marks the return as approved, an unknown return is ignored
+func (o *OrderReadModel) ApproveReturn(returnId string, approvedAt time.Time) {
+	if orderReturn := o.findReturn(returnId); orderReturn != nil {
+		orderReturn.Status = value_objects.ReturnApprovedStatus
+		orderReturn.ApprovedAt = approvedAt
+	}
 }
 
-func getShopItemsTotalPrice(shopItems []*ShopItemReadModel) float64 {
-	var totalPrice float64 = 0
+// RefundReturn marks the return as refunded and adds its refund amount to the refunded amount of the order, an unknown return is ignored
+func (o *OrderReadModel) RefundReturn(returnId string, refundId string, refundedAt time.Time) {
+	orderReturn := o.findReturn(returnId)
+	if orderReturn == nil {
+		return
+	}
+
+	orderReturn.Status = value_objects.ReturnRefundedStatus
+	orderReturn.RefundId = refundId
+	orderReturn.RefundedAt = refundedAt
+
+	refunded := domain.ZeroMoney(o.TotalPrice.Currency)
+	for _, r := range o.Returns {
+		if r.Status == value_objects.ReturnRefundedStatus {
+			refunded, _ = refunded.Add(r.RefundAmount)
+		}
+	}
+	o.RefundedAmount = refunded
+}
+
+func (o *OrderReadModel) findReturn(returnId string) *OrderReturnReadModel {
+	for _, orderReturn := range o.Returns {
+		if orderReturn.ReturnId == returnId {
+			return orderReturn
+		}
+	}
+
+	return nil
+}
+
+// CouponCode is the code of the applied coupon, it is empty without a coupon
+func (o OrderReadModel) CouponCode() string {
+	if o.Coupon == nil {
+		return ""
+	}
+	return o.Coupon.Code
+}
+
+// recalculateTotalPrice uses the discount rules of the order aggregate, so the read model totals match the aggregate totals
+func (o *OrderReadModel) recalculateTotalPrice() {
+	subtotal := getShopItemsTotalPrice(o.ShopItems)
+	o.Discount = domain.ZeroMoney(subtotal.Currency)
+	if o.Coupon != nil {
+		coupon, err := value_objects.NewCoupon(o.Coupon.Code, o.Coupon.DiscountType, o.Coupon.PercentOff, o.Coupon.AmountOff, o.Coupon.MinimumBasket)
+		if err == nil {
+			if discount, err := coupon.Discount(subtotal); err == nil {
+				o.Discount = discount
+			}
+		}
+	}
+
+	o.TotalPrice, _ = subtotal.Subtract(o.Discount)
+}
+
+// getShopItemsTotalPrice sums the prices of the items, the order aggregate only accepts the items which are priced in one currency
+func getShopItemsTotalPrice(shopItems []*ShopItemReadModel) domain.Money {
+	if len(shopItems) == 0 {
+		return domain.Money{}
+	}
+
+	totalPrice := domain.ZeroMoney(shopItems[0].Price.Currency)
 	for _, item := range shopItems {
-		totalPrice += item.Price * float64(item.Quantity)
+		price, err := item.Price.Multiply(item.Quantity)
+		if err != nil {
+			continue
+		}
+		if sum, err := totalPrice.Add(price); err == nil {
+			totalPrice = sum
+		}
 	}
 
 	return totalPrice
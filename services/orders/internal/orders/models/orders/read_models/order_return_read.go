@@ -0,0 +1,39 @@
+package read_models
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"time"
+)
+
+// OrderReturnReadModel is a return of some items of the order, the statuses are the statuses of the return value object
+type OrderReturnReadModel struct {
+	ReturnId     string                 `json:"returnId" bson:"returnId"`
+	Items        []*ReturnItemReadModel `json:"items" bson:"items"`
+	Reason       string                 `json:"reason,omitempty" bson:"reason,omitempty"`
+	RefundAmount domain.Money           `json:"refundAmount" bson:"refundAmount"`
+	Status       string                 `json:"status" bson:"status"`
+	RefundId     string                 `json:"refundId,omitempty" bson:"refundId,omitempty"`
+	RequestedAt  time.Time              `json:"requestedAt" bson:"requestedAt"`
+	ApprovedAt   time.Time              `json:"approvedAt,omitempty" bson:"approvedAt,omitempty"`
+	RefundedAt   time.Time              `json:"refundedAt,omitempty" bson:"refundedAt,omitempty"`
+}
+
+type ReturnItemReadModel struct {
+	ProductId string `json:"productId" bson:"productId"`
+	Quantity  uint64 `json:"quantity" bson:"quantity"`
+}
+
+func NewOrderReturnReadModel(returnId string, items []*ReturnItemReadModel, reason string, refundAmount domain.Money, status string, requestedAt time.Time) *OrderReturnReadModel {
+	return &OrderReturnReadModel{
+		ReturnId:     returnId,
+		Items:        items,
+		Reason:       reason,
+		RefundAmount: refundAmount,
+		Status:       status,
+		RequestedAt:  requestedAt,
+	}
+}
+
+func NewReturnItemReadModel(productId string, quantity uint64) *ReturnItemReadModel {
+	return &ReturnItemReadModel{ProductId: productId, Quantity: quantity}
+}
@@ -1,12 +1,15 @@
 package read_models
 
+import "github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+
 type ShopItemReadModel struct {
-	Title       string  `json:"title,omitempty" bson:"title,omitempty"`
-	Description string  `json:"description,omitempty" bson:"description,omitempty"`
-	Quantity    uint64  `json:"quantity,omitempty" bson:"quantity,omitempty"`
-	Price       float64 `json:"price,omitempty" bson:"price,omitempty"`
+	ProductId   string       `json:"productId,omitempty" bson:"productId,omitempty"`
+	Title       string       `json:"title,omitempty" bson:"title,omitempty"`
+	Description string       `json:"description,omitempty" bson:"description,omitempty"`
+	Quantity    uint64       `json:"quantity,omitempty" bson:"quantity,omitempty"`
+	Price       domain.Money `json:"price" bson:"price"`
 }
 
-func NewShopItemReadModel(title string, description string, quantity uint64, price float64) *ShopItemReadModel {
-	return &ShopItemReadModel{Title: title, Description: description, Quantity: quantity, Price: price}
+func NewShopItemReadModel(productId string, title string, description string, quantity uint64, price domain.Money) *ShopItemReadModel {
+	return &ShopItemReadModel{ProductId: productId, Title: title, Description: description, Quantity: quantity, Price: price}
 }
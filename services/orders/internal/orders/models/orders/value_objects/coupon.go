@@ -0,0 +1,141 @@
+package value_objects
+
+import (
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	domainExceptions "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/exceptions/domain"
+	"strings"
+)
+
+const (
+	PercentageDiscount  = "percentage"
+	FixedAmountDiscount = "fixed_amount"
+)
+
+var percent = domain.NewDecimal(1, 2)
+
+// Coupon is the discount rule of a coupon applied to an order, the minimum basket is optional and a zero money means no minimum
+type Coupon struct {
+	code          string
+	discountType  string
+	percentOff    domain.Decimal
+	amountOff     domain.Money
+	minimumBasket domain.Money
+}
+
+func NewCoupon(code string, discountType string, percentOff domain.Decimal, amountOff domain.Money, minimumBasket domain.Money) (*Coupon, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return nil, domainExceptions.NewInvalidCouponError("[Coupon_NewCoupon] coupon code is required")
+	}
+
+	switch discountType {
+	case PercentageDiscount:
+		if percentOff.Sign() <= 0 || percentOff.Cmp(domain.NewDecimal(100, 0)) > 0 {
+			return nil, domainExceptions.NewInvalidCouponError(fmt.Sprintf("[Coupon_NewCoupon] percent off of coupon %s should be greater than 0 and at most 100", code))
+		}
+		amountOff = domain.Money{}
+	case FixedAmountDiscount:
+		if !amountOff.Currency.IsValid() || !amountOff.IsPositive() {
+			return nil, domainExceptions.NewInvalidCouponError(fmt.Sprintf("[Coupon_NewCoupon] amount off of coupon %s should be a positive amount in a supported currency", code))
+		}
+		percentOff = domain.Decimal{}
+	default:
+		return nil, domainExceptions.NewInvalidCouponError(fmt.Sprintf("[Coupon_NewCoupon] discount type %q of coupon %s is not supported", discountType, code))
+	}
+
+	if !minimumBasket.IsZero() {
+		if !minimumBasket.Currency.IsValid() || minimumBasket.IsNegative() {
+			return nil, domainExceptions.NewInvalidCouponError(fmt.Sprintf("[Coupon_NewCoupon] minimum basket of coupon %s should be a positive amount in a supported currency", code))
+		}
+		if discountType == FixedAmountDiscount && minimumBasket.Currency != amountOff.Currency {
+			return nil, domainExceptions.NewInvalidCouponError(fmt.Sprintf("[Coupon_NewCoupon] minimum basket and amount off of coupon %s should be in one currency", code))
+		}
+	}
+
+	return &Coupon{
+		code:          code,
+		discountType:  discountType,
+		percentOff:    percentOff,
+		amountOff:     amountOff,
+		minimumBasket: minimumBasket,
+	}, nil
+}
+
+func (c *Coupon) Code() string {
+	return c.code
+}
+
+func (c *Coupon) DiscountType() string {
+	return c.discountType
+}
+
+func (c *Coupon) PercentOff() domain.Decimal {
+	return c.percentOff
+}
+
+func (c *Coupon) AmountOff() domain.Money {
+	return c.amountOff
+}
+
+func (c *Coupon) MinimumBasket() domain.Money {
+	return c.minimumBasket
+}
+
+// CheckMinimumBasket checks the basket reaches the minimum basket of the coupon and is priced in the currency of the coupon
+func (c *Coupon) CheckMinimumBasket(basket domain.Money) error {
+	if err := c.checkCurrency(basket); err != nil {
+		return err
+	}
+	if c.minimumBasket.IsZero() {
+		return nil
+	}
+
+	if cmp, _ := basket.Compare(c.minimumBasket); cmp < 0 {
+		return domainExceptions.NewMinimumBasketNotReachedError(fmt.Sprintf("[Coupon_CheckMinimumBasket] minimum basket of coupon %s is %s and the basket is %s", c.code, c.minimumBasket, basket))
+	}
+
+	return nil
+}
+
+// Discount is the discount of the coupon on the basket, it is zero when the basket is below the minimum basket and never more than the basket
+func (c *Coupon) Discount(basket domain.Money) (domain.Money, error) {
+	if err := c.CheckMinimumBasket(basket); err != nil {
+		if domainExceptions.IsMinimumBasketNotReachedError(err) {
+			return domain.ZeroMoney(basket.Currency), nil
+		}
+		return domain.Money{}, err
+	}
+
+	if c.discountType == PercentageDiscount {
+		return basket.MultiplyBy(c.percentOff.Mul(percent), domain.RoundHalfUp)
+	}
+
+	if cmp, _ := c.amountOff.Compare(basket); cmp > 0 {
+		return basket, nil
+	}
+
+	return c.amountOff, nil
+}
+
+func (c *Coupon) checkCurrency(basket domain.Money) error {
+	currency := c.amountOff.Currency
+	if currency == "" {
+		currency = c.minimumBasket.Currency
+	}
+	if currency != "" && currency != basket.Currency {
+		return domainExceptions.NewOrderCurrencyMismatchError(fmt.Sprintf("[Coupon_checkCurrency] coupon %s is in %s and the basket is priced in %s", c.code, currency, basket.Currency))
+	}
+
+	return nil
+}
+
+func (c *Coupon) String() string {
+	return fmt.Sprintf("Code: {%s}, DiscountType: {%s}, PercentOff: {%s}, AmountOff: {%v}, MinimumBasket: {%v},",
+		c.code,
+		c.discountType,
+		c.percentOff,
+		c.amountOff,
+		c.minimumBasket,
+	)
+}
@@ -0,0 +1,66 @@
+package value_objects
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	domainExceptions "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/exceptions/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_New_Coupon(t *testing.T) {
+	coupon, err := NewCoupon(" summer10 ", PercentageDiscount, domain.NewDecimal(10, 0), domain.Money{}, domain.Money{})
+	require.NoError(t, err)
+	assert.Equal(t, "SUMMER10", coupon.Code())
+
+	_, err = NewCoupon("SUMMER10", PercentageDiscount, domain.NewDecimal(101, 0), domain.Money{}, domain.Money{})
+	assert.True(t, domainExceptions.IsInvalidCouponError(err))
+
+	_, err = NewCoupon("FIVE", FixedAmountDiscount, domain.Decimal{}, domain.Money{}, domain.Money{})
+	assert.True(t, domainExceptions.IsInvalidCouponError(err))
+
+	_, err = NewCoupon("FIVE", FixedAmountDiscount, domain.Decimal{}, domain.MustParseMoney("5", "USD"), domain.MustParseMoney("50", "EUR"))
+	assert.True(t, domainExceptions.IsInvalidCouponError(err))
+
+	_, err = NewCoupon("FIVE", "free_shipping", domain.Decimal{}, domain.Money{}, domain.Money{})
+	assert.True(t, domainExceptions.IsInvalidCouponError(err))
+}
+
+func Test_Coupon_Discount(t *testing.T) {
+	t.Run("Percentage", func(t *testing.T) {
+		coupon, err := NewCoupon("SUMMER", PercentageDiscount, domain.NewDecimal(125, 1), domain.Money{}, domain.Money{})
+		require.NoError(t, err)
+
+		discount, err := coupon.Discount(domain.MustParseMoney("19.99", "EUR"))
+		require.NoError(t, err)
+		assert.Equal(t, domain.MustParseMoney("2.50", "EUR"), discount)
+	})
+
+	t.Run("Fixed amount is capped at the basket", func(t *testing.T) {
+		coupon, err := NewCoupon("FIVE", FixedAmountDiscount, domain.Decimal{}, domain.MustParseMoney("5", "USD"), domain.Money{})
+		require.NoError(t, err)
+
+		discount, err := coupon.Discount(domain.MustParseMoney("20", "USD"))
+		require.NoError(t, err)
+		assert.Equal(t, domain.MustParseMoney("5", "USD"), discount)
+
+		discount, err = coupon.Discount(domain.MustParseMoney("3.20", "USD"))
+		require.NoError(t, err)
+		assert.Equal(t, domain.MustParseMoney("3.20", "USD"), discount)
+
+		_, err = coupon.Discount(domain.MustParseMoney("20", "EUR"))
+		assert.True(t, domainExceptions.IsOrderCurrencyMismatchError(err))
+	})
+
+	t.Run("Minimum basket", func(t *testing.T) {
+		coupon, err := NewCoupon("BIG", PercentageDiscount, domain.NewDecimal(10, 0), domain.Money{}, domain.MustParseMoney("50", "USD"))
+		require.NoError(t, err)
+
+		assert.True(t, domainExceptions.IsMinimumBasketNotReachedError(coupon.CheckMinimumBasket(domain.MustParseMoney("49.99", "USD"))))
+		assert.NoError(t, coupon.CheckMinimumBasket(domain.MustParseMoney("50", "USD")))
+
+		discount, err := coupon.Discount(domain.MustParseMoney("49.99", "USD"))
+		require.NoError(t, err)
+		assert.True(t, discount.IsZero())
+	})
+}
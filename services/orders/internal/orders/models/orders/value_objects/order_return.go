@@ -0,0 +1,127 @@
+package value_objects
+
+import (
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+const (
+	ReturnRequestedStatus = "requested"
+	ReturnApprovedStatus  = "approved"
+	ReturnRefundedStatus  = "refunded"
+)
+
+// ReturnItem is a returned quantity of a shop item of the order
+type ReturnItem struct {
+	productId uuid.UUID
+	quantity  uint64
+}
+
+func NewReturnItem(productId uuid.UUID, quantity uint64) *ReturnItem {
+	return &ReturnItem{productId: productId, quantity: quantity}
+}
+
+func (r *ReturnItem) ProductId() uuid.UUID {
+	return r.productId
+}
+
+func (r *ReturnItem) Quantity() uint64 {
+	return r.quantity
+}
+
+func (r *ReturnItem) String() string {
+	return fmt.Sprintf("ProductId: {%s}, Quantity: {%v},", r.productId, r.quantity)
+}
+
+// OrderReturn is a return of some items of a completed order, it is requested, approved when the items are received and refunded at last.
+// The transitions return a new return instead of changing it
+type OrderReturn struct {
+	returnId     uuid.UUID
+	items        []*ReturnItem
+	reason       string
+	refundAmount domain.Money
+	status       string
+	refundId     uuid.UUID
+	requestedAt  time.Time
+	approvedAt   time.Time
+	refundedAt   time.Time
+}
+
+func NewOrderReturn(returnId uuid.UUID, items []*ReturnItem, reason string, refundAmount domain.Money, requestedAt time.Time) *OrderReturn {
+	return &OrderReturn{
+		returnId:     returnId,
+		items:        items,
+		reason:       reason,
+		refundAmount: refundAmount,
+		status:       ReturnRequestedStatus,
+		requestedAt:  requestedAt,
+	}
+}
+
+// Approve is the approved copy of the return
+func (r *OrderReturn) Approve(approvedAt time.Time) *OrderReturn {
+	approved := *r
+	approved.status = ReturnApprovedStatus
+	approved.approvedAt = approvedAt
+
+	return &approved
+}
+
+// Refund is the refunded copy of the return, the refund id is the id of the refund in the payments service
+func (r *OrderReturn) Refund(refundId uuid.UUID, refundedAt time.Time) *OrderReturn {
+	refunded := *r
+	refunded.status = ReturnRefundedStatus
+	refunded.refundId = refundId
+	refunded.refundedAt = refundedAt
+
+	return &refunded
+}
+
+func (r *OrderReturn) ReturnId() uuid.UUID {
+	return r.returnId
+}
+
+func (r *OrderReturn) Items() []*ReturnItem {
+	return r.items
+}
+
+func (r *OrderReturn) Reason() string {
+	return r.reason
+}
+
+// RefundAmount is the price of the returned items minus their share of the order discount
+func (r *OrderReturn) RefundAmount() domain.Money {
+	return r.refundAmount
+}
+
+func (r *OrderReturn) Status() string {
+	return r.status
+}
+
+func (r *OrderReturn) RefundId() uuid.UUID {
+	return r.refundId
+}
+
+func (r *OrderReturn) RequestedAt() time.Time {
+	return r.requestedAt
+}
+
+func (r *OrderReturn) ApprovedAt() time.Time {
+	return r.approvedAt
+}
+
+func (r *OrderReturn) RefundedAt() time.Time {
+	return r.refundedAt
+}
+
+func (r *OrderReturn) String() string {
+	return fmt.Sprintf("ReturnId: {%s}, Items: {%v}, Reason: {%s}, RefundAmount: {%v}, Status: {%s},",
+		r.returnId,
+		r.items,
+		r.reason,
+		r.refundAmount,
+		r.status,
+	)
+}
@@ -2,17 +2,21 @@ package value_objects
 
 import (
 	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
 )
 
 type ShopItem struct {
+	productId   uuid.UUID
 	title       string
 	description string
 	quantity    uint64
-	price       float64
+	price       domain.Money
 }
 
-func CreateNewShopItem(title string, description string, quantity uint64, price float64) *ShopItem {
+func CreateNewShopItem(productId uuid.UUID, title string, description string, quantity uint64, price domain.Money) *ShopItem {
 	return &ShopItem{
+		productId:   productId,
 		title:       title,
 		description: description,
 		quantity:    quantity,
@@ -20,6 +24,10 @@ func CreateNewShopItem(title string, description string, quantity uint64, price
 	}
 }
 
+func (s *ShopItem) ProductId() uuid.UUID {
+	return s.productId
+}
+
 func (s *ShopItem) Title() string {
 	return s.title
 }
@@ -32,12 +40,18 @@ func (s *ShopItem) Quantity() uint64 {
 	return s.quantity
 }
 
-func (s *ShopItem) Price() float64 {
+func (s *ShopItem) Price() domain.Money {
 	return s.price
 }
 
+// TotalPrice is the price of the item multiplied by its quantity, it returns domain.ErrDecimalOutOfRange for a too large quantity
+func (s *ShopItem) TotalPrice() (domain.Money, error) {
+	return s.price.Multiply(s.quantity)
+}
+
 func (s *ShopItem) String() string {
-	return fmt.Sprintf("Title: {%s}, Description: {%s}, Quantity: {%v}, Price: {%v},",
+	return fmt.Sprintf("ProductId: {%s}, Title: {%s}, Description: {%s}, Quantity: {%v}, Price: {%v},",
+		s.productId,
 		s.title,
 		s.description,
 		s.quantity,
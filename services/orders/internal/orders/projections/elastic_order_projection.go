@@ -2,20 +2,203 @@ package projections
 
 import (
 	"context"
+	"emperror.dev/errors"
+	"fmt"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/projection"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/models"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/repositories"
+	applyingCouponEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/events/domain/v1"
+	approvingReturnEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/approving_return/events/domain/v1"
+	cancelingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/domain/v1"
+	completingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/completing_order/events/domain/v1"
+	creatingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/events/domain/v1"
+	issuingRefundEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/events/domain/v1"
+	payingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/domain/v1"
+	requestingReturnEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/events/domain/v1"
+	reschedulingDeliveryEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/rescheduling_delivery/events/domain/v1"
+	submittingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/events/domain/v1"
+	updatingShoppingCartEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/events/domain/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/read_models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
 )
 
+// elasticOrderProjection keeps the stream version of the last projected event in the read model,
+// the events with the same or an older version are already projected and are skipped, so the subscription can replay them safely
 type elasticOrderProjection struct {
 	elasticOrderReadRepository repositories.OrderReadRepository
+	logger                     logger.Logger
 }
 
-func NewElasticOrderProjection(elasticOrderReadRepository repositories.OrderReadRepository) projection.IProjection {
-	return &elasticOrderProjection{elasticOrderReadRepository: elasticOrderReadRepository}
+func NewElasticOrderProjection(elasticOrderReadRepository repositories.OrderReadRepository, logger logger.Logger) projection.IProjection {
+	return &elasticOrderProjection{elasticOrderReadRepository: elasticOrderReadRepository, logger: logger}
 }
 
 func (e elasticOrderProjection) ProcessEvent(ctx context.Context, streamEvent *models.StreamEvent) error {
-	//TODO: Handling and projecting event to elastic read model
+	switch evt := streamEvent.Event.(type) {
+
+	case *creatingOrderEvents.OrderCreatedV1:
+		return e.onOrderCreated(ctx, evt, streamEvent.Version)
+
+	case *updatingShoppingCartEvents.ShoppingCartUpdatedV1:
+		items, err := mapper.Map[[]*read_models.ShopItemReadModel](evt.ShopItems)
+		if err != nil {
+			return errors.WrapIf(err, "[elasticOrderProjection_ProcessEvent.Map] error in mapping shopItems")
+		}
+		return e.updateOrder(ctx, evt.OrderId, streamEvent, func(order *read_models.OrderReadModel) {
+			order.UpdateShopItems(items)
+			order.UpdatedAt = evt.UpdatedAt
+		})
+
+	case *applyingCouponEvents.CouponAppliedV1:
+		coupon, err := mapper.Map[*read_models.CouponReadModel](evt.Coupon)
+		if err != nil {
+			return errors.WrapIf(err, "[elasticOrderProjection_ProcessEvent.Map] error in mapping coupon")
+		}
+		return e.updateOrder(ctx, evt.OrderId, streamEvent, func(order *read_models.OrderReadModel) {
+			order.ApplyCoupon(coupon)
+			order.UpdatedAt = evt.AppliedAt
+		})
+
+	case *submittingOrderEvents.OrderSubmittedV1:
+		return e.updateOrder(ctx, evt.OrderId, streamEvent, func(order *read_models.OrderReadModel) {
+			order.Submitted = true
+			order.Status = read_models.OrderSubmittedStatus
+			order.UpdatedAt = evt.SubmittedAt
+		})
+
+	case *payingOrderEvents.OrderPaidV1:
+		return e.updateOrder(ctx, evt.OrderId, streamEvent, func(order *read_models.OrderReadModel) {
+			order.Paid = true
+			order.Status = read_models.OrderPaidStatus
+			order.PaymentId = evt.PaymentId.String()
+			order.UpdatedAt = evt.PaidAt
+		})
+
+	case *cancelingOrderEvents.OrderCanceledV1:
+		return e.updateOrder(ctx, evt.OrderId, streamEvent, func(order *read_models.OrderReadModel) {
+			order.Canceled = true
+			order.Status = read_models.OrderCanceledStatus
+			order.CancelReason = evt.CancelReason
+			order.UpdatedAt = evt.CanceledAt
+		})
+
+	case *completingOrderEvents.OrderCompletedV1:
+		return e.updateOrder(ctx, evt.OrderId, streamEvent, func(order *read_models.OrderReadModel) {
+			order.Completed = true
+			order.Status = read_models.OrderCompletedStatus
+			order.UpdatedAt = evt.CompletedAt
+		})
+
+	case *reschedulingDeliveryEvents.DeliveryRescheduledV1:
+		return e.updateOrder(ctx, evt.OrderId, streamEvent, func(order *read_models.OrderReadModel) {
+			order.DeliveredTime = evt.DeliveredTime
+			order.UpdatedAt = evt.RescheduledAt
+		})
+
+	case *requestingReturnEvents.ReturnRequestedV1:
+		items, err := mapper.Map[[]*read_models.ReturnItemReadModel](evt.Items)
+		if err != nil {
+			return errors.WrapIf(err, "[elasticOrderProjection_ProcessEvent.Map] error in mapping return items")
+		}
+		return e.updateOrder(ctx, evt.OrderId, streamEvent, func(order *read_models.OrderReadModel) {
+			order.AddReturn(read_models.NewOrderReturnReadModel(evt.ReturnId.String(), items, evt.Reason, evt.RefundAmount, value_objects.ReturnRequestedStatus, evt.RequestedAt))
+			order.UpdatedAt = evt.RequestedAt
+		})
+
+	case *approvingReturnEvents.ReturnApprovedV1:
+		return e.updateOrder(ctx, evt.OrderId, streamEvent, func(order *read_models.OrderReadModel) {
+			order.ApproveReturn(evt.ReturnId.String(), evt.ApprovedAt)
+			order.UpdatedAt = evt.ApprovedAt
+		})
+
+	case *issuingRefundEvents.RefundIssuedV1:
+		return e.updateOrder(ctx, evt.OrderId, streamEvent, func(order *read_models.OrderReadModel) {
+			order.RefundReturn(evt.ReturnId.String(), evt.RefundId.String(), evt.RefundedAt)
+			order.UpdatedAt = evt.RefundedAt
+		})
+	}
+
 	return nil
 }
+
+func (e elasticOrderProjection) onOrderCreated(ctx context.Context, evt *creatingOrderEvents.OrderCreatedV1, version int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "elasticOrderProjection.onOrderCreated")
+	span.LogFields(log.String("OrderId", evt.OrderId.String()))
+	span.LogFields(log.Object("Event", evt))
+	defer span.Finish()
+
+	existing, err := e.elasticOrderReadRepository.GetOrderByOrderId(ctx, evt.OrderId)
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, "[elasticOrderProjection_onOrderCreated.GetOrderByOrderId] error in loading order with elasticOrderReadRepository"))
+	}
+	if existing != nil && existing.Version >= version {
+		e.skip(evt.OrderId, version, existing.Version)
+		return nil
+	}
+
+	items, err := mapper.Map[[]*read_models.ShopItemReadModel](evt.ShopItems)
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, "[elasticOrderProjection_onOrderCreated.Map] error in mapping shopItems"))
+	}
+
+	orderRead := read_models.NewOrderReadModel(evt.OrderId, items, evt.AccountEmail, evt.DeliveryAddress, evt.DeliveredTime)
+	orderRead.CreatedAt = evt.CreatedAt
+	orderRead.Version = version
+
+	_, err = e.elasticOrderReadRepository.CreateOrder(ctx, orderRead)
+	if customErrors.IsConflictError(err) {
+		e.skip(evt.OrderId, version, version)
+		return nil
+	}
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, "[elasticOrderProjection_onOrderCreated.CreateOrder] error in creating order with elasticOrderReadRepository"))
+	}
+
+	return nil
+}
+
+// updateOrder applies the event to the order read model when the event is newer than the projected state of the order
+func (e elasticOrderProjection) updateOrder(ctx context.Context, orderId uuid.UUID, streamEvent *models.StreamEvent, apply func(order *read_models.OrderReadModel)) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "elasticOrderProjection.updateOrder")
+	span.LogFields(log.String("OrderId", orderId.String()))
+	span.LogFields(log.Object("Event", streamEvent.Event))
+	defer span.Finish()
+
+	orderRead, err := e.elasticOrderReadRepository.GetOrderByOrderId(ctx, orderId)
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, "[elasticOrderProjection_updateOrder.GetOrderByOrderId] error in loading order with elasticOrderReadRepository"))
+	}
+	if orderRead == nil {
+		return tracing.TraceWithErr(span, errors.Errorf("[elasticOrderProjection_updateOrder] order with orderId %s not found in the elastic read model", orderId))
+	}
+	if orderRead.Version >= streamEvent.Version {
+		e.skip(orderId, streamEvent.Version, orderRead.Version)
+		return nil
+	}
+
+	apply(orderRead)
+	orderRead.Version = streamEvent.Version
+
+	_, err = e.elasticOrderReadRepository.UpdateOrder(ctx, orderRead)
+	if customErrors.IsConflictError(err) {
+		// a concurrent projection of the same stream stored a newer version
+		e.skip(orderId, streamEvent.Version, streamEvent.Version)
+		return nil
+	}
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, "[elasticOrderProjection_updateOrder.UpdateOrder] error in updating order with elasticOrderReadRepository"))
+	}
+
+	return nil
+}
+
+func (e elasticOrderProjection) skip(orderId uuid.UUID, version int64, projectedVersion int64) {
+	e.logger.Infow(fmt.Sprintf("[elasticOrderProjection] event with version %d of order %s is already projected", version, orderId), logger.Fields{"OrderId": orderId, "Version": version, "ProjectedVersion": projectedVersion})
+}
@@ -0,0 +1,153 @@
+package projections
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/models"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/mappings"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	applyingCouponEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/events/domain/v1"
+	creatingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/events/domain/v1"
+	payingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/domain/v1"
+	submittingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/events/domain/v1"
+	updatingShoppingCartEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/events/domain/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/read_models"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+var configureMappings sync.Once
+
+// inMemoryOrderReadRepository rejects the stale writes like the elastic external versioning
+type inMemoryOrderReadRepository struct {
+	orders map[string]read_models.OrderReadModel
+	writes int
+}
+
+func (r *inMemoryOrderReadRepository) GetAllOrders(ctx context.Context, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
+	return nil, nil
+}
+
+func (r *inMemoryOrderReadRepository) SearchOrders(ctx context.Context, searchText string, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
+	return nil, nil
+}
+
+func (r *inMemoryOrderReadRepository) GetCustomerOrders(ctx context.Context, accountEmail string, status string, listQuery *utils.ListQuery) (*utils.ListResult[*read_models.OrderReadModel], error) {
+	return nil, nil
+}
+
+func (r *inMemoryOrderReadRepository) GetOrderById(ctx context.Context, id uuid.UUID) (*read_models.OrderReadModel, error) {
+	return nil, nil
+}
+
+func (r *inMemoryOrderReadRepository) GetOrderByOrderId(ctx context.Context, orderId uuid.UUID) (*read_models.OrderReadModel, error) {
+	order, ok := r.orders[orderId.String()]
+	if !ok {
+		return nil, nil
+	}
+	return &order, nil
+}
+
+func (r *inMemoryOrderReadRepository) CreateOrder(ctx context.Context, order *read_models.OrderReadModel) (*read_models.OrderReadModel, error) {
+	return r.UpdateOrder(ctx, order)
+}
+
+func (r *inMemoryOrderReadRepository) UpdateOrder(ctx context.Context, order *read_models.OrderReadModel) (*read_models.OrderReadModel, error) {
+	if existing, ok := r.orders[order.OrderId]; ok && existing.Version >= order.Version {
+		return nil, customErrors.NewConflictError("stale version")
+	}
+	r.orders[order.OrderId] = *order
+	r.writes++
+	return order, nil
+}
+
+func (r *inMemoryOrderReadRepository) DeleteOrderByID(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func Test_Elastic_Order_Projection_Is_Idempotent(t *testing.T) {
+	configureMappings.Do(func() {
+		require.NoError(t, mappings.ConfigureMappings())
+	})
+
+	repository := &inMemoryOrderReadRepository{orders: map[string]read_models.OrderReadModel{}}
+	projection := NewElasticOrderProjection(repository, defaultLogger.Logger)
+	ctx := context.Background()
+
+	orderId := uuid.NewV4()
+	created, err := creatingOrderEvents.NewOrderCreatedEventV1(orderId, []*dtos.ShopItemDto{{Title: "book", Quantity: 2, Price: domain.MustParseMoney("10", "USD")}}, "test@example.com", "test address", time.Now(), time.Now())
+	require.NoError(t, err)
+	submitted, err := submittingOrderEvents.NewOrderSubmittedV1(orderId, time.Now())
+	require.NoError(t, err)
+	paid, err := payingOrderEvents.NewOrderPaidV1(orderId, uuid.NewV4(), time.Now())
+	require.NoError(t, err)
+
+	events := []*models.StreamEvent{
+		{Version: 0, Event: created},
+		{Version: 1, Event: submitted},
+		{Version: 2, Event: paid},
+	}
+	for _, event := range events {
+		require.NoError(t, projection.ProcessEvent(ctx, event))
+	}
+
+	// replaying the stream doesn't change the projected order
+	for _, event := range events {
+		require.NoError(t, projection.ProcessEvent(ctx, event))
+	}
+
+	order, err := repository.GetOrderByOrderId(ctx, orderId)
+	require.NoError(t, err)
+	assert.Equal(t, 3, repository.writes)
+	assert.Equal(t, int64(2), order.Version)
+	assert.True(t, order.Submitted)
+	assert.True(t, order.Paid)
+	assert.Equal(t, domain.MustParseMoney("20", "USD"), order.TotalPrice)
+}
+
+func Test_Elastic_Order_Projection_Recalculates_Discount(t *testing.T) {
+	configureMappings.Do(func() {
+		require.NoError(t, mappings.ConfigureMappings())
+	})
+
+	repository := &inMemoryOrderReadRepository{orders: map[string]read_models.OrderReadModel{}}
+	projection := NewElasticOrderProjection(repository, defaultLogger.Logger)
+	ctx := context.Background()
+
+	orderId := uuid.NewV4()
+	created, err := creatingOrderEvents.NewOrderCreatedEventV1(orderId, []*dtos.ShopItemDto{{Title: "book", Quantity: 2, Price: domain.MustParseMoney("10", "USD")}}, "test@example.com", "test address", time.Now(), time.Now())
+	require.NoError(t, err)
+	couponApplied, err := applyingCouponEvents.NewCouponAppliedV1(orderId, &dtos.CouponDto{Code: "SUMMER10", DiscountType: "percentage", PercentOff: domain.NewDecimal(10, 0)}, time.Now())
+	require.NoError(t, err)
+	cartUpdated, err := updatingShoppingCartEvents.NewShoppingCartUpdatedV1(orderId, []*dtos.ShopItemDto{{Title: "book", Quantity: 3, Price: domain.MustParseMoney("10", "USD")}}, time.Now())
+	require.NoError(t, err)
+
+	require.NoError(t, projection.ProcessEvent(ctx, &models.StreamEvent{Version: 0, Event: created}))
+	require.NoError(t, projection.ProcessEvent(ctx, &models.StreamEvent{Version: 1, Event: couponApplied}))
+
+	order, err := repository.GetOrderByOrderId(ctx, orderId)
+	require.NoError(t, err)
+	assert.Equal(t, "SUMMER10", order.CouponCode())
+	assert.Equal(t, domain.MustParseMoney("2", "USD"), order.Discount)
+	assert.Equal(t, domain.MustParseMoney("18", "USD"), order.TotalPrice)
+
+	require.NoError(t, projection.ProcessEvent(ctx, &models.StreamEvent{Version: 2, Event: cartUpdated}))
+
+	order, err = repository.GetOrderByOrderId(ctx, orderId)
+	require.NoError(t, err)
+	assert.Equal(t, domain.MustParseMoney("3", "USD"), order.Discount)
+	assert.Equal(t, domain.MustParseMoney("27", "USD"), order.TotalPrice)
+
+	orderReadDto, err := mapper.Map[*dtos.OrderReadDto](order)
+	require.NoError(t, err)
+	assert.Equal(t, "SUMMER10", orderReadDto.CouponCode)
+	assert.Equal(t, domain.MustParseMoney("3", "USD"), orderReadDto.Discount)
+}
@@ -10,14 +10,37 @@ import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/producer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/repositories"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	applyingCouponEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/events/domain/v1"
+	applyingCouponIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/events/integration/v1"
+	approvingReturnEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/approving_return/events/domain/v1"
+	approvingReturnIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/approving_return/events/integration/v1"
+	cancelingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/domain/v1"
+	cancelingOrderIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/integration/v1"
+	completingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/completing_order/events/domain/v1"
+	completingOrderIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/completing_order/events/integration/v1"
 	creatingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/events/domain/v1"
 	v1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/events/integration/v1"
+	issuingRefundEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/events/domain/v1"
+	issuingRefundIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/events/integration/v1"
+	payingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/domain/v1"
+	payingOrderIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/integration/v1"
+	requestingReturnEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/events/domain/v1"
+	requestingReturnIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/events/integration/v1"
+	reschedulingDeliveryEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/rescheduling_delivery/events/domain/v1"
+	reschedulingDeliveryIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/rescheduling_delivery/events/integration/v1"
+	submittingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/events/domain/v1"
+	submittingOrderIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/submitting_order/events/integration/v1"
+	updatingShoppingCartEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/events/domain/v1"
+	updatingShoppingCartIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/events/integration/v1"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/read_models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
 )
 
 type mongoOrderProjection struct {
@@ -35,13 +58,43 @@ func (m mongoOrderProjection) ProcessEvent(ctx context.Context, streamEvent *mod
 	switch evt := streamEvent.Event.(type) {
 
 	case *creatingOrderEvents.OrderCreatedV1:
-		return m.onOrderCreated(ctx, evt)
+		return m.onOrderCreated(ctx, evt, streamEvent.Version)
+
+	case *updatingShoppingCartEvents.ShoppingCartUpdatedV1:
+		return m.onShoppingCartUpdated(ctx, evt, streamEvent.Version)
+
+	case *applyingCouponEvents.CouponAppliedV1:
+		return m.onCouponApplied(ctx, evt, streamEvent.Version)
+
+	case *submittingOrderEvents.OrderSubmittedV1:
+		return m.onOrderSubmitted(ctx, evt, streamEvent.Version)
+
+	case *payingOrderEvents.OrderPaidV1:
+		return m.onOrderPaid(ctx, evt, streamEvent.Version)
+
+	case *cancelingOrderEvents.OrderCanceledV1:
+		return m.onOrderCanceled(ctx, evt, streamEvent.Version)
+
+	case *completingOrderEvents.OrderCompletedV1:
+		return m.onOrderCompleted(ctx, evt, streamEvent.Version)
+
+	case *reschedulingDeliveryEvents.DeliveryRescheduledV1:
+		return m.onDeliveryRescheduled(ctx, evt, streamEvent.Version)
+
+	case *requestingReturnEvents.ReturnRequestedV1:
+		return m.onReturnRequested(ctx, evt, streamEvent.Version)
+
+	case *approvingReturnEvents.ReturnApprovedV1:
+		return m.onReturnApproved(ctx, evt, streamEvent.Version)
+
+	case *issuingRefundEvents.RefundIssuedV1:
+		return m.onRefundIssued(ctx, evt, streamEvent.Version)
 	}
 
 	return nil
 }
 
-func (m *mongoOrderProjection) onOrderCreated(ctx context.Context, evt *creatingOrderEvents.OrderCreatedV1) error {
+func (m *mongoOrderProjection) onOrderCreated(ctx context.Context, evt *creatingOrderEvents.OrderCreatedV1, version int64) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "mongoOrderProjection.onOrderCreated")
 	span.LogFields(log.String("OrderId", evt.OrderId.String()))
 	span.LogFields(log.Object("Event", evt))
@@ -53,6 +106,7 @@ func (m *mongoOrderProjection) onOrderCreated(ctx context.Context, evt *creating
 	}
 
 	orderRead := read_models.NewOrderReadModel(evt.OrderId, items, evt.AccountEmail, evt.DeliveryAddress, evt.DeliveredTime)
+	orderRead.Version = version
 	_, err = m.mongoOrderRepository.CreateOrder(ctx, orderRead)
 	if err != nil {
 		return errors.WrapIf(err, "[mongoOrderProjection_onOrderCreated.CreateOrder] error in creating order with mongoOrderRepository")
@@ -76,3 +130,209 @@ func (m *mongoOrderProjection) onOrderCreated(ctx context.Context, evt *creating
 
 	return nil
 }
+
+func (m *mongoOrderProjection) onShoppingCartUpdated(ctx context.Context, evt *updatingShoppingCartEvents.ShoppingCartUpdatedV1, version int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongoOrderProjection.onShoppingCartUpdated")
+	span.LogFields(log.String("OrderId", evt.OrderId.String()))
+	span.LogFields(log.Object("Event", evt))
+	defer span.Finish()
+
+	items, err := mapper.Map[[]*read_models.ShopItemReadModel](evt.ShopItems)
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, "[mongoOrderProjection_onShoppingCartUpdated.Map] error in mapping shopItems"))
+	}
+
+	return tracing.TraceWithErr(span, m.updateOrder(ctx, evt.OrderId, version, func(order *read_models.OrderReadModel) {
+		order.UpdateShopItems(items)
+		order.UpdatedAt = evt.UpdatedAt
+	}, func(orderReadDto *dtos.OrderReadDto) types.IMessage {
+		return updatingShoppingCartIntegration.NewShoppingCartUpdatedV1(orderReadDto)
+	}))
+}
+
+func (m *mongoOrderProjection) onCouponApplied(ctx context.Context, evt *applyingCouponEvents.CouponAppliedV1, version int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongoOrderProjection.onCouponApplied")
+	span.LogFields(log.String("OrderId", evt.OrderId.String()))
+	span.LogFields(log.Object("Event", evt))
+	defer span.Finish()
+
+	coupon, err := mapper.Map[*read_models.CouponReadModel](evt.Coupon)
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, "[mongoOrderProjection_onCouponApplied.Map] error in mapping coupon"))
+	}
+
+	return tracing.TraceWithErr(span, m.updateOrder(ctx, evt.OrderId, version, func(order *read_models.OrderReadModel) {
+		order.ApplyCoupon(coupon)
+		order.UpdatedAt = evt.AppliedAt
+	}, func(orderReadDto *dtos.OrderReadDto) types.IMessage {
+		return applyingCouponIntegration.NewCouponAppliedV1(orderReadDto)
+	}))
+}
+
+func (m *mongoOrderProjection) onOrderSubmitted(ctx context.Context, evt *submittingOrderEvents.OrderSubmittedV1, version int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongoOrderProjection.onOrderSubmitted")
+	span.LogFields(log.String("OrderId", evt.OrderId.String()))
+	span.LogFields(log.Object("Event", evt))
+	defer span.Finish()
+
+	return tracing.TraceWithErr(span, m.updateOrder(ctx, evt.OrderId, version, func(order *read_models.OrderReadModel) {
+		order.Submitted = true
+		order.Status = read_models.OrderSubmittedStatus
+		order.UpdatedAt = evt.SubmittedAt
+	}, func(orderReadDto *dtos.OrderReadDto) types.IMessage {
+		return submittingOrderIntegration.NewOrderSubmittedV1(orderReadDto)
+	}))
+}
+
+func (m *mongoOrderProjection) onOrderPaid(ctx context.Context, evt *payingOrderEvents.OrderPaidV1, version int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongoOrderProjection.onOrderPaid")
+	span.LogFields(log.String("OrderId", evt.OrderId.String()))
+	span.LogFields(log.Object("Event", evt))
+	defer span.Finish()
+
+	return tracing.TraceWithErr(span, m.updateOrder(ctx, evt.OrderId, version, func(order *read_models.OrderReadModel) {
+		order.Paid = true
+		order.Status = read_models.OrderPaidStatus
+		order.PaymentId = evt.PaymentId.String()
+		order.UpdatedAt = evt.PaidAt
+	}, func(orderReadDto *dtos.OrderReadDto) types.IMessage {
+		return payingOrderIntegration.NewOrderPaidV1(orderReadDto)
+	}))
+}
+
+func (m *mongoOrderProjection) onOrderCanceled(ctx context.Context, evt *cancelingOrderEvents.OrderCanceledV1, version int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongoOrderProjection.onOrderCanceled")
+	span.LogFields(log.String("OrderId", evt.OrderId.String()))
+	span.LogFields(log.Object("Event", evt))
+	defer span.Finish()
+
+	return tracing.TraceWithErr(span, m.updateOrder(ctx, evt.OrderId, version, func(order *read_models.OrderReadModel) {
+		order.Canceled = true
+		order.Status = read_models.OrderCanceledStatus
+		order.CancelReason = evt.CancelReason
+		order.UpdatedAt = evt.CanceledAt
+	}, func(orderReadDto *dtos.OrderReadDto) types.IMessage {
+		return cancelingOrderIntegration.NewOrderCanceledV1(orderReadDto)
+	}))
+}
+
+func (m *mongoOrderProjection) onOrderCompleted(ctx context.Context, evt *completingOrderEvents.OrderCompletedV1, version int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongoOrderProjection.onOrderCompleted")
+	span.LogFields(log.String("OrderId", evt.OrderId.String()))
+	span.LogFields(log.Object("Event", evt))
+	defer span.Finish()
+
+	return tracing.TraceWithErr(span, m.updateOrder(ctx, evt.OrderId, version, func(order *read_models.OrderReadModel) {
+		order.Completed = true
+		order.Status = read_models.OrderCompletedStatus
+		order.UpdatedAt = evt.CompletedAt
+	}, func(orderReadDto *dtos.OrderReadDto) types.IMessage {
+		return completingOrderIntegration.NewOrderCompletedV1(orderReadDto)
+	}))
+}
+
+func (m *mongoOrderProjection) onDeliveryRescheduled(ctx context.Context, evt *reschedulingDeliveryEvents.DeliveryRescheduledV1, version int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongoOrderProjection.onDeliveryRescheduled")
+	span.LogFields(log.String("OrderId", evt.OrderId.String()))
+	span.LogFields(log.Object("Event", evt))
+	defer span.Finish()
+
+	return tracing.TraceWithErr(span, m.updateOrder(ctx, evt.OrderId, version, func(order *read_models.OrderReadModel) {
+		order.DeliveredTime = evt.DeliveredTime
+		order.UpdatedAt = evt.RescheduledAt
+	}, func(orderReadDto *dtos.OrderReadDto) types.IMessage {
+		return reschedulingDeliveryIntegration.NewDeliveryRescheduledV1(orderReadDto)
+	}))
+}
+
+func (m *mongoOrderProjection) onReturnRequested(ctx context.Context, evt *requestingReturnEvents.ReturnRequestedV1, version int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongoOrderProjection.onReturnRequested")
+	span.LogFields(log.String("OrderId", evt.OrderId.String()))
+	span.LogFields(log.Object("Event", evt))
+	defer span.Finish()
+
+	items, err := mapper.Map[[]*read_models.ReturnItemReadModel](evt.Items)
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, "[mongoOrderProjection_onReturnRequested.Map] error in mapping return items"))
+	}
+
+	return tracing.TraceWithErr(span, m.updateOrder(ctx, evt.OrderId, version, func(order *read_models.OrderReadModel) {
+		order.AddReturn(read_models.NewOrderReturnReadModel(evt.ReturnId.String(), items, evt.Reason, evt.RefundAmount, value_objects.ReturnRequestedStatus, evt.RequestedAt))
+		order.UpdatedAt = evt.RequestedAt
+	}, func(orderReadDto *dtos.OrderReadDto) types.IMessage {
+		return requestingReturnIntegration.NewReturnRequestedV1(orderReadDto)
+	}))
+}
+
+func (m *mongoOrderProjection) onReturnApproved(ctx context.Context, evt *approvingReturnEvents.ReturnApprovedV1, version int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongoOrderProjection.onReturnApproved")
+	span.LogFields(log.String("OrderId", evt.OrderId.String()))
+	span.LogFields(log.Object("Event", evt))
+	defer span.Finish()
+
+	returnId := evt.ReturnId.String()
+
+	return tracing.TraceWithErr(span, m.updateOrder(ctx, evt.OrderId, version, func(order *read_models.OrderReadModel) {
+		order.ApproveReturn(returnId, evt.ApprovedAt)
+		order.UpdatedAt = evt.ApprovedAt
+	}, func(orderReadDto *dtos.OrderReadDto) types.IMessage {
+		var returnedItems []*dtos.ReturnItemReadDto
+		for _, orderReturn := range orderReadDto.Returns {
+			if orderReturn.ReturnId == returnId {
+				returnedItems = orderReturn.Items
+			}
+		}
+
+		return approvingReturnIntegration.NewReturnApprovedV1(orderReadDto, returnId, returnedItems)
+	}))
+}
+
+func (m *mongoOrderProjection) onRefundIssued(ctx context.Context, evt *issuingRefundEvents.RefundIssuedV1, version int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongoOrderProjection.onRefundIssued")
+	span.LogFields(log.String("OrderId", evt.OrderId.String()))
+	span.LogFields(log.Object("Event", evt))
+	defer span.Finish()
+
+	return tracing.TraceWithErr(span, m.updateOrder(ctx, evt.OrderId, version, func(order *read_models.OrderReadModel) {
+		order.RefundReturn(evt.ReturnId.String(), evt.RefundId.String(), evt.RefundedAt)
+		order.UpdatedAt = evt.RefundedAt
+	}, func(orderReadDto *dtos.OrderReadDto) types.IMessage {
+		return issuingRefundIntegration.NewRefundIssuedV1(orderReadDto)
+	}))
+}
+
+// updateOrder applies a state transition of the order to its read model, stamps the read model with the stream version of the transition
+// and publishes the integration event of the transition with the updated read model
+func (m *mongoOrderProjection) updateOrder(ctx context.Context, orderId uuid.UUID, version int64, apply func(order *read_models.OrderReadModel), integrationEvent func(orderReadDto *dtos.OrderReadDto) types.IMessage) error {
+	orderRead, err := m.mongoOrderRepository.GetOrderByOrderId(ctx, orderId)
+	if err != nil {
+		return errors.WrapIf(err, "[mongoOrderProjection_updateOrder.GetOrderByOrderId] error in loading order with mongoOrderRepository")
+	}
+	if orderRead == nil {
+		return errors.Errorf("[mongoOrderProjection_updateOrder] order with orderId %s not found in the read model", orderId)
+	}
+
+	apply(orderRead)
+	orderRead.Version = version
+
+	orderRead, err = m.mongoOrderRepository.UpdateOrder(ctx, orderRead)
+	if err != nil {
+		return errors.WrapIf(err, "[mongoOrderProjection_updateOrder.UpdateOrder] error in updating order with mongoOrderRepository")
+	}
+
+	orderReadDto, err := mapper.Map[*dtos.OrderReadDto](orderRead)
+	if err != nil {
+		return customErrors.NewApplicationErrorWrap(err, "[mongoOrderProjection_updateOrder.Map] error in mapping OrderReadDto")
+	}
+
+	message := integrationEvent(orderReadDto)
+
+	err = m.rabbitmqProducer.Publish(ctx, message, nil)
+	if err != nil {
+		return customErrors.NewApplicationErrorWrap(err, fmt.Sprintf("[mongoOrderProjection_updateOrder.PublishMessage] error in publishing %s integration event", message.GetEventTypeName()))
+	}
+
+	m.logger.Infow(fmt.Sprintf("[mongoOrderProjection.updateOrder] %s message with messageId `%s` published to the rabbitmq broker", message.GetEventTypeName(), message.GeMessageId()), logger.Fields{"MessageId": message.GeMessageId(), "Id": orderRead.OrderId})
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package projections
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/repositories"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// orderWatchProjection notifies the watchers of an order with the read model which is projected by the mongo projection,
+// so it should be configured after the mongo projection
+type orderWatchProjection struct {
+	mongoOrderRepository repositories.OrderReadRepository
+	orderWatcher         contracts.OrderWatcher
+	logger               logger.Logger
+}
+
+func NewOrderWatchProjection(mongoOrderRepository repositories.OrderReadRepository, orderWatcher contracts.OrderWatcher, logger logger.Logger) projection.IProjection {
+	return &orderWatchProjection{mongoOrderRepository: mongoOrderRepository, orderWatcher: orderWatcher, logger: logger}
+}
+
+func (o orderWatchProjection) ProcessEvent(ctx context.Context, streamEvent *models.StreamEvent) error {
+	orderId := streamEvent.Event.GetAggregateId()
+	if !o.orderWatcher.HasWatchers(orderId) {
+		return nil
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "orderWatchProjection.ProcessEvent")
+	span.LogFields(log.String("OrderId", orderId.String()))
+	span.LogFields(log.Int64("Version", streamEvent.Version))
+	defer span.Finish()
+
+	orderRead, err := o.mongoOrderRepository.GetOrderByOrderId(ctx, orderId)
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, "[orderWatchProjection_ProcessEvent.GetOrderByOrderId] error in loading order with mongoOrderRepository"))
+	}
+	if orderRead == nil {
+		return nil
+	}
+
+	o.orderWatcher.Notify(orderId, &contracts.OrderChange{Version: streamEvent.Version, EventType: streamEvent.Event.GetEventType(), Order: orderRead})
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package watchers
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts"
+	uuid "github.com/satori/go.uuid"
+	"sync"
+)
+
+// watcherBufferSize is the number of the changes which are kept for a watcher before it is dropped as a lagging watcher
+const watcherBufferSize = 64
+
+type watcher struct {
+	changes chan *contracts.OrderChange
+}
+
+// inMemoryOrderWatcher keeps the watchers of the orders of this instance, the notify never blocks the projection of the subscription
+type inMemoryOrderWatcher struct {
+	mu       sync.Mutex
+	watchers map[uuid.UUID]map[*watcher]struct{}
+}
+
+func NewInMemoryOrderWatcher() contracts.OrderWatcher {
+	return &inMemoryOrderWatcher{watchers: make(map[uuid.UUID]map[*watcher]struct{})}
+}
+
+func (o *inMemoryOrderWatcher) Watch(orderId uuid.UUID) (<-chan *contracts.OrderChange, func()) {
+	w := &watcher{changes: make(chan *contracts.OrderChange, watcherBufferSize)}
+
+	o.mu.Lock()
+	if o.watchers[orderId] == nil {
+		o.watchers[orderId] = make(map[*watcher]struct{})
+	}
+	o.watchers[orderId][w] = struct{}{}
+	o.mu.Unlock()
+
+	return w.changes, func() {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+
+		o.remove(orderId, w)
+	}
+}
+
+func (o *inMemoryOrderWatcher) HasWatchers(orderId uuid.UUID) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return len(o.watchers[orderId]) > 0
+}
+
+func (o *inMemoryOrderWatcher) Notify(orderId uuid.UUID, change *contracts.OrderChange) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for w := range o.watchers[orderId] {
+		select {
+		case w.changes <- change:
+		default:
+			// the watcher is lagging, it is dropped and resumes from its last received version
+			o.remove(orderId, w)
+		}
+	}
+}
+
+// remove closes the channel of the watcher once, it should be called with the lock
+func (o *inMemoryOrderWatcher) remove(orderId uuid.UUID, w *watcher) {
+	orderWatchers, ok := o.watchers[orderId]
+	if !ok {
+		return
+	}
+	if _, ok := orderWatchers[w]; !ok {
+		return
+	}
+
+	delete(orderWatchers, w)
+	close(w.changes)
+	if len(orderWatchers) == 0 {
+		delete(o.watchers, orderId)
+	}
+}
@@ -0,0 +1,60 @@
+package watchers
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_Notify_Delivers_Changes_To_Watchers_Of_Order(t *testing.T) {
+	orderWatcher := NewInMemoryOrderWatcher()
+	orderId := uuid.NewV4()
+
+	changes, stop := orderWatcher.Watch(orderId)
+	defer stop()
+	otherChanges, stopOther := orderWatcher.Watch(uuid.NewV4())
+	defer stopOther()
+
+	assert.True(t, orderWatcher.HasWatchers(orderId))
+
+	orderWatcher.Notify(orderId, &contracts.OrderChange{Version: 1, EventType: "OrderSubmittedV1"})
+
+	change := <-changes
+	assert.Equal(t, int64(1), change.Version)
+	assert.Equal(t, "OrderSubmittedV1", change.EventType)
+	assert.Len(t, otherChanges, 0)
+}
+
+func Test_Stop_Closes_Changes_And_Removes_Watcher(t *testing.T) {
+	orderWatcher := NewInMemoryOrderWatcher()
+	orderId := uuid.NewV4()
+
+	changes, stop := orderWatcher.Watch(orderId)
+	stop()
+	stop()
+
+	_, ok := <-changes
+	assert.False(t, ok)
+	assert.False(t, orderWatcher.HasWatchers(orderId))
+}
+
+func Test_Notify_Drops_Lagging_Watcher(t *testing.T) {
+	orderWatcher := NewInMemoryOrderWatcher()
+	orderId := uuid.NewV4()
+
+	changes, stop := orderWatcher.Watch(orderId)
+	defer stop()
+
+	for version := int64(0); version <= watcherBufferSize; version++ {
+		orderWatcher.Notify(orderId, &contracts.OrderChange{Version: version})
+	}
+
+	var received int
+	for range changes {
+		received++
+	}
+	require.Equal(t, watcherBufferSize, received)
+	assert.False(t, orderWatcher.HasWatchers(orderId))
+}
@@ -0,0 +1,77 @@
+package mappings
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	grpcOrderService "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/proto/service_clients"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ConfigureMappings() error {
+	err := mapper.CreateCustomMap[*models.SalesPeriod, *dtos.SalesPeriodDto](func(period *models.SalesPeriod) *dtos.SalesPeriodDto {
+		return &dtos.SalesPeriodDto{
+			PeriodStart:       period.PeriodStart,
+			Currency:          period.Currency.String(),
+			Orders:            period.Orders,
+			ItemsSold:         period.ItemsSold,
+			Revenue:           period.Revenue,
+			Discounts:         period.Discounts,
+			Refunds:           period.Refunds,
+			NetRevenue:        period.NetRevenue(),
+			AverageOrderValue: period.AverageOrderValue(),
+			AverageBasketSize: period.AverageBasketSize(),
+			CanceledOrders:    period.CanceledOrders,
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	err = mapper.CreateCustomMap[*models.ProductSales, *dtos.ProductSalesDto](func(product *models.ProductSales) *dtos.ProductSalesDto {
+		return &dtos.ProductSalesDto{
+			ProductId: product.ProductId,
+			Title:     product.Title,
+			Currency:  product.Currency.String(),
+			Quantity:  product.Quantity,
+			Revenue:   product.Revenue,
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	err = mapper.CreateCustomMap[*dtos.SalesPeriodDto, *grpcOrderService.SalesPeriod](func(period *dtos.SalesPeriodDto) *grpcOrderService.SalesPeriod {
+		return &grpcOrderService.SalesPeriod{
+			PeriodStart:       timestamppb.New(period.PeriodStart),
+			Currency:          period.Currency,
+			Orders:            period.Orders,
+			ItemsSold:         period.ItemsSold,
+			Revenue:           period.Revenue.String(),
+			Discounts:         period.Discounts.String(),
+			Refunds:           period.Refunds.String(),
+			NetRevenue:        period.NetRevenue.String(),
+			AverageOrderValue: period.AverageOrderValue.String(),
+			AverageBasketSize: period.AverageBasketSize.String(),
+			CanceledOrders:    period.CanceledOrders,
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	err = mapper.CreateMap[*models.SalesReportRebuild, *dtos.SalesReportRebuildDto]()
+	if err != nil {
+		return err
+	}
+
+	return mapper.CreateCustomMap[*dtos.ProductSalesDto, *grpcOrderService.ProductSales](func(product *dtos.ProductSalesDto) *grpcOrderService.ProductSales {
+		return &grpcOrderService.ProductSales{
+			ProductId: product.ProductId.String(),
+			Title:     product.Title,
+			Currency:  product.Currency,
+			Quantity:  product.Quantity,
+			Revenue:   product.Revenue.String(),
+		}
+	})
+}
@@ -0,0 +1,39 @@
+package mediatr
+
+import (
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/contracts"
+	exportingSalesReportDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/exporting_sales_report/dtos"
+	exportingSalesReportV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/exporting_sales_report/queries/v1"
+	gettingSalesReportDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/getting_sales_report/dtos"
+	gettingSalesReportV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/getting_sales_report/queries/v1"
+	gettingSalesReportRebuildDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/getting_sales_report_rebuild/dtos"
+	gettingSalesReportRebuildV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/getting_sales_report_rebuild/queries/v1"
+	rebuildingSalesReportV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/rebuilding_sales_report/commands/v1"
+	rebuildingSalesReportDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/rebuilding_sales_report/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+)
+
+func ConfigReportsMediator(salesReportRepository contracts.SalesReportRepository, salesReportRebuildRepository contracts.SalesReportRebuildRepository, infra *infrastructure.InfrastructureConfiguration) error {
+	err := mediatr.RegisterRequestHandler[*gettingSalesReportV1.GetSalesReport, *gettingSalesReportDtos.GetSalesReportResponseDto](gettingSalesReportV1.NewGetSalesReportHandler(infra.Log, infra.Cfg, salesReportRepository))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*exportingSalesReportV1.ExportSalesReport, *exportingSalesReportDtos.ExportSalesReportResponseDto](exportingSalesReportV1.NewExportSalesReportHandler(infra.Log, infra.Cfg, salesReportRepository))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*rebuildingSalesReportV1.RebuildSalesReport, *rebuildingSalesReportDtos.RebuildSalesReportResponseDto](rebuildingSalesReportV1.NewRebuildSalesReportHandler(infra.Log, infra.Cfg, salesReportRebuildRepository))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*gettingSalesReportRebuildV1.GetSalesReportRebuild, *gettingSalesReportRebuildDtos.GetSalesReportRebuildResponseDto](gettingSalesReportRebuildV1.NewGetSalesReportRebuildHandler(infra.Log, infra.Cfg, salesReportRebuildRepository))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
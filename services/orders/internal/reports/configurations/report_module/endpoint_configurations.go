@@ -0,0 +1,41 @@
+package report_module
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/delivery"
+	exportingSalesReportV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/exporting_sales_report/endpoints/v1"
+	gettingSalesReportV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/getting_sales_report/endpoints/v1"
+	gettingSalesReportRebuildV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/getting_sales_report_rebuild/endpoints/v1"
+	rebuildingSalesReportV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/rebuilding_sales_report/endpoints/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+)
+
+func (c *reportsModuleConfigurator) configEndpoints(ctx context.Context) {
+	configV1Endpoints(c.echoServer, c.InfrastructureConfiguration, ctx)
+}
+
+func configV1Endpoints(echoServer customEcho.EchoHttpServer, infra *infrastructure.InfrastructureConfiguration, ctx context.Context) {
+	echoServer.ConfigGroup("/api/v1", func(v1 *echo.Group) {
+		reportsGroup := v1.Group("/reports")
+
+		reportEndpointBase := delivery.NewReportEndpointBase(infra, reportsGroup)
+
+		// GetSalesReport
+		getSalesReportEndpoint := gettingSalesReportV1.NewGetSalesReportEndpoint(reportEndpointBase)
+		getSalesReportEndpoint.MapRoute()
+
+		// ExportSalesReport
+		exportSalesReportEndpoint := exportingSalesReportV1.NewExportSalesReportEndpoint(reportEndpointBase)
+		exportSalesReportEndpoint.MapRoute()
+
+		// RebuildSalesReport
+		rebuildSalesReportEndpoint := rebuildingSalesReportV1.NewRebuildSalesReportEndpoint(reportEndpointBase)
+		rebuildSalesReportEndpoint.MapRoute()
+
+		// GetSalesReportRebuild
+		getSalesReportRebuildEndpoint := gettingSalesReportRebuildV1.NewGetSalesReportRebuildEndpoint(reportEndpointBase)
+		getSalesReportRebuildEndpoint.MapRoute()
+	})
+}
@@ -0,0 +1,50 @@
+package report_module
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/eventstroredb"
+	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/configurations/mappings"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/configurations/mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/data/repositories"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/rebuilding_sales_report/workers"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/projections"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+)
+
+type reportsModuleConfigurator struct {
+	*infrastructure.InfrastructureConfiguration
+	echoServer customEcho.EchoHttpServer
+}
+
+func NewReportsModuleConfigurator(infrastructure *infrastructure.InfrastructureConfiguration, echoServer customEcho.EchoHttpServer) contracts.ReportsModuleConfigurator {
+	return &reportsModuleConfigurator{InfrastructureConfiguration: infrastructure, echoServer: echoServer}
+}
+
+func (c *reportsModuleConfigurator) ConfigureReportsModule(ctx context.Context) error {
+	salesReportRepository := repositories.NewPostgresSalesReportRepository(c.Log, c.Cfg, c.Gorm.DB)
+	salesReportRebuildRepository := repositories.NewPostgresSalesReportRebuildRepository(c.Log, c.Cfg, c.Gorm.DB)
+
+	// the sales projection is fed by the order subscription of the event store worker, and by the replayer of the rebuild worker
+	salesProjection := projections.NewSalesProjection(salesReportRepository, c.Log)
+	c.Projections = append(c.Projections, salesProjection)
+
+	projectionReplayer := eventstroredb.NewEsdbProjectionReplayer(c.Log, c.Esdb, c.EsdbSerializer)
+	salesReportRebuilder := workers.NewSalesReportRebuilder(c.Log, c.Cfg, salesReportRepository, salesReportRebuildRepository, projectionReplayer, salesProjection)
+	c.Workers = append(c.Workers, workers.NewSalesReportRebuildWorker(salesReportRebuilder, c.Log))
+
+	err := mappings.ConfigureMappings()
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.ConfigReportsMediator(salesReportRepository, salesReportRebuildRepository, c.InfrastructureConfiguration)
+	if err != nil {
+		return err
+	}
+
+	c.configEndpoints(ctx)
+
+	return nil
+}
@@ -0,0 +1,7 @@
+package contracts
+
+import "context"
+
+type ReportsModuleConfigurator interface {
+	ConfigureReportsModule(ctx context.Context) error
+}
@@ -0,0 +1,20 @@
+package contracts
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type SalesReportRebuildRepository interface {
+	AddRebuild(ctx context.Context, rebuild *models.SalesReportRebuild) error
+	UpdateRebuild(ctx context.Context, rebuild *models.SalesReportRebuild) error
+	// GetRebuild returns nil when the rebuild doesn't exist
+	GetRebuild(ctx context.Context, rebuildId uuid.UUID) (*models.SalesReportRebuild, error)
+	// GetLatestRebuild returns the last requested rebuild or nil when a rebuild is never requested
+	GetLatestRebuild(ctx context.Context) (*models.SalesReportRebuild, error)
+	// ClaimRebuild marks the oldest pending rebuild, or a running rebuild whose lease is expired, as running until the lease ends. It returns
+	// nil when there is no rebuild to run
+	ClaimRebuild(ctx context.Context, lease time.Duration) (*models.SalesReportRebuild, error)
+}
@@ -0,0 +1,24 @@
+package contracts
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// ProjectSalesOrder applies an event to the sales state of its order and returns the sales which the event adds to the daily sales
+type ProjectSalesOrder func(order *models.SalesOrder) (*models.SalesChanges, error)
+
+type SalesReportRepository interface {
+	// ProjectOrderEvent applies the event with the stream version to the sales order and adds its sales to the daily sales in one transaction.
+	// The event is applied only when it is the next event of the order stream, it returns false for an event which is already applied or
+	// comes after a missing event
+	ProjectOrderEvent(ctx context.Context, orderId uuid.UUID, version int64, project ProjectSalesOrder) (bool, error)
+	// GetDailySales returns the daily sales of the days between from and to, both days included
+	GetDailySales(ctx context.Context, from time.Time, to time.Time) ([]*models.DailySales, error)
+	// GetTopProducts returns the products with the most sold items between from and to, both days included
+	GetTopProducts(ctx context.Context, from time.Time, to time.Time, limit int) ([]*models.ProductSales, error)
+	// Reset removes the sales orders and the daily sales, so they can be rebuilt from the event store
+	Reset(ctx context.Context) error
+}
@@ -0,0 +1,126 @@
+package repositories
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type postgresSalesReportRebuildRepository struct {
+	log  logger.Logger
+	cfg  *config.Config
+	gorm *gorm.DB
+}
+
+func NewPostgresSalesReportRebuildRepository(log logger.Logger, cfg *config.Config, gorm *gorm.DB) contracts.SalesReportRebuildRepository {
+	return &postgresSalesReportRebuildRepository{log: log, cfg: cfg, gorm: gorm}
+}
+
+func (p *postgresSalesReportRebuildRepository) AddRebuild(ctx context.Context, rebuild *models.SalesReportRebuild) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresSalesReportRebuildRepository.AddRebuild")
+	span.LogFields(log.String("RebuildId", rebuild.RebuildId.String()))
+	defer span.Finish()
+
+	if err := p.gorm.WithContext(ctx).Create(rebuild).Error; err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, "[postgresSalesReportRebuildRepository_AddRebuild.Create] error in inserting the sales report rebuild"))
+	}
+
+	return nil
+}
+
+func (p *postgresSalesReportRebuildRepository) UpdateRebuild(ctx context.Context, rebuild *models.SalesReportRebuild) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresSalesReportRebuildRepository.UpdateRebuild")
+	span.LogFields(log.String("RebuildId", rebuild.RebuildId.String()))
+	defer span.Finish()
+
+	if err := p.gorm.WithContext(ctx).Save(rebuild).Error; err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, "[postgresSalesReportRebuildRepository_UpdateRebuild.Save] error in updating the sales report rebuild"))
+	}
+
+	return nil
+}
+
+func (p *postgresSalesReportRebuildRepository) GetRebuild(ctx context.Context, rebuildId uuid.UUID) (*models.SalesReportRebuild, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresSalesReportRebuildRepository.GetRebuild")
+	span.LogFields(log.String("RebuildId", rebuildId.String()))
+	defer span.Finish()
+
+	rebuild := &models.SalesReportRebuild{}
+	err := p.gorm.WithContext(ctx).First(rebuild, "rebuild_id = ?", rebuildId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[postgresSalesReportRebuildRepository_GetRebuild.First] error in loading the sales report rebuild"))
+	}
+
+	return rebuild, nil
+}
+
+func (p *postgresSalesReportRebuildRepository) GetLatestRebuild(ctx context.Context) (*models.SalesReportRebuild, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresSalesReportRebuildRepository.GetLatestRebuild")
+	defer span.Finish()
+
+	rebuild := &models.SalesReportRebuild{}
+	err := p.gorm.WithContext(ctx).Order("created_at DESC").First(rebuild).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[postgresSalesReportRebuildRepository_GetLatestRebuild.First] error in loading the latest sales report rebuild"))
+	}
+
+	return rebuild, nil
+}
+
+func (p *postgresSalesReportRebuildRepository) ClaimRebuild(ctx context.Context, lease time.Duration) (*models.SalesReportRebuild, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresSalesReportRebuildRepository.ClaimRebuild")
+	defer span.Finish()
+
+	var claimed *models.SalesReportRebuild
+	err := p.gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		// the skipped row locks let the workers of the other instances claim the other rebuilds
+		rebuild := &models.SalesReportRebuild{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? OR (status = ? AND lease_until < ?)", models.RebuildPending, models.RebuildRunning, now).
+			Order("created_at").
+			First(rebuild).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return errors.WrapIf(err, "[postgresSalesReportRebuildRepository_ClaimRebuild.First] error in loading the next sales report rebuild")
+		}
+
+		leaseUntil := now.Add(lease)
+		rebuild.Status = models.RebuildRunning
+		rebuild.LeaseUntil = &leaseUntil
+		rebuild.Attempts++
+		if rebuild.StartedAt == nil {
+			rebuild.StartedAt = &now
+		}
+		if err := tx.Save(rebuild).Error; err != nil {
+			return errors.WrapIf(err, "[postgresSalesReportRebuildRepository_ClaimRebuild.Save] error in claiming the sales report rebuild")
+		}
+		claimed = rebuild
+
+		return nil
+	})
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, err)
+	}
+
+	return claimed, nil
+}
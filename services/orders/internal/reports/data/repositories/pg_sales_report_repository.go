@@ -0,0 +1,181 @@
+package repositories
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type postgresSalesReportRepository struct {
+	log  logger.Logger
+	cfg  *config.Config
+	gorm *gorm.DB
+}
+
+func NewPostgresSalesReportRepository(log logger.Logger, cfg *config.Config, gorm *gorm.DB) contracts.SalesReportRepository {
+	return &postgresSalesReportRepository{log: log, cfg: cfg, gorm: gorm}
+}
+
+func (p *postgresSalesReportRepository) ProjectOrderEvent(ctx context.Context, orderId uuid.UUID, version int64, project contracts.ProjectSalesOrder) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresSalesReportRepository.ProjectOrderEvent")
+	span.LogFields(log.String("OrderId", orderId.String()))
+	span.LogFields(log.Int64("Version", version))
+	defer span.Finish()
+
+	applied := false
+	err := p.gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the row lock orders the projections of the same order by the subscription and a rebuild
+		order := &models.SalesOrder{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(order, "order_id = ?", orderId).Error
+		exists := err == nil
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.WrapIf(err, "[postgresSalesReportRepository_ProjectOrderEvent.First] error in loading the sales order")
+		}
+
+		// the first event of a stream has the version zero
+		if (!exists && version != 0) || (exists && version != order.Version+1) {
+			return nil
+		}
+		if !exists {
+			order = &models.SalesOrder{OrderId: orderId}
+		}
+
+		changes, err := project(order)
+		if err != nil {
+			return errors.WrapIf(err, "[postgresSalesReportRepository_ProjectOrderEvent.project] error in projecting the event to the sales order")
+		}
+		order.Version = version
+
+		if exists {
+			if err := tx.Save(order).Error; err != nil {
+				return errors.WrapIf(err, "[postgresSalesReportRepository_ProjectOrderEvent.Save] error in updating the sales order")
+			}
+		} else {
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(order)
+			if result.Error != nil {
+				return errors.WrapIf(result.Error, "[postgresSalesReportRepository_ProjectOrderEvent.Create] error in inserting the sales order")
+			}
+			if result.RowsAffected == 0 {
+				// a concurrent projection created the sales order
+				return nil
+			}
+		}
+
+		if err := addSalesChanges(tx, changes); err != nil {
+			return err
+		}
+		applied = true
+
+		return nil
+	})
+	if err != nil {
+		return false, tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[postgresSalesReportRepository_ProjectOrderEvent.Transaction] error in projecting version %d of order %s", version, orderId)))
+	}
+
+	return applied, nil
+}
+
+// addSalesChanges adds the changes to the daily sales with the atomic increments of the upserts
+func addSalesChanges(tx *gorm.DB, changes *models.SalesChanges) error {
+	if changes == nil {
+		return nil
+	}
+
+	if changes.Sales != nil {
+		err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "day"}, {Name: "currency"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"orders":          gorm.Expr("daily_sales.orders + excluded.orders"),
+				"items_sold":      gorm.Expr("daily_sales.items_sold + excluded.items_sold"),
+				"revenue":         gorm.Expr("daily_sales.revenue + excluded.revenue"),
+				"discounts":       gorm.Expr("daily_sales.discounts + excluded.discounts"),
+				"refunds":         gorm.Expr("daily_sales.refunds + excluded.refunds"),
+				"canceled_orders": gorm.Expr("daily_sales.canceled_orders + excluded.canceled_orders"),
+			}),
+		}).Create(changes.Sales).Error
+		if err != nil {
+			return errors.WrapIf(err, "[postgresSalesReportRepository_addSalesChanges.Create] error in adding the daily sales")
+		}
+	}
+
+	for _, productSales := range changes.ProductSales {
+		err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "day"}, {Name: "product_id"}, {Name: "currency"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"title":    gorm.Expr("excluded.title"),
+				"quantity": gorm.Expr("daily_product_sales.quantity + excluded.quantity"),
+				"revenue":  gorm.Expr("daily_product_sales.revenue + excluded.revenue"),
+			}),
+		}).Create(productSales).Error
+		if err != nil {
+			return errors.WrapIf(err, "[postgresSalesReportRepository_addSalesChanges.Create] error in adding the daily product sales")
+		}
+	}
+
+	return nil
+}
+
+func (p *postgresSalesReportRepository) GetDailySales(ctx context.Context, from time.Time, to time.Time) ([]*models.DailySales, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresSalesReportRepository.GetDailySales")
+	span.LogFields(log.String("From", from.String()))
+	span.LogFields(log.String("To", to.String()))
+	defer span.Finish()
+
+	var dailySales []*models.DailySales
+	err := p.gorm.WithContext(ctx).
+		Where("day BETWEEN ? AND ?", models.SalesDay(from), models.SalesDay(to)).
+		Order("day, currency").
+		Find(&dailySales).Error
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[postgresSalesReportRepository_GetDailySales.Find] error in loading the daily sales"))
+	}
+	p.log.Infow(fmt.Sprintf("[postgresSalesReportRepository.GetDailySales] %d daily sales loaded", len(dailySales)), logger.Fields{"From": from, "To": to})
+
+	return dailySales, nil
+}
+
+func (p *postgresSalesReportRepository) GetTopProducts(ctx context.Context, from time.Time, to time.Time, limit int) ([]*models.ProductSales, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresSalesReportRepository.GetTopProducts")
+	span.LogFields(log.String("From", from.String()))
+	span.LogFields(log.String("To", to.String()))
+	defer span.Finish()
+
+	var products []*models.ProductSales
+	err := p.gorm.WithContext(ctx).
+		Model(&models.DailyProductSales{}).
+		Select("product_id, currency, MAX(title) AS title, SUM(quantity) AS quantity, SUM(revenue) AS revenue").
+		Where("day BETWEEN ? AND ?", models.SalesDay(from), models.SalesDay(to)).
+		Group("product_id, currency").
+		Order("quantity DESC, revenue DESC").
+		Limit(limit).
+		Scan(&products).Error
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[postgresSalesReportRepository_GetTopProducts.Scan] error in loading the top products"))
+	}
+
+	return products, nil
+}
+
+func (p *postgresSalesReportRepository) Reset(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresSalesReportRepository.Reset")
+	defer span.Finish()
+
+	err := p.gorm.WithContext(ctx).Exec(fmt.Sprintf("TRUNCATE %s, %s, %s", models.SalesOrder{}.TableName(), models.DailySales{}.TableName(), models.DailyProductSales{}.TableName())).Error
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, "[postgresSalesReportRepository_Reset.Exec] error in truncating the sales tables"))
+	}
+	p.log.Info("[postgresSalesReportRepository.Reset] sales report removed")
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package delivery
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+)
+
+type ReportEndpointBase struct {
+	*infrastructure.InfrastructureConfiguration
+	ReportsGroup *echo.Group
+}
+
+func NewReportEndpointBase(infra *infrastructure.InfrastructureConfiguration, reportsGroup *echo.Group) *ReportEndpointBase {
+	return &ReportEndpointBase{ReportsGroup: reportsGroup, InfrastructureConfiguration: infra}
+}
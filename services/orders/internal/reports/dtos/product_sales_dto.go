@@ -0,0 +1,14 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ProductSalesDto struct {
+	ProductId uuid.UUID      `json:"productId"`
+	Title     string         `json:"title"`
+	Currency  string         `json:"currency"`
+	Quantity  int64          `json:"quantity"`
+	Revenue   domain.Decimal `json:"revenue"`
+}
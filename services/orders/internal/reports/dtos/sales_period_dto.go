@@ -0,0 +1,20 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"time"
+)
+
+type SalesPeriodDto struct {
+	PeriodStart       time.Time      `json:"periodStart"`
+	Currency          string         `json:"currency"`
+	Orders            int64          `json:"orders"`
+	ItemsSold         int64          `json:"itemsSold"`
+	Revenue           domain.Decimal `json:"revenue"`
+	Discounts         domain.Decimal `json:"discounts"`
+	Refunds           domain.Decimal `json:"refunds"`
+	NetRevenue        domain.Decimal `json:"netRevenue"`
+	AverageOrderValue domain.Decimal `json:"averageOrderValue"`
+	AverageBasketSize domain.Decimal `json:"averageBasketSize"`
+	CanceledOrders    int64          `json:"canceledOrders"`
+}
@@ -0,0 +1,17 @@
+package dtos
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type SalesReportRebuildDto struct {
+	RebuildId      uuid.UUID  `json:"rebuildId"`
+	Status         string     `json:"status"`
+	ReplayedEvents int64      `json:"replayedEvents"`
+	Attempts       int        `json:"attempts"`
+	Error          string     `json:"error,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	StartedAt      *time.Time `json:"startedAt"`
+	FinishedAt     *time.Time `json:"finishedAt"`
+}
@@ -0,0 +1,46 @@
+package dtos
+
+import (
+	"emperror.dev/errors"
+	"github.com/labstack/echo/v4"
+	"time"
+)
+
+// SalesReportDateLayout is the layout of the from and to days of the sales report requests
+const SalesReportDateLayout = "2006-01-02"
+
+type SalesReportRequestDto struct {
+	From        time.Time `query:"from" json:"from"`
+	To          time.Time `query:"to" json:"to"`
+	Granularity string    `query:"granularity" json:"granularity"`
+}
+
+// GetSalesReportRequestFromCtx binds the days of the sales report from the query string, the days are in the SalesReportDateLayout
+func GetSalesReportRequestFromCtx(c echo.Context) (*SalesReportRequestDto, error) {
+	request := &SalesReportRequestDto{}
+	err := echo.QueryParamsBinder(c).
+		Time("from", &request.From, SalesReportDateLayout).
+		Time("to", &request.To, SalesReportDateLayout).
+		String("granularity", &request.Granularity).
+		BindError()
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// ParseSalesReportDays parses the from and to days of the grpc sales report requests
+func ParseSalesReportDays(from string, to string) (time.Time, time.Time, error) {
+	fromDay, err := time.Parse(SalesReportDateLayout, from)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.WrapIf(err, "the from day should be in the yyyy-mm-dd format")
+	}
+
+	toDay, err := time.Parse(SalesReportDateLayout, to)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.WrapIf(err, "the to day should be in the yyyy-mm-dd format")
+	}
+
+	return fromDay, toDay, nil
+}
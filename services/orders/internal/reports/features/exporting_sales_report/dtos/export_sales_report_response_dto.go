@@ -0,0 +1,7 @@
+package dtos
+
+type ExportSalesReportResponseDto struct {
+	FileName    string `json:"fileName"`
+	ContentType string `json:"contentType"`
+	Content     []byte `json:"content"`
+}
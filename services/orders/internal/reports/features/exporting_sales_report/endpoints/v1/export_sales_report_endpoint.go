@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/delivery"
+	reportsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/exporting_sales_report/dtos"
+	exportingSalesReportV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/exporting_sales_report/queries/v1"
+	"net/http"
+)
+
+type exportSalesReportEndpoint struct {
+	*delivery.ReportEndpointBase
+}
+
+func NewExportSalesReportEndpoint(endpointBase *delivery.ReportEndpointBase) *exportSalesReportEndpoint {
+	return &exportSalesReportEndpoint{endpointBase}
+}
+
+func (ep *exportSalesReportEndpoint) MapRoute() {
+	ep.ReportsGroup.GET("/sales/export", ep.handler())
+}
+
+// ExportSalesReport
+// @Tags Reports
+// @Summary Export sales report
+// @Description Download the sales of the days, weeks or months between two days (yyyy-mm-dd, utc) as a csv file
+// @Accept json
+// @Produce text/csv
+// @Param ExportSalesReportRequestDto query dtos.ExportSalesReportRequestDto true "ExportSalesReportRequestDto"
+// @Success 200 {file} file
+// @Router /api/v1/reports/sales/export [get]
+func (ep *exportSalesReportEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.ExportSalesReportHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "exportSalesReportEndpoint.handler")
+		defer span.Finish()
+
+		salesReportRequest, err := reportsDtos.GetSalesReportRequestFromCtx(c)
+		if err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[exportSalesReportEndpoint_handler.GetSalesReportRequestFromCtx] error in getting data from query string")
+			ep.Log.Errorf(fmt.Sprintf("[exportSalesReportEndpoint_handler.GetSalesReportRequestFromCtx] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		request := &dtos.ExportSalesReportRequestDto{SalesReportRequestDto: salesReportRequest}
+
+		query := exportingSalesReportV1.NewExportSalesReport(request.From, request.To, request.Granularity)
+		if err := ep.Validator.StructCtx(ctx, query); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[exportSalesReportEndpoint_handler.StructCtx] query validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[exportSalesReportEndpoint_handler.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		queryResult, err := mediatr.Send[*exportingSalesReportV1.ExportSalesReport, *dtos.ExportSalesReportResponseDto](ctx, query)
+		if err != nil {
+			err = errors.WithMessage(err, "[exportSalesReportEndpoint_handler.Send] error in sending ExportSalesReport")
+			ep.Log.Error(fmt.Sprintf("[exportSalesReportEndpoint_handler.Send] err: {%v}", tracing.TraceWithErr(span, err)))
+			return err
+		}
+
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", queryResult.FileName))
+
+		return c.Blob(http.StatusOK, queryResult.ContentType, queryResult.Content)
+	}
+}
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	"time"
+)
+
+// ExportSalesReport exports the sales of the periods of the granularity between the from and to days as a csv file
+type ExportSalesReport struct {
+	From        time.Time `validate:"required"`
+	To          time.Time `validate:"required,gtefield=From"`
+	Granularity string    `validate:"required,oneof=day week month"`
+}
+
+func NewExportSalesReport(from time.Time, to time.Time, granularity string) *ExportSalesReport {
+	if granularity == "" {
+		granularity = models.DayGranularity
+	}
+
+	return &ExportSalesReport{From: from, To: to, Granularity: granularity}
+}
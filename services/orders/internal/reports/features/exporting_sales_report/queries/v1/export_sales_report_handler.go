@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/contracts"
+	reportsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/exporting_sales_report/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+const salesReportContentType = "text/csv"
+
+type ExportSalesReportHandler struct {
+	log                   logger.Logger
+	cfg                   *config.Config
+	salesReportRepository contracts.SalesReportRepository
+}
+
+func NewExportSalesReportHandler(log logger.Logger, cfg *config.Config, salesReportRepository contracts.SalesReportRepository) *ExportSalesReportHandler {
+	return &ExportSalesReportHandler{log: log, cfg: cfg, salesReportRepository: salesReportRepository}
+}
+
+func (q *ExportSalesReportHandler) Handle(ctx context.Context, query *ExportSalesReport) (*dtos.ExportSalesReportResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ExportSalesReportHandler.Handle")
+	span.LogFields(log.Object("Query", query))
+	defer span.Finish()
+
+	dailySales, err := q.salesReportRepository.GetDailySales(ctx, query.From, query.To)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[ExportSalesReportHandler_Handle.GetDailySales] error in getting the daily sales in the repository"))
+	}
+
+	content, err := writeSalesReportCsv(models.GroupSalesByPeriod(dailySales, query.Granularity))
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[ExportSalesReportHandler_Handle.writeSalesReportCsv] error in writing the sales report csv"))
+	}
+
+	fileName := fmt.Sprintf("sales_%s_%s_%s.csv", query.From.Format(reportsDtos.SalesReportDateLayout), query.To.Format(reportsDtos.SalesReportDateLayout), query.Granularity)
+
+	q.log.Infow(fmt.Sprintf("[ExportSalesReportHandler.Handle] sales report %s exported", fileName), logger.Fields{"From": query.From, "To": query.To, "Granularity": query.Granularity})
+
+	return &dtos.ExportSalesReportResponseDto{FileName: fileName, ContentType: salesReportContentType, Content: content}, nil
+}
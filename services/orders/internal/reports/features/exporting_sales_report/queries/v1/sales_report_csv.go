@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"bytes"
+	"emperror.dev/errors"
+	"encoding/csv"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	"strconv"
+)
+
+var salesReportCsvHeader = []string{
+	"period_start",
+	"currency",
+	"orders",
+	"items_sold",
+	"revenue",
+	"discounts",
+	"refunds",
+	"net_revenue",
+	"average_order_value",
+	"average_basket_size",
+	"canceled_orders",
+}
+
+// writeSalesReportCsv writes a row for each period after the header, the amounts are written as exact decimals without the currency symbols
+func writeSalesReportCsv(periods []*models.SalesPeriod) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	writer := csv.NewWriter(buffer)
+
+	if err := writer.Write(salesReportCsvHeader); err != nil {
+		return nil, errors.WrapIf(err, "[writeSalesReportCsv.Write] error in writing the csv header")
+	}
+
+	for _, period := range periods {
+		row := []string{
+			period.PeriodStart.Format(dtos.SalesReportDateLayout),
+			period.Currency.String(),
+			strconv.FormatInt(period.Orders, 10),
+			strconv.FormatInt(period.ItemsSold, 10),
+			period.Revenue.String(),
+			period.Discounts.String(),
+			period.Refunds.String(),
+			period.NetRevenue().String(),
+			period.AverageOrderValue().String(),
+			period.AverageBasketSize().String(),
+			strconv.FormatInt(period.CanceledOrders, 10),
+		}
+		if err := writer.Write(row); err != nil {
+			return nil, errors.WrapIf(err, "[writeSalesReportCsv.Write] error in writing the csv row")
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, errors.WrapIf(err, "[writeSalesReportCsv.Flush] error in flushing the csv")
+	}
+
+	return buffer.Bytes(), nil
+}
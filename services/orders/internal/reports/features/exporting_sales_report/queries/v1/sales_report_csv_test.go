@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"encoding/csv"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_Write_Sales_Report_Csv(t *testing.T) {
+	periods := []*models.SalesPeriod{
+		{
+			PeriodStart:    time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			Currency:       domain.USD,
+			Orders:         4,
+			ItemsSold:      10,
+			Revenue:        domain.NewDecimal(12550, 2),
+			Discounts:      domain.NewDecimal(500, 2),
+			Refunds:        domain.NewDecimal(2550, 2),
+			CanceledOrders: 1,
+		},
+	}
+
+	content, err := writeSalesReportCsv(periods)
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(strings.NewReader(string(content))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, salesReportCsvHeader, records[0])
+	assert.Equal(t, []string{"2024-03-01", "USD", "4", "10", "125.50", "5.00", "25.50", "100.00", "31.38", "2.50", "1"}, records[1])
+}
+
+func Test_Write_Sales_Report_Csv_Without_Periods(t *testing.T) {
+	content, err := writeSalesReportCsv(nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, strings.Join(salesReportCsvHeader, ",")+"\n", string(content))
+}
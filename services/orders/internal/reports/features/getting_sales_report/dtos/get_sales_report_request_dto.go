@@ -0,0 +1,9 @@
+package dtos
+
+import reportsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/dtos"
+
+type GetSalesReportRequestDto struct {
+	*reportsDtos.SalesReportRequestDto
+	// Top is the number of the products with the most sold items, 10 by default
+	Top int `query:"top" json:"top"`
+}
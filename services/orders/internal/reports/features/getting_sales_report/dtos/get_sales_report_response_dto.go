@@ -0,0 +1,11 @@
+package dtos
+
+import reportsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/dtos"
+
+type GetSalesReportResponseDto struct {
+	From        string                         `json:"from"`
+	To          string                         `json:"to"`
+	Granularity string                         `json:"granularity"`
+	Periods     []*reportsDtos.SalesPeriodDto  `json:"periods"`
+	TopProducts []*reportsDtos.ProductSalesDto `json:"topProducts"`
+}
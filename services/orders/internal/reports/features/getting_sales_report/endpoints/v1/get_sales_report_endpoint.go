@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/delivery"
+	reportsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/getting_sales_report/dtos"
+	gettingSalesReportV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/getting_sales_report/queries/v1"
+	"net/http"
+)
+
+type getSalesReportEndpoint struct {
+	*delivery.ReportEndpointBase
+}
+
+func NewGetSalesReportEndpoint(endpointBase *delivery.ReportEndpointBase) *getSalesReportEndpoint {
+	return &getSalesReportEndpoint{endpointBase}
+}
+
+func (ep *getSalesReportEndpoint) MapRoute() {
+	ep.ReportsGroup.GET("/sales", ep.handler())
+}
+
+// GetSalesReport
+// @Tags Reports
+// @Summary Get sales report
+// @Description Get the revenue, orders, average order value, basket size and refunds of the days, weeks or months between two days (yyyy-mm-dd, utc) with the top selling products
+// @Accept json
+// @Produce json
+// @Param GetSalesReportRequestDto query dtos.GetSalesReportRequestDto true "GetSalesReportRequestDto"
+// @Success 200 {object} dtos.GetSalesReportResponseDto
+// @Router /api/v1/reports/sales [get]
+func (ep *getSalesReportEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.GetSalesReportHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "getSalesReportEndpoint.handler")
+		defer span.Finish()
+
+		salesReportRequest, err := reportsDtos.GetSalesReportRequestFromCtx(c)
+		if err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[getSalesReportEndpoint_handler.GetSalesReportRequestFromCtx] error in getting data from query string")
+			ep.Log.Errorf(fmt.Sprintf("[getSalesReportEndpoint_handler.GetSalesReportRequestFromCtx] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		request := &dtos.GetSalesReportRequestDto{SalesReportRequestDto: salesReportRequest}
+		if err := echo.QueryParamsBinder(c).Int("top", &request.Top).BindError(); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[getSalesReportEndpoint_handler.QueryParamsBinder] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[getSalesReportEndpoint_handler.QueryParamsBinder] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		query := gettingSalesReportV1.NewGetSalesReport(request.From, request.To, request.Granularity, request.Top)
+		if err := ep.Validator.StructCtx(ctx, query); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[getSalesReportEndpoint_handler.StructCtx] query validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[getSalesReportEndpoint_handler.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		queryResult, err := mediatr.Send[*gettingSalesReportV1.GetSalesReport, *dtos.GetSalesReportResponseDto](ctx, query)
+		if err != nil {
+			err = errors.WithMessage(err, "[getSalesReportEndpoint_handler.Send] error in sending GetSalesReport")
+			ep.Log.Error(fmt.Sprintf("[getSalesReportEndpoint_handler.Send] err: {%v}", tracing.TraceWithErr(span, err)))
+			return err
+		}
+
+		return c.JSON(http.StatusOK, queryResult)
+	}
+}
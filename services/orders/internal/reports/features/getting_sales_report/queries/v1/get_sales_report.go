@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	"time"
+)
+
+// defaultTopProducts is the number of the top products of a report which doesn't set it
+const defaultTopProducts = 10
+
+// GetSalesReport gets the sales of the periods of the granularity between the from and to days, both days included
+type GetSalesReport struct {
+	From        time.Time `validate:"required"`
+	To          time.Time `validate:"required,gtefield=From"`
+	Granularity string    `validate:"required,oneof=day week month"`
+	Top         int       `validate:"gte=1,lte=100"`
+}
+
+func NewGetSalesReport(from time.Time, to time.Time, granularity string, top int) *GetSalesReport {
+	if granularity == "" {
+		granularity = models.DayGranularity
+	}
+	if top == 0 {
+		top = defaultTopProducts
+	}
+
+	return &GetSalesReport{From: from, To: to, Granularity: granularity, Top: top}
+}
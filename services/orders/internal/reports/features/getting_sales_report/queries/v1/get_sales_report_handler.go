@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"context"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/contracts"
+	reportsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/getting_sales_report/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type GetSalesReportHandler struct {
+	log                   logger.Logger
+	cfg                   *config.Config
+	salesReportRepository contracts.SalesReportRepository
+}
+
+func NewGetSalesReportHandler(log logger.Logger, cfg *config.Config, salesReportRepository contracts.SalesReportRepository) *GetSalesReportHandler {
+	return &GetSalesReportHandler{log: log, cfg: cfg, salesReportRepository: salesReportRepository}
+}
+
+func (q *GetSalesReportHandler) Handle(ctx context.Context, query *GetSalesReport) (*dtos.GetSalesReportResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetSalesReportHandler.Handle")
+	span.LogFields(log.Object("Query", query))
+	defer span.Finish()
+
+	dailySales, err := q.salesReportRepository.GetDailySales(ctx, query.From, query.To)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetSalesReportHandler_Handle.GetDailySales] error in getting the daily sales in the repository"))
+	}
+
+	periodsDto, err := mapper.Map[[]*reportsDtos.SalesPeriodDto](models.GroupSalesByPeriod(dailySales, query.Granularity))
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetSalesReportHandler_Handle.Map] error in the mapping sales periods"))
+	}
+
+	topProducts, err := q.salesReportRepository.GetTopProducts(ctx, query.From, query.To, query.Top)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetSalesReportHandler_Handle.GetTopProducts] error in getting the top products in the repository"))
+	}
+
+	topProductsDto, err := mapper.Map[[]*reportsDtos.ProductSalesDto](topProducts)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetSalesReportHandler_Handle.Map] error in the mapping top products"))
+	}
+
+	q.log.Infow("[GetSalesReportHandler.Handle] sales report fetched", logger.Fields{"From": query.From, "To": query.To, "Granularity": query.Granularity})
+
+	return &dtos.GetSalesReportResponseDto{
+		From:        query.From.Format(reportsDtos.SalesReportDateLayout),
+		To:          query.To.Format(reportsDtos.SalesReportDateLayout),
+		Granularity: query.Granularity,
+		Periods:     periodsDto,
+		TopProducts: topProductsDto,
+	}, nil
+}
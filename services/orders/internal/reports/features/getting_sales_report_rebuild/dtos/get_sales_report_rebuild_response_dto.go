@@ -0,0 +1,7 @@
+package dtos
+
+import reportsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/dtos"
+
+type GetSalesReportRebuildResponseDto struct {
+	Rebuild *reportsDtos.SalesReportRebuildDto `json:"rebuild"`
+}
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/delivery"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/getting_sales_report_rebuild/dtos"
+	gettingSalesReportRebuildV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/getting_sales_report_rebuild/queries/v1"
+	"net/http"
+)
+
+type getSalesReportRebuildEndpoint struct {
+	*delivery.ReportEndpointBase
+}
+
+func NewGetSalesReportRebuildEndpoint(endpointBase *delivery.ReportEndpointBase) *getSalesReportRebuildEndpoint {
+	return &getSalesReportRebuildEndpoint{endpointBase}
+}
+
+func (ep *getSalesReportRebuildEndpoint) MapRoute() {
+	ep.ReportsGroup.GET("/sales/rebuilds/:id", ep.handler())
+}
+
+// GetSalesReportRebuild
+// @Tags Reports
+// @Summary Get sales report rebuild
+// @Description Get the status, the replayed events and the error of a requested rebuild of the sales report
+// @Accept json
+// @Produce json
+// @Param id path string true "Rebuild ID"
+// @Success 200 {object} dtos.GetSalesReportRebuildResponseDto
+// @Router /api/v1/reports/sales/rebuilds/{id} [get]
+func (ep *getSalesReportRebuildEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.GetSalesReportRebuildHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "getSalesReportRebuildEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.GetSalesReportRebuildRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[getSalesReportRebuildEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[getSalesReportRebuildEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		query := gettingSalesReportRebuildV1.NewGetSalesReportRebuild(request.RebuildId)
+		if err := ep.Validator.StructCtx(ctx, query); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[getSalesReportRebuildEndpoint_handler.StructCtx] query validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[getSalesReportRebuildEndpoint_handler.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		queryResult, err := mediatr.Send[*gettingSalesReportRebuildV1.GetSalesReportRebuild, *dtos.GetSalesReportRebuildResponseDto](ctx, query)
+		if err != nil {
+			err = errors.WithMessage(err, "[getSalesReportRebuildEndpoint_handler.Send] error in sending GetSalesReportRebuild")
+			ep.Log.Errorw(fmt.Sprintf("[getSalesReportRebuildEndpoint_handler.Send] id: {%s}, err: %v", query.RebuildId, tracing.TraceWithErr(span, err)), logger.Fields{"RebuildId": query.RebuildId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, queryResult)
+	}
+}
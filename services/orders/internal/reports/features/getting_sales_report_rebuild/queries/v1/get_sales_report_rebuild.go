@@ -0,0 +1,12 @@
+package v1
+
+import uuid "github.com/satori/go.uuid"
+
+// GetSalesReportRebuild gets the status of a requested rebuild of the sales report
+type GetSalesReportRebuild struct {
+	RebuildId uuid.UUID `validate:"required"`
+}
+
+func NewGetSalesReportRebuild(rebuildId uuid.UUID) *GetSalesReportRebuild {
+	return &GetSalesReportRebuild{RebuildId: rebuildId}
+}
@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/contracts"
+	reportsDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/getting_sales_report_rebuild/dtos"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type GetSalesReportRebuildHandler struct {
+	log                          logger.Logger
+	cfg                          *config.Config
+	salesReportRebuildRepository contracts.SalesReportRebuildRepository
+}
+
+func NewGetSalesReportRebuildHandler(log logger.Logger, cfg *config.Config, salesReportRebuildRepository contracts.SalesReportRebuildRepository) *GetSalesReportRebuildHandler {
+	return &GetSalesReportRebuildHandler{log: log, cfg: cfg, salesReportRebuildRepository: salesReportRebuildRepository}
+}
+
+func (q *GetSalesReportRebuildHandler) Handle(ctx context.Context, query *GetSalesReportRebuild) (*dtos.GetSalesReportRebuildResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetSalesReportRebuildHandler.Handle")
+	span.LogFields(log.Object("Query", query))
+	defer span.Finish()
+
+	rebuild, err := q.salesReportRebuildRepository.GetRebuild(ctx, query.RebuildId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetSalesReportRebuildHandler_Handle.GetRebuild] error in getting the sales report rebuild in the repository"))
+	}
+	if rebuild == nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewNotFoundError(fmt.Sprintf("[GetSalesReportRebuildHandler_Handle] sales report rebuild with id %s not found", query.RebuildId)))
+	}
+
+	rebuildDto, err := mapper.Map[*reportsDtos.SalesReportRebuildDto](rebuild)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetSalesReportRebuildHandler_Handle.Map] error in the mapping sales report rebuild"))
+	}
+
+	return &dtos.GetSalesReportRebuildResponseDto{Rebuild: rebuildDto}, nil
+}
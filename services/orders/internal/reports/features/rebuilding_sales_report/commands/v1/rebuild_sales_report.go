@@ -0,0 +1,9 @@
+package v1
+
+// RebuildSalesReport requests a background rebuild of the sales report from all the order events of the event store
+type RebuildSalesReport struct {
+}
+
+func NewRebuildSalesReport() *RebuildSalesReport {
+	return &RebuildSalesReport{}
+}
@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/rebuilding_sales_report/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type RebuildSalesReportHandler struct {
+	log                          logger.Logger
+	cfg                          *config.Config
+	salesReportRebuildRepository contracts.SalesReportRebuildRepository
+}
+
+func NewRebuildSalesReportHandler(log logger.Logger, cfg *config.Config, salesReportRebuildRepository contracts.SalesReportRebuildRepository) *RebuildSalesReportHandler {
+	return &RebuildSalesReportHandler{log: log, cfg: cfg, salesReportRebuildRepository: salesReportRebuildRepository}
+}
+
+// Handle queues a rebuild for the rebuild worker. A pending or running rebuild is returned instead of queueing another one, and a failed
+// rebuild is resumed, so its replay continues on the report which it already removed
+func (c *RebuildSalesReportHandler) Handle(ctx context.Context, command *RebuildSalesReport) (*dtos.RebuildSalesReportResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RebuildSalesReportHandler.Handle")
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	rebuild, err := c.salesReportRebuildRepository.GetLatestRebuild(ctx)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[RebuildSalesReportHandler_Handle.GetLatestRebuild] error in getting the latest sales report rebuild in the repository"))
+	}
+
+	switch {
+	case rebuild != nil && !rebuild.Finished():
+		c.log.Infow(fmt.Sprintf("[RebuildSalesReportHandler.Handle] sales report rebuild %s is already %s", rebuild.RebuildId, rebuild.Status), logger.Fields{"RebuildId": rebuild.RebuildId})
+
+	case rebuild != nil && rebuild.Status == models.RebuildFailed:
+		rebuild.Resume()
+		if err := c.salesReportRebuildRepository.UpdateRebuild(ctx, rebuild); err != nil {
+			return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[RebuildSalesReportHandler_Handle.UpdateRebuild] error in resuming the sales report rebuild in the repository"))
+		}
+		c.log.Infow(fmt.Sprintf("[RebuildSalesReportHandler.Handle] failed sales report rebuild %s resumed", rebuild.RebuildId), logger.Fields{"RebuildId": rebuild.RebuildId})
+
+	default:
+		rebuild = models.NewSalesReportRebuild()
+		if err := c.salesReportRebuildRepository.AddRebuild(ctx, rebuild); err != nil {
+			return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[RebuildSalesReportHandler_Handle.AddRebuild] error in adding the sales report rebuild in the repository"))
+		}
+		c.log.Infow(fmt.Sprintf("[RebuildSalesReportHandler.Handle] sales report rebuild %s queued", rebuild.RebuildId), logger.Fields{"RebuildId": rebuild.RebuildId})
+	}
+
+	return &dtos.RebuildSalesReportResponseDto{RebuildId: rebuild.RebuildId, Status: rebuild.Status}, nil
+}
@@ -0,0 +1,8 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+type RebuildSalesReportResponseDto struct {
+	RebuildId uuid.UUID `json:"rebuildId"`
+	Status    string    `json:"status"`
+}
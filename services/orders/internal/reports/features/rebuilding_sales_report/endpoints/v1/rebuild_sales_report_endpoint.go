@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/delivery"
+	rebuildingSalesReportV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/rebuilding_sales_report/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/features/rebuilding_sales_report/dtos"
+	"net/http"
+	"strings"
+)
+
+type rebuildSalesReportEndpoint struct {
+	*delivery.ReportEndpointBase
+}
+
+func NewRebuildSalesReportEndpoint(endpointBase *delivery.ReportEndpointBase) *rebuildSalesReportEndpoint {
+	return &rebuildSalesReportEndpoint{endpointBase}
+}
+
+func (ep *rebuildSalesReportEndpoint) MapRoute() {
+	ep.ReportsGroup.POST("/sales/rebuild", ep.handler())
+}
+
+// RebuildSalesReport
+// @Tags Reports
+// @Summary Rebuild sales report
+// @Description Queue a background rebuild which removes the sales report and projects all the order events of the event store again, a failed rebuild is resumed. The status of the rebuild is at the location header
+// @Accept json
+// @Produce json
+// @Success 202 {object} dtos.RebuildSalesReportResponseDto
+// @Router /api/v1/reports/sales/rebuild [post]
+func (ep *rebuildSalesReportEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.RebuildSalesReportHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "rebuildSalesReportEndpoint.handler")
+		defer span.Finish()
+
+		command := rebuildingSalesReportV1.NewRebuildSalesReport()
+
+		result, err := mediatr.Send[*rebuildingSalesReportV1.RebuildSalesReport, *dtos.RebuildSalesReportResponseDto](ctx, command)
+		if err != nil {
+			err = errors.WithMessage(err, "[rebuildSalesReportEndpoint_handler.Send] error in sending RebuildSalesReport")
+			ep.Log.Error(fmt.Sprintf("[rebuildSalesReportEndpoint_handler.Send] err: {%v}", tracing.TraceWithErr(span, err)))
+			return err
+		}
+
+		// the rebuild status route is next to the rebuild route
+		c.Response().Header().Set(echo.HeaderLocation, fmt.Sprintf("%s/rebuilds/%s", strings.TrimSuffix(c.Path(), "/rebuild"), result.RebuildId))
+
+		return c.JSON(http.StatusAccepted, result)
+	}
+}
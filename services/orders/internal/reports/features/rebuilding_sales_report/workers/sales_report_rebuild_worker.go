@@ -0,0 +1,114 @@
+package workers
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/eventstroredb"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	"time"
+)
+
+const (
+	rebuildPollInterval = 5 * time.Second
+	// rebuildLease is how long a running rebuild is hidden from the other workers, a rebuild which runs longer can be claimed by another
+	// worker too and both of them replay the events, the sales projection applies each event once
+	rebuildLease = 30 * time.Minute
+)
+
+// SalesReportRebuilder runs the requested rebuilds of the sales report out of the requests, the report is removed once per rebuild and
+// the replay of a failed or interrupted rebuild starts again from the start of the event store without removing the report
+type SalesReportRebuilder struct {
+	log                          logger.Logger
+	cfg                          *config.Config
+	salesReportRepository        contracts.SalesReportRepository
+	salesReportRebuildRepository contracts.SalesReportRebuildRepository
+	projectionReplayer           eventstroredb.EsdbProjectionReplayer
+	salesProjection              projection.IProjection
+}
+
+func NewSalesReportRebuilder(log logger.Logger, cfg *config.Config, salesReportRepository contracts.SalesReportRepository, salesReportRebuildRepository contracts.SalesReportRebuildRepository, projectionReplayer eventstroredb.EsdbProjectionReplayer, salesProjection projection.IProjection) *SalesReportRebuilder {
+	return &SalesReportRebuilder{log: log, cfg: cfg, salesReportRepository: salesReportRepository, salesReportRebuildRepository: salesReportRebuildRepository, projectionReplayer: projectionReplayer, salesProjection: salesProjection}
+}
+
+// RunNext claims the next requested rebuild and runs it, it returns false when there is no rebuild to run
+func (r *SalesReportRebuilder) RunNext(ctx context.Context) (bool, error) {
+	rebuild, err := r.salesReportRebuildRepository.ClaimRebuild(ctx, rebuildLease)
+	if err != nil {
+		return false, errors.WrapIf(err, "[SalesReportRebuilder_RunNext.ClaimRebuild] error in claiming the next sales report rebuild")
+	}
+	if rebuild == nil {
+		return false, nil
+	}
+
+	if rebuild.ResetAt == nil {
+		if err := r.salesReportRepository.Reset(ctx); err != nil {
+			return true, r.finish(ctx, rebuild, 0, errors.WrapIf(err, "[SalesReportRebuilder_RunNext.Reset] error in removing the sales report"))
+		}
+
+		now := time.Now()
+		rebuild.ResetAt = &now
+		if err := r.salesReportRebuildRepository.UpdateRebuild(ctx, rebuild); err != nil {
+			// the rebuild is claimed again after its lease and removes the report again, nothing is replayed to it yet
+			return true, errors.WrapIf(err, "[SalesReportRebuilder_RunNext.UpdateRebuild] error in saving the reset of the sales report rebuild")
+		}
+	}
+
+	replayedEvents, err := r.projectionReplayer.Replay(ctx, r.cfg.Subscriptions.OrderSubscription.Prefix, r.salesProjection)
+	if err != nil {
+		err = errors.WrapIf(err, "[SalesReportRebuilder_RunNext.Replay] error in replaying the order events to the sales projection")
+	}
+
+	return true, r.finish(ctx, rebuild, replayedEvents, err)
+}
+
+// finish saves the result of the rebuild with a context which outlives the stopping worker, an interrupted rebuild is queued again for the next worker
+func (r *SalesReportRebuilder) finish(ctx context.Context, rebuild *models.SalesReportRebuild, replayedEvents int64, err error) error {
+	switch {
+	case err == nil:
+		rebuild.Complete(replayedEvents)
+		r.log.Infow(fmt.Sprintf("[SalesReportRebuilder.finish] sales report rebuild %s completed with %d replayed events", rebuild.RebuildId, replayedEvents), logger.Fields{"RebuildId": rebuild.RebuildId, "ReplayedEvents": replayedEvents})
+	case ctx.Err() != nil:
+		rebuild.Interrupt(replayedEvents)
+		r.log.Infow(fmt.Sprintf("[SalesReportRebuilder.finish] sales report rebuild %s interrupted after %d replayed events", rebuild.RebuildId, replayedEvents), logger.Fields{"RebuildId": rebuild.RebuildId, "ReplayedEvents": replayedEvents})
+	default:
+		rebuild.Fail(replayedEvents, err)
+	}
+
+	if updateErr := r.salesReportRebuildRepository.UpdateRebuild(context.Background(), rebuild); updateErr != nil {
+		return errors.Combine(err, errors.WrapIf(updateErr, "[SalesReportRebuilder_finish.UpdateRebuild] error in saving the result of the sales report rebuild"))
+	}
+
+	return err
+}
+
+// NewSalesReportRebuildWorker runs the requested rebuilds of the sales report until the worker stops
+func NewSalesReportRebuildWorker(rebuilder *SalesReportRebuilder, logger logger.Logger) web.Worker {
+	stop := make(chan struct{})
+
+	return web.NewBackgroundWorker(func(ctx context.Context) error {
+		ticker := time.NewTicker(rebuildPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if _, err := rebuilder.RunNext(ctx); err != nil && ctx.Err() == nil {
+					logger.Errorf("[SalesReportRebuildWorker.RunNext] error in rebuilding the sales report: {%v}", err)
+				}
+			case <-stop:
+				return nil
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}, func(ctx context.Context) error {
+		close(stop)
+		return nil
+	})
+}
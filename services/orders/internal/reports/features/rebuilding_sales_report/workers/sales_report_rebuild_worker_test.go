@@ -0,0 +1,152 @@
+package workers
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func Test_Sales_Report_Rebuild(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rebuild removes the report once and replays the events", func(t *testing.T) {
+		rebuilder, salesReports, rebuilds, replayer := newTestRebuilder()
+		rebuild := rebuilds.add(models.NewSalesReportRebuild())
+		replayer.replay = func(ctx context.Context) (int64, error) { return 42, nil }
+
+		ran, err := rebuilder.RunNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, ran)
+		assert.Equal(t, 1, salesReports.resets)
+		assert.Equal(t, models.RebuildCompleted, rebuild.Status)
+		assert.Equal(t, int64(42), rebuild.ReplayedEvents)
+		assert.NotNil(t, rebuild.FinishedAt)
+
+		ran, err = rebuilder.RunNext(ctx)
+		require.NoError(t, err)
+		assert.False(t, ran)
+	})
+
+	t.Run("failed rebuild resumes without removing the report again", func(t *testing.T) {
+		rebuilder, salesReports, rebuilds, replayer := newTestRebuilder()
+		rebuild := rebuilds.add(models.NewSalesReportRebuild())
+		replayer.replay = func(ctx context.Context) (int64, error) { return 10, errors.New("event store is not available") }
+
+		_, err := rebuilder.RunNext(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, models.RebuildFailed, rebuild.Status)
+		assert.Contains(t, rebuild.Error, "event store is not available")
+		assert.NotNil(t, rebuild.ResetAt)
+
+		rebuild.Resume()
+		replayer.replay = func(ctx context.Context) (int64, error) { return 25, nil }
+
+		ran, err := rebuilder.RunNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, ran)
+		assert.Equal(t, 1, salesReports.resets)
+		assert.Equal(t, models.RebuildCompleted, rebuild.Status)
+		assert.Equal(t, 2, rebuild.Attempts)
+	})
+
+	t.Run("interrupted rebuild is queued again", func(t *testing.T) {
+		rebuilder, _, rebuilds, replayer := newTestRebuilder()
+		rebuild := rebuilds.add(models.NewSalesReportRebuild())
+
+		cancelCtx, cancel := context.WithCancel(ctx)
+		replayer.replay = func(ctx context.Context) (int64, error) {
+			cancel()
+			return 5, ctx.Err()
+		}
+
+		_, err := rebuilder.RunNext(cancelCtx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, models.RebuildPending, rebuild.Status)
+		assert.Equal(t, int64(5), rebuild.ReplayedEvents)
+		assert.Nil(t, rebuild.LeaseUntil)
+	})
+}
+
+func newTestRebuilder() (*SalesReportRebuilder, *fakeSalesReportRepository, *fakeSalesReportRebuildRepository, *fakeProjectionReplayer) {
+	salesReports := &fakeSalesReportRepository{}
+	rebuilds := &fakeSalesReportRebuildRepository{rebuilds: map[uuid.UUID]*models.SalesReportRebuild{}}
+	replayer := &fakeProjectionReplayer{}
+	cfg := &config.Config{Subscriptions: &config.Subscriptions{OrderSubscription: &config.Subscription{Prefix: []string{"order-"}}}}
+
+	return NewSalesReportRebuilder(defaultLogger.Logger, cfg, salesReports, rebuilds, replayer, nil), salesReports, rebuilds, replayer
+}
+
+type fakeSalesReportRepository struct {
+	contracts.SalesReportRepository
+	resets int
+}
+
+func (f *fakeSalesReportRepository) Reset(ctx context.Context) error {
+	f.resets++
+	return nil
+}
+
+type fakeSalesReportRebuildRepository struct {
+	rebuilds map[uuid.UUID]*models.SalesReportRebuild
+}
+
+func (f *fakeSalesReportRebuildRepository) add(rebuild *models.SalesReportRebuild) *models.SalesReportRebuild {
+	f.rebuilds[rebuild.RebuildId] = rebuild
+	return rebuild
+}
+
+func (f *fakeSalesReportRebuildRepository) AddRebuild(ctx context.Context, rebuild *models.SalesReportRebuild) error {
+	f.add(rebuild)
+	return nil
+}
+
+func (f *fakeSalesReportRebuildRepository) UpdateRebuild(ctx context.Context, rebuild *models.SalesReportRebuild) error {
+	f.add(rebuild)
+	return nil
+}
+
+func (f *fakeSalesReportRebuildRepository) GetRebuild(ctx context.Context, rebuildId uuid.UUID) (*models.SalesReportRebuild, error) {
+	return f.rebuilds[rebuildId], nil
+}
+
+func (f *fakeSalesReportRebuildRepository) GetLatestRebuild(ctx context.Context) (*models.SalesReportRebuild, error) {
+	var latest *models.SalesReportRebuild
+	for _, rebuild := range f.rebuilds {
+		if latest == nil || rebuild.CreatedAt.After(latest.CreatedAt) {
+			latest = rebuild
+		}
+	}
+
+	return latest, nil
+}
+
+func (f *fakeSalesReportRebuildRepository) ClaimRebuild(ctx context.Context, lease time.Duration) (*models.SalesReportRebuild, error) {
+	for _, rebuild := range f.rebuilds {
+		if rebuild.Status == models.RebuildPending {
+			leaseUntil := time.Now().Add(lease)
+			rebuild.Status = models.RebuildRunning
+			rebuild.LeaseUntil = &leaseUntil
+			rebuild.Attempts++
+			return rebuild, nil
+		}
+	}
+
+	return nil, nil
+}
+
+type fakeProjectionReplayer struct {
+	replay func(ctx context.Context) (int64, error)
+}
+
+func (f *fakeProjectionReplayer) Replay(ctx context.Context, prefixes []string, projection projection.IProjection) (int64, error) {
+	return f.replay(ctx)
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// DailySales are the sales of a day in a currency, the sales projection adds the sales of the orders to the day of the change
+type DailySales struct {
+	Day      time.Time       `json:"day" gorm:"primaryKey;type:date"`
+	Currency domain.Currency `json:"currency" gorm:"primaryKey"`
+	// Orders is the number of the orders which are paid in the day
+	Orders    int64 `json:"orders"`
+	ItemsSold int64 `json:"itemsSold"`
+	// Revenue is the total price of the paid orders after their discounts
+	Revenue        domain.Decimal `json:"revenue"`
+	Discounts      domain.Decimal `json:"discounts"`
+	Refunds        domain.Decimal `json:"refunds"`
+	CanceledOrders int64          `json:"canceledOrders"`
+}
+
+// DailyProductSales are the sales of a product in a day, the revenue is the price of the sold items before the discounts of the orders
+type DailyProductSales struct {
+	Day       time.Time       `json:"day" gorm:"primaryKey;type:date"`
+	ProductId uuid.UUID       `json:"productId" gorm:"primaryKey"`
+	Currency  domain.Currency `json:"currency" gorm:"primaryKey"`
+	Title     string          `json:"title"`
+	Quantity  int64           `json:"quantity"`
+	Revenue   domain.Decimal  `json:"revenue"`
+}
+
+// ProductSales are the sales of a product in a date range
+type ProductSales struct {
+	ProductId uuid.UUID       `json:"productId"`
+	Currency  domain.Currency `json:"currency"`
+	Title     string          `json:"title"`
+	Quantity  int64           `json:"quantity"`
+	Revenue   domain.Decimal  `json:"revenue"`
+}
+
+// SalesChanges are the sales which an order event adds to the daily sales
+type SalesChanges struct {
+	Sales        *DailySales
+	ProductSales []*DailyProductSales
+}
+
+func NewDailySales(day time.Time, currency domain.Currency) *DailySales {
+	return &DailySales{Day: day, Currency: currency}
+}
+
+func (DailySales) TableName() string {
+	return "daily_sales"
+}
+
+func (DailyProductSales) TableName() string {
+	return "daily_product_sales"
+}
+
+// SalesDay is the utc day of the time, the sales are bucketed by the utc days
+func SalesDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
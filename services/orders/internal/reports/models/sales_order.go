@@ -0,0 +1,161 @@
+package models
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/serializer/jsonSerializer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// SalesOrder is the state of an order which the sales projection needs to count the order in the sales of the day it is paid.
+// Version is the stream version of the last event of the order which is applied to it
+type SalesOrder struct {
+	OrderId    uuid.UUID         `json:"orderId" gorm:"primaryKey"`
+	Version    int64             `json:"version"`
+	Items      []*SalesOrderItem `json:"items" gorm:"serializer:json"`
+	Coupon     *dtos.CouponDto   `json:"coupon" gorm:"serializer:json"`
+	Discount   domain.Money      `json:"discount" gorm:"embedded;embeddedPrefix:discount_"`
+	TotalPrice domain.Money      `json:"totalPrice" gorm:"embedded;embeddedPrefix:total_price_"`
+	// Returns are the refund amounts of the requested returns by their return id
+	Returns        map[string]domain.Money `json:"returns" gorm:"serializer:json"`
+	RefundedAmount domain.Money            `json:"refundedAmount" gorm:"embedded;embeddedPrefix:refunded_amount_"`
+	Paid           bool                    `json:"paid"`
+	Canceled       bool                    `json:"canceled"`
+}
+
+type SalesOrderItem struct {
+	ProductId uuid.UUID    `json:"productId"`
+	Title     string       `json:"title"`
+	Quantity  uint64       `json:"quantity"`
+	Price     domain.Money `json:"price"`
+}
+
+func (SalesOrder) TableName() string {
+	return "sales_orders"
+}
+
+// UpdateItems replaces the items of the order and recalculates its discount and total price
+func (o *SalesOrder) UpdateItems(items []*SalesOrderItem) {
+	o.Items = items
+	o.recalculateTotalPrice()
+}
+
+// ApplyCoupon sets the discount rule of the applied coupon and recalculates the discount and total price of the order
+func (o *SalesOrder) ApplyCoupon(coupon *dtos.CouponDto) {
+	o.Coupon = coupon
+	o.recalculateTotalPrice()
+}
+
+// RequestReturn keeps the refund amount of the return until its refund is issued
+func (o *SalesOrder) RequestReturn(returnId uuid.UUID, refundAmount domain.Money) {
+	if o.Returns == nil {
+		o.Returns = map[string]domain.Money{}
+	}
+	o.Returns[returnId.String()] = refundAmount
+}
+
+// Pay marks the order as paid and returns its sales on the day of the payment
+func (o *SalesOrder) Pay(paidAt time.Time) *SalesChanges {
+	if o.Paid {
+		return nil
+	}
+	o.Paid = true
+
+	day := SalesDay(paidAt)
+	sales := NewDailySales(day, o.TotalPrice.Currency)
+	sales.Orders = 1
+	sales.Revenue = o.TotalPrice.Amount
+	sales.Discounts = o.Discount.Amount
+
+	productSales := make([]*DailyProductSales, 0, len(o.Items))
+	for _, item := range o.Items {
+		// the order aggregate only accepts the items which their total price is in range
+		revenue, _ := item.Price.Multiply(item.Quantity)
+		sales.ItemsSold += int64(item.Quantity)
+		productSales = append(productSales, &DailyProductSales{
+			Day:       day,
+			ProductId: item.ProductId,
+			Currency:  item.Price.Currency,
+			Title:     item.Title,
+			Quantity:  int64(item.Quantity),
+			Revenue:   revenue.Amount,
+		})
+	}
+
+	return &SalesChanges{Sales: sales, ProductSales: productSales}
+}
+
+// Cancel marks the order as canceled and counts it in the canceled orders of the day, the paid amount of a canceled order which is not
+// refunded yet is counted as a refund
+func (o *SalesOrder) Cancel(canceledAt time.Time) *SalesChanges {
+	if o.Canceled {
+		return nil
+	}
+	o.Canceled = true
+
+	sales := NewDailySales(SalesDay(canceledAt), o.TotalPrice.Currency)
+	sales.CanceledOrders = 1
+	if o.Paid {
+		if remaining, err := o.TotalPrice.Subtract(o.RefundedAmount); err == nil && remaining.IsPositive() {
+			sales.Refunds = remaining.Amount
+			o.RefundedAmount = o.TotalPrice
+		}
+	}
+
+	return &SalesChanges{Sales: sales}
+}
+
+// Refund counts the refund amount of the return in the refunds of the day, an unknown return is ignored
+func (o *SalesOrder) Refund(returnId uuid.UUID, refundedAt time.Time) *SalesChanges {
+	refundAmount, ok := o.Returns[returnId.String()]
+	if !ok {
+		return nil
+	}
+	delete(o.Returns, returnId.String())
+
+	refunded, err := o.RefundedAmount.Add(refundAmount)
+	if err != nil {
+		refunded = refundAmount
+	}
+	o.RefundedAmount = refunded
+
+	sales := NewDailySales(SalesDay(refundedAt), refundAmount.Currency)
+	sales.Refunds = refundAmount.Amount
+
+	return &SalesChanges{Sales: sales}
+}
+
+// recalculateTotalPrice uses the discount rules of the order aggregate, so the reported revenue matches the paid total of the order
+func (o *SalesOrder) recalculateTotalPrice() {
+	subtotal := domain.Money{}
+	if len(o.Items) > 0 {
+		subtotal = domain.ZeroMoney(o.Items[0].Price.Currency)
+	}
+	for _, item := range o.Items {
+		price, err := item.Price.Multiply(item.Quantity)
+		if err != nil {
+			continue
+		}
+		if sum, err := subtotal.Add(price); err == nil {
+			subtotal = sum
+		}
+	}
+
+	o.Discount = domain.ZeroMoney(subtotal.Currency)
+	if o.Coupon != nil {
+		coupon, err := value_objects.NewCoupon(o.Coupon.Code, o.Coupon.DiscountType, o.Coupon.PercentOff, o.Coupon.AmountOff, o.Coupon.MinimumBasket)
+		if err == nil {
+			if discount, err := coupon.Discount(subtotal); err == nil {
+				o.Discount = discount
+			}
+		}
+	}
+
+	o.TotalPrice, _ = subtotal.Subtract(o.Discount)
+}
+
+func (o *SalesOrder) String() string {
+	return jsonSerializer.PrettyPrint(o)
+}
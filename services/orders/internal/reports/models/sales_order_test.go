@@ -0,0 +1,86 @@
+package models
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/value_objects"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func newPaidSalesOrder(t *testing.T, paidAt time.Time) (*SalesOrder, *SalesChanges) {
+	order := &SalesOrder{OrderId: uuid.NewV4()}
+	order.UpdateItems([]*SalesOrderItem{
+		{ProductId: uuid.NewV4(), Title: "Keyboard", Quantity: 2, Price: domain.MustParseMoney("30.00", "USD")},
+		{ProductId: uuid.NewV4(), Title: "Mouse", Quantity: 1, Price: domain.MustParseMoney("40.00", "USD")},
+	})
+	order.ApplyCoupon(&dtos.CouponDto{Code: "TEN", DiscountType: value_objects.PercentageDiscount, PercentOff: domain.NewDecimal(10, 0)})
+
+	changes := order.Pay(paidAt)
+	require.NotNil(t, changes)
+
+	return order, changes
+}
+
+func Test_Sales_Order_Pay_Counts_The_Discounted_Total_In_The_Payment_Day(t *testing.T) {
+	paidAt := time.Date(2024, 3, 5, 23, 30, 0, 0, time.FixedZone("UTC-2", -2*60*60))
+	order, changes := newPaidSalesOrder(t, paidAt)
+
+	sales := changes.Sales
+	assert.Equal(t, time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC), sales.Day)
+	assert.Equal(t, domain.USD, sales.Currency)
+	assert.Equal(t, int64(1), sales.Orders)
+	assert.Equal(t, int64(3), sales.ItemsSold)
+	assert.Equal(t, "90.00", sales.Revenue.String())
+	assert.Equal(t, "10.00", sales.Discounts.String())
+
+	require.Len(t, changes.ProductSales, 2)
+	assert.Equal(t, int64(2), changes.ProductSales[0].Quantity)
+	assert.Equal(t, "60.00", changes.ProductSales[0].Revenue.String())
+
+	assert.Nil(t, order.Pay(paidAt))
+}
+
+func Test_Sales_Order_Refund_Counts_The_Refund_Amount_Once(t *testing.T) {
+	order, _ := newPaidSalesOrder(t, time.Now())
+
+	returnId := uuid.NewV4()
+	order.RequestReturn(returnId, domain.MustParseMoney("27.00", "USD"))
+
+	refundedAt := time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC)
+	changes := order.Refund(returnId, refundedAt)
+	require.NotNil(t, changes)
+	assert.Equal(t, SalesDay(refundedAt), changes.Sales.Day)
+	assert.Equal(t, "27.00", changes.Sales.Refunds.String())
+	assert.Equal(t, "27.00", order.RefundedAmount.Amount.String())
+
+	assert.Nil(t, order.Refund(returnId, refundedAt))
+	assert.Nil(t, order.Refund(uuid.NewV4(), refundedAt))
+}
+
+func Test_Sales_Order_Cancel_Refunds_The_Unrefunded_Paid_Amount(t *testing.T) {
+	order, _ := newPaidSalesOrder(t, time.Now())
+
+	returnId := uuid.NewV4()
+	order.RequestReturn(returnId, domain.MustParseMoney("27.00", "USD"))
+	order.Refund(returnId, time.Now())
+
+	changes := order.Cancel(time.Now())
+	require.NotNil(t, changes)
+	assert.Equal(t, int64(1), changes.Sales.CanceledOrders)
+	assert.Equal(t, "63.00", changes.Sales.Refunds.String())
+	assert.Nil(t, order.Cancel(time.Now()))
+}
+
+func Test_Sales_Order_Cancel_Of_An_Unpaid_Order_Has_No_Refund(t *testing.T) {
+	order := &SalesOrder{OrderId: uuid.NewV4()}
+	order.UpdateItems([]*SalesOrderItem{{ProductId: uuid.NewV4(), Quantity: 1, Price: domain.MustParseMoney("15.00", "EUR")}})
+
+	changes := order.Cancel(time.Now())
+	require.NotNil(t, changes)
+	assert.Equal(t, int64(1), changes.Sales.CanceledOrders)
+	assert.True(t, changes.Sales.Refunds.IsZero())
+}
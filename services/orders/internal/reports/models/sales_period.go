@@ -0,0 +1,104 @@
+package models
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"sort"
+	"time"
+)
+
+const (
+	DayGranularity   = "day"
+	WeekGranularity  = "week"
+	MonthGranularity = "month"
+)
+
+// basketSizeScale is the number of the fraction digits of the average number of the items of the orders
+const basketSizeScale = 2
+
+// SalesPeriod are the sales of a day, an iso week or a month in a currency, the periods are rolled up from the daily sales
+type SalesPeriod struct {
+	PeriodStart    time.Time       `json:"periodStart"`
+	Currency       domain.Currency `json:"currency"`
+	Orders         int64           `json:"orders"`
+	ItemsSold      int64           `json:"itemsSold"`
+	Revenue        domain.Decimal  `json:"revenue"`
+	Discounts      domain.Decimal  `json:"discounts"`
+	Refunds        domain.Decimal  `json:"refunds"`
+	CanceledOrders int64           `json:"canceledOrders"`
+}
+
+// NetRevenue is the revenue of the period minus its refunds
+func (p *SalesPeriod) NetRevenue() domain.Decimal {
+	return p.Revenue.Sub(p.Refunds)
+}
+
+// AverageOrderValue is the average revenue of the paid orders, rounded to the minor unit of the currency
+func (p *SalesPeriod) AverageOrderValue() domain.Decimal {
+	if p.Orders == 0 {
+		return domain.Decimal{}
+	}
+
+	return p.Revenue.MulDiv(domain.NewDecimal(1, 0), domain.NewDecimal(p.Orders, 0), p.Currency.Exponent(), domain.RoundHalfUp)
+}
+
+// AverageBasketSize is the average number of the items of the paid orders
+func (p *SalesPeriod) AverageBasketSize() domain.Decimal {
+	if p.Orders == 0 {
+		return domain.Decimal{}
+	}
+
+	return domain.NewDecimal(p.ItemsSold, 0).MulDiv(domain.NewDecimal(1, 0), domain.NewDecimal(p.Orders, 0), basketSizeScale, domain.RoundHalfUp)
+}
+
+// PeriodStart is the start of the period of the granularity which the day belongs to, the weeks start on monday
+func PeriodStart(day time.Time, granularity string) time.Time {
+	day = SalesDay(day)
+	switch granularity {
+	case WeekGranularity:
+		// time.Sunday is 0, so sunday is the 7th day of the iso week
+		weekday := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -weekday)
+	case MonthGranularity:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// GroupSalesByPeriod rolls the daily sales up to the periods of the granularity, the periods are ordered by their start and currency
+func GroupSalesByPeriod(dailySales []*DailySales, granularity string) []*SalesPeriod {
+	type periodKey struct {
+		start    time.Time
+		currency domain.Currency
+	}
+
+	periods := make(map[periodKey]*SalesPeriod)
+	for _, sales := range dailySales {
+		key := periodKey{start: PeriodStart(sales.Day, granularity), currency: sales.Currency}
+		period, ok := periods[key]
+		if !ok {
+			period = &SalesPeriod{PeriodStart: key.start, Currency: key.currency}
+			periods[key] = period
+		}
+
+		period.Orders += sales.Orders
+		period.ItemsSold += sales.ItemsSold
+		period.Revenue = period.Revenue.Add(sales.Revenue)
+		period.Discounts = period.Discounts.Add(sales.Discounts)
+		period.Refunds = period.Refunds.Add(sales.Refunds)
+		period.CanceledOrders += sales.CanceledOrders
+	}
+
+	result := make([]*SalesPeriod, 0, len(periods))
+	for _, period := range periods {
+		result = append(result, period)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].PeriodStart.Equal(result[j].PeriodStart) {
+			return result[i].PeriodStart.Before(result[j].PeriodStart)
+		}
+		return result[i].Currency < result[j].Currency
+	})
+
+	return result
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func Test_Period_Start(t *testing.T) {
+	// 2024-03-10 is a sunday
+	day := time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), PeriodStart(day, DayGranularity))
+	assert.Equal(t, time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC), PeriodStart(day, WeekGranularity))
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), PeriodStart(day, MonthGranularity))
+}
+
+func Test_Group_Sales_By_Period(t *testing.T) {
+	dailySales := []*DailySales{
+		{Day: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC), Currency: domain.USD, Orders: 2, ItemsSold: 5, Revenue: domain.NewDecimal(10000, 2), Refunds: domain.NewDecimal(1000, 2)},
+		{Day: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), Currency: domain.USD, Orders: 1, ItemsSold: 2, Revenue: domain.NewDecimal(5000, 2), CanceledOrders: 1},
+		{Day: time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), Currency: domain.USD, Orders: 1, ItemsSold: 1, Revenue: domain.NewDecimal(2000, 2)},
+		{Day: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), Currency: domain.EUR, Orders: 1, ItemsSold: 1, Revenue: domain.NewDecimal(900, 2)},
+	}
+
+	periods := GroupSalesByPeriod(dailySales, WeekGranularity)
+	require.Len(t, periods, 3)
+
+	assert.Equal(t, time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC), periods[0].PeriodStart)
+	assert.Equal(t, domain.EUR, periods[0].Currency)
+
+	usd := periods[1]
+	assert.Equal(t, domain.USD, usd.Currency)
+	assert.Equal(t, int64(3), usd.Orders)
+	assert.Equal(t, int64(7), usd.ItemsSold)
+	assert.Equal(t, int64(1), usd.CanceledOrders)
+	assert.Equal(t, "150.00", usd.Revenue.String())
+	assert.Equal(t, "140.00", usd.NetRevenue().String())
+	assert.Equal(t, "50.00", usd.AverageOrderValue().String())
+	assert.Equal(t, "2.33", usd.AverageBasketSize().String())
+
+	assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), periods[2].PeriodStart)
+}
+
+func Test_Sales_Period_Averages_Without_Orders(t *testing.T) {
+	period := &SalesPeriod{Currency: domain.USD, Refunds: domain.NewDecimal(500, 2)}
+
+	assert.True(t, period.AverageOrderValue().IsZero())
+	assert.True(t, period.AverageBasketSize().IsZero())
+	assert.Equal(t, "-5.00", period.NetRevenue().String())
+}
@@ -0,0 +1,74 @@
+package models
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+const (
+	RebuildPending   = "pending"
+	RebuildRunning   = "running"
+	RebuildCompleted = "completed"
+	RebuildFailed    = "failed"
+)
+
+// SalesReportRebuild is a background rebuild of the sales report from the event store. The report is removed once, a failed or interrupted
+// rebuild resumes the replay without removing the report again because the sales projection skips the events which are already applied.
+// LeaseUntil hides a running rebuild from the other workers, a rebuild whose worker stopped is claimed again after its lease
+type SalesReportRebuild struct {
+	RebuildId      uuid.UUID  `json:"rebuildId" gorm:"primaryKey"`
+	Status         string     `json:"status" gorm:"index"`
+	ReplayedEvents int64      `json:"replayedEvents"`
+	Attempts       int        `json:"attempts"`
+	Error          string     `json:"error"`
+	ResetAt        *time.Time `json:"resetAt"`
+	LeaseUntil     *time.Time `json:"leaseUntil"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	StartedAt      *time.Time `json:"startedAt"`
+	FinishedAt     *time.Time `json:"finishedAt"`
+}
+
+func (SalesReportRebuild) TableName() string {
+	return "sales_report_rebuilds"
+}
+
+func NewSalesReportRebuild() *SalesReportRebuild {
+	return &SalesReportRebuild{RebuildId: uuid.NewV4(), Status: RebuildPending, CreatedAt: time.Now()}
+}
+
+// Finished is true when the rebuild completed or failed, a failed rebuild is resumed by requesting a rebuild again
+func (r *SalesReportRebuild) Finished() bool {
+	return r.Status == RebuildCompleted || r.Status == RebuildFailed
+}
+
+// Resume queues a failed rebuild again, it keeps the reset time so the report is not removed again
+func (r *SalesReportRebuild) Resume() {
+	r.Status = RebuildPending
+	r.Error = ""
+	r.FinishedAt = nil
+}
+
+func (r *SalesReportRebuild) Complete(replayedEvents int64) {
+	now := time.Now()
+	r.Status = RebuildCompleted
+	r.ReplayedEvents = replayedEvents
+	r.Error = ""
+	r.LeaseUntil = nil
+	r.FinishedAt = &now
+}
+
+func (r *SalesReportRebuild) Fail(replayedEvents int64, err error) {
+	now := time.Now()
+	r.Status = RebuildFailed
+	r.ReplayedEvents = replayedEvents
+	r.Error = err.Error()
+	r.LeaseUntil = nil
+	r.FinishedAt = &now
+}
+
+// Interrupt queues a rebuild whose worker is stopping, so the next worker resumes it without waiting for its lease
+func (r *SalesReportRebuild) Interrupt(replayedEvents int64) {
+	r.Status = RebuildPending
+	r.ReplayedEvents = replayedEvents
+	r.LeaseUntil = nil
+}
@@ -0,0 +1,93 @@
+package projections
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	applyingCouponEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/applying_coupon/events/domain/v1"
+	cancelingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/domain/v1"
+	creatingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/events/domain/v1"
+	issuingRefundEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/events/domain/v1"
+	payingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/domain/v1"
+	requestingReturnEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/events/domain/v1"
+	updatingShoppingCartEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/updating_shopping_card/events/domain/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/contracts"
+	reportModels "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// salesProjection counts the orders in the sales of the days they are paid, canceled and refunded. The events of an order are applied in
+// the order of their stream versions, an event which is already applied or comes after a missing event is skipped, so the subscription
+// and a rebuild from the event store can project the same events
+type salesProjection struct {
+	salesReportRepository contracts.SalesReportRepository
+	logger                logger.Logger
+}
+
+func NewSalesProjection(salesReportRepository contracts.SalesReportRepository, logger logger.Logger) projection.IProjection {
+	return &salesProjection{salesReportRepository: salesReportRepository, logger: logger}
+}
+
+func (s salesProjection) ProcessEvent(ctx context.Context, streamEvent *models.StreamEvent) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "salesProjection.ProcessEvent")
+	span.LogFields(log.Object("Event", streamEvent.Event))
+	defer span.Finish()
+
+	orderId := streamEvent.Event.GetAggregateId()
+	applied, err := s.salesReportRepository.ProjectOrderEvent(ctx, orderId, streamEvent.Version, func(order *reportModels.SalesOrder) (*reportModels.SalesChanges, error) {
+		return projectOrderEvent(order, streamEvent.Event)
+	})
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WrapIf(err, "[salesProjection_ProcessEvent.ProjectOrderEvent] error in projecting the order event to the sales report"))
+	}
+
+	if !applied {
+		s.logger.Infow(fmt.Sprintf("[salesProjection.ProcessEvent] version %d of order %s is skipped, it is already projected or an earlier event is not projected yet", streamEvent.Version, orderId), logger.Fields{"OrderId": orderId, "Version": streamEvent.Version})
+	}
+
+	return nil
+}
+
+// projectOrderEvent applies the event to the sales order, the events which don't change the sales only advance the version of the order
+func projectOrderEvent(order *reportModels.SalesOrder, event domain.IDomainEvent) (*reportModels.SalesChanges, error) {
+	switch evt := event.(type) {
+	case *creatingOrderEvents.OrderCreatedV1:
+		order.UpdateItems(salesOrderItems(evt.ShopItems))
+
+	case *updatingShoppingCartEvents.ShoppingCartUpdatedV1:
+		order.UpdateItems(salesOrderItems(evt.ShopItems))
+
+	case *applyingCouponEvents.CouponAppliedV1:
+		order.ApplyCoupon(evt.Coupon)
+
+	case *payingOrderEvents.OrderPaidV1:
+		return order.Pay(evt.PaidAt), nil
+
+	case *cancelingOrderEvents.OrderCanceledV1:
+		return order.Cancel(evt.CanceledAt), nil
+
+	case *requestingReturnEvents.ReturnRequestedV1:
+		order.RequestReturn(evt.ReturnId, evt.RefundAmount)
+
+	case *issuingRefundEvents.RefundIssuedV1:
+		return order.Refund(evt.ReturnId, evt.RefundedAt), nil
+	}
+
+	return nil, nil
+}
+
+func salesOrderItems(shopItems []*dtos.ShopItemDto) []*reportModels.SalesOrderItem {
+	items := make([]*reportModels.SalesOrderItem, 0, len(shopItems))
+	for _, item := range shopItems {
+		items = append(items, &reportModels.SalesOrderItem{ProductId: item.ProductId, Title: item.Title, Quantity: item.Quantity, Price: item.Price})
+	}
+
+	return items
+}
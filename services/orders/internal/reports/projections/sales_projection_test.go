@@ -0,0 +1,54 @@
+package projections
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/dtos"
+	cancelingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/canceling_order/events/domain/v1"
+	creatingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/creating_order/events/domain/v1"
+	issuingRefundEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/issuing_refund/events/domain/v1"
+	payingOrderEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/paying_order/events/domain/v1"
+	requestingReturnEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/features/requesting_return/events/domain/v1"
+	reportModels "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func Test_Project_Order_Events_To_Sales(t *testing.T) {
+	orderId := uuid.NewV4()
+	returnId := uuid.NewV4()
+	paidAt := time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)
+	order := &reportModels.SalesOrder{OrderId: orderId}
+
+	changes, err := projectOrderEvent(order, &creatingOrderEvents.OrderCreatedV1{
+		OrderId:   orderId,
+		ShopItems: []*dtos.ShopItemDto{{ProductId: uuid.NewV4(), Title: "Lamp", Quantity: 3, Price: domain.MustParseMoney("12.50", "EUR")}},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, changes)
+	assert.Equal(t, "37.50", order.TotalPrice.Amount.String())
+
+	changes, err = projectOrderEvent(order, &payingOrderEvents.OrderPaidV1{OrderId: orderId, PaidAt: paidAt})
+	require.NoError(t, err)
+	require.NotNil(t, changes)
+	assert.Equal(t, reportModels.SalesDay(paidAt), changes.Sales.Day)
+	assert.Equal(t, int64(3), changes.Sales.ItemsSold)
+	assert.Equal(t, "37.50", changes.Sales.Revenue.String())
+
+	changes, err = projectOrderEvent(order, &requestingReturnEvents.ReturnRequestedV1{OrderId: orderId, ReturnId: returnId, RefundAmount: domain.MustParseMoney("12.50", "EUR")})
+	require.NoError(t, err)
+	assert.Nil(t, changes)
+
+	changes, err = projectOrderEvent(order, &issuingRefundEvents.RefundIssuedV1{OrderId: orderId, ReturnId: returnId, RefundedAt: paidAt.AddDate(0, 0, 2)})
+	require.NoError(t, err)
+	require.NotNil(t, changes)
+	assert.Equal(t, "12.50", changes.Sales.Refunds.String())
+
+	changes, err = projectOrderEvent(order, &cancelingOrderEvents.OrderCanceledV1{OrderId: orderId, CanceledAt: paidAt.AddDate(0, 0, 3)})
+	require.NoError(t, err)
+	require.NotNil(t, changes)
+	assert.Equal(t, int64(1), changes.Sales.CanceledOrders)
+	assert.Equal(t, "25.00", changes.Sales.Refunds.String())
+}
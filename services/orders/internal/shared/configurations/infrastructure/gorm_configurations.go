@@ -0,0 +1,16 @@
+package infrastructure
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/gormPostgres"
+)
+
+func (ic *infrastructureConfigurator) configGorm() (*gormPostgres.Gorm, error, func()) {
+	gorm, err := gormPostgres.NewGorm(ic.cfg.GormPostgres)
+	if err != nil {
+		return nil, err, nil
+	}
+
+	return gorm, nil, func() {
+		gorm.Close()
+	}
+}
@@ -0,0 +1,18 @@
+package infrastructure
+
+import (
+	grpcClient "github.com/mehdihadeli/store-golang-microservice-sample/pkg/grpc"
+	"google.golang.org/grpc"
+)
+
+func (ic *infrastructureConfigurator) configCatalogsGrpcClient() (*grpc.ClientConn, error, func()) {
+	conn, err := grpcClient.NewGrpcClient(ic.cfg.CatalogsReadServiceClient)
+	if err != nil {
+		return nil, err, nil
+	}
+	ic.log.Infof("catalogs read service grpc client is configured for address {%s}", ic.cfg.CatalogsReadServiceClient.Address)
+
+	return conn, nil, func() {
+		_ = conn.Close()
+	}
+}
@@ -6,20 +6,29 @@ import (
 	"github.com/go-playground/validator"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/msgpack"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/protobuf"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/contracts/projection"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/es/upcasting"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/eventstroredb"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/gormPostgres"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/poison"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/producer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/scheduler"
 	postgres "github.com/mehdihadeli/store-golang-microservice-sample/pkg/postgres_pgx"
 	rabbitmqProducer "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/producer"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/producer/options"
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/web/custom_middlewares"
 	v7 "github.com/olivere/elastic/v7"
 	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
 )
 
 type InfrastructureConfiguration struct {
@@ -27,18 +36,25 @@ type InfrastructureConfiguration struct {
 	Cfg                  *config.Config
 	Validator            *validator.Validate
 	Pgx                  *postgres.Pgx
+	Gorm                 *gormPostgres.Gorm
 	Metrics              *OrdersServiceMetrics
 	Esdb                 *esdb.Client
 	EsdbSerializer       *eventstroredb.EsdbSerializer
+	EventUpcasters       *upcasting.EventUpcasterRegistry
 	CheckpointRepository contracts.SubscriptionCheckpointRepository
 	ElasticClient        *v7.Client
+	CatalogsGrpcClient   *grpc.ClientConn
 	MongoClient          *mongo.Client
 	CustomMiddlewares    cutomMiddlewares.CustomMiddlewares
 	Projections          []projection.IProjection
 	RabbitMQConnection   types.IConnection
 	EventSerializer      serializer.EventSerializer
+	MessageRegistry      *messageRegistry.MessageRegistry
 	Producer             producer.Producer
+	MessageScheduler     *scheduler.MessageScheduler
 	Consumers            []consumer.Consumer
+	// Workers are the background workers of the modules, the server runs them next to its own workers
+	Workers []web.Worker
 }
 
 type InfrastructureConfigurator interface {
@@ -75,7 +91,38 @@ func (ic *infrastructureConfigurator) ConfigInfrastructures(ctx context.Context)
 	cleanup = append(cleanup, mongoCleanup)
 	infrastructure.MongoClient = mongoClient
 
-	esdb, checkpointRepository, esdbSerializer, err, eventStoreCleanup := ic.configEventStore()
+	gorm, err, gormCleanup := ic.configGorm()
+	if err != nil {
+		return nil, err, nil
+	}
+	cleanup = append(cleanup, gormCleanup)
+	infrastructure.Gorm = gorm
+
+	if ic.cfg.Elastic != nil {
+		elasticClient, err, elasticCleanup := ic.configElasticSearch(ctx)
+		if err != nil {
+			return nil, err, nil
+		}
+		cleanup = append(cleanup, elasticCleanup)
+		infrastructure.ElasticClient = elasticClient
+	}
+
+	if ic.cfg.CatalogsReadServiceClient != nil {
+		catalogsGrpcClient, err, catalogsGrpcClientCleanup := ic.configCatalogsGrpcClient()
+		if err != nil {
+			return nil, err, nil
+		}
+		cleanup = append(cleanup, catalogsGrpcClientCleanup)
+		infrastructure.CatalogsGrpcClient = catalogsGrpcClient
+	}
+
+	// json is the default content type, the other serializers are negotiated by the message content type
+	infrastructure.EventSerializer = serializer.NewEventSerializerRegistry(json.NewJsonEventSerializer(), protobuf.NewProtobufEventSerializer(), msgpack.NewMsgPackEventSerializer())
+
+	// upcasters of the stored old event shapes are registered by the modules
+	infrastructure.EventUpcasters = upcasting.NewEventUpcasterRegistry()
+
+	esdb, checkpointRepository, esdbSerializer, err, eventStoreCleanup := ic.configEventStore(infrastructure.EventSerializer, infrastructure.EventUpcasters)
 	if err != nil {
 		return nil, err, nil
 	}
@@ -84,8 +131,6 @@ func (ic *infrastructureConfigurator) ConfigInfrastructures(ctx context.Context)
 	infrastructure.CheckpointRepository = checkpointRepository
 	infrastructure.EsdbSerializer = esdbSerializer
 
-	infrastructure.EventSerializer = json.NewJsonEventSerializer()
-
 	connection, err := types.NewRabbitMQConnection(ctx, ic.cfg.RabbitMQ)
 	if err != nil {
 		return nil, err, nil
@@ -95,12 +140,23 @@ func (ic *infrastructureConfigurator) ConfigInfrastructures(ctx context.Context)
 		_ = connection.Close()
 	})
 
-	mqProducer, err := rabbitmqProducer.NewRabbitMQProducer(connection, func(builder *options.RabbitMQProducerOptionsBuilder) {}, ic.log, infrastructure.EventSerializer)
+	// message contracts are registered by the modules, the producer stamps their wire names into the message type
+	infrastructure.MessageRegistry = messageRegistry.NewMessageRegistry()
+
+	mqProducer, err := rabbitmqProducer.NewRabbitMQProducer(connection, func(builder *options.RabbitMQProducerOptionsBuilder) {
+		builder.WithMessageRegistry(infrastructure.MessageRegistry)
+	}, ic.log, infrastructure.EventSerializer)
 	if err != nil {
 		return nil, err, nil
 	}
 	infrastructure.Producer = mqProducer
 
+	scheduledMessagesCollection := mongoClient.Database(ic.cfg.Mongo.Db).Collection(ic.cfg.MongoCollections.ScheduledMessages)
+	// scheduled messages which can't be deserialized anymore are kept with the poison messages to replay them after fixing the type mapping
+	poisonMessageStore := poison.NewMongoPoisonMessageStore(mongoClient.Database(ic.cfg.Mongo.Db).Collection(ic.cfg.MongoCollections.PoisonMessages))
+	poisonMessageHandler := poison.NewStorePoisonMessageHandler(poisonMessageStore, metrics.PoisonMessages, ic.log)
+	infrastructure.MessageScheduler = scheduler.NewMessageScheduler(mqProducer, scheduler.NewMongoScheduledMessageStore(scheduledMessagesCollection), infrastructure.EventSerializer, poisonMessageHandler, ic.log)
+
 	if err != nil {
 		return nil, err, nil
 	}
@@ -2,6 +2,8 @@ package infrastructure
 
 import (
 	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/poison"
+	rabbitmqMetrics "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/metrics"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -10,24 +12,58 @@ type OrdersServiceMetrics struct {
 	SuccessGrpcRequests prometheus.Counter
 	ErrorGrpcRequests   prometheus.Counter
 
-	CreateOrderGrpcRequests  prometheus.Counter
-	UpdateOrderGrpcRequests  prometheus.Counter
-	PayOrderGrpcRequests     prometheus.Counter
-	SubmitOrderGrpcRequests  prometheus.Counter
-	GetOrderByIdGrpcRequests prometheus.Counter
-	GetOrdersGrpcRequests    prometheus.Counter
-	SearchOrderGrpcRequests  prometheus.Counter
+	CreateOrderGrpcRequests        prometheus.Counter
+	UpdateOrderGrpcRequests        prometheus.Counter
+	PayOrderGrpcRequests           prometheus.Counter
+	SubmitOrderGrpcRequests        prometheus.Counter
+	CancelOrderGrpcRequests        prometheus.Counter
+	CompleteOrderGrpcRequests      prometheus.Counter
+	GetOrderByIdGrpcRequests       prometheus.Counter
+	GetOrdersGrpcRequests          prometheus.Counter
+	SearchOrderGrpcRequests        prometheus.Counter
+	GetOrderHistoryGrpcRequests    prometheus.Counter
+	GetCustomerOrdersGrpcRequests  prometheus.Counter
+	ApplyCouponGrpcRequests        prometheus.Counter
+	RequestReturnGrpcRequests      prometheus.Counter
+	ApproveReturnGrpcRequests      prometheus.Counter
+	IssueRefundGrpcRequests        prometheus.Counter
+	GetSalesReportGrpcRequests     prometheus.Counter
+	ExportSalesReportGrpcRequests  prometheus.Counter
+	WatchOrderGrpcRequests         prometheus.Counter
+	RescheduleDeliveryGrpcRequests prometheus.Counter
 
 	SuccessHttpRequests prometheus.Counter
 	ErrorHttpRequests   prometheus.Counter
 
-	CreateOrderHttpRequests  prometheus.Counter
-	UpdateOrderHttpRequests  prometheus.Counter
-	PayOrderHttpRequests     prometheus.Counter
-	SubmitOrderHttpRequests  prometheus.Counter
-	GetOrderByIdHttpRequests prometheus.Counter
-	SearchOrderHttpRequests  prometheus.Counter
-	GetOrdersHttpRequests    prometheus.Counter
+	CreateOrderHttpRequests        prometheus.Counter
+	UpdateOrderHttpRequests        prometheus.Counter
+	PayOrderHttpRequests           prometheus.Counter
+	SubmitOrderHttpRequests        prometheus.Counter
+	CancelOrderHttpRequests        prometheus.Counter
+	CompleteOrderHttpRequests      prometheus.Counter
+	GetOrderByIdHttpRequests       prometheus.Counter
+	SearchOrderHttpRequests        prometheus.Counter
+	GetOrdersHttpRequests          prometheus.Counter
+	GetOrderHistoryHttpRequests    prometheus.Counter
+	GetCustomerOrdersHttpRequests  prometheus.Counter
+	ApplyCouponHttpRequests        prometheus.Counter
+	RequestReturnHttpRequests      prometheus.Counter
+	ApproveReturnHttpRequests      prometheus.Counter
+	IssueRefundHttpRequests        prometheus.Counter
+	RescheduleDeliveryHttpRequests prometheus.Counter
+
+	CreateCouponHttpRequests  prometheus.Counter
+	UpdateCouponHttpRequests  prometheus.Counter
+	DeleteCouponHttpRequests  prometheus.Counter
+	GetCouponByIdHttpRequests prometheus.Counter
+	GetCouponsHttpRequests    prometheus.Counter
+
+	GetSalesReportHttpRequests        prometheus.Counter
+	ExportSalesReportHttpRequests     prometheus.Counter
+	RebuildSalesReportHttpRequests    prometheus.Counter
+	GetSalesReportRebuildHttpRequests prometheus.Counter
+
+	GetAvailableDeliverySlotsHttpRequests prometheus.Counter
 
 	SuccessKafkaMessages prometheus.Counter
 	ErrorKafkaMessages   prometheus.Counter
@@ -35,6 +71,9 @@ type OrdersServiceMetrics struct {
 	CreateOrderKafkaMessages prometheus.Counter
 	UpdateOrderKafkaMessages prometheus.Counter
 	DeleteOrderKafkaMessages prometheus.Counter
+
+	PoisonMessages *poison.PoisonMessageMetrics
+	RabbitMQ       *rabbitmqMetrics.RabbitMQMetrics
 }
 
 func (ic *infrastructureConfigurator) configCatalogsMetrics() *OrdersServiceMetrics {
@@ -64,6 +103,14 @@ func (ic *infrastructureConfigurator) configCatalogsMetrics() *OrdersServiceMetr
 			Name: fmt.Sprintf("%s_submit_order_grpc_requests_total", cfg.ServiceName),
 			Help: "The total number of submit order grpc requests",
 		}),
+		CancelOrderGrpcRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_cancel_order_grpc_requests_total", cfg.ServiceName),
+			Help: "The total number of cancel order grpc requests",
+		}),
+		CompleteOrderGrpcRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_complete_order_grpc_requests_total", cfg.ServiceName),
+			Help: "The total number of complete order grpc requests",
+		}),
 		GetOrderByIdGrpcRequests: promauto.NewCounter(prometheus.CounterOpts{
 			Name: fmt.Sprintf("%s_get_order_by_id_grpc_requests_total", cfg.ServiceName),
 			Help: "The total number of get order by id grpc requests",
@@ -76,6 +123,46 @@ func (ic *infrastructureConfigurator) configCatalogsMetrics() *OrdersServiceMetr
 			Name: fmt.Sprintf("%s_search_order_grpc_requests_total", cfg.ServiceName),
 			Help: "The total number of search order grpc requests",
 		}),
+		GetOrderHistoryGrpcRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_get_order_history_grpc_requests_total", cfg.ServiceName),
+			Help: "The total number of get order history grpc requests",
+		}),
+		GetCustomerOrdersGrpcRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_get_customer_orders_grpc_requests_total", cfg.ServiceName),
+			Help: "The total number of get customer orders grpc requests",
+		}),
+		ApplyCouponGrpcRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_apply_coupon_grpc_requests_total", cfg.ServiceName),
+			Help: "The total number of apply coupon grpc requests",
+		}),
+		RequestReturnGrpcRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_request_return_grpc_requests_total", cfg.ServiceName),
+			Help: "The total number of request return grpc requests",
+		}),
+		ApproveReturnGrpcRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_approve_return_grpc_requests_total", cfg.ServiceName),
+			Help: "The total number of approve return grpc requests",
+		}),
+		IssueRefundGrpcRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_issue_refund_grpc_requests_total", cfg.ServiceName),
+			Help: "The total number of issue refund grpc requests",
+		}),
+		GetSalesReportGrpcRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_get_sales_report_grpc_requests_total", cfg.ServiceName),
+			Help: "The total number of get sales report grpc requests",
+		}),
+		ExportSalesReportGrpcRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_export_sales_report_grpc_requests_total", cfg.ServiceName),
+			Help: "The total number of export sales report grpc requests",
+		}),
+		WatchOrderGrpcRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_watch_order_grpc_requests_total", cfg.ServiceName),
+			Help: "The total number of watch order grpc requests",
+		}),
+		RescheduleDeliveryGrpcRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_reschedule_delivery_grpc_requests_total", cfg.ServiceName),
+			Help: "The total number of reschedule delivery grpc requests",
+		}),
 		GetOrdersHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
 			Name: fmt.Sprintf("%s_get_orders_http_requests_total", cfg.ServiceName),
 			Help: "The total number of get orders http requests",
@@ -104,6 +191,14 @@ func (ic *infrastructureConfigurator) configCatalogsMetrics() *OrdersServiceMetr
 			Name: fmt.Sprintf("%s_submit_order_http_requests_total", cfg.ServiceName),
 			Help: "The total number of submit order http requests",
 		}),
+		CancelOrderHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_cancel_order_http_requests_total", cfg.ServiceName),
+			Help: "The total number of cancel order http requests",
+		}),
+		CompleteOrderHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_complete_order_http_requests_total", cfg.ServiceName),
+			Help: "The total number of complete order http requests",
+		}),
 		GetOrderByIdHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
 			Name: fmt.Sprintf("%s_get_order_by_id_http_requests_total", cfg.ServiceName),
 			Help: "The total number of get order by id http requests",
@@ -112,5 +207,83 @@ func (ic *infrastructureConfigurator) configCatalogsMetrics() *OrdersServiceMetr
 			Name: fmt.Sprintf("%s_search_order_http_requests_total", cfg.ServiceName),
 			Help: "The total number of search order http requests",
 		}),
+		GetOrderHistoryHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_get_order_history_http_requests_total", cfg.ServiceName),
+			Help: "The total number of get order history http requests",
+		}),
+		GetCustomerOrdersHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_get_customer_orders_http_requests_total", cfg.ServiceName),
+			Help: "The total number of get customer orders http requests",
+		}),
+		ApplyCouponHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_apply_coupon_http_requests_total", cfg.ServiceName),
+			Help: "The total number of apply coupon http requests",
+		}),
+		RequestReturnHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_request_return_http_requests_total", cfg.ServiceName),
+			Help: "The total number of request return http requests",
+		}),
+		ApproveReturnHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_approve_return_http_requests_total", cfg.ServiceName),
+			Help: "The total number of approve return http requests",
+		}),
+		IssueRefundHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_issue_refund_http_requests_total", cfg.ServiceName),
+			Help: "The total number of issue refund http requests",
+		}),
+		RescheduleDeliveryHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_reschedule_delivery_http_requests_total", cfg.ServiceName),
+			Help: "The total number of reschedule delivery http requests",
+		}),
+		CreateCouponHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_create_coupon_http_requests_total", cfg.ServiceName),
+			Help: "The total number of create coupon http requests",
+		}),
+		UpdateCouponHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_update_coupon_http_requests_total", cfg.ServiceName),
+			Help: "The total number of update coupon http requests",
+		}),
+		DeleteCouponHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_delete_coupon_http_requests_total", cfg.ServiceName),
+			Help: "The total number of delete coupon http requests",
+		}),
+		GetCouponByIdHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_get_coupon_by_id_http_requests_total", cfg.ServiceName),
+			Help: "The total number of get coupon by id http requests",
+		}),
+		GetCouponsHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_get_coupons_http_requests_total", cfg.ServiceName),
+			Help: "The total number of get coupons http requests",
+		}),
+		GetSalesReportHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_get_sales_report_http_requests_total", cfg.ServiceName),
+			Help: "The total number of get sales report http requests",
+		}),
+		ExportSalesReportHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_export_sales_report_http_requests_total", cfg.ServiceName),
+			Help: "The total number of export sales report http requests",
+		}),
+		RebuildSalesReportHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_rebuild_sales_report_http_requests_total", cfg.ServiceName),
+			Help: "The total number of rebuild sales report http requests",
+		}),
+		GetSalesReportRebuildHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_get_sales_report_rebuild_http_requests_total", cfg.ServiceName),
+			Help: "The total number of get sales report rebuild http requests",
+		}),
+		GetAvailableDeliverySlotsHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_get_available_delivery_slots_http_requests_total", cfg.ServiceName),
+			Help: "The total number of get available delivery slots http requests",
+		}),
+		SuccessKafkaMessages: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_success_kafka_processed_messages_total", cfg.ServiceName),
+			Help: "The total number of success kafka processed messages",
+		}),
+		ErrorKafkaMessages: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_error_kafka_processed_messages_total", cfg.ServiceName),
+			Help: "The total number of error kafka processed messages",
+		}),
+		RabbitMQ:       rabbitmqMetrics.NewRabbitMQMetrics(cfg.ServiceName),
+		PoisonMessages: poison.NewPoisonMessageMetrics(cfg.ServiceName),
 	}
 }
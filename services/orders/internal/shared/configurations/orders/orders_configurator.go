@@ -7,7 +7,10 @@ import (
 	"github.com/labstack/echo/v4"
 	grpcServer "github.com/mehdihadeli/store-golang-microservice-sample/pkg/grpc"
 	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/configurations/coupon_module"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/configurations/delivery_slot_module"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/order_module"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/configurations/report_module"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/web"
 	"net/http"
@@ -34,7 +37,25 @@ func (c *ordersServiceConfigurator) ConfigureOrdersService(ctx context.Context)
 		return errors.WithMessage(err, "[OrdersServiceConfigurator_ConfigureOrdersService.ConfigureOrdersModule] error in order module configurator")
 	}
 
-	err = c.migrateOrders()
+	cc := coupon_module.NewCouponsModuleConfigurator(c.InfrastructureConfiguration, c.echoServer)
+	err = cc.ConfigureCouponsModule(ctx)
+	if err != nil {
+		return errors.WithMessage(err, "[OrdersServiceConfigurator_ConfigureOrdersService.ConfigureCouponsModule] error in coupon module configurator")
+	}
+
+	rc := report_module.NewReportsModuleConfigurator(c.InfrastructureConfiguration, c.echoServer)
+	err = rc.ConfigureReportsModule(ctx)
+	if err != nil {
+		return errors.WithMessage(err, "[OrdersServiceConfigurator_ConfigureOrdersService.ConfigureReportsModule] error in report module configurator")
+	}
+
+	dc := delivery_slot_module.NewDeliverySlotsModuleConfigurator(c.InfrastructureConfiguration, c.echoServer)
+	err = dc.ConfigureDeliverySlotsModule(ctx)
+	if err != nil {
+		return errors.WithMessage(err, "[OrdersServiceConfigurator_ConfigureOrdersService.ConfigureDeliverySlotsModule] error in delivery slot module configurator")
+	}
+
+	err = c.migrateOrders(ctx)
 	if err != nil {
 		return errors.WithMessage(err, "[OrdersServiceConfigurator_ConfigureOrdersService.migrateOrders] error in the orders migration")
 	}
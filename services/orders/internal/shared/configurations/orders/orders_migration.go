@@ -1,6 +1,40 @@
 package orders
 
-func (c *ordersServiceConfigurator) migrateOrders() error {
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/coupons/models"
+	deliverySlotModels "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/delivery_slots/models"
+	reportModels "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/reports/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"strings"
+)
+
+func (c *ordersServiceConfigurator) migrateOrders(ctx context.Context) error {
+	// scheduler worker polls scheduled messages by their delivery time
+	index, err := c.MongoClient.Database(c.Cfg.Mongo.Db).Collection(c.Cfg.MongoCollections.ScheduledMessages).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "deliverAt", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+	c.Log.Infof("(CreatedIndex) index: {%s}", index)
+
+	// customer orders are queried by the account email, optionally by the status, newest first
+	indexes, err := c.MongoClient.Database(c.Cfg.Mongo.Db).Collection(c.Cfg.MongoCollections.Orders).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "accountEmail", Value: 1}, {Key: "createdAt", Value: -1}}},
+		{Keys: bson.D{{Key: "accountEmail", Value: 1}, {Key: "status", Value: 1}, {Key: "createdAt", Value: -1}}},
+	})
+	if err != nil {
+		return err
+	}
+	c.Log.Infof("(CreatedIndexes) indexes: {%s}", strings.Join(indexes, ", "))
+
+	// or we could use `gorm.Migrate()`
+	err = c.Gorm.DB.AutoMigrate(&models.Coupon{}, &models.CouponRedemption{}, &reportModels.SalesOrder{}, &reportModels.DailySales{}, &reportModels.DailyProductSales{}, &reportModels.SalesReportRebuild{}, &deliverySlotModels.DeliverySlot{}, &deliverySlotModels.DeliverySlotReservation{}, &deliverySlotModels.DeliverySlotReconciliation{})
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -77,9 +77,9 @@ func (s *Server) Run() error {
 		panic(fmt.Sprintf("server type %s is not supported", deliveryType))
 	}
 
-	backgroundWorkers := webWoker.NewWorkersRunner([]webWoker.Worker{
-		workers.NewRabbitMQWorkerWorker(infrastructureConfigurations), workers.NewEventStoreDBWorker(infrastructureConfigurations), workers.NewMetricsWorker(infrastructureConfigurations),
-	})
+	backgroundWorkers := webWoker.NewWorkersRunner(append([]webWoker.Worker{
+		workers.NewRabbitMQWorkerWorker(infrastructureConfigurations), workers.NewEventStoreDBWorker(infrastructureConfigurations), workers.NewMetricsWorker(infrastructureConfigurations), workers.NewMessageSchedulerWorker(infrastructureConfigurations),
+	}, infrastructureConfigurations.Workers...))
 
 	workersErr := backgroundWorkers.Start(ctx)
 	go func() {
@@ -8,10 +8,15 @@ import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
 	webWoker "github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/clients/catalogs"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/consumers"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/mappings"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/messages"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/projections"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/upcasters"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/watchers"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/web/workers"
 	"math"
@@ -25,6 +30,8 @@ type E2ETestFixture struct {
 	V1            *V1Groups
 	GrpcServer    grpcServer.GrpcServer
 	HttpServer    *httptest.Server
+	CatalogClient *catalogs.InMemoryCatalogClient
+	OrderWatcher  contracts.OrderWatcher
 	workersRunner *webWoker.WorkersRunner
 	ctx           context.Context
 	cancel        context.CancelFunc
@@ -49,7 +56,8 @@ func NewE2ETestFixture() *E2ETestFixture {
 
 	v1Groups := &V1Groups{OrdersGroup: ordersV1}
 
-	err := mediatr.ConfigOrdersMediator(infrastructures)
+	catalogClient := catalogs.NewInMemoryCatalogClient()
+	err := mediatr.ConfigOrdersMediator(infrastructures, catalogClient)
 	if err != nil {
 		cancel()
 		return nil
@@ -61,7 +69,21 @@ func NewE2ETestFixture() *E2ETestFixture {
 		return nil
 	}
 
-	projections.ConfigOrderProjections(infrastructures)
+	err = messages.ConfigMessageContracts(infrastructures.MessageRegistry)
+	if err != nil {
+		cancel()
+		return nil
+	}
+
+	upcasters.ConfigEventUpcasters(infrastructures.EventUpcasters)
+
+	orderWatcher := watchers.NewInMemoryOrderWatcher()
+	err = projections.ConfigOrderProjections(ctx, infrastructures, orderWatcher)
+	if err != nil {
+		cancel()
+		return nil
+	}
+
 	err = consumers.ConfigConsumers(infrastructures)
 	if err != nil {
 		cancel()
@@ -89,6 +111,8 @@ func NewE2ETestFixture() *E2ETestFixture {
 		V1:                          v1Groups,
 		GrpcServer:                  grpcServer,
 		HttpServer:                  httpServer,
+		CatalogClient:               catalogClient,
+		OrderWatcher:                orderWatcher,
 		workersRunner:               workersRunner,
 		ctx:                         ctx,
 		cancel:                      cancel,
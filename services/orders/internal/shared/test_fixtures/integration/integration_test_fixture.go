@@ -10,10 +10,13 @@ import (
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/config"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/consumers"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/mappings"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/messages"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/projections"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/configurations/upcasters"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/contracts/repositories"
 	orderRepositories "github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/data/repositories"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/models/orders/aggregate"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/orders/watchers"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
 	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/web/workers"
 	"math"
@@ -48,8 +51,21 @@ func NewIntegrationTestFixture() *IntegrationTestFixture {
 		cancel()
 		return nil
 	}
+
+	err = messages.ConfigMessageContracts(infrastructures.MessageRegistry)
+	if err != nil {
+		cancel()
+		return nil
+	}
+
+	upcasters.ConfigEventUpcasters(infrastructures.EventUpcasters)
 	
-	projections.ConfigOrderProjections(infrastructures)
+	err = projections.ConfigOrderProjections(ctx, infrastructures, watchers.NewInMemoryOrderWatcher())
+	if err != nil {
+		cancel()
+		return nil
+	}
+
 	err = consumers.ConfigConsumers(infrastructures)
 	if err != nil {
 		cancel()
@@ -0,0 +1,12 @@
+package workers
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/scheduler"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/orders/internal/shared/configurations/infrastructure"
+	"time"
+)
+
+func NewMessageSchedulerWorker(infra *infrastructure.InfrastructureConfiguration) web.Worker {
+	return scheduler.NewMessageSchedulerWorker(infra.MessageScheduler, time.Second, infra.Log)
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"flag"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/constants"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/zap"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/server"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/web"
+	"log"
+)
+
+// @contact.name Mehdi Hadeli
+// @contact.url https://github.com/mehdihadeli
+// @title Payments Service Api
+// @version 1.0
+// @description Payments Service Api.
+func main() {
+	flag.Parse()
+
+	env := core.ConfigAppEnv(constants.Dev)
+
+	cfg, err := config.InitConfig(env)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	appLogger := zap.NewZapLogger(cfg.Logger)
+	appLogger.WithName(web.GetMicroserviceName(cfg))
+	appLogger.Fatal(server.NewServer(appLogger, cfg).Run())
+}
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"emperror.dev/errors"
+	"flag"
+	"fmt"
+	"github.com/caarlos0/env/v6"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/constants"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/gormPostgres"
+	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/probes"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/spf13/viper"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+var configPath string
+
+func init() {
+	flag.StringVar(&configPath, "config", "", "payments microservice config path")
+}
+
+type Config struct {
+	ServiceName    string                     `mapstructure:"serviceName" env:"ServiceName"`
+	Logger         *logger.LogConfig          `mapstructure:"logger" envPrefix:"Logger_"`
+	Http           *customEcho.EchoHttpConfig `mapstructure:"http" envPrefix:"Http_"`
+	Context        Context                    `mapstructure:"context" envPrefix:"Context_"`
+	GormPostgres   *gormPostgres.Config       `mapstructure:"gormPostgres" envPrefix:"GormPostgres_"`
+	RabbitMQ       *config.RabbitMQConfig     `mapstructure:"rabbitmq" envPrefix:"RabbitMQ_"`
+	Probes         probes.Config              `mapstructure:"probes" envPrefix:"Probes_"`
+	Jaeger         *tracing.Config            `mapstructure:"jaeger" envPrefix:"Jaeger_"`
+	PaymentGateway PaymentGateway             `mapstructure:"paymentGateway" envPrefix:"PaymentGateway_"`
+}
+
+type Context struct {
+	Timeout int `mapstructure:"timeout" env:"Timeout"`
+}
+
+// PaymentGateway selects the gateway which authorizes, captures and refunds the payments, `fake` is the deterministic stand-in for the
+// local environments and the tests
+type PaymentGateway struct {
+	Type string `mapstructure:"type" env:"Type"`
+}
+
+func InitConfig(environment string) (*Config, error) {
+	if configPath == "" {
+		configPathFromEnv := os.Getenv(constants.ConfigPath)
+		if configPathFromEnv != "" {
+			configPath = configPathFromEnv
+		} else {
+			//https://stackoverflow.com/questions/31873396/is-it-possible-to-get-the-current-root-of-package-structure-as-a-string-in-golan
+			//https://stackoverflow.com/questions/18537257/how-to-get-the-directory-of-the-currently-running-file
+			d, err := dirname()
+			if err != nil {
+				return nil, err
+			}
+
+			configPath = d
+		}
+	}
+
+	cfg := &Config{}
+
+	viper.SetConfigName(fmt.Sprintf("config.%s", environment))
+	viper.AddConfigPath(configPath)
+	viper.SetConfigType(constants.Json)
+
+	if err := viper.ReadInConfig(); err != nil {
+		return nil, errors.WrapIf(err, "viper.ReadInConfig")
+	}
+
+	if err := viper.Unmarshal(cfg); err != nil {
+		return nil, errors.WrapIf(err, "viper.Unmarshal")
+	}
+
+	if err := env.Parse(cfg); err != nil {
+		fmt.Printf("%+v\n", err)
+	}
+
+	postgresHost := os.Getenv(constants.PostgresqlHost)
+	if postgresHost != "" {
+		cfg.GormPostgres.Host = postgresHost
+	}
+	jaegerAddr := os.Getenv(constants.JaegerHostPort)
+	if jaegerAddr != "" {
+		cfg.Jaeger.HostPort = jaegerAddr
+	}
+
+	return cfg, nil
+}
+
+func filename() (string, error) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		return "", errors.New("unable to get the current filename")
+	}
+	return filename, nil
+}
+
+func dirname() (string, error) {
+	filename, err := filename()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Dir(filename), nil
+}
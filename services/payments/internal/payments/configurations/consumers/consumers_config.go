@@ -0,0 +1,37 @@
+package consumers
+
+import (
+	rabbitmqConsumer "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/consumer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/consumer/options"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/consts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/delivery"
+	refundingPaymentIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/events/integration/external/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/configurations/infrastructure"
+)
+
+func ConfigConsumers(infra *infrastructure.InfrastructureConfiguration) error {
+	consumerBase := delivery.NewPaymentConsumersBase(infra)
+
+	ordersConsumer, err := rabbitmqConsumer.NewRabbitMQMultiTypeConsumer(
+		infra.RabbitMQConnection,
+		consts.OrdersQueue,
+		func(builder *options.RabbitMQMultiTypeConsumerOptionsBuilder) {
+			// queue arguments should be the same as the declared topology, otherwise the broker rejects the declaration
+			builder.WithQueueArgs(infra.Cfg.RabbitMQ.Topology.QueueArguments(consts.OrdersQueue))
+			builder.WithMetrics(infra.Metrics.RabbitMQ)
+			builder.WithMessageRegistry(infra.MessageRegistry)
+		},
+		infra.EventSerializer,
+		infra.Log)
+	if err != nil {
+		return err
+	}
+
+	err = rabbitmqConsumer.AddHandler[*refundingPaymentIntegration.PaymentRejectedV1](ordersConsumer, refundingPaymentIntegration.NewPaymentRejectedConsumer(consumerBase), nil)
+	if err != nil {
+		return err
+	}
+	infra.Consumers = append(infra.Consumers, ordersConsumer)
+
+	return nil
+}
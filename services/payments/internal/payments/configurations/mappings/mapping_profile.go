@@ -0,0 +1,11 @@
+package mappings
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/dto"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/models"
+)
+
+func ConfigureMappings() error {
+	return mapper.CreateMap[*models.PaymentIntent, *dto.PaymentIntentDto]()
+}
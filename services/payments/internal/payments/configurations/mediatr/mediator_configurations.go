@@ -0,0 +1,39 @@
+package mediatr
+
+import (
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/contracts"
+	capturingPaymentV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/capturing_payment/commands/v1"
+	capturingPaymentDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/capturing_payment/dtos"
+	creatingPaymentIntentV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/creating_payment_intent/commands/v1"
+	creatingPaymentIntentDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/creating_payment_intent/dtos"
+	gettingPaymentIntentByIdDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/getting_payment_intent_by_id/dtos"
+	gettingPaymentIntentByIdV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/getting_payment_intent_by_id/queries/v1"
+	refundingPaymentV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/commands/v1"
+	refundingPaymentDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/configurations/infrastructure"
+)
+
+func ConfigPaymentsMediator(repository contracts.PaymentIntentRepository, paymentGateway contracts.PaymentGateway, infra *infrastructure.InfrastructureConfiguration) error {
+	err := mediatr.RegisterRequestHandler[*creatingPaymentIntentV1.CreatePaymentIntent, *creatingPaymentIntentDtos.CreatePaymentIntentResponseDto](creatingPaymentIntentV1.NewCreatePaymentIntentHandler(infra.Log, infra.Cfg, repository, paymentGateway))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*capturingPaymentV1.CapturePayment, *capturingPaymentDtos.CapturePaymentResponseDto](capturingPaymentV1.NewCapturePaymentHandler(infra.Log, infra.Cfg, repository, paymentGateway, infra.Producer))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*refundingPaymentV1.RefundPayment, *refundingPaymentDtos.RefundPaymentResponseDto](refundingPaymentV1.NewRefundPaymentHandler(infra.Log, infra.Cfg, repository, paymentGateway, infra.Producer))
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*gettingPaymentIntentByIdV1.GetPaymentIntentById, *gettingPaymentIntentByIdDtos.GetPaymentIntentByIdResponseDto](gettingPaymentIntentByIdV1.NewGetPaymentIntentByIdHandler(infra.Log, infra.Cfg, repository))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
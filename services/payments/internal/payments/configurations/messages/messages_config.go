@@ -0,0 +1,29 @@
+package messages
+
+import (
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	capturedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/capturing_payment/events/integration/v1"
+	refundingPaymentIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/events/integration/external/v1"
+	refundedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/events/integration/v1"
+)
+
+// ConfigMessageContracts registers the wire names of the payment integration events, the consumers of the other services use the same names
+func ConfigMessageContracts(registry *messageRegistry.MessageRegistry) error {
+	err := messageRegistry.Register[*capturedIntegration.PaymentSucceededV1](registry, "payments.payment_succeeded", 1)
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*capturedIntegration.PaymentFailedV1](registry, "payments.payment_failed", 1)
+	if err != nil {
+		return err
+	}
+
+	err = messageRegistry.Register[*refundedIntegration.PaymentRefundedV1](registry, "payments.payment_refunded", 1)
+	if err != nil {
+		return err
+	}
+
+	// the consumed order events have the wire names of the orders service contracts
+	return messageRegistry.Register[*refundingPaymentIntegration.PaymentRejectedV1](registry, "orders.payment_rejected", 1)
+}
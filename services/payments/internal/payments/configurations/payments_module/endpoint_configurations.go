@@ -0,0 +1,41 @@
+package payments_module
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/delivery"
+	capturingPaymentV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/capturing_payment/endpoints/v1"
+	creatingPaymentIntentV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/creating_payment_intent/endpoints/v1"
+	gettingPaymentIntentByIdV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/getting_payment_intent_by_id/endpoints/v1"
+	refundingPaymentV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/endpoints/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/configurations/infrastructure"
+)
+
+func (c *paymentsModuleConfigurator) configEndpoints(ctx context.Context) {
+	configV1Endpoints(c.echoServer, c.InfrastructureConfiguration, ctx)
+}
+
+func configV1Endpoints(echoServer customEcho.EchoHttpServer, infra *infrastructure.InfrastructureConfiguration, ctx context.Context) {
+	echoServer.ConfigGroup("/api/v1", func(v1 *echo.Group) {
+		group := v1.Group("/payments")
+		paymentEndpointBase := delivery.NewPaymentEndpointBase(infra, group)
+
+		// CreatePaymentIntent
+		createPaymentIntentEndpoint := creatingPaymentIntentV1.NewCreatePaymentIntentEndpoint(paymentEndpointBase)
+		createPaymentIntentEndpoint.MapRoute()
+
+		// CapturePayment
+		capturePaymentEndpoint := capturingPaymentV1.NewCapturePaymentEndpoint(paymentEndpointBase)
+		capturePaymentEndpoint.MapRoute()
+
+		// RefundPayment
+		refundPaymentEndpoint := refundingPaymentV1.NewRefundPaymentEndpoint(paymentEndpointBase)
+		refundPaymentEndpoint.MapRoute()
+
+		// GetPaymentIntentById
+		getPaymentIntentByIdEndpoint := gettingPaymentIntentByIdV1.NewGetPaymentIntentByIdEndpoint(paymentEndpointBase)
+		getPaymentIntentByIdEndpoint.MapRoute()
+	},
+	)
+}
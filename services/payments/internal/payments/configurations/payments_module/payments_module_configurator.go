@@ -0,0 +1,68 @@
+package payments_module
+
+import (
+	"context"
+	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/topology"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/configurations/consumers"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/configurations/mappings"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/configurations/mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/configurations/messages"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/contracts"
+	repositoriesImp "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/data/repositories"
+	capturedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/capturing_payment/events/integration/v1"
+	refundedIntegration "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/events/integration/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/gateways"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/configurations/infrastructure"
+)
+
+type paymentsModuleConfigurator struct {
+	*infrastructure.InfrastructureConfiguration
+	echoServer customEcho.EchoHttpServer
+}
+
+func NewPaymentsModuleConfigurator(infrastructure *infrastructure.InfrastructureConfiguration, echoServer customEcho.EchoHttpServer) contracts.PaymentsModuleConfigurator {
+	return &paymentsModuleConfigurator{InfrastructureConfiguration: infrastructure, echoServer: echoServer}
+}
+
+func (c *paymentsModuleConfigurator) ConfigurePaymentsModule(ctx context.Context) error {
+	paymentIntentRepository := repositoriesImp.NewPostgresPaymentIntentRepository(c.Log, c.Cfg, c.Gorm.DB)
+
+	paymentGateway, err := gateways.NewPaymentGateway(c.Cfg.PaymentGateway, c.Log)
+	if err != nil {
+		return err
+	}
+
+	err = mappings.ConfigureMappings()
+	if err != nil {
+		return err
+	}
+
+	// conflicting message contracts fail the startup
+	err = messages.ConfigMessageContracts(c.MessageRegistry)
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.ConfigPaymentsMediator(paymentIntentRepository, paymentGateway, c.InfrastructureConfiguration)
+	if err != nil {
+		return err
+	}
+
+	err = consumers.ConfigConsumers(c.InfrastructureConfiguration)
+	if err != nil {
+		return err
+	}
+
+	// exchanges of the produced integration events should be declared in the topology
+	producedMessages := []types.IMessage{&capturedIntegration.PaymentSucceededV1{}, &capturedIntegration.PaymentFailedV1{}, &refundedIntegration.PaymentRefundedV1{}}
+	err = topology.ConfigureTopology(c.RabbitMQConnection, c.Cfg.RabbitMQ.Topology, c.Consumers, producedMessages)
+	if err != nil {
+		return err
+	}
+
+	c.configEndpoints(ctx)
+
+	return nil
+}
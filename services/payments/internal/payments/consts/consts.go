@@ -0,0 +1,8 @@
+package consts
+
+const (
+	// IdempotencyKeyHeader is the request header of the client generated key, the retried requests with the same key are applied once
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// OrdersQueue receives the order events which refund the payments
+	OrdersQueue = "payments_service_orders"
+)
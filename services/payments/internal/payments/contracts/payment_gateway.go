@@ -0,0 +1,42 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+// PaymentGateway is the payment provider which moves the money. The requests carry an idempotency key, so a retried request returns the
+// result of the first one. A declined payment is not an error, the error is returned only when the gateway can't be reached.
+type PaymentGateway interface {
+	Authorize(ctx context.Context, request *AuthorizeRequest) (*GatewayResult, error)
+	Capture(ctx context.Context, request *CaptureRequest) (*GatewayResult, error)
+	Refund(ctx context.Context, request *RefundRequest) (*GatewayResult, error)
+}
+
+type AuthorizeRequest struct {
+	IdempotencyKey string
+	OrderId        uuid.UUID
+	Amount         domain.Money
+	PaymentMethod  string
+}
+
+type CaptureRequest struct {
+	IdempotencyKey         string
+	AuthorizationReference string
+	Amount                 domain.Money
+	PaymentMethod          string
+}
+
+type RefundRequest struct {
+	IdempotencyKey   string
+	CaptureReference string
+	Amount           domain.Money
+}
+
+type GatewayResult struct {
+	Reference     string
+	Approved      bool
+	DeclineReason string
+}
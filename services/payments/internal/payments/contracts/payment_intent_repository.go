@@ -0,0 +1,22 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/models"
+	uuid "github.com/satori/go.uuid"
+)
+
+// PaymentIntentRepository updates the payment intents with optimistic concurrency, a PaymentConcurrencyError is returned when the intent
+// is changed by another operation since it is loaded
+type PaymentIntentRepository interface {
+	GetPaymentIntentById(ctx context.Context, paymentIntentId uuid.UUID) (*models.PaymentIntent, error)
+	// GetPaymentIntentByIdempotencyKey returns nil when there is no intent with the key
+	GetPaymentIntentByIdempotencyKey(ctx context.Context, idempotencyKey string) (*models.PaymentIntent, error)
+	CreatePaymentIntent(ctx context.Context, paymentIntent *models.PaymentIntent) (*models.PaymentIntent, error)
+	UpdatePaymentIntent(ctx context.Context, paymentIntent *models.PaymentIntent) (*models.PaymentIntent, error)
+	// GetPaymentRefundByIdempotencyKey returns nil when there is no refund with the key
+	GetPaymentRefundByIdempotencyKey(ctx context.Context, idempotencyKey string) (*models.PaymentRefund, error)
+	// AddPaymentRefund stores the refund and the updated intent in one transaction
+	AddPaymentRefund(ctx context.Context, paymentIntent *models.PaymentIntent, refund *models.PaymentRefund) error
+}
@@ -0,0 +1,7 @@
+package contracts
+
+import "context"
+
+type PaymentsModuleConfigurator interface {
+	ConfigurePaymentsModule(ctx context.Context) error
+}
@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/exceptions"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+)
+
+type postgresPaymentIntentRepository struct {
+	log  logger.Logger
+	cfg  *config.Config
+	gorm *gorm.DB
+}
+
+func NewPostgresPaymentIntentRepository(log logger.Logger, cfg *config.Config, gorm *gorm.DB) *postgresPaymentIntentRepository {
+	return &postgresPaymentIntentRepository{log: log, cfg: cfg, gorm: gorm}
+}
+
+func (p *postgresPaymentIntentRepository) GetPaymentIntentById(ctx context.Context, paymentIntentId uuid.UUID) (*models.PaymentIntent, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresPaymentIntentRepository.GetPaymentIntentById")
+	span.LogFields(log.String("PaymentIntentId", paymentIntentId.String()))
+	defer span.Finish()
+
+	var paymentIntent models.PaymentIntent
+	if err := p.gorm.WithContext(ctx).First(&paymentIntent, paymentIntentId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, tracing.TraceWithErr(span, customErrors.NewNotFoundError(fmt.Sprintf("payment intent with id %s not found", paymentIntentId)))
+		}
+
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, fmt.Sprintf("[postgresPaymentIntentRepository_GetPaymentIntentById.First] can't find the payment intent with id %s into the database.", paymentIntentId)))
+	}
+
+	return &paymentIntent, nil
+}
+
+func (p *postgresPaymentIntentRepository) GetPaymentIntentByIdempotencyKey(ctx context.Context, idempotencyKey string) (*models.PaymentIntent, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresPaymentIntentRepository.GetPaymentIntentByIdempotencyKey")
+	span.LogFields(log.String("IdempotencyKey", idempotencyKey))
+	defer span.Finish()
+
+	var paymentIntents []*models.PaymentIntent
+	if err := p.gorm.WithContext(ctx).Where("idempotency_key = ?", idempotencyKey).Limit(1).Find(&paymentIntents).Error; err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[postgresPaymentIntentRepository_GetPaymentIntentByIdempotencyKey.Find] error in loading the payment intent of the idempotency key"))
+	}
+	if len(paymentIntents) == 0 {
+		return nil, nil
+	}
+
+	return paymentIntents[0], nil
+}
+
+func (p *postgresPaymentIntentRepository) CreatePaymentIntent(ctx context.Context, paymentIntent *models.PaymentIntent) (*models.PaymentIntent, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresPaymentIntentRepository.CreatePaymentIntent")
+	defer span.Finish()
+
+	if err := p.gorm.WithContext(ctx).Create(paymentIntent).Error; err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[postgresPaymentIntentRepository_CreatePaymentIntent.Create] error in the inserting payment intent into the database."))
+	}
+	span.LogFields(log.Object("PaymentIntent", paymentIntent))
+	p.log.Infow(fmt.Sprintf("[postgresPaymentIntentRepository.CreatePaymentIntent] payment intent with id '%s' created", paymentIntent.PaymentIntentId), logger.Fields{"PaymentIntentId": paymentIntent.PaymentIntentId, "OrderId": paymentIntent.OrderId})
+
+	return paymentIntent, nil
+}
+
+func (p *postgresPaymentIntentRepository) UpdatePaymentIntent(ctx context.Context, paymentIntent *models.PaymentIntent) (*models.PaymentIntent, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresPaymentIntentRepository.UpdatePaymentIntent")
+	defer span.Finish()
+
+	if err := updatePaymentIntent(p.gorm.WithContext(ctx), paymentIntent); err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WithMessage(err, "[postgresPaymentIntentRepository_UpdatePaymentIntent.updatePaymentIntent] error in updating the payment intent"))
+	}
+	span.LogFields(log.Object("PaymentIntent", paymentIntent))
+	p.log.Infow(fmt.Sprintf("[postgresPaymentIntentRepository.UpdatePaymentIntent] payment intent with id '%s' updated", paymentIntent.PaymentIntentId), logger.Fields{"PaymentIntentId": paymentIntent.PaymentIntentId, "Status": paymentIntent.Status})
+
+	return paymentIntent, nil
+}
+
+func (p *postgresPaymentIntentRepository) GetPaymentRefundByIdempotencyKey(ctx context.Context, idempotencyKey string) (*models.PaymentRefund, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresPaymentIntentRepository.GetPaymentRefundByIdempotencyKey")
+	span.LogFields(log.String("IdempotencyKey", idempotencyKey))
+	defer span.Finish()
+
+	var refunds []*models.PaymentRefund
+	if err := p.gorm.WithContext(ctx).Where("idempotency_key = ?", idempotencyKey).Limit(1).Find(&refunds).Error; err != nil {
+		return nil, tracing.TraceWithErr(span, errors.WrapIf(err, "[postgresPaymentIntentRepository_GetPaymentRefundByIdempotencyKey.Find] error in loading the refund of the idempotency key"))
+	}
+	if len(refunds) == 0 {
+		return nil, nil
+	}
+
+	return refunds[0], nil
+}
+
+func (p *postgresPaymentIntentRepository) AddPaymentRefund(ctx context.Context, paymentIntent *models.PaymentIntent, refund *models.PaymentRefund) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "postgresPaymentIntentRepository.AddPaymentRefund")
+	span.LogFields(log.String("PaymentIntentId", paymentIntent.PaymentIntentId.String()))
+	defer span.Finish()
+
+	err := p.gorm.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := updatePaymentIntent(tx, paymentIntent); err != nil {
+			return err
+		}
+
+		return tx.Create(refund).Error
+	})
+	if err != nil {
+		return tracing.TraceWithErr(span, errors.WithMessage(err, "[postgresPaymentIntentRepository_AddPaymentRefund.Transaction] error in storing the refund"))
+	}
+
+	p.log.Infow(fmt.Sprintf("[postgresPaymentIntentRepository.AddPaymentRefund] %s of payment intent '%s' refunded", refund.Amount, paymentIntent.PaymentIntentId), logger.Fields{"PaymentIntentId": paymentIntent.PaymentIntentId, "PaymentRefundId": refund.PaymentRefundId})
+
+	return nil
+}
+
+// updatePaymentIntent saves the intent if its version is not changed since it is loaded
+func updatePaymentIntent(tx *gorm.DB, paymentIntent *models.PaymentIntent) error {
+	paymentIntent.UpdatedAt = time.Now()
+
+	result := tx.Model(&models.PaymentIntent{}).
+		Where("payment_intent_id = ? AND version = ?", paymentIntent.PaymentIntentId, paymentIntent.Version).
+		Updates(map[string]interface{}{
+			"status":                   paymentIntent.Status,
+			"gateway_reference":        paymentIntent.GatewayReference,
+			"failure_reason":           paymentIntent.FailureReason,
+			"refunded_amount_amount":   paymentIntent.RefundedAmount.Amount,
+			"refunded_amount_currency": paymentIntent.RefundedAmount.Currency,
+			"version":                  paymentIntent.Version + 1,
+			"updated_at":               paymentIntent.UpdatedAt,
+		})
+	if result.Error != nil {
+		return errors.WrapIf(result.Error, fmt.Sprintf("error in updating the payment intent %s", paymentIntent.PaymentIntentId))
+	}
+	if result.RowsAffected == 0 {
+		return exceptions.NewPaymentConcurrencyError(fmt.Sprintf("payment intent %s is changed by another operation", paymentIntent.PaymentIntentId))
+	}
+	paymentIntent.Version++
+
+	return nil
+}
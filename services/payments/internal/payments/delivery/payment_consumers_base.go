@@ -0,0 +1,21 @@
+package delivery
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/configurations/infrastructure"
+)
+
+type PaymentConsumersBase struct {
+	*infrastructure.InfrastructureConfiguration
+}
+
+func NewPaymentConsumersBase(infra *infrastructure.InfrastructureConfiguration) *PaymentConsumersBase {
+	return &PaymentConsumersBase{InfrastructureConfiguration: infra}
+}
+
+func (pm *PaymentConsumersBase) CommitMessage() {
+	pm.Metrics.SuccessKafkaMessages.Inc()
+}
+
+func (pm *PaymentConsumersBase) CommitErrMessage() {
+	pm.Metrics.ErrorKafkaMessages.Inc()
+}
@@ -0,0 +1,15 @@
+package delivery
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/configurations/infrastructure"
+)
+
+type PaymentEndpointBase struct {
+	*infrastructure.InfrastructureConfiguration
+	PaymentsGroup *echo.Group
+}
+
+func NewPaymentEndpointBase(infra *infrastructure.InfrastructureConfiguration, paymentsGroup *echo.Group) *PaymentEndpointBase {
+	return &PaymentEndpointBase{PaymentsGroup: paymentsGroup, InfrastructureConfiguration: infra}
+}
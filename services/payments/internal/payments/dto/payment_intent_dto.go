@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type PaymentIntentDto struct {
+	PaymentIntentId uuid.UUID    `json:"paymentIntentId"`
+	OrderId         uuid.UUID    `json:"orderId"`
+	Amount          domain.Money `json:"amount"`
+	RefundedAmount  domain.Money `json:"refundedAmount"`
+	PaymentMethod   string       `json:"paymentMethod"`
+	Status          string       `json:"status"`
+	FailureReason   string       `json:"failureReason,omitempty"`
+	CreatedAt       time.Time    `json:"createdAt"`
+	UpdatedAt       time.Time    `json:"updatedAt"`
+}
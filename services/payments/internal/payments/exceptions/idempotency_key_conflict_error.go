@@ -0,0 +1,39 @@
+package exceptions
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// idempotencyKeyConflictError is returned when an idempotency key is reused for a request which is different from the first one
+type idempotencyKeyConflictError struct {
+	customErrors.ConflictError
+}
+
+type IdempotencyKeyConflictError interface {
+	customErrors.ConflictError
+	IsIdempotencyKeyConflictError() bool
+}
+
+func NewIdempotencyKeyConflictError(message string) error {
+	conflict := customErrors.NewConflictError(message)
+	customErr := customErrors.GetCustomError(conflict).(customErrors.ConflictError)
+	ce := &idempotencyKeyConflictError{
+		ConflictError: customErr,
+	}
+
+	return errors.WithStackIf(ce)
+}
+
+func (err *idempotencyKeyConflictError) IsIdempotencyKeyConflictError() bool {
+	return true
+}
+
+func IsIdempotencyKeyConflictError(err error) bool {
+	var e IdempotencyKeyConflictError
+	if errors.As(err, &e) {
+		return e.IsIdempotencyKeyConflictError()
+	}
+
+	return false
+}
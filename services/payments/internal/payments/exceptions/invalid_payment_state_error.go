@@ -0,0 +1,39 @@
+package exceptions
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// invalidPaymentStateError is returned when an operation isn't allowed in the current status of the payment intent, like capturing a failed payment
+type invalidPaymentStateError struct {
+	customErrors.BadRequestError
+}
+
+type InvalidPaymentStateError interface {
+	customErrors.BadRequestError
+	IsInvalidPaymentStateError() bool
+}
+
+func NewInvalidPaymentStateError(message string) error {
+	bad := customErrors.NewBadRequestError(message)
+	customErr := customErrors.GetCustomError(bad).(customErrors.BadRequestError)
+	br := &invalidPaymentStateError{
+		BadRequestError: customErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+func (err *invalidPaymentStateError) IsInvalidPaymentStateError() bool {
+	return true
+}
+
+func IsInvalidPaymentStateError(err error) bool {
+	var e InvalidPaymentStateError
+	if errors.As(err, &e) {
+		return e.IsInvalidPaymentStateError()
+	}
+
+	return false
+}
@@ -0,0 +1,39 @@
+package exceptions
+
+import (
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+)
+
+// paymentConcurrencyError is returned when a payment intent is changed by another operation after it is loaded, the operation can be retried
+type paymentConcurrencyError struct {
+	customErrors.ConflictError
+}
+
+type PaymentConcurrencyError interface {
+	customErrors.ConflictError
+	IsPaymentConcurrencyError() bool
+}
+
+func NewPaymentConcurrencyError(message string) error {
+	conflict := customErrors.NewConflictError(message)
+	customErr := customErrors.GetCustomError(conflict).(customErrors.ConflictError)
+	ce := &paymentConcurrencyError{
+		ConflictError: customErr,
+	}
+
+	return errors.WithStackIf(ce)
+}
+
+func (err *paymentConcurrencyError) IsPaymentConcurrencyError() bool {
+	return true
+}
+
+func IsPaymentConcurrencyError(err error) bool {
+	var e PaymentConcurrencyError
+	if errors.As(err, &e) {
+		return e.IsPaymentConcurrencyError()
+	}
+
+	return false
+}
@@ -0,0 +1,33 @@
+package exceptions
+
+import (
+	"fmt"
+	httpErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Invalid_Payment_State_Error(t *testing.T) {
+	err := NewInvalidPaymentStateError("payment is failed")
+	assert.True(t, IsInvalidPaymentStateError(err))
+	assert.True(t, customErrors.IsBadRequestError(err))
+	assert.False(t, IsIdempotencyKeyConflictError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
+
+func Test_Idempotency_Key_Conflict_Error(t *testing.T) {
+	err := NewIdempotencyKeyConflictError("key is used by another order")
+	assert.True(t, IsIdempotencyKeyConflictError(err))
+	assert.True(t, customErrors.IsConflictError(err))
+	assert.False(t, IsPaymentConcurrencyError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
+
+func Test_Payment_Concurrency_Error(t *testing.T) {
+	err := NewPaymentConcurrencyError("payment intent is changed")
+	assert.True(t, IsPaymentConcurrencyError(err))
+	assert.True(t, customErrors.IsConflictError(err))
+	assert.False(t, IsInvalidPaymentStateError(err))
+	fmt.Println(httpErrors.ErrorsWithStack(err))
+}
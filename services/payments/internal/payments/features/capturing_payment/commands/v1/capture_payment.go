@@ -0,0 +1,14 @@
+package v1
+
+import (
+	uuid "github.com/satori/go.uuid"
+)
+
+type CapturePayment struct {
+	PaymentIntentId uuid.UUID `validate:"required"`
+	IdempotencyKey  string    `validate:"required,max=255"`
+}
+
+func NewCapturePayment(paymentIntentId uuid.UUID, idempotencyKey string) *CapturePayment {
+	return &CapturePayment{PaymentIntentId: paymentIntentId, IdempotencyKey: idempotencyKey}
+}
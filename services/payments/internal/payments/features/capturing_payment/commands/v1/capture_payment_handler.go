@@ -0,0 +1,120 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/producer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	messagingUtils "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/utils"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/dto"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/exceptions"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/capturing_payment/dtos"
+	integrationEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/capturing_payment/events/integration/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type CapturePaymentHandler struct {
+	log              logger.Logger
+	cfg              *config.Config
+	repository       contracts.PaymentIntentRepository
+	paymentGateway   contracts.PaymentGateway
+	rabbitmqProducer producer.Producer
+}
+
+func NewCapturePaymentHandler(log logger.Logger, cfg *config.Config, repository contracts.PaymentIntentRepository, paymentGateway contracts.PaymentGateway, rabbitmqProducer producer.Producer) *CapturePaymentHandler {
+	return &CapturePaymentHandler{log: log, cfg: cfg, repository: repository, paymentGateway: paymentGateway, rabbitmqProducer: rabbitmqProducer}
+}
+
+func (c *CapturePaymentHandler) Handle(ctx context.Context, command *CapturePayment) (*dtos.CapturePaymentResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CapturePaymentHandler.Handle")
+	span.LogFields(log.String("PaymentIntentId", command.PaymentIntentId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	paymentIntent, err := c.repository.GetPaymentIntentById(ctx, command.PaymentIntentId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[CapturePaymentHandler_Handle.GetPaymentIntentById] error in loading the payment intent"))
+	}
+
+	switch paymentIntent.Status {
+	case models.PaymentAuthorized:
+		if err := c.capture(ctx, paymentIntent, command.IdempotencyKey); err != nil {
+			return nil, tracing.TraceWithErr(span, err)
+		}
+	case models.PaymentSucceeded, models.PaymentPartiallyRefunded, models.PaymentRefunded:
+		// the event of a retried capture is published again in case the first one is lost, the orders service ignores the paid orders
+		c.log.Infow(fmt.Sprintf("[CapturePaymentHandler.Handle] payment intent '%s' is already captured", paymentIntent.PaymentIntentId), logger.Fields{"PaymentIntentId": paymentIntent.PaymentIntentId})
+		if err := c.publish(ctx, integrationEvents.NewPaymentSucceededV1(paymentIntent.PaymentIntentId, paymentIntent.OrderId, paymentIntent.Amount)); err != nil {
+			return nil, tracing.TraceWithErr(span, err)
+		}
+	default:
+		return nil, tracing.TraceWithErr(span, exceptions.NewInvalidPaymentStateError(fmt.Sprintf("payment intent '%s' with status '%s' can't be captured", paymentIntent.PaymentIntentId, paymentIntent.Status)))
+	}
+
+	paymentIntentDto, err := mapper.Map[*dto.PaymentIntentDto](paymentIntent)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[CapturePaymentHandler_Handle.Map] error in the mapping payment intent"))
+	}
+
+	return &dtos.CapturePaymentResponseDto{PaymentIntent: paymentIntentDto}, nil
+}
+
+// capture captures the authorized amount, a declined capture fails the intent and the order is canceled by the PaymentFailed event
+func (c *CapturePaymentHandler) capture(ctx context.Context, paymentIntent *models.PaymentIntent, idempotencyKey string) error {
+	result, err := c.paymentGateway.Capture(ctx, &contracts.CaptureRequest{
+		IdempotencyKey:         idempotencyKey,
+		AuthorizationReference: paymentIntent.GatewayReference,
+		Amount:                 paymentIntent.Amount,
+		PaymentMethod:          paymentIntent.PaymentMethod,
+	})
+	if err != nil {
+		return customErrors.NewApplicationErrorWrap(err, "[CapturePaymentHandler_capture.Capture] error in capturing the payment in the gateway")
+	}
+
+	var event types.IMessage
+	if result.Approved {
+		paymentIntent.Status = models.PaymentSucceeded
+		paymentIntent.GatewayReference = result.Reference
+		paymentIntent.RefundedAmount = domain.ZeroMoney(paymentIntent.Amount.Currency)
+		event = integrationEvents.NewPaymentSucceededV1(paymentIntent.PaymentIntentId, paymentIntent.OrderId, paymentIntent.Amount)
+	} else {
+		paymentIntent.Status = models.PaymentFailed
+		paymentIntent.FailureReason = result.DeclineReason
+		event = integrationEvents.NewPaymentFailedV1(paymentIntent.PaymentIntentId, paymentIntent.OrderId, result.DeclineReason)
+	}
+
+	_, err = c.repository.UpdatePaymentIntent(ctx, paymentIntent)
+	if exceptions.IsPaymentConcurrencyError(err) {
+		return err
+	}
+	if err != nil {
+		return customErrors.NewApplicationErrorWrap(err, "[CapturePaymentHandler_capture.UpdatePaymentIntent] error in updating the payment intent in the repository")
+	}
+
+	if err := c.publish(ctx, event); err != nil {
+		return err
+	}
+
+	c.log.Infow(fmt.Sprintf("[CapturePaymentHandler.Handle] payment intent '%s' of order '%s' is %s", paymentIntent.PaymentIntentId, paymentIntent.OrderId, paymentIntent.Status), logger.Fields{"PaymentIntentId": paymentIntent.PaymentIntentId, "OrderId": paymentIntent.OrderId, "MessageId": event.GeMessageId()})
+
+	return nil
+}
+
+func (c *CapturePaymentHandler) publish(ctx context.Context, event types.IMessage) error {
+	err := c.rabbitmqProducer.Publish(ctx, event, nil)
+	if err != nil {
+		return customErrors.NewApplicationErrorWrap(err, fmt.Sprintf("[CapturePaymentHandler_publish.PublishMessage] error in publishing %s integration event", messagingUtils.GetMessageName(event)))
+	}
+
+	return nil
+}
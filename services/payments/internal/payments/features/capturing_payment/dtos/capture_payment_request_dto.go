@@ -0,0 +1,8 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// CapturePaymentRequestDto validation will handle in command level, the idempotency key is read from the `Idempotency-Key` header
+type CapturePaymentRequestDto struct {
+	PaymentIntentId uuid.UUID `param:"id" json:"-"`
+}
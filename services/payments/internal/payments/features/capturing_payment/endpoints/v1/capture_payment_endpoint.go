@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/consts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/capturing_payment/commands/v1"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/delivery"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/capturing_payment/dtos"
+)
+
+type capturePaymentEndpoint struct {
+	*delivery.PaymentEndpointBase
+}
+
+func NewCapturePaymentEndpoint(endpointBase *delivery.PaymentEndpointBase) *capturePaymentEndpoint {
+	return &capturePaymentEndpoint{endpointBase}
+}
+
+func (ep *capturePaymentEndpoint) MapRoute() {
+	ep.PaymentsGroup.POST("/:id/capture", ep.handler())
+}
+
+// CapturePayment
+// @Tags Payments
+// @Summary Capture payment
+// @Description Capture the authorized amount of a payment intent
+// @Accept json
+// @Produce json
+// @Param Idempotency-Key header string true "Client generated key of the capture"
+// @Param id path string true "Payment Intent ID"
+// @Success 200 {object} dtos.CapturePaymentResponseDto
+// @Router /api/v1/payments/{id}/capture [post]
+func (ep *capturePaymentEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.CapturePaymentHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "capturePaymentEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.CapturePaymentRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[capturePaymentEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[capturePaymentEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := v1.NewCapturePayment(request.PaymentIntentId, c.Request().Header.Get(consts.IdempotencyKeyHeader))
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[capturePaymentEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[capturePaymentEndpoint_handler.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*v1.CapturePayment, *dtos.CapturePaymentResponseDto](ctx, command)
+
+		if err != nil {
+			err = errors.WithMessage(err, "[capturePaymentEndpoint_handler.Send] error in sending CapturePayment")
+			ep.Log.Errorw(fmt.Sprintf("[capturePaymentEndpoint_handler.Send] id: {%s}, err: {%v}", command.PaymentIntentId, tracing.TraceWithErr(span, err)), logger.Fields{"PaymentIntentId": command.PaymentIntentId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
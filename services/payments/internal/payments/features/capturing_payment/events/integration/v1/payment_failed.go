@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// PaymentFailedV1 is published when the gateway declines the capture of an authorized payment, the orders service cancels the unpaid order
+type PaymentFailedV1 struct {
+	*types.Message
+	PaymentId uuid.UUID `json:"paymentId"`
+	OrderId   uuid.UUID `json:"orderId"`
+	Reason    string    `json:"reason"`
+}
+
+func NewPaymentFailedV1(paymentId uuid.UUID, orderId uuid.UUID, reason string) *PaymentFailedV1 {
+	return &PaymentFailedV1{PaymentId: paymentId, OrderId: orderId, Reason: reason, Message: types.NewMessage(uuid.NewV4().String())}
+}
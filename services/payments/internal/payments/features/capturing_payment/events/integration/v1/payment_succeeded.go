@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// PaymentSucceededV1 is published when the amount of a payment intent is captured, the orders service marks the order paid
+type PaymentSucceededV1 struct {
+	*types.Message
+	PaymentId uuid.UUID    `json:"paymentId"`
+	OrderId   uuid.UUID    `json:"orderId"`
+	Amount    domain.Money `json:"amount"`
+}
+
+func NewPaymentSucceededV1(paymentId uuid.UUID, orderId uuid.UUID, amount domain.Money) *PaymentSucceededV1 {
+	return &PaymentSucceededV1{PaymentId: paymentId, OrderId: orderId, Amount: amount, Message: types.NewMessage(uuid.NewV4().String())}
+}
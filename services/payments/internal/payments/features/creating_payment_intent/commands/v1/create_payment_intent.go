@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CreatePaymentIntent struct {
+	PaymentIntentId uuid.UUID `validate:"required"`
+	IdempotencyKey  string    `validate:"required,max=255"`
+	OrderId         uuid.UUID `validate:"required"`
+	Amount          domain.Money
+	PaymentMethod   string `validate:"required"`
+}
+
+func NewCreatePaymentIntent(idempotencyKey string, orderId uuid.UUID, amount domain.Money, paymentMethod string) *CreatePaymentIntent {
+	return &CreatePaymentIntent{PaymentIntentId: uuid.NewV4(), IdempotencyKey: idempotencyKey, OrderId: orderId, Amount: amount, PaymentMethod: paymentMethod}
+}
@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/dto"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/exceptions"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/creating_payment_intent/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type CreatePaymentIntentHandler struct {
+	log            logger.Logger
+	cfg            *config.Config
+	repository     contracts.PaymentIntentRepository
+	paymentGateway contracts.PaymentGateway
+}
+
+func NewCreatePaymentIntentHandler(log logger.Logger, cfg *config.Config, repository contracts.PaymentIntentRepository, paymentGateway contracts.PaymentGateway) *CreatePaymentIntentHandler {
+	return &CreatePaymentIntentHandler{log: log, cfg: cfg, repository: repository, paymentGateway: paymentGateway}
+}
+
+func (c *CreatePaymentIntentHandler) Handle(ctx context.Context, command *CreatePaymentIntent) (*dtos.CreatePaymentIntentResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CreatePaymentIntentHandler.Handle")
+	span.LogFields(log.String("OrderId", command.OrderId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	if !command.Amount.IsPositive() {
+		return nil, tracing.TraceWithErr(span, customErrors.NewBadRequestError(fmt.Sprintf("payment amount should be positive, amount: %s", command.Amount)))
+	}
+
+	existing, err := c.repository.GetPaymentIntentByIdempotencyKey(ctx, command.IdempotencyKey)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[CreatePaymentIntentHandler_Handle.GetPaymentIntentByIdempotencyKey] error in loading the payment intent of the idempotency key"))
+	}
+	if existing != nil {
+		return c.existingResponse(existing, command)
+	}
+
+	result, err := c.paymentGateway.Authorize(ctx, &contracts.AuthorizeRequest{
+		IdempotencyKey: command.IdempotencyKey,
+		OrderId:        command.OrderId,
+		Amount:         command.Amount,
+		PaymentMethod:  command.PaymentMethod,
+	})
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[CreatePaymentIntentHandler_Handle.Authorize] error in authorizing the payment in the gateway"))
+	}
+
+	// a declined authorization doesn't cancel the order, the customer can pay it by another intent
+	paymentIntent := &models.PaymentIntent{
+		PaymentIntentId:  command.PaymentIntentId,
+		OrderId:          command.OrderId,
+		Amount:           command.Amount,
+		PaymentMethod:    command.PaymentMethod,
+		Status:           models.PaymentAuthorized,
+		GatewayReference: result.Reference,
+		IdempotencyKey:   command.IdempotencyKey,
+		CreatedAt:        time.Now(),
+	}
+	if !result.Approved {
+		paymentIntent.Status = models.PaymentFailed
+		paymentIntent.FailureReason = result.DeclineReason
+	}
+
+	createdIntent, err := c.repository.CreatePaymentIntent(ctx, paymentIntent)
+	if err != nil {
+		// a concurrent request with the same key may have created the intent in the meantime
+		existing, getErr := c.repository.GetPaymentIntentByIdempotencyKey(ctx, command.IdempotencyKey)
+		if getErr == nil && existing != nil {
+			return c.existingResponse(existing, command)
+		}
+
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[CreatePaymentIntentHandler_Handle.CreatePaymentIntent] error in creating the payment intent in the repository"))
+	}
+
+	paymentIntentDto, err := mapper.Map[*dto.PaymentIntentDto](createdIntent)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[CreatePaymentIntentHandler_Handle.Map] error in the mapping payment intent"))
+	}
+
+	c.log.Infow(fmt.Sprintf("[CreatePaymentIntentHandler.Handle] payment intent with id '%s' of order '%s' is %s", createdIntent.PaymentIntentId, command.OrderId, createdIntent.Status), logger.Fields{"PaymentIntentId": createdIntent.PaymentIntentId, "OrderId": command.OrderId})
+
+	return &dtos.CreatePaymentIntentResponseDto{PaymentIntent: paymentIntentDto}, nil
+}
+
+// existingResponse returns the intent of a retried request, the key of another request is rejected so it can't return a wrong payment
+func (c *CreatePaymentIntentHandler) existingResponse(existing *models.PaymentIntent, command *CreatePaymentIntent) (*dtos.CreatePaymentIntentResponseDto, error) {
+	amountCmp, err := existing.Amount.Compare(command.Amount)
+	if err != nil || amountCmp != 0 || existing.OrderId != command.OrderId || existing.PaymentMethod != command.PaymentMethod {
+		return nil, exceptions.NewIdempotencyKeyConflictError(fmt.Sprintf("idempotency key '%s' is already used by another payment intent", command.IdempotencyKey))
+	}
+
+	paymentIntentDto, err := mapper.Map[*dto.PaymentIntentDto](existing)
+	if err != nil {
+		return nil, errors.WrapIf(err, "[CreatePaymentIntentHandler_existingResponse.Map] error in the mapping payment intent")
+	}
+
+	c.log.Infow(fmt.Sprintf("[CreatePaymentIntentHandler.Handle] payment intent with id '%s' is already created by the idempotency key", existing.PaymentIntentId), logger.Fields{"PaymentIntentId": existing.PaymentIntentId, "OrderId": existing.OrderId})
+
+	return &dtos.CreatePaymentIntentResponseDto{PaymentIntent: paymentIntentDto}, nil
+}
@@ -0,0 +1,13 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+// CreatePaymentIntentRequestDto validation will handle in command level, the idempotency key is read from the `Idempotency-Key` header
+type CreatePaymentIntentRequestDto struct {
+	OrderId       uuid.UUID    `json:"orderId"`
+	Amount        domain.Money `json:"amount"`
+	PaymentMethod string       `json:"paymentMethod"`
+}
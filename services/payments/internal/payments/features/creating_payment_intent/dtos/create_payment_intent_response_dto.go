@@ -0,0 +1,7 @@
+package dtos
+
+import "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/dto"
+
+type CreatePaymentIntentResponseDto struct {
+	PaymentIntent *dto.PaymentIntentDto `json:"paymentIntent"`
+}
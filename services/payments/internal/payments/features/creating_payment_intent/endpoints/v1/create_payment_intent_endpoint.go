@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/consts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/creating_payment_intent/commands/v1"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/delivery"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/creating_payment_intent/dtos"
+)
+
+type createPaymentIntentEndpoint struct {
+	*delivery.PaymentEndpointBase
+}
+
+func NewCreatePaymentIntentEndpoint(endpointBase *delivery.PaymentEndpointBase) *createPaymentIntentEndpoint {
+	return &createPaymentIntentEndpoint{endpointBase}
+}
+
+func (ep *createPaymentIntentEndpoint) MapRoute() {
+	ep.PaymentsGroup.POST("", ep.handler())
+}
+
+// CreatePaymentIntent
+// @Tags Payments
+// @Summary Create payment intent
+// @Description Create a payment intent of an order and authorize its amount
+// @Accept json
+// @Produce json
+// @Param Idempotency-Key header string true "Client generated key, the retried requests with the same key return the same intent"
+// @Param CreatePaymentIntentRequestDto body dtos.CreatePaymentIntentRequestDto true "Payment intent data"
+// @Success 201 {object} dtos.CreatePaymentIntentResponseDto
+// @Router /api/v1/payments [post]
+func (ep *createPaymentIntentEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.CreatePaymentIntentHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "createPaymentIntentEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.CreatePaymentIntentRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[createPaymentIntentEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[createPaymentIntentEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := v1.NewCreatePaymentIntent(c.Request().Header.Get(consts.IdempotencyKeyHeader), request.OrderId, request.Amount, request.PaymentMethod)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[createPaymentIntentEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[createPaymentIntentEndpoint_handler.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*v1.CreatePaymentIntent, *dtos.CreatePaymentIntentResponseDto](ctx, command)
+
+		if err != nil {
+			err = errors.WithMessage(err, "[createPaymentIntentEndpoint_handler.Send] error in sending CreatePaymentIntent")
+			ep.Log.Errorw(fmt.Sprintf("[createPaymentIntentEndpoint_handler.Send] id: {%s}, err: {%v}", command.OrderId, tracing.TraceWithErr(span, err)), logger.Fields{"OrderId": command.OrderId})
+			return err
+		}
+
+		return c.JSON(http.StatusCreated, result)
+	}
+}
@@ -0,0 +1,8 @@
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// GetPaymentIntentByIdRequestDto validation will handle in query level
+type GetPaymentIntentByIdRequestDto struct {
+	PaymentIntentId uuid.UUID `param:"id" json:"-"`
+}
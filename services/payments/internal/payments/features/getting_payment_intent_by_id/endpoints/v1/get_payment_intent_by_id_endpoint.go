@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/getting_payment_intent_by_id/queries/v1"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/delivery"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/getting_payment_intent_by_id/dtos"
+)
+
+type getPaymentIntentByIdEndpoint struct {
+	*delivery.PaymentEndpointBase
+}
+
+func NewGetPaymentIntentByIdEndpoint(endpointBase *delivery.PaymentEndpointBase) *getPaymentIntentByIdEndpoint {
+	return &getPaymentIntentByIdEndpoint{endpointBase}
+}
+
+func (ep *getPaymentIntentByIdEndpoint) MapRoute() {
+	ep.PaymentsGroup.GET("/:id", ep.handler())
+}
+
+// GetPaymentIntentById
+// @Tags Payments
+// @Summary Get payment intent by id
+// @Description Get payment intent by id
+// @Accept json
+// @Produce json
+// @Param id path string true "Payment Intent ID"
+// @Success 200 {object} dtos.GetPaymentIntentByIdResponseDto
+// @Router /api/v1/payments/{id} [get]
+func (ep *getPaymentIntentByIdEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.GetPaymentIntentByIdHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "getPaymentIntentByIdEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.GetPaymentIntentByIdRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[getPaymentIntentByIdEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[getPaymentIntentByIdEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		query := v1.NewGetPaymentIntentById(request.PaymentIntentId)
+		if err := ep.Validator.StructCtx(ctx, query); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[getPaymentIntentByIdEndpoint_handler.StructCtx] query validation failed")
+			ep.Log.Errorf("[getPaymentIntentByIdEndpoint_handler.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr))
+			return validationErr
+		}
+
+		queryResult, err := mediatr.Send[*v1.GetPaymentIntentById, *dtos.GetPaymentIntentByIdResponseDto](ctx, query)
+
+		if err != nil {
+			err = errors.WithMessage(err, "[getPaymentIntentByIdEndpoint_handler.Send] error in sending GetPaymentIntentById")
+			ep.Log.Errorw(fmt.Sprintf("[getPaymentIntentByIdEndpoint_handler.Send] id: {%s}, err: {%v}", query.PaymentIntentId, tracing.TraceWithErr(span, err)), logger.Fields{"PaymentIntentId": query.PaymentIntentId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, queryResult)
+	}
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	uuid "github.com/satori/go.uuid"
+)
+
+type GetPaymentIntentById struct {
+	PaymentIntentId uuid.UUID `validate:"required"`
+}
+
+func NewGetPaymentIntentById(paymentIntentId uuid.UUID) *GetPaymentIntentById {
+	return &GetPaymentIntentById{PaymentIntentId: paymentIntentId}
+}
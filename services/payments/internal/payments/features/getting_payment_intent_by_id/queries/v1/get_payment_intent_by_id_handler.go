@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/dto"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/getting_payment_intent_by_id/dtos"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type GetPaymentIntentByIdHandler struct {
+	log        logger.Logger
+	cfg        *config.Config
+	repository contracts.PaymentIntentRepository
+}
+
+func NewGetPaymentIntentByIdHandler(log logger.Logger, cfg *config.Config, repository contracts.PaymentIntentRepository) *GetPaymentIntentByIdHandler {
+	return &GetPaymentIntentByIdHandler{log: log, cfg: cfg, repository: repository}
+}
+
+func (q *GetPaymentIntentByIdHandler) Handle(ctx context.Context, query *GetPaymentIntentById) (*dtos.GetPaymentIntentByIdResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetPaymentIntentByIdHandler.Handle")
+	span.LogFields(log.String("PaymentIntentId", query.PaymentIntentId.String()))
+	span.LogFields(log.Object("Query", query))
+	defer span.Finish()
+
+	paymentIntent, err := q.repository.GetPaymentIntentById(ctx, query.PaymentIntentId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, fmt.Sprintf("[GetPaymentIntentByIdHandler_Handle.GetPaymentIntentById] error in getting payment intent with id %s in the repository", query.PaymentIntentId)))
+	}
+
+	paymentIntentDto, err := mapper.Map[*dto.PaymentIntentDto](paymentIntent)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[GetPaymentIntentByIdHandler_Handle.Map] error in the mapping payment intent"))
+	}
+
+	q.log.Infow(fmt.Sprintf("[GetPaymentIntentByIdHandler.Handle] payment intent with id: {%s} fetched", query.PaymentIntentId), logger.Fields{"PaymentIntentId": query.PaymentIntentId})
+
+	return &dtos.GetPaymentIntentByIdResponseDto{PaymentIntent: paymentIntentDto}, nil
+}
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+type RefundPayment struct {
+	PaymentRefundId uuid.UUID `validate:"required"`
+	PaymentIntentId uuid.UUID `validate:"required"`
+	IdempotencyKey  string    `validate:"required,max=255"`
+	Amount          domain.Money
+	Reason          string `validate:"lte=1000"`
+}
+
+func NewRefundPayment(paymentIntentId uuid.UUID, idempotencyKey string, amount domain.Money, reason string) *RefundPayment {
+	return &RefundPayment{PaymentRefundId: uuid.NewV4(), PaymentIntentId: paymentIntentId, IdempotencyKey: idempotencyKey, Amount: amount, Reason: reason}
+}
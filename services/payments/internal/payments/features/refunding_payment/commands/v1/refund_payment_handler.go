@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/mapper"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/producer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/dto"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/exceptions"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/dtos"
+	integrationEvents "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/events/integration/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/models"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+type RefundPaymentHandler struct {
+	log              logger.Logger
+	cfg              *config.Config
+	repository       contracts.PaymentIntentRepository
+	paymentGateway   contracts.PaymentGateway
+	rabbitmqProducer producer.Producer
+}
+
+func NewRefundPaymentHandler(log logger.Logger, cfg *config.Config, repository contracts.PaymentIntentRepository, paymentGateway contracts.PaymentGateway, rabbitmqProducer producer.Producer) *RefundPaymentHandler {
+	return &RefundPaymentHandler{log: log, cfg: cfg, repository: repository, paymentGateway: paymentGateway, rabbitmqProducer: rabbitmqProducer}
+}
+
+func (c *RefundPaymentHandler) Handle(ctx context.Context, command *RefundPayment) (*dtos.RefundPaymentResponseDto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RefundPaymentHandler.Handle")
+	span.LogFields(log.String("PaymentIntentId", command.PaymentIntentId.String()))
+	span.LogFields(log.Object("Command", command))
+	defer span.Finish()
+
+	if !command.Amount.IsPositive() {
+		return nil, tracing.TraceWithErr(span, customErrors.NewBadRequestError(fmt.Sprintf("refund amount should be positive, amount: %s", command.Amount)))
+	}
+
+	existing, err := c.repository.GetPaymentRefundByIdempotencyKey(ctx, command.IdempotencyKey)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[RefundPaymentHandler_Handle.GetPaymentRefundByIdempotencyKey] error in loading the refund of the idempotency key"))
+	}
+
+	paymentIntent, err := c.repository.GetPaymentIntentById(ctx, command.PaymentIntentId)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[RefundPaymentHandler_Handle.GetPaymentIntentById] error in loading the payment intent"))
+	}
+
+	if existing != nil {
+		// a retried refund returns the stored refund, so the amount is refunded once
+		amountCmp, err := existing.Amount.Compare(command.Amount)
+		if err != nil || amountCmp != 0 || existing.PaymentIntentId != command.PaymentIntentId {
+			return nil, tracing.TraceWithErr(span, exceptions.NewIdempotencyKeyConflictError(fmt.Sprintf("idempotency key '%s' is already used by another refund", command.IdempotencyKey)))
+		}
+		c.log.Infow(fmt.Sprintf("[RefundPaymentHandler.Handle] refund '%s' is already done by the idempotency key", existing.PaymentRefundId), logger.Fields{"PaymentIntentId": paymentIntent.PaymentIntentId, "PaymentRefundId": existing.PaymentRefundId})
+
+		return c.response(existing, paymentIntent)
+	}
+
+	if paymentIntent.Status != models.PaymentSucceeded && paymentIntent.Status != models.PaymentPartiallyRefunded {
+		return nil, tracing.TraceWithErr(span, exceptions.NewInvalidPaymentStateError(fmt.Sprintf("payment intent '%s' with status '%s' can't be refunded", paymentIntent.PaymentIntentId, paymentIntent.Status)))
+	}
+
+	refundableAmount, err := paymentIntent.RefundableAmount()
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[RefundPaymentHandler_Handle.RefundableAmount] error in calculating the refundable amount"))
+	}
+	refundableCmp, err := command.Amount.Compare(refundableAmount)
+	if err != nil || refundableCmp > 0 {
+		return nil, tracing.TraceWithErr(span, customErrors.NewBadRequestError(fmt.Sprintf("refund amount %s is more than the refundable amount %s of payment intent '%s'", command.Amount, refundableAmount, paymentIntent.PaymentIntentId)))
+	}
+
+	result, err := c.paymentGateway.Refund(ctx, &contracts.RefundRequest{
+		IdempotencyKey:   command.IdempotencyKey,
+		CaptureReference: paymentIntent.GatewayReference,
+		Amount:           command.Amount,
+	})
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[RefundPaymentHandler_Handle.Refund] error in refunding the payment in the gateway"))
+	}
+	if !result.Approved {
+		return nil, tracing.TraceWithErr(span, customErrors.NewBadRequestError(fmt.Sprintf("refund of payment intent '%s' is declined by the gateway: %s", paymentIntent.PaymentIntentId, result.DeclineReason)))
+	}
+
+	paymentIntent.RefundedAmount, err = paymentIntent.RefundedAmount.Add(command.Amount)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[RefundPaymentHandler_Handle.Add] error in calculating the refunded amount"))
+	}
+	fullyRefunded := refundableCmp == 0
+	paymentIntent.Status = models.PaymentPartiallyRefunded
+	if fullyRefunded {
+		paymentIntent.Status = models.PaymentRefunded
+	}
+
+	refund := &models.PaymentRefund{
+		PaymentRefundId:  command.PaymentRefundId,
+		PaymentIntentId:  paymentIntent.PaymentIntentId,
+		Amount:           command.Amount,
+		Reason:           command.Reason,
+		GatewayReference: result.Reference,
+		IdempotencyKey:   command.IdempotencyKey,
+		CreatedAt:        time.Now(),
+	}
+
+	err = c.repository.AddPaymentRefund(ctx, paymentIntent, refund)
+	if exceptions.IsPaymentConcurrencyError(err) {
+		return nil, tracing.TraceWithErr(span, err)
+	}
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[RefundPaymentHandler_Handle.AddPaymentRefund] error in storing the refund in the repository"))
+	}
+
+	paymentRefunded := integrationEvents.NewPaymentRefundedV1(paymentIntent.PaymentIntentId, refund.PaymentRefundId, paymentIntent.OrderId, refund.Amount, fullyRefunded)
+
+	err = c.rabbitmqProducer.Publish(ctx, paymentRefunded, nil)
+	if err != nil {
+		return nil, tracing.TraceWithErr(span, customErrors.NewApplicationErrorWrap(err, "[RefundPaymentHandler_Handle.PublishMessage] error in publishing PaymentRefunded integration event"))
+	}
+
+	c.log.Infow(fmt.Sprintf("[RefundPaymentHandler.Handle] %s of payment intent '%s' refunded", refund.Amount, paymentIntent.PaymentIntentId), logger.Fields{"PaymentIntentId": paymentIntent.PaymentIntentId, "PaymentRefundId": refund.PaymentRefundId, "MessageId": paymentRefunded.MessageId})
+
+	return c.response(refund, paymentIntent)
+}
+
+func (c *RefundPaymentHandler) response(refund *models.PaymentRefund, paymentIntent *models.PaymentIntent) (*dtos.RefundPaymentResponseDto, error) {
+	paymentIntentDto, err := mapper.Map[*dto.PaymentIntentDto](paymentIntent)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(err, "[RefundPaymentHandler_response.Map] error in the mapping payment intent")
+	}
+
+	return &dtos.RefundPaymentResponseDto{PaymentRefundId: refund.PaymentRefundId, PaymentIntent: paymentIntentDto}, nil
+}
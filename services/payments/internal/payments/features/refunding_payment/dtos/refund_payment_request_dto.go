@@ -0,0 +1,13 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+// RefundPaymentRequestDto validation will handle in command level, the idempotency key is read from the `Idempotency-Key` header
+type RefundPaymentRequestDto struct {
+	PaymentIntentId uuid.UUID    `param:"id" json:"-"`
+	Amount          domain.Money `json:"amount"`
+	Reason          string       `json:"reason"`
+}
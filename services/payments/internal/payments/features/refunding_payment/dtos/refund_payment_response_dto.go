@@ -0,0 +1,11 @@
+package dtos
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/dto"
+	uuid "github.com/satori/go.uuid"
+)
+
+type RefundPaymentResponseDto struct {
+	PaymentRefundId uuid.UUID             `json:"paymentRefundId"`
+	PaymentIntent   *dto.PaymentIntentDto `json:"paymentIntent"`
+}
@@ -0,0 +1,72 @@
+package v1
+
+import (
+	"emperror.dev/errors"
+	"fmt"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/consts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/commands/v1"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/delivery"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/dtos"
+)
+
+type refundPaymentEndpoint struct {
+	*delivery.PaymentEndpointBase
+}
+
+func NewRefundPaymentEndpoint(endpointBase *delivery.PaymentEndpointBase) *refundPaymentEndpoint {
+	return &refundPaymentEndpoint{endpointBase}
+}
+
+func (ep *refundPaymentEndpoint) MapRoute() {
+	ep.PaymentsGroup.POST("/:id/refunds", ep.handler())
+}
+
+// RefundPayment
+// @Tags Payments
+// @Summary Refund payment
+// @Description Refund the whole or a part of the captured amount of a payment intent
+// @Accept json
+// @Produce json
+// @Param Idempotency-Key header string true "Client generated key, the retried requests with the same key refund the amount once"
+// @Param RefundPaymentRequestDto body dtos.RefundPaymentRequestDto true "Refund data"
+// @Param id path string true "Payment Intent ID"
+// @Success 200 {object} dtos.RefundPaymentResponseDto
+// @Router /api/v1/payments/{id}/refunds [post]
+func (ep *refundPaymentEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ep.Metrics.RefundPaymentHttpRequests.Inc()
+		ctx, span := tracing.StartHttpServerTracerSpan(c, "refundPaymentEndpoint.handler")
+		defer span.Finish()
+
+		request := &dtos.RefundPaymentRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[refundPaymentEndpoint_handler.Bind] error in the binding request")
+			ep.Log.Errorf(fmt.Sprintf("[refundPaymentEndpoint_handler.Bind] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+			return badRequestErr
+		}
+
+		command := v1.NewRefundPayment(request.PaymentIntentId, c.Request().Header.Get(consts.IdempotencyKeyHeader), request.Amount, request.Reason)
+		if err := ep.Validator.StructCtx(ctx, command); err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(err, "[refundPaymentEndpoint_handler.StructCtx] command validation failed")
+			ep.Log.Errorf(fmt.Sprintf("[refundPaymentEndpoint_handler.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr)))
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*v1.RefundPayment, *dtos.RefundPaymentResponseDto](ctx, command)
+
+		if err != nil {
+			err = errors.WithMessage(err, "[refundPaymentEndpoint_handler.Send] error in sending RefundPayment")
+			ep.Log.Errorw(fmt.Sprintf("[refundPaymentEndpoint_handler.Send] id: {%s}, err: {%v}", command.PaymentIntentId, tracing.TraceWithErr(span, err)), logger.Fields{"PaymentIntentId": command.PaymentIntentId})
+			return err
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
@@ -0,0 +1,15 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+)
+
+// PaymentRejectedV1 is published by the orders service when a captured payment can't pay its order, the captured amount is refunded
+type PaymentRejectedV1 struct {
+	*types.Message
+	PaymentId string       `json:"paymentId"`
+	OrderId   string       `json:"orderId"`
+	Amount    domain.Money `json:"amount"`
+	Reason    string       `json:"reason"`
+}
@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/http_errors/custom_errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/tracing"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/delivery"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/exceptions"
+	refundingPaymentV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/commands/v1"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/dtos"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+type paymentRejectedConsumer struct {
+	*delivery.PaymentConsumersBase
+}
+
+func NewPaymentRejectedConsumer(paymentConsumersBase *delivery.PaymentConsumersBase) *paymentRejectedConsumer {
+	return &paymentRejectedConsumer{paymentConsumersBase}
+}
+
+func (c *paymentRejectedConsumer) Handle(ctx context.Context, consumeContext types.IMessageConsumeContext[*PaymentRejectedV1]) error {
+	if consumeContext.Message() == nil {
+		return nil
+	}
+	span, ctx := opentracing.StartSpanFromContext(ctx, "paymentRejectedConsumer.Handle")
+	span.LogFields(log.Object("Message", consumeContext.Created()))
+	defer span.Finish()
+
+	message := consumeContext.Message()
+
+	paymentId, err := uuid.FromString(message.PaymentId)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(err, "[paymentRejectedConsumer_Handle.uuid.FromString] error in the converting payment uuid")
+		c.Log.Errorf(fmt.Sprintf("[paymentRejectedConsumer_Handle.uuid.FromString] err: %v", tracing.TraceWithErr(span, badRequestErr)))
+		c.CommitErrMessage()
+
+		return badRequestErr
+	}
+
+	// the idempotency key of the payment refunds it once, even when the order rejects the republished events of a retried capture
+	command := refundingPaymentV1.NewRefundPayment(paymentId, fmt.Sprintf("payment_rejected:%s", paymentId), message.Amount, message.Reason)
+	if err := c.Validator.StructCtx(ctx, command); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(err, "[paymentRejectedConsumer_Handle.StructCtx] command validation failed")
+		c.Log.Errorf(fmt.Sprintf("[paymentRejectedConsumer_Handle.StructCtx] err: {%v}", tracing.TraceWithErr(span, validationErr)))
+		c.CommitErrMessage()
+
+		return validationErr
+	}
+
+	_, err = mediatr.Send[*refundingPaymentV1.RefundPayment, *dtos.RefundPaymentResponseDto](ctx, command)
+	if exceptions.IsInvalidPaymentStateError(err) {
+		// a fully refunded payment has nothing left to refund
+		c.Log.Infow(fmt.Sprintf("[paymentRejectedConsumer_Handle.Send] payment intent '%s' is not refunded, %v", paymentId, err), logger.Fields{"PaymentIntentId": paymentId, "OrderId": message.OrderId})
+		c.CommitMessage()
+
+		return nil
+	}
+	if err != nil {
+		err = errors.WithMessage(err, "[paymentRejectedConsumer_Handle.Send] error in sending RefundPayment")
+		c.Log.Errorw(fmt.Sprintf("[paymentRejectedConsumer_Handle.Send] id: {%s}, err: {%v}", paymentId, tracing.TraceWithErr(span, err)), logger.Fields{"PaymentIntentId": paymentId, "OrderId": message.OrderId})
+		c.CommitErrMessage()
+
+		return err
+	}
+	c.CommitMessage()
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mehdihadeli/go-mediatr"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/inmemory"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/test"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/delivery"
+	refundingPaymentV1 "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/commands/v1"
+	refundingPaymentDtos "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/features/refunding_payment/dtos"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/gateways"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/models"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/test_fixtures/integration"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Payment_Rejected_Consumer(t *testing.T) {
+	test.SkipCI(t)
+	fixture := integration.NewIntegrationTestFixture()
+	defer fixture.Cleanup()
+
+	ctx := context.Background()
+	transport := inmemory.NewInMemoryTransport()
+	producer := inmemory.NewInMemoryProducer(transport, fixture.EventSerializer, fixture.Log)
+
+	err := mediatr.RegisterRequestHandler[*refundingPaymentV1.RefundPayment, *refundingPaymentDtos.RefundPaymentResponseDto](refundingPaymentV1.NewRefundPaymentHandler(fixture.Log, fixture.Cfg, fixture.PaymentIntentRepository, gateways.NewFakePaymentGateway(), producer))
+	require.NoError(t, err)
+
+	consumer := inmemory.NewInMemoryConsumer[*PaymentRejectedV1](transport, NewPaymentRejectedConsumer(delivery.NewPaymentConsumersBase(fixture.InfrastructureConfiguration)), fixture.EventSerializer, fixture.Log)
+	require.NoError(t, consumer.Consume(ctx))
+
+	amount := domain.MustParseMoney("25", "USD")
+	paymentIntent, err := fixture.PaymentIntentRepository.CreatePaymentIntent(ctx, &models.PaymentIntent{
+		PaymentIntentId:  uuid.NewV4(),
+		OrderId:          uuid.NewV4(),
+		Amount:           amount,
+		RefundedAmount:   domain.ZeroMoney(amount.Currency),
+		PaymentMethod:    "card",
+		Status:           models.PaymentSucceeded,
+		GatewayReference: "fake_capture",
+		IdempotencyKey:   uuid.NewV4().String(),
+		CreatedAt:        time.Now(),
+	})
+	require.NoError(t, err)
+
+	event := &PaymentRejectedV1{
+		Message:   types.NewMessage(uuid.NewV4().String()),
+		PaymentId: paymentIntent.PaymentIntentId.String(),
+		OrderId:   paymentIntent.OrderId.String(),
+		Amount:    amount,
+		Reason:    "order is canceled",
+	}
+	require.NoError(t, producer.Publish(ctx, event, nil))
+	// a redelivered rejection doesn't refund the payment twice
+	require.NoError(t, producer.Publish(ctx, event, nil))
+
+	refundedIntent, err := fixture.PaymentIntentRepository.GetPaymentIntentById(ctx, paymentIntent.PaymentIntentId)
+	require.NoError(t, err)
+	assert.Equal(t, models.PaymentRefunded, refundedIntent.Status)
+	assert.Equal(t, amount, refundedIntent.RefundedAmount)
+}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// PaymentRefundedV1 is published for each full or partial refund of a captured payment
+type PaymentRefundedV1 struct {
+	*types.Message
+	PaymentId       uuid.UUID    `json:"paymentId"`
+	PaymentRefundId uuid.UUID    `json:"paymentRefundId"`
+	OrderId         uuid.UUID    `json:"orderId"`
+	Amount          domain.Money `json:"amount"`
+	// FullyRefunded is true when the whole captured amount of the payment is refunded
+	FullyRefunded bool `json:"fullyRefunded"`
+}
+
+func NewPaymentRefundedV1(paymentId uuid.UUID, paymentRefundId uuid.UUID, orderId uuid.UUID, amount domain.Money, fullyRefunded bool) *PaymentRefundedV1 {
+	return &PaymentRefundedV1{
+		PaymentId:       paymentId,
+		PaymentRefundId: paymentRefundId,
+		OrderId:         orderId,
+		Amount:          amount,
+		FullyRefunded:   fullyRefunded,
+		Message:         types.NewMessage(uuid.NewV4().String()),
+	}
+}
@@ -0,0 +1,60 @@
+package gateways
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/contracts"
+	uuid "github.com/satori/go.uuid"
+)
+
+// The payment methods which are declined by the fake gateway, the other payment methods are approved
+const (
+	DeclinedPaymentMethod          = "tok_declined"
+	InsufficientFundsPaymentMethod = "tok_insufficient_funds"
+	CaptureDeclinedPaymentMethod   = "tok_capture_declined"
+)
+
+// fakePaymentGateway is the stand-in of a payment provider for the local environments and the tests. It keeps no state, the decision
+// depends only on the payment method and the references are derived from the idempotency keys, so a retried request gets the same result
+// even after a restart.
+type fakePaymentGateway struct{}
+
+func NewFakePaymentGateway() contracts.PaymentGateway {
+	return &fakePaymentGateway{}
+}
+
+func (g *fakePaymentGateway) Authorize(ctx context.Context, request *contracts.AuthorizeRequest) (*contracts.GatewayResult, error) {
+	switch request.PaymentMethod {
+	case DeclinedPaymentMethod:
+		return declined("auth", request.IdempotencyKey, "card declined"), nil
+	case InsufficientFundsPaymentMethod:
+		return declined("auth", request.IdempotencyKey, "insufficient funds"), nil
+	default:
+		return approved("auth", request.IdempotencyKey), nil
+	}
+}
+
+func (g *fakePaymentGateway) Capture(ctx context.Context, request *contracts.CaptureRequest) (*contracts.GatewayResult, error) {
+	if request.PaymentMethod == CaptureDeclinedPaymentMethod {
+		return declined("capture", request.IdempotencyKey, "capture declined by the issuer"), nil
+	}
+
+	return approved("capture", request.IdempotencyKey), nil
+}
+
+func (g *fakePaymentGateway) Refund(ctx context.Context, request *contracts.RefundRequest) (*contracts.GatewayResult, error) {
+	return approved("refund", request.IdempotencyKey), nil
+}
+
+func approved(operation string, idempotencyKey string) *contracts.GatewayResult {
+	return &contracts.GatewayResult{Reference: reference(operation, idempotencyKey), Approved: true}
+}
+
+func declined(operation string, idempotencyKey string, reason string) *contracts.GatewayResult {
+	return &contracts.GatewayResult{Reference: reference(operation, idempotencyKey), DeclineReason: reason}
+}
+
+func reference(operation string, idempotencyKey string) string {
+	return fmt.Sprintf("fake_%s_%s", operation, uuid.NewV5(uuid.NamespaceOID, idempotencyKey))
+}
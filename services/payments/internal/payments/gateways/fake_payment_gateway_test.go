@@ -0,0 +1,61 @@
+package gateways
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/contracts"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Fake_Payment_Gateway(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewFakePaymentGateway()
+	amount := domain.MustParseMoney("25", "USD")
+
+	authorize := func(idempotencyKey string, paymentMethod string) *contracts.GatewayResult {
+		result, err := gateway.Authorize(ctx, &contracts.AuthorizeRequest{IdempotencyKey: idempotencyKey, OrderId: uuid.NewV4(), Amount: amount, PaymentMethod: paymentMethod})
+		require.NoError(t, err)
+
+		return result
+	}
+
+	t.Run("authorization is approved", func(t *testing.T) {
+		result := authorize("key-1", "tok_visa")
+		assert.True(t, result.Approved)
+		assert.Empty(t, result.DeclineReason)
+		assert.NotEmpty(t, result.Reference)
+	})
+
+	t.Run("retried request gets the same result", func(t *testing.T) {
+		assert.Equal(t, authorize("key-2", "tok_visa"), authorize("key-2", "tok_visa"))
+		assert.NotEqual(t, authorize("key-2", "tok_visa").Reference, authorize("key-3", "tok_visa").Reference)
+	})
+
+	t.Run("declined payment methods", func(t *testing.T) {
+		for _, paymentMethod := range []string{DeclinedPaymentMethod, InsufficientFundsPaymentMethod} {
+			result := authorize("key-4", paymentMethod)
+			assert.False(t, result.Approved)
+			assert.NotEmpty(t, result.DeclineReason)
+		}
+	})
+
+	t.Run("capture is declined", func(t *testing.T) {
+		auth := authorize("key-5", CaptureDeclinedPaymentMethod)
+		require.True(t, auth.Approved)
+
+		result, err := gateway.Capture(ctx, &contracts.CaptureRequest{IdempotencyKey: "key-6", AuthorizationReference: auth.Reference, Amount: amount, PaymentMethod: CaptureDeclinedPaymentMethod})
+		require.NoError(t, err)
+		assert.False(t, result.Approved)
+		assert.NotEmpty(t, result.DeclineReason)
+	})
+
+	t.Run("refund is approved", func(t *testing.T) {
+		result, err := gateway.Refund(ctx, &contracts.RefundRequest{IdempotencyKey: "key-7", CaptureReference: "fake_capture", Amount: amount})
+		require.NoError(t, err)
+		assert.True(t, result.Approved)
+	})
+}
@@ -0,0 +1,21 @@
+package gateways
+
+import (
+	"emperror.dev/errors"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/contracts"
+)
+
+const FakePaymentGatewayType = "fake"
+
+// NewPaymentGateway creates the gateway of the configured type, the real providers are added here with their own type
+func NewPaymentGateway(cfg config.PaymentGateway, log logger.Logger) (contracts.PaymentGateway, error) {
+	switch cfg.Type {
+	case FakePaymentGatewayType, "":
+		log.Warnf("[NewPaymentGateway] %s payment gateway is used, the payments are not sent to a payment provider", FakePaymentGatewayType)
+		return NewFakePaymentGateway(), nil
+	default:
+		return nil, errors.Errorf("payment gateway type %q is not supported", cfg.Type)
+	}
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/serializer/jsonSerializer"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+const (
+	PaymentAuthorized        = "authorized"
+	PaymentSucceeded         = "succeeded"
+	PaymentFailed            = "failed"
+	PaymentPartiallyRefunded = "partially_refunded"
+	PaymentRefunded          = "refunded"
+)
+
+// PaymentIntent is the payment of an order, its amount is authorized by the gateway when it is created and it succeeds when the amount is
+// captured. The IdempotencyKey of the client which created it is unique, so a retried request returns the same intent
+type PaymentIntent struct {
+	PaymentIntentId  uuid.UUID    `json:"paymentIntentId" gorm:"primaryKey"`
+	OrderId          uuid.UUID    `json:"orderId" gorm:"index"`
+	Amount           domain.Money `json:"amount" gorm:"embedded;embeddedPrefix:amount_"`
+	RefundedAmount   domain.Money `json:"refundedAmount" gorm:"embedded;embeddedPrefix:refunded_amount_"`
+	PaymentMethod    string       `json:"paymentMethod"`
+	Status           string       `json:"status"`
+	GatewayReference string       `json:"gatewayReference"`
+	FailureReason    string       `json:"failureReason"`
+	IdempotencyKey   string       `json:"idempotencyKey" gorm:"uniqueIndex"`
+	// Version is incremented by each update, the intent is updated only when the version is not changed since it is loaded
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// RefundableAmount is the captured amount which is not refunded yet
+func (p *PaymentIntent) RefundableAmount() (domain.Money, error) {
+	return p.Amount.Subtract(p.RefundedAmount)
+}
+
+func (p *PaymentIntent) String() string {
+	return jsonSerializer.PrettyPrint(p)
+}
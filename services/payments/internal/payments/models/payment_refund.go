@@ -0,0 +1,19 @@
+package models
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/domain"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// PaymentRefund is a full or partial refund of a succeeded payment intent, a retried refund request with the same IdempotencyKey returns
+// the stored refund instead of refunding the amount again
+type PaymentRefund struct {
+	PaymentRefundId  uuid.UUID    `json:"paymentRefundId" gorm:"primaryKey"`
+	PaymentIntentId  uuid.UUID    `json:"paymentIntentId" gorm:"index"`
+	Amount           domain.Money `json:"amount" gorm:"embedded;embeddedPrefix:amount_"`
+	Reason           string       `json:"reason"`
+	GatewayReference string       `json:"gatewayReference"`
+	IdempotencyKey   string       `json:"idempotencyKey" gorm:"uniqueIndex"`
+	CreatedAt        time.Time    `json:"createdAt"`
+}
@@ -0,0 +1,95 @@
+package infrastructure
+
+import (
+	"context"
+	"github.com/go-playground/validator"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/json"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/msgpack"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/core/serializer/protobuf"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/gormPostgres"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/consumer"
+	messageRegistry "github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/message_registry"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/messaging/producer"
+	rabbitmqProducer "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/producer"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/producer/options"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/types"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/config"
+)
+
+type InfrastructureConfiguration struct {
+	Log                logger.Logger
+	Cfg                *config.Config
+	Validator          *validator.Validate
+	Gorm               *gormPostgres.Gorm
+	Metrics            *PaymentsServiceMetrics
+	RabbitMQConnection types.IConnection
+	EventSerializer    serializer.EventSerializer
+	MessageRegistry    *messageRegistry.MessageRegistry
+	Producer           producer.Producer
+	Consumers          []consumer.Consumer
+}
+
+type InfrastructureConfigurator interface {
+	ConfigInfrastructures(ctx context.Context) (*InfrastructureConfiguration, error, func())
+}
+
+type infrastructureConfigurator struct {
+	log logger.Logger
+	cfg *config.Config
+}
+
+func NewInfrastructureConfigurator(log logger.Logger, cfg *config.Config) InfrastructureConfigurator {
+	return &infrastructureConfigurator{log: log, cfg: cfg}
+}
+
+func (ic *infrastructureConfigurator) ConfigInfrastructures(ctx context.Context) (*InfrastructureConfiguration, error, func()) {
+	infrastructure := &InfrastructureConfiguration{Cfg: ic.cfg, Log: ic.log, Validator: validator.New()}
+
+	metrics := ic.configPaymentsMetrics()
+	infrastructure.Metrics = metrics
+
+	var cleanup []func()
+
+	gorm, err := ic.configGorm()
+	if err != nil {
+		return nil, err, nil
+	}
+	infrastructure.Gorm = gorm
+
+	err, jaegerCleanup := ic.configJaeger()
+	if err != nil {
+		return nil, err, nil
+	}
+	cleanup = append(cleanup, jaegerCleanup)
+
+	// json is the default content type, the other serializers are negotiated by the message content type
+	infrastructure.EventSerializer = serializer.NewEventSerializerRegistry(json.NewJsonEventSerializer(), protobuf.NewProtobufEventSerializer(), msgpack.NewMsgPackEventSerializer())
+
+	connection, err := types.NewRabbitMQConnection(ctx, ic.cfg.RabbitMQ)
+	if err != nil {
+		return nil, err, nil
+	}
+	infrastructure.RabbitMQConnection = connection
+	cleanup = append(cleanup, func() {
+		_ = connection.Close()
+	})
+
+	// message contracts are registered by the modules, the producer stamps their wire names into the message type
+	infrastructure.MessageRegistry = messageRegistry.NewMessageRegistry()
+
+	mqProducer, err := rabbitmqProducer.NewRabbitMQProducer(connection, func(builder *options.RabbitMQProducerOptionsBuilder) {
+		builder.WithMessageRegistry(infrastructure.MessageRegistry)
+	}, ic.log, infrastructure.EventSerializer)
+	if err != nil {
+		return nil, err, nil
+	}
+	infrastructure.Producer = mqProducer
+
+	return infrastructure, nil, func() {
+		for _, c := range cleanup {
+			c()
+		}
+	}
+}
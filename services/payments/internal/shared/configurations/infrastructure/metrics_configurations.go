@@ -0,0 +1,62 @@
+package infrastructure
+
+import (
+	"fmt"
+	rabbitmqMetrics "github.com/mehdihadeli/store-golang-microservice-sample/pkg/rabbitmq/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+type PaymentsServiceMetrics struct {
+	CreatePaymentIntentHttpRequests  prometheus.Counter
+	CapturePaymentHttpRequests       prometheus.Counter
+	RefundPaymentHttpRequests        prometheus.Counter
+	GetPaymentIntentByIdHttpRequests prometheus.Counter
+
+	SuccessHttpRequests prometheus.Counter
+	ErrorHttpRequests   prometheus.Counter
+
+	SuccessKafkaMessages prometheus.Counter
+	ErrorKafkaMessages   prometheus.Counter
+
+	RabbitMQ *rabbitmqMetrics.RabbitMQMetrics
+}
+
+func (ic *infrastructureConfigurator) configPaymentsMetrics() *PaymentsServiceMetrics {
+	cfg := ic.cfg
+	return &PaymentsServiceMetrics{
+		CreatePaymentIntentHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_create_payment_intent_http_requests_total", cfg.ServiceName),
+			Help: "The total number of create payment intent http requests",
+		}),
+		CapturePaymentHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_capture_payment_http_requests_total", cfg.ServiceName),
+			Help: "The total number of capture payment http requests",
+		}),
+		RefundPaymentHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_refund_payment_http_requests_total", cfg.ServiceName),
+			Help: "The total number of refund payment http requests",
+		}),
+		GetPaymentIntentByIdHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_get_payment_intent_by_id_http_requests_total", cfg.ServiceName),
+			Help: "The total number of get payment intent by id http requests",
+		}),
+		SuccessHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_success_http_requests_total", cfg.ServiceName),
+			Help: "The total number of success http requests",
+		}),
+		ErrorHttpRequests: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_error_http_requests_total", cfg.ServiceName),
+			Help: "The total number of error http requests",
+		}),
+		SuccessKafkaMessages: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_success_kafka_processed_messages_total", cfg.ServiceName),
+			Help: "The total number of success kafka processed messages",
+		}),
+		ErrorKafkaMessages: promauto.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_error_kafka_processed_messages_total", cfg.ServiceName),
+			Help: "The total number of error kafka processed messages",
+		}),
+		RabbitMQ: rabbitmqMetrics.NewRabbitMQMetrics(cfg.ServiceName),
+	}
+}
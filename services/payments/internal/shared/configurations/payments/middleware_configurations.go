@@ -0,0 +1,23 @@
+package payments
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/configurations/infrastructure"
+	customMiddlewares "github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/web/middlewares"
+)
+
+func (c *paymentsServiceConfigurator) configMiddlewares(metrics *infrastructure.PaymentsServiceMetrics) {
+	c.echoServer.SetupDefaultMiddlewares()
+
+	middlewares := customMiddlewares.NewCustomMiddlewares(c.Log, c.Cfg, getHttpMetricsCb(metrics))
+	c.echoServer.AddMiddlewares(middlewares.RequestMetricsMiddleware)
+}
+
+func getHttpMetricsCb(metrics *infrastructure.PaymentsServiceMetrics) func(err error) {
+	return func(err error) {
+		if err != nil {
+			metrics.ErrorHttpRequests.Inc()
+		} else {
+			metrics.SuccessHttpRequests.Inc()
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package payments
+
+import (
+	"context"
+	"emperror.dev/errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/configurations/payments_module"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/configurations/infrastructure"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/web"
+	"net/http"
+)
+
+type paymentsServiceConfigurator struct {
+	*infrastructure.InfrastructureConfiguration
+	echoServer customEcho.EchoHttpServer
+}
+
+func NewPaymentsServiceConfigurator(infrastructureConfiguration *infrastructure.InfrastructureConfiguration, echoServer customEcho.EchoHttpServer) *paymentsServiceConfigurator {
+	return &paymentsServiceConfigurator{echoServer: echoServer, InfrastructureConfiguration: infrastructureConfiguration}
+}
+
+func (c *paymentsServiceConfigurator) ConfigurePaymentsService(ctx context.Context) error {
+	pc := payments_module.NewPaymentsModuleConfigurator(c.InfrastructureConfiguration, c.echoServer)
+	err := pc.ConfigurePaymentsModule(ctx)
+	if err != nil {
+		return errors.WithMessage(err, "[PaymentsServiceConfigurator_ConfigurePaymentsService.ConfigurePaymentsModule] error in payments module configurator")
+	}
+
+	err = c.migratePayments()
+	if err != nil {
+		return errors.WithMessage(err, "[PaymentsServiceConfigurator_ConfigurePaymentsService.migratePayments] error in migratePayments")
+	}
+
+	c.configMiddlewares(c.Metrics)
+
+	c.echoServer.GetEchoInstance().GET("", func(ec echo.Context) error {
+		return ec.String(http.StatusOK, fmt.Sprintf("%s is running...", web.GetMicroserviceName(c.Cfg)))
+	})
+
+	return nil
+}
@@ -0,0 +1,10 @@
+package payments
+
+import (
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/models"
+)
+
+func (c *paymentsServiceConfigurator) migratePayments() error {
+	// or we could use the sql migrations of the migrations folder
+	return c.Gorm.DB.AutoMigrate(&models.PaymentIntent{}, &models.PaymentRefund{})
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	customEcho "github.com/mehdihadeli/store-golang-microservice-sample/pkg/http/custom_echo"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger"
+	webWoker "github.com/mehdihadeli/store-golang-microservice-sample/pkg/web"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/configurations/infrastructure"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/configurations/payments"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/constants"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/web"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/web/workers"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+type Server struct {
+	log    logger.Logger
+	cfg    *config.Config
+	doneCh chan struct{}
+}
+
+func NewServer(log logger.Logger, cfg *config.Config) *Server {
+	return &Server{log: log, cfg: cfg, doneCh: make(chan struct{})}
+}
+
+func (s *Server) Run() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	echoServer := customEcho.NewEchoHttpServer(s.cfg.Http, s.log)
+
+	ic := infrastructure.NewInfrastructureConfigurator(s.log, s.cfg)
+	infrastructureConfigurations, err, infraCleanup := ic.ConfigInfrastructures(ctx)
+	if err != nil {
+		return err
+	}
+	defer infraCleanup()
+
+	paymentsConfigurator := payments.NewPaymentsServiceConfigurator(infrastructureConfigurations, echoServer)
+	err = paymentsConfigurator.ConfigurePaymentsService(ctx)
+	if err != nil {
+		return err
+	}
+
+	var serverError error
+
+	go func() {
+		if err := echoServer.RunHttpServer(nil); err != nil {
+			s.log.Errorf("(s.RunHttpServer) err: {%v}", err)
+			serverError = err
+			cancel()
+		}
+	}()
+	s.log.Infof("%s is listening on Http PORT: {%s}", web.GetMicroserviceName(s.cfg), s.cfg.Http.Port)
+
+	backgroundWorkers := webWoker.NewWorkersRunner([]webWoker.Worker{
+		workers.NewRabbitMQWorkerWorker(infrastructureConfigurations), workers.NewMetricsWorker(infrastructureConfigurations),
+	})
+
+	workersErr := backgroundWorkers.Start(ctx)
+	go func() {
+		for {
+			select {
+			case e := <-workersErr:
+				serverError = e
+				cancel()
+				return
+			}
+		}
+	}()
+
+	<-ctx.Done()
+	s.waitForShootDown(constants.WaitShotDownDuration)
+
+	s.log.Infof("%s is shutting down Http PORT: {%s}", web.GetMicroserviceName(s.cfg), s.cfg.Http.Port)
+	if err := echoServer.GracefulShutdown(ctx); err != nil {
+		s.log.Warnf("(Shutdown) err: {%v}", err)
+	}
+
+	backgroundWorkers.Stop(ctx)
+
+	<-s.doneCh
+	s.log.Infof("%s server exited properly", web.GetMicroserviceName(s.cfg))
+
+	return serverError
+}
+
+func (s *Server) waitForShootDown(duration time.Duration) {
+	go func() {
+		time.Sleep(duration)
+		s.doneCh <- struct{}{}
+	}()
+}
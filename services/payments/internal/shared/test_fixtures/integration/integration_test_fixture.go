@@ -0,0 +1,47 @@
+package integration
+
+import (
+	"context"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/constants"
+	"github.com/mehdihadeli/store-golang-microservice-sample/pkg/logger/defaultLogger"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/config"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/configurations/mappings"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/contracts"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/payments/data/repositories"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/internal/shared/configurations/infrastructure"
+)
+
+type IntegrationTestFixture struct {
+	*infrastructure.InfrastructureConfiguration
+	PaymentIntentRepository contracts.PaymentIntentRepository
+	ctx                     context.Context
+	cancel                  context.CancelFunc
+	Cleanup                 func()
+}
+
+func NewIntegrationTestFixture() *IntegrationTestFixture {
+	cfg, _ := config.InitConfig(constants.Test)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := infrastructure.NewInfrastructureConfigurator(defaultLogger.Logger, cfg)
+	infrastructures, _, cleanup := c.ConfigInfrastructures(context.Background())
+
+	paymentIntentRep := repositories.NewPostgresPaymentIntentRepository(infrastructures.Log, cfg, infrastructures.Gorm.DB)
+
+	err := mappings.ConfigureMappings()
+	if err != nil {
+		cancel()
+		return nil
+	}
+
+	return &IntegrationTestFixture{
+		Cleanup: func() {
+			cancel()
+			cleanup()
+		},
+		InfrastructureConfiguration: infrastructures,
+		PaymentIntentRepository:     paymentIntentRep,
+		ctx:                         ctx,
+		cancel:                      cancel,
+	}
+}
@@ -0,0 +1,11 @@
+package web
+
+import (
+	"fmt"
+	"github.com/mehdihadeli/store-golang-microservice-sample/services/payments/config"
+	"strings"
+)
+
+func GetMicroserviceName(cfg *config.Config) string {
+	return fmt.Sprintf("(%s)", strings.ToUpper(cfg.ServiceName))
+}
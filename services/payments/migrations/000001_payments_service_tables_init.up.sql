@@ -0,0 +1,38 @@
+-- https://github.com/golang-migrate/migrate/blob/master/MIGRATIONS.md
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE payment_intents
+(
+    payment_intent_id        UUID PRIMARY KEY         DEFAULT uuid_generate_v4(),
+    order_id                 UUID         NOT NULL,
+    amount_amount            NUMERIC      NOT NULL,
+    amount_currency          CHAR(3)      NOT NULL,
+    refunded_amount_amount   NUMERIC      NOT NULL DEFAULT 0,
+    refunded_amount_currency CHAR(3)      NOT NULL DEFAULT '',
+    payment_method           VARCHAR(250) NOT NULL,
+    status                   VARCHAR(50)  NOT NULL,
+    gateway_reference        VARCHAR(250) NOT NULL DEFAULT '',
+    failure_reason           VARCHAR(1000) NOT NULL DEFAULT '',
+    idempotency_key          VARCHAR(255) NOT NULL,
+    version                  INTEGER      NOT NULL DEFAULT 0,
+    created_at               TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at               TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_payment_intents_order_id ON payment_intents (order_id);
+CREATE UNIQUE INDEX idx_payment_intents_idempotency_key ON payment_intents (idempotency_key);
+
+CREATE TABLE payment_refunds
+(
+    payment_refund_id UUID PRIMARY KEY         DEFAULT uuid_generate_v4(),
+    payment_intent_id UUID         NOT NULL REFERENCES payment_intents (payment_intent_id),
+    amount_amount     NUMERIC      NOT NULL,
+    amount_currency   CHAR(3)      NOT NULL,
+    reason            VARCHAR(1000) NOT NULL DEFAULT '',
+    gateway_reference VARCHAR(250) NOT NULL DEFAULT '',
+    idempotency_key   VARCHAR(255) NOT NULL,
+    created_at        TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_payment_refunds_payment_intent_id ON payment_refunds (payment_intent_id);
+CREATE UNIQUE INDEX idx_payment_refunds_idempotency_key ON payment_refunds (idempotency_key);